@@ -35,3 +35,4 @@ echo "Building generator"
 cd "${BASE_DIR}/generator"
 go generate ./...
 GOFLAGS="-buildvcs=false" go build -o build/generator
+build/generator selftest
@@ -34,24 +34,28 @@ trap 'onError' ERR
 
 cd "${BASE_DIR}"
 
+echo "Auditing the markers and serialization tags of the K8S API source code"
+
+generator/build/generator audit "paths=./pkg/apis/workspaces/v1alpha2;./pkg/apis/workspaces/v1alpha1;./pkg/devfile"
+
 # We have to generate plugin overrides before generating parent overrides, as the parent overrides
 # require the overrides generated for plugins
 
 echo "Generating Plugin Overrides"
 
-generator/build/generator "overrides:isForPluginOverrides=true" "paths=./pkg/apis/workspaces/v1alpha2"
+generator/build/generator --header-file generator/header.go.txt "overrides:isForPluginOverrides=true" "paths=./pkg/apis/workspaces/v1alpha2"
 
 echo "Generating Parent Overrides"
 
-generator/build/generator "overrides:isForPluginOverrides=false" "paths=./pkg/apis/workspaces/v1alpha2"
+generator/build/generator --header-file generator/header.go.txt "overrides:isForPluginOverrides=false" "paths=./pkg/apis/workspaces/v1alpha2"
 
 echo "Validating K8S API Source code"
 
-generator/build/generator "validate" "paths=./pkg/apis/workspaces/v1alpha2"
+generator/build/generator --header-file generator/header.go.txt "validate:runtimeChecks=true" "paths=./pkg/apis/workspaces/v1alpha2"
 
 echo "Generating Interface Implementations"
 
-generator/build/generator "interfaces" "paths=./pkg/apis/workspaces/v1alpha2"
+generator/build/generator --header-file generator/header.go.txt "interfaces" "paths=./pkg/apis/workspaces/v1alpha2"
 
 echo "Generating K8S CRDs"
 
@@ -59,14 +63,81 @@ generator/build/generator "crds" "output:crds:artifacts:config=crds" "paths=./pk
 
 echo "Generating DeepCopy implementations"
 
-generator/build/generator "deepcopy" "paths=./pkg/apis/workspaces/v1alpha2;./pkg/apis/workspaces/v1alpha1"
+generator/build/generator --header-file generator/header.go.txt "deepcopy" "paths=./pkg/apis/workspaces/v1alpha2;./pkg/apis/workspaces/v1alpha1"
 
 echo "Generating JsonSchemas"
 
-generator/build/generator "schemas" "output:schemas:artifacts:config=schemas" "paths=./pkg/apis/workspaces/v1alpha2"
+# The schemas are embedded in the github.com/devfile/api/v2/schemas GO package, to be retrieved with schemas.GetSchema.
+# When SCHEMAS_VERSION is set, the schemas are written in the schemas/${SCHEMAS_VERSION} folder,
+# and the schemas/latest folder is refreshed with a copy of them
+if [ -n "${SCHEMAS_VERSION}" ]; then
+  generator/build/generator --header-file generator/header.go.txt "schemas:version=${SCHEMAS_VERSION},embedPackage=schemas" "output:schemas:artifacts:config=schemas" "paths=./pkg/apis/workspaces/v1alpha2"
+else
+  generator/build/generator --header-file generator/header.go.txt "schemas:embedPackage=schemas" "output:schemas:artifacts:config=schemas" "paths=./pkg/apis/workspaces/v1alpha2"
+fi
 
 echo "Generating Getter Implementations"
 
-generator/build/generator "getters" "paths=./pkg/apis/workspaces/v1alpha2"
+generator/build/generator --header-file generator/header.go.txt "getters" "paths=./pkg/apis/workspaces/v1alpha2"
+
+echo "Generating String and Summary Implementations"
+
+generator/build/generator --header-file generator/header.go.txt "stringers" "paths=./pkg/apis/workspaces/v1alpha2"
+
+echo "Generating the JSON pointer accessors"
+
+generator/build/generator --header-file generator/header.go.txt "pointers" "paths=./pkg/apis/workspaces/v1alpha2"
+
+echo "Generating the read-only views"
+
+generator/build/generator --header-file generator/header.go.txt "views" "paths=./pkg/apis/workspaces/v1alpha2"
+
+echo "Generating the application of the parent and plugin overrides"
+
+generator/build/generator --header-file generator/header.go.txt "mergers" "paths=./pkg/apis/workspaces/v1alpha2"
+
+echo "Generating the conversion functions between the v1alpha1 and v1alpha2 K8S APIs"
+
+generator/build/generator --header-file generator/header.go.txt "conversions" "paths=./pkg/apis/workspaces/v1alpha1;./pkg/apis/workspaces/v1alpha2"
+
+echo "Generating the IsValid and Values methods of the enum types"
+
+generator/build/generator --header-file generator/header.go.txt "enums" "paths=./pkg/apis/workspaces/v1alpha2;./pkg/devfile"
+
+echo "Generating the table of the version-gated fields"
+
+generator/build/generator --header-file generator/header.go.txt "since" "paths=./pkg/apis/workspaces/v1alpha2;./pkg/devfile"
+
+echo "Generating the supported schema versions"
+
+generator/build/generator --header-file generator/header.go.txt "versions" "output:versions:dir=./pkg/apis/workspaces/versions" "paths=./pkg/apis/workspaces/v1alpha2;./pkg/devfile"
+
+echo "Generating the data of the devfile language servers"
+
+generator/build/generator --header-file generator/header.go.txt "lsdata" "output:lsdata:dir=./pkg/lsdata" "paths=./pkg/apis/workspaces/v1alpha2"
+
+echo "Generating the UI hints of the devfile editors"
+
+generator/build/generator "uihints" "output:uihints:artifacts:config=schemas/latest" "paths=./pkg/apis/workspaces/v1alpha2"
+
+echo "Generating the constants of the well-known keys"
+
+generator/build/generator --header-file generator/header.go.txt "keys" "paths=./pkg/devfile/keys"
+
+# The API packages of the typed configuration CRDs, scaffolded with the new-config-crd command,
+# get their K8S CRDs, DeepCopy implementations, getters and Json schemas from the same generators
+CONFIG_PATHS=$(find pkg/apis/config -mindepth 1 -maxdepth 1 -type d 2>/dev/null | sort | sed 's|^|./|' | paste -sd ';' -)
+if [ -n "${CONFIG_PATHS}" ]; then
+  echo "Generating the typed configuration CRDs"
+
+  generator/build/generator audit "paths=${CONFIG_PATHS}"
+  generator/build/generator --header-file generator/header.go.txt "validate" "deepcopy" "getters" "paths=${CONFIG_PATHS}"
+  generator/build/generator "crds" "output:crds:artifacts:config=crds" "paths=${CONFIG_PATHS}"
+  generator/build/generator "schemas" "output:schemas:artifacts:config=schemas/config" "paths=${CONFIG_PATHS}"
+fi
+
+echo "Generating the documentation of the devfile-specific markers"
+
+generator/build/generator overrides interfaces getters stringers pointers views mergers schemas since uihints -w --format markdown > docs/markers.md
 
 echo "Finished generation of required GO sources, K8S CRDs, and Json Schemas"
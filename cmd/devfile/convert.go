@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/devfile/api/v2/pkg/devfile/convert"
+	"github.com/spf13/cobra"
+)
+
+// newConvertCommand returns the command that converts a devfile between the yaml and Json formats,
+// optionally normalizing it
+func newConvertCommand() *cobra.Command {
+	to := string(convert.YAML)
+	normalize := false
+	cmd := &cobra.Command{
+		Use:   "convert <devfile>",
+		Short: "Converts a devfile between the yaml and Json formats, optionally normalizing it.",
+		Long: `Converts a devfile between the yaml and Json formats, optionally normalizing it, and prints the converted devfile.
+
+The fields of the objects are always sorted by name, and the devfile keeps its schema version.
+With the --normalize flag, the devfile is canonicalized first (unions normalized, default values set,
+lists identified by a name or an id sorted, empty lists removed), so that semantically identical devfiles
+are converted into the same document, which is useful for pipelines that diff or sign devfiles.
+Devfiles with fields unknown to this version of the API cannot be normalized, since these fields would be lost.`,
+		Example: `
+# Convert a yaml devfile to Json
+devfile convert devfile.yaml --to json > devfile.json
+
+# Normalize a devfile, keeping it in yaml
+devfile convert devfile.yaml --to yaml --normalize
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if to != string(convert.YAML) && to != string(convert.JSON) {
+				return fmt.Errorf("unknown format %q, should be one of: %s, %s", to, convert.YAML, convert.JSON)
+			}
+			content, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			converted, err := convert.Convert(content, convert.Options{To: convert.Format(to), Normalize: normalize})
+			if err != nil {
+				return exitError{err, exitDevfileErrors}
+			}
+			_, err = c.OutOrStdout().Write(converted)
+			return err
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVar(&to, "to", to, "format of the converted devfile (either 'yaml' or 'json')")
+	cmd.Flags().BoolVar(&normalize, "normalize", normalize, "canonicalize the devfile before converting it")
+	return cmd
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/devfile/api/v2/pkg/devfile/diff"
+	"github.com/spf13/cobra"
+)
+
+const (
+	textOutput = "text"
+	jsonOutput = "json"
+)
+
+// newDiffCommand returns the command that prints the structured differences between two devfiles
+func newDiffCommand() *cobra.Command {
+	outputFormat := textOutput
+	cmd := &cobra.Command{
+		Use:   "diff <original devfile> <updated devfile>",
+		Short: "Prints the differences between two devfiles, at the level of the devfile fields.",
+		Long: `Prints the differences between two devfiles, at the level of the devfile fields.
+
+Both devfiles are canonicalized before being compared (unions normalized, default values set, empty lists removed),
+and the elements of lists identified by a name or an id (such as components, commands or endpoints) are compared by key.`,
+		Example: `
+# Print the differences between two devfiles
+devfile diff devfile.yaml updated-devfile.yaml
+
+# Print the differences between two devfiles as a Json array of changes
+devfile diff devfile.yaml updated-devfile.yaml --output json
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(c *cobra.Command, args []string) error {
+			if outputFormat != textOutput && outputFormat != jsonOutput {
+				return fmt.Errorf("unknown output format %q, should be one of: %s, %s", outputFormat, textOutput, jsonOutput)
+			}
+			original, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			updated, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			changes, err := diff.Devfiles(original, updated)
+			if err != nil {
+				return exitError{err, exitDevfileErrors}
+			}
+
+			if outputFormat == jsonOutput {
+				if changes == nil {
+					changes = []diff.Change{}
+				}
+				encoder := json.NewEncoder(c.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(changes)
+			}
+			_, err = fmt.Fprint(c.OutOrStdout(), diff.Format(changes))
+			return err
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", textOutput, "output format of the differences (either 'text' or 'json')")
+	return cmd
+}
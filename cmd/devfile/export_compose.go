@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/devfile/api/v2/pkg/devfile/compose"
+	"github.com/devfile/api/v2/pkg/devfile/flatten"
+	"github.com/devfile/api/v2/pkg/devfile/rules"
+	"github.com/spf13/cobra"
+)
+
+// newExportComposeCommand returns the command that renders a devfile into a docker-compose.yaml file
+func newExportComposeCommand() *cobra.Command {
+	projectDir := compose.DefaultProjectDir
+	cmd := &cobra.Command{
+		Use:   "export-compose <devfile>",
+		Short: "Renders a devfile into a docker-compose.yaml file, for local development without Kubernetes.",
+		Long: `Renders a devfile into a docker-compose.yaml file, for local development without Kubernetes,
+and prints it out.
+
+The devfile is flattened first: its parent and plugins are fetched over http(s) and merged into it.
+Each container component is then rendered as a service, each volume component as a named volume (or as a tmpfs mount
+when it is ephemeral), and the endpoints as published or exposed ports according to their exposure.
+The containers that mount the project sources get a bind mount of the --project-dir folder.
+
+The devfile features that only apply to Kubernetes, such as Kubernetes components or pod overrides, are not rendered,
+and are printed out as warnings on the standard error.`,
+		Example: `
+# Render a devfile into a docker-compose.yaml file next to it
+devfile export-compose devfile.yaml > docker-compose.yaml
+
+# Render a devfile into a docker-compose.yaml file in a sub-folder of the project
+devfile export-compose devfile.yaml --project-dir .. > .devfile/docker-compose.yaml
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			rulesConfig, err := rules.LoadForDevfile(args[0])
+			if err != nil {
+				return err
+			}
+			content, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			flattened, flattenWarnings, err := flatten.ValidateAndFlatten(content, flatten.ResolveOptions{
+				Resolver:        flatten.HTTPResolver(nil),
+				IncludeResolver: flatten.DirIncludeResolver(filepath.Dir(args[0])),
+				ValidationRules: rulesConfig,
+			})
+			if err != nil {
+				return exitError{err, exitDevfileErrors}
+			}
+			project, warnings, err := compose.Export(&flattened, compose.Options{ProjectDir: projectDir})
+			if err != nil {
+				return exitError{err, exitDevfileErrors}
+			}
+			composeContent, err := compose.Marshal(project)
+			if err != nil {
+				return err
+			}
+
+			for _, warning := range flattenWarnings {
+				fmt.Fprintf(c.ErrOrStderr(), "warning: %s\n", warning)
+			}
+			for _, warning := range warnings {
+				fmt.Fprintf(c.ErrOrStderr(), "warning: %s\n", warning)
+			}
+			_, err = c.OutOrStdout().Write(composeContent)
+			return err
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVar(&projectDir, "project-dir", projectDir, "host folder mounted as the project sources, relative to the folder of the docker-compose.yaml file")
+	return cmd
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/devfile/api/v2/pkg/devfile/endpoints"
+	"github.com/devfile/api/v2/pkg/devfile/flatten"
+	"github.com/devfile/api/v2/pkg/devfile/manifests"
+	"github.com/devfile/api/v2/pkg/devfile/rules"
+	"github.com/spf13/cobra"
+)
+
+// newExportManifestsCommand returns the command that renders a devfile into the Kubernetes manifests of a workspace
+func newExportManifestsCommand() *cobra.Command {
+	opts := manifests.Options{}
+	routing := string(endpoints.SubdomainRouting)
+	cmd := &cobra.Command{
+		Use:   "export-manifests <devfile>",
+		Short: "Renders a devfile into the Kubernetes manifests of a workspace, for tools that don't run the DevWorkspace operator.",
+		Long: `Renders a devfile into the Kubernetes manifests of a workspace, for tools that don't run the DevWorkspace operator,
+and prints them out as a multi-document yaml file, ready to be applied with kubectl.
+
+The devfile is flattened first: its parent and plugins are fetched over http(s) and merged into it.
+The manifests are:
+- the PersistentVolumeClaims of the project sources and of the volume components that are not ephemeral,
+- a Deployment whose pod runs the container components, with the apply commands bound to the preStart event as init containers,
+- a Service per container component with public or internal endpoints,
+- an Ingress per public http or websocket endpoint, routed from the --base-domain with the --routing strategy.
+
+The devfile features that are not rendered, such as Kubernetes components, are printed out as warnings on the standard error.`,
+		Example: `
+# Deploy a devfile in the dev namespace, with its public endpoints on subdomains of apps.example.com
+devfile export-manifests devfile.yaml --namespace dev --base-domain apps.example.com | kubectl apply -f -
+
+# Render a devfile with a given storage class, routing the public endpoints on paths of a TLS domain
+devfile export-manifests devfile.yaml --storage-class fast --base-domain example.com --routing path --tls
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Endpoints.Routing = endpoints.RoutingStrategy(routing)
+			if opts.Endpoints.Routing != endpoints.SubdomainRouting && opts.Endpoints.Routing != endpoints.PathRouting {
+				return fmt.Errorf("unknown routing strategy %q, should be one of: %s, %s", routing, endpoints.SubdomainRouting, endpoints.PathRouting)
+			}
+			rulesConfig, err := rules.LoadForDevfile(args[0])
+			if err != nil {
+				return err
+			}
+			content, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			flattened, flattenWarnings, err := flatten.ValidateAndFlatten(content, flatten.ResolveOptions{
+				Resolver:        flatten.HTTPResolver(nil),
+				IncludeResolver: flatten.DirIncludeResolver(filepath.Dir(args[0])),
+				ValidationRules: rulesConfig,
+			})
+			if err != nil {
+				return exitError{err, exitDevfileErrors}
+			}
+			result, warnings, err := manifests.Export(&flattened, opts)
+			if err != nil {
+				return exitError{err, exitDevfileErrors}
+			}
+			manifestsContent, err := manifests.Marshal(result)
+			if err != nil {
+				return err
+			}
+
+			for _, warning := range flattenWarnings {
+				fmt.Fprintf(c.ErrOrStderr(), "warning: %s\n", warning)
+			}
+			for _, warning := range warnings {
+				fmt.Fprintf(c.ErrOrStderr(), "warning: %s\n", warning)
+			}
+			_, err = c.OutOrStdout().Write(manifestsContent)
+			return err
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVar(&opts.Name, "name", "", "name of the Deployment, and prefix of the other objects (by default, the devfile metadata name)")
+	cmd.Flags().StringVarP(&opts.Namespace, "namespace", "n", "", "namespace of the objects")
+	cmd.Flags().StringVar(&opts.StorageClass, "storage-class", "", "storage class of the PersistentVolumeClaims (by default, the default storage class of the cluster)")
+	cmd.Flags().StringVar(&opts.IngressClass, "ingress-class", "", "class of the Ingresses (by default, the default ingress class of the cluster)")
+	cmd.Flags().StringVar(&opts.Endpoints.BaseDomain, "base-domain", "", "domain from which the public endpoints are routed (required for devfiles with public endpoints)")
+	cmd.Flags().StringVar(&routing, "routing", routing, "routing strategy of the public endpoints (either 'subdomain' or 'path')")
+	cmd.Flags().StringVar(&opts.Endpoints.Prefix, "prefix", "", "prefix of the subdomains or paths of the public endpoints, to distinguish several workspaces on the same domain")
+	cmd.Flags().BoolVar(&opts.Endpoints.TLS, "tls", false, "serve the public endpoints over TLS, with the default certificate of the ingress controller")
+	return cmd
+}
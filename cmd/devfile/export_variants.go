@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/devfile/architectures"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+// newExportVariantsCommand returns the command that generates the per-architecture variants of a devfile
+func newExportVariantsCommand() *cobra.Command {
+	outputDir := ""
+	cmd := &cobra.Command{
+		Use:   "export-variants <devfile>",
+		Short: "Generates the per-architecture variants of a devfile whose components are overridden on some architectures.",
+		Long: `Generates the per-architecture variants of a devfile whose components are overridden on some architectures,
+so that a stack can be published for each architecture from a single devfile.
+
+The overrides of a component are set in its '` + architectures.OverridesAttribute + `' attribute,
+as a strategic merge patch of the component per architecture.
+A component with overrides should cover all the architectures declared in the devfile metadata,
+with an empty patch for the architectures on which it is used as is: the command fails otherwise.
+
+Each variant is the devfile with the overrides of its architecture applied to the components, without
+the overrides attribute, and with its architecture as the only architecture of the metadata.
+The parent and plugins of the devfile are not resolved.
+
+The variants are printed out as a multi-document yaml file, or written to '<output-dir>/<architecture>/devfile.yaml'
+with the --output-dir flag.`,
+		Example: `
+# Print the variants of a devfile
+devfile export-variants devfile.yaml
+
+# Write the variants to stacks/nodejs/amd64/devfile.yaml, stacks/nodejs/arm64/devfile.yaml, ...
+devfile export-variants devfile.yaml --output-dir stacks/nodejs
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			content, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			d := &v1alpha2.Devfile{}
+			if err := yaml.Unmarshal(content, d); err != nil {
+				return exitError{fmt.Errorf("invalid devfile %s: %w", args[0], err), exitDevfileErrors}
+			}
+			variants, err := architectures.Variants(d)
+			if err != nil {
+				return exitError{err, exitDevfileErrors}
+			}
+
+			var text strings.Builder
+			for i, variant := range variants {
+				variantContent, err := yaml.Marshal(variant.Devfile)
+				if err != nil {
+					return err
+				}
+				if outputDir != "" {
+					folder := filepath.Join(outputDir, string(variant.Architecture))
+					if err := os.MkdirAll(folder, 0755); err != nil {
+						return err
+					}
+					if err := ioutil.WriteFile(filepath.Join(folder, "devfile.yaml"), variantContent, 0644); err != nil {
+						return err
+					}
+					continue
+				}
+				if i > 0 {
+					text.WriteString("---\n")
+				}
+				text.WriteString("# architecture: " + string(variant.Architecture) + "\n")
+				text.Write(variantContent)
+			}
+			_, err = fmt.Fprint(c.OutOrStdout(), text.String())
+			return err
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVar(&outputDir, "output-dir", "", "folder in which each variant is written to '<architecture>/devfile.yaml' (by default, the variants are printed out)")
+	return cmd
+}
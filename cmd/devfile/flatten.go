@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/devfile/flatten"
+	"github.com/devfile/api/v2/pkg/devfile/rules"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+// newFlattenCommand returns the command that prints the flattened content of a devfile,
+// with a report of what its parent and plugins contributed to it
+func newFlattenCommand() *cobra.Command {
+	outputFormat := textOutput
+	resolve := false
+	cmd := &cobra.Command{
+		Use:   "flatten <devfile>",
+		Short: "Prints the flattened content of a devfile, with a report of what its parent and plugins contributed to it.",
+		Long: `Prints the flattened content of a devfile, with a report of what its parent and plugins contributed to it,
+to help understanding why a workspace doesn't look as expected.
+
+The fragments included by the devfile are read from the folder of the devfile, and its parent and plugins
+are resolved recursively, overridden and merged into it, without creating anything.
+Relative uris are read from the folder of the devfile, and the other import references are only fetched
+over http(s) with the --resolve flag.
+The report lists, for each parent and plugin, the chain of imports it comes from, the components, commands, projects
+and starter projects it declares, and the elements overridden by the importing devfile.
+
+In text output, the flattened devfile is printed as yaml, followed by the report as yaml comments.
+In Json output, the flattened devfile, the report and the warnings are printed as a single Json object.
+When the flattened devfile is invalid, it is still printed out before the validation errors.`,
+		Example: `
+# Print the flattened devfile and the report, fetching the remote parent and plugins
+devfile flatten devfile.yaml --resolve
+
+# Print the flattened devfile, the report and the warnings as a Json object
+devfile flatten devfile.yaml --resolve --output json
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if outputFormat != textOutput && outputFormat != jsonOutput {
+				return fmt.Errorf("unknown output format %q, should be one of: %s, %s", outputFormat, textOutput, jsonOutput)
+			}
+			rulesConfig, err := rules.LoadForDevfile(args[0])
+			if err != nil {
+				return err
+			}
+			content, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			flattened, report, warnings, validationErr := flatten.ValidateAndFlattenWithReport(context.Background(), content, flatten.ResolveOptions{
+				Resolver:        localFileResolver(filepath.Dir(args[0]), resolve),
+				IncludeResolver: flatten.DirIncludeResolver(filepath.Dir(args[0])),
+				ValidationRules: rulesConfig,
+			})
+			// the flattened devfile is only returned along with an error when the validation fails
+			if validationErr != nil && flattened.SchemaVersion == "" {
+				return exitError{validationErr, exitDevfileErrors}
+			}
+
+			if outputFormat == jsonOutput {
+				warningMessages := []string{}
+				for _, warning := range warnings {
+					warningMessages = append(warningMessages, warning.String())
+				}
+				encoder := json.NewEncoder(c.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				err = encoder.Encode(struct {
+					Devfile  flatten.FlattenedDevfile `json:"devfile"`
+					Report   flatten.Report           `json:"report"`
+					Warnings []string                 `json:"warnings"`
+				}{flattened, report, warningMessages})
+			} else {
+				err = printFlattenedDevfile(c, flattened, report, warnings)
+			}
+			if err != nil {
+				return err
+			}
+
+			if validationErr != nil {
+				return exitError{validationErr, exitDevfileErrors}
+			}
+			return nil
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", textOutput, "output format of the flattened devfile and report (either 'text' or 'json')")
+	cmd.Flags().BoolVar(&resolve, "resolve", resolve, "fetch the parent and plugins that are not relative uris over http(s)")
+	return cmd
+}
+
+// printFlattenedDevfile prints the flattened devfile as yaml, followed by the report as yaml comments,
+// and the warnings on the standard error
+func printFlattenedDevfile(c *cobra.Command, flattened flatten.FlattenedDevfile, report flatten.Report, warnings []flatten.Warning) error {
+	devfileContent, err := yaml.Marshal(flattened)
+	if err != nil {
+		return err
+	}
+	var text strings.Builder
+	text.Write(devfileContent)
+	text.WriteString("\n# Flattening report:\n")
+	for _, line := range strings.Split(strings.TrimSuffix(flatten.FormatReport(report), "\n"), "\n") {
+		text.WriteString("# " + line + "\n")
+	}
+
+	for _, warning := range warnings {
+		fmt.Fprintf(c.ErrOrStderr(), "warning: %s\n", warning)
+	}
+	_, err = fmt.Fprint(c.OutOrStdout(), text.String())
+	return err
+}
+
+// localFileResolver returns a resolver that reads relative uris from the given folder,
+// and fetches the other import references over http(s) only if `remote` is true
+func localFileResolver(dir string, remote bool) flatten.Resolver {
+	httpResolver := flatten.HTTPResolver(nil)
+	return func(ctx context.Context, ref v1alpha2.ImportReference) ([]byte, error) {
+		if ref.Uri != "" {
+			if parsed, err := url.Parse(ref.Uri); err == nil && parsed.Scheme == "" && !filepath.IsAbs(ref.Uri) {
+				return ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(ref.Uri)))
+			}
+		}
+		if !remote {
+			return nil, fmt.Errorf("remote import references are only fetched with the --resolve flag")
+		}
+		return httpResolver(ctx, ref)
+	}
+}
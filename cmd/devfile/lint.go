@@ -0,0 +1,121 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/devfile/lint"
+	"github.com/devfile/api/v2/pkg/devfile/rules"
+	"github.com/spf13/cobra"
+)
+
+// newLintCommand returns the command that checks a devfile against the lint rules
+func newLintCommand() *cobra.Command {
+	outputFormat := textOutput
+	severities := map[string]string{}
+	failOn := string(lint.Error)
+	configPath := ""
+
+	var rulesHelp strings.Builder
+	for _, rule := range lint.Rules() {
+		fmt.Fprintf(&rulesHelp, "\n- %s (%s by default): %s", rule.ID, rule.DefaultSeverity, rule.Description)
+		var parameters []string
+		for name := range rule.Parameters {
+			parameters = append(parameters, name)
+		}
+		sort.Strings(parameters)
+		for _, name := range parameters {
+			fmt.Fprintf(&rulesHelp, "\n  - parameter %s: %s", name, rule.Parameters[name])
+		}
+	}
+
+	cmd := &cobra.Command{
+		Use:   "lint <devfile>",
+		Short: "Checks a devfile against style rules, beyond its validity.",
+		Long: `Checks a devfile against style rules, beyond its validity, and prints the problems found.
+
+The available rules are:
+` + rulesHelp.String() + `
+
+The rules can be configured in a ` + "`" + rules.FileName + "`" + ` file, which is read from the folder of the devfile
+unless another file is given with the --config flag. The --severity flag overrides the severities of this file.
+
+The command fails when a problem has at least the severity given by the --fail-on flag, so that it can be used as a quality gate.`,
+		Example: `
+# Lint a devfile
+devfile lint devfile.yaml
+
+# Lint a devfile, failing on unpinned images and ignoring missing display names
+devfile lint devfile.yaml --severity unpinned-image=error,missing-display-name=off
+
+# Lint a devfile with the rules configured in a given file
+devfile lint devfile.yaml --config lint-rules.yaml
+
+# Lint a devfile, failing on warnings, and print the problems as a Json array
+devfile lint devfile.yaml --fail-on warning --output json
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if outputFormat != textOutput && outputFormat != jsonOutput {
+				return fmt.Errorf("unknown output format %q, should be one of: %s, %s", outputFormat, textOutput, jsonOutput)
+			}
+			failOnSeverity, err := lint.ParseSeverity(failOn)
+			if err != nil {
+				return err
+			}
+			var rulesConfig *rules.Config
+			if configPath != "" {
+				rulesConfig, err = rules.LoadFile(configPath)
+			} else {
+				rulesConfig, err = rules.LoadForDevfile(args[0])
+			}
+			if err != nil {
+				return err
+			}
+			config := rulesConfig.LintConfig()
+			for rule, severity := range severities {
+				config.Severities[lint.RuleID(rule)] = lint.Severity(severity)
+			}
+			if err := config.Validate(); err != nil {
+				return err
+			}
+			content, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			findings, err := lint.Devfile(content, config)
+			if err != nil {
+				return exitError{err, exitDevfileErrors}
+			}
+
+			if outputFormat == jsonOutput {
+				if findings == nil {
+					findings = []lint.Finding{}
+				}
+				encoder := json.NewEncoder(c.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				err = encoder.Encode(findings)
+			} else {
+				_, err = fmt.Fprint(c.OutOrStdout(), lint.Format(findings))
+			}
+			if err != nil {
+				return err
+			}
+
+			if maxSeverity := lint.MaxSeverity(findings); failOnSeverity != lint.Off && maxSeverity.AtLeast(failOnSeverity) {
+				return exitError{fmt.Errorf("the devfile has problems with the %s severity", maxSeverity), exitDevfileErrors}
+			}
+			return nil
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", textOutput, "output format of the problems (either 'text' or 'json')")
+	cmd.Flags().StringToStringVar(&severities, "severity", severities, "severity of the given rules (one of 'off', 'info', 'warning' or 'error'), as a comma-separated list of rule=severity pairs")
+	cmd.Flags().StringVar(&configPath, "config", configPath, "rule configuration file (by default, the "+rules.FileName+" file of the devfile folder, if any)")
+	cmd.Flags().StringVar(&failOn, "fail-on", failOn, "minimum severity of the problems that make the command fail (one of 'info', 'warning' or 'error', or 'off' to never fail)")
+	return cmd
+}
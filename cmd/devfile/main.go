@@ -0,0 +1,58 @@
+package main
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// Exit codes of the devfile command, which allow CI pipelines to branch on the class of failure
+const (
+	// exitDevfileErrors is used when the devfile is invalid, or has problems that make the command fail
+	exitDevfileErrors = 1
+	// exitInvalidOptions is used when the command line flags or arguments are invalid
+	exitInvalidOptions = 2
+)
+
+// exitError is an error that makes the command exit with the given code
+type exitError struct {
+	error
+	code int
+}
+
+func (e exitError) Unwrap() error {
+	return e.error
+}
+
+// exitCode returns the code with which the command should exit for the given error.
+// Errors that are not exit errors are reported while validating the command line, before reading the devfiles,
+// so they're considered as invalid options.
+func exitCode(err error) int {
+	var exitErr exitError
+	if errors.As(err, &exitErr) {
+		return exitErr.code
+	}
+	return exitInvalidOptions
+}
+
+func main() {
+	cmd := &cobra.Command{
+		Use:   "devfile",
+		Short: "Provides commands that help working with devfiles, built with the libraries of the devfile API.",
+		Long: `Provides commands that help working with devfiles, built with the libraries of the devfile API,
+for registry reviews, quality gates and the debugging of devfiles.`,
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(newDiffCommand())
+	cmd.AddCommand(newLintCommand())
+	cmd.AddCommand(newFlattenCommand())
+	cmd.AddCommand(newConvertCommand())
+	cmd.AddCommand(newExportComposeCommand())
+	cmd.AddCommand(newExportManifestsCommand())
+	cmd.AddCommand(newExportVariantsCommand())
+
+	if err := cmd.Execute(); err != nil {
+		os.Exit(exitCode(err))
+	}
+}
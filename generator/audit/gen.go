@@ -0,0 +1,329 @@
+package audit
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/generator/genutils"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+// KnownGenerators contains the generators whose markers are consumed, indexed by name.
+// It is set by the generator command to all its generators.
+var KnownGenerators map[string]genall.Generator
+
+// k8sMarkers are the markers of the K8S code generators, such as openapi-gen, and of the K8S API conventions,
+// such as `required`, which are not consumed by this generator, but are kept in the API source code for the K8S tooling
+var k8sMarkers = []string{
+	"k8s:openapi-gen",
+	"patchMergeKey",
+	"patchStrategy",
+	"required",
+}
+
+// toplevelListMarkerName is the name of the marker of the devfile top-level lists, registered by the `interfaces` generator
+const toplevelListMarkerName = "devfile:toplevellist"
+
+// +controllertools:marker:generateHelp
+
+// Generator audits the markers of the API GO code, and reports as errors:
+//
+// - the markers found in the source code that are not consumed by any of the generators, such as misspelled or obsolete markers,
+//
+// - the markers whose arguments cannot be parsed,
+//
+// - the expectations of the generators that are not met by the source code, such as a struct that has a union discriminator
+// but is not annotated with the `union` marker, or a union member that is not optional,
+//
+// - the inconsistent serialization tags: the exported fields without a `json` tag in the structs that have `json` tags, the unknown `json` and `yaml` tag options,
+// such as a misspelled `omitempty`, the `yaml` tags that don't match the `json` tag, and the fields whose Json names collide
+// case-insensitively, including the fields of inlined structs.
+// The `yaml` tag is optional, since the API types are serialized to yaml through their `json` tags with `sigs.k8s.io/yaml`.
+//
+// Marker drift otherwise results in silent mis-generation, since the generators just ignore the markers they don't know,
+// and tag drift in silent serialization bugs, since the Json decoder matches field names case-insensitively and ignores unknown options.
+// It doesn't generate anything, and is meant to be run before the other generators, to break the build on the first report.
+type Generator struct {
+	// ExternalMarkers contains the names of the markers that are consumed by tools other than this generator,
+	// and should not be reported, in addition to the markers of the K8S code generators.
+	ExternalMarkers []string `marker:"externalMarkers,optional"`
+}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	return nil
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// Generate audits the source code
+func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	registry, err := knownMarkers()
+	if err != nil {
+		return err
+	}
+	collector := &markers.Collector{Registry: registry}
+
+	for _, root := range ctx.Roots {
+		ctx.Checker.Check(root)
+		root.NeedTypesInfo()
+
+		g.checkUnknownMarkers(root, registry)
+
+		if err := markers.EachType(collector, root, func(info *markers.TypeInfo) {
+			checkExpectations(root, info)
+			checkTags(root, info)
+		}); err != nil {
+			root.AddError(err)
+		}
+	}
+	return nil
+}
+
+// knownMarkers returns a registry that contains the markers of all the known generators
+func knownMarkers() (*markers.Registry, error) {
+	registry := &markers.Registry{}
+	names := make([]string, 0, len(KnownGenerators))
+	for name := range KnownGenerators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, isAudit := KnownGenerators[name].(Generator); isAudit {
+			continue
+		}
+		if err := KnownGenerators[name].RegisterMarkers(registry); err != nil {
+			return nil, fmt.Errorf("cannot register the markers of the %s generator: %w", name, err)
+		}
+	}
+	return registry, nil
+}
+
+// checkUnknownMarkers reports the markers of the package source files that are registered by none of the known generators,
+// for any target, and are not declared as external markers
+func (g Generator) checkUnknownMarkers(root *loader.Package, registry *markers.Registry) {
+	for _, file := range root.Syntax {
+		for _, commentGroup := range file.Comments {
+			for _, comment := range commentGroup.List {
+				if !strings.HasPrefix(comment.Text, "//") {
+					continue
+				}
+				markerText := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+				// a single `+` is used as a separator between the documentation and the markers of a field
+				if !strings.HasPrefix(markerText, "+") || markerText == "+" {
+					continue
+				}
+				if registry.Lookup(markerText, markers.DescribesPackage) != nil ||
+					registry.Lookup(markerText, markers.DescribesType) != nil ||
+					registry.Lookup(markerText, markers.DescribesField) != nil ||
+					g.isExternal(markerText) {
+					continue
+				}
+				root.AddError(loader.ErrFromNode(fmt.Errorf(
+					"marker `%v` is not consumed by any generator: it might be misspelled or obsolete, or should be declared as an external marker of the audit",
+					markerText), comment))
+			}
+		}
+	}
+}
+
+// isExternal returns true if the given marker text is one of the external markers, with or without arguments
+func (g Generator) isExternal(markerText string) bool {
+	name := strings.TrimPrefix(markerText, "+")
+	for _, external := range append(k8sMarkers, g.ExternalMarkers...) {
+		if name == external || strings.HasPrefix(name, external+"=") || strings.HasPrefix(name, external+":") {
+			return true
+		}
+	}
+	return false
+}
+
+// checkExpectations reports the expectations of the generators that are not met by the given type
+func checkExpectations(root *loader.Package, info *markers.TypeInfo) {
+	isUnion := info.Markers.Get(genutils.UnionMarker.Name) != nil
+	for _, field := range info.Fields {
+		if field.Markers.Get(genutils.UnionDiscriminatorMarker.Name) != nil {
+			if !isUnion {
+				root.AddError(loader.ErrFromNode(fmt.Errorf(
+					"type `%v` has the union discriminator `%v`, but is not annotated with the `%s` marker",
+					info.Name,
+					field.Name,
+					genutils.UnionMarker.Name), info.RawSpec))
+			}
+			continue
+		}
+
+		if isUnion {
+			if field.Markers.Get("optional") == nil || !strings.Contains(field.Tag.Get("json"), ",omitempty") {
+				root.AddError(loader.ErrFromNode(fmt.Errorf(
+					"in union `%v` the member `%v` should have the `+optional` comment marker and the `omitempty` option in its `json` tag, since only one member of the union is set",
+					info.Name,
+					field.Name), field.RawField))
+			}
+		}
+
+		if field.Markers.Get(toplevelListMarkerName) != nil {
+			if _, isSlice := field.RawField.Type.(*ast.ArrayType); !isSlice {
+				root.AddError(loader.ErrFromNode(fmt.Errorf(
+					"field `%v` of type `%v` is annotated with the `%s` marker, but is not a list",
+					field.Name,
+					info.Name,
+					toplevelListMarkerName), field.RawField))
+			}
+		}
+	}
+}
+
+// knownTagOptions are the options of the `json` and `yaml` tags, indexed by tag key.
+// `inline` is not a `json` option of the standard library, but is the K8S convention for embedded structs,
+// which the Json encoder inlines anyway.
+var knownTagOptions = map[string][]string{
+	"json": {"omitempty", "string", "inline"},
+	"yaml": {"omitempty", "inline", "flow"},
+}
+
+// checkTags reports the inconsistent `json` and `yaml` tags of the fields of the given type
+func checkTags(root *loader.Package, info *markers.TypeInfo) {
+	if _, isStruct := info.RawSpec.Type.(*ast.StructType); !isStruct {
+		return
+	}
+	// structs without any `json` tag, such as visitors or options, are not serialized
+	isSerialized := false
+	for _, field := range info.Fields {
+		if _, hasJSONTag := field.Tag.Lookup("json"); hasJSONTag {
+			isSerialized = true
+			break
+		}
+	}
+	if !isSerialized {
+		return
+	}
+	for _, field := range info.Fields {
+		if !ast.IsExported(field.Name) && field.Name != "" {
+			continue
+		}
+		jsonTag, hasJSONTag := field.Tag.Lookup("json")
+		if !hasJSONTag {
+			root.AddError(loader.ErrFromNode(fmt.Errorf(
+				"field `%v` of type `%v` has no `json` tag: its serialized name would depend on the GO field name",
+				fieldLabel(field), info.Name), field.RawField))
+			continue
+		}
+		for _, key := range []string{"json", "yaml"} {
+			tag, hasTag := field.Tag.Lookup(key)
+			if !hasTag {
+				continue
+			}
+			for _, option := range unknownTagOptions(key, tag) {
+				root.AddError(loader.ErrFromNode(fmt.Errorf(
+					"field `%v` of type `%v` has the unknown `%s` tag option `%s`: it might be misspelled, and is ignored by the %s encoder",
+					fieldLabel(field), info.Name, key, option, key), field.RawField))
+			}
+		}
+		if yamlTag, hasYAMLTag := field.Tag.Lookup("yaml"); hasYAMLTag && tagName(yamlTag) != tagName(jsonTag) {
+			root.AddError(loader.ErrFromNode(fmt.Errorf(
+				"field `%v` of type `%v` has the `yaml` name `%s` that doesn't match its `json` name `%s`: the API is serialized to yaml through the `json` tags",
+				fieldLabel(field), info.Name, tagName(yamlTag), tagName(jsonTag)), field.RawField))
+		}
+	}
+
+	typ := root.TypesInfo.TypeOf(info.RawSpec.Name)
+	if typ == nil {
+		return
+	}
+	structType, isStruct := typ.Underlying().(*types.Struct)
+	if !isStruct {
+		return
+	}
+	jsonNames := map[string]string{}
+	for _, name := range serializedNames(structType, map[*types.Struct]bool{}) {
+		lowerName := strings.ToLower(name)
+		if otherName, isCollision := jsonNames[lowerName]; isCollision {
+			root.AddError(loader.ErrFromNode(fmt.Errorf(
+				"type `%v` has several fields serialized as `%s` and `%s`, which collide since Json field names are matched case-insensitively",
+				info.Name, otherName, name), info.RawSpec))
+			continue
+		}
+		jsonNames[lowerName] = name
+	}
+}
+
+// serializedNames returns the Json names of the fields of the given struct, including the fields of the inlined structs
+func serializedNames(structType *types.Struct, visited map[*types.Struct]bool) []string {
+	if visited[structType] {
+		return nil
+	}
+	visited[structType] = true
+	var names []string
+	for i := 0; i < structType.NumFields(); i++ {
+		field := structType.Field(i)
+		jsonTag, hasJSONTag := reflect.StructTag(structType.Tag(i)).Lookup("json")
+		if jsonTag == "-" || (!field.Exported() && !field.Embedded()) {
+			continue
+		}
+		name := tagName(jsonTag)
+		if field.Embedded() && name == "" {
+			if embedded, isStruct := derefType(field.Type()).Underlying().(*types.Struct); isStruct {
+				names = append(names, serializedNames(embedded, visited)...)
+			}
+			continue
+		}
+		if !hasJSONTag || name == "" {
+			name = field.Name()
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func derefType(typ types.Type) types.Type {
+	if pointer, isPointer := typ.(*types.Pointer); isPointer {
+		return pointer.Elem()
+	}
+	return typ
+}
+
+// tagName returns the field name part of the given tag value
+func tagName(tag string) string {
+	return strings.Split(tag, ",")[0]
+}
+
+// unknownTagOptions returns the options of the given tag value that are not known for the given tag key
+func unknownTagOptions(key string, tag string) []string {
+	var unknown []string
+	for _, option := range strings.Split(tag, ",")[1:] {
+		isKnown := false
+		for _, knownOption := range knownTagOptions[key] {
+			if option == knownOption {
+				isKnown = true
+				break
+			}
+		}
+		if !isKnown {
+			unknown = append(unknown, option)
+		}
+	}
+	return unknown
+}
+
+// fieldLabel returns the name of the given field, or the name of its type for an embedded field
+func fieldLabel(field markers.FieldInfo) string {
+	if field.Name != "" {
+		return field.Name
+	}
+	return types.ExprString(field.RawField.Type)
+}
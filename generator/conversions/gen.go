@@ -0,0 +1,459 @@
+package conversions
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/generator/genutils"
+	"k8s.io/apimachinery/pkg/version"
+	crdmarkers "sigs.k8s.io/controller-tools/pkg/crd/markers"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+const (
+	enumMarkerName = "kubebuilder:validation:Enum"
+	// generatedFileName is the name of the file generated by this generator
+	generatedFileName = "zz_generated.conversions.go"
+)
+
+// +controllertools:marker:generateHelp
+
+// Generator generates the conversion functions between two versions of an API, given as the two `paths` roots,
+// such as `./pkg/apis/workspaces/v1alpha1` and `./pkg/apis/workspaces/v1alpha2`.
+// The functions are generated in the package of the older version, according to the Kubernetes version ordering,
+// like the hand-written conversions of the older versions to the newer ones.
+//
+// For each struct type defined in both packages, such as `Container`, it generates the `autoConvert_v1alpha1_Container_To_v1alpha2_Container()`
+// and `autoConvert_v1alpha2_Container_To_v1alpha1_Container()` functions, that convert the fields of the same name that can be converted automatically:
+// the fields of identical types, of the same struct types (through their conversion functions), of the same basic types with compatible enum values,
+// and the pointers, lists and maps of those.
+// The other fields are flagged with a `// WARNING: in.<Field> requires manual conversion` comment in the generated function.
+//
+// The exported `Convert_v1alpha1_Container_To_v1alpha2_Container()` functions are generated when all the fields of the type can be converted automatically.
+// Otherwise, they should be hand-written, calling the `autoConvert_` function and converting the flagged fields.
+// The hand-written `Convert_` functions, declared in the other files of the package, are used by the generated functions instead of being generated.
+type Generator struct {
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	return crdmarkers.Register(into)
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// Generate generates the artifacts
+func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	if len(ctx.Roots) != 2 {
+		return fmt.Errorf("the conversions generator expects the two packages of the API versions to convert between, but got %d packages", len(ctx.Roots))
+	}
+	root, peer := ctx.Roots[0], ctx.Roots[1]
+	if version.CompareKubeAwareVersionStrings(root.Name, peer.Name) > 0 {
+		root, peer = peer, root
+	}
+	if root.Name == peer.Name {
+		root.AddError(fmt.Errorf("cannot generate the conversions between two packages with the same name %q", root.Name))
+		return nil
+	}
+
+	enumValues := map[types.Object][]string{}
+	for _, pkg := range []*loader.Package{root, peer} {
+		ctx.Checker.Check(pkg)
+		pkg.NeedTypesInfo()
+		if err := markers.EachType(ctx.Collector, pkg, func(info *markers.TypeInfo) {
+			if enumMarker, isEnum := info.Markers.Get(enumMarkerName).(crdmarkers.Enum); isEnum {
+				obj := pkg.Types.Scope().Lookup(info.Name)
+				for _, value := range enumMarker {
+					enumValues[obj] = append(enumValues[obj], fmt.Sprint(value))
+				}
+			}
+		}); err != nil {
+			pkg.AddError(err)
+			return nil
+		}
+	}
+
+	writer := &conversionWriter{
+		pkg:         root.Types,
+		rootImports: genutils.PackageImports(root),
+		namesByPath: map[string]string{},
+		enumValues:  enumValues,
+		handWritten: map[string]bool{},
+		convertible: map[string]bool{},
+	}
+	for _, name := range root.Types.Scope().Names() {
+		obj, isTypeName := root.Types.Scope().Lookup(name).(*types.TypeName)
+		peerObj, peerIsTypeName := peer.Types.Scope().Lookup(name).(*types.TypeName)
+		if !isTypeName || !peerIsTypeName || !obj.Exported() {
+			continue
+		}
+		if isSerializedStruct(obj.Type()) && isSerializedStruct(peerObj.Type()) {
+			writer.conversions = append(writer.conversions,
+				conversion{name: name, in: obj.Type().(*types.Named), out: peerObj.Type().(*types.Named)},
+				conversion{name: name, in: peerObj.Type().(*types.Named), out: obj.Type().(*types.Named)})
+		}
+	}
+	for _, c := range writer.conversions {
+		if fn, isFunc := root.Types.Scope().Lookup(c.functionName()).(*types.Func); isFunc && filepath.Base(root.Fset.Position(fn.Pos()).Filename) != generatedFileName {
+			writer.handWritten[c.functionName()] = true
+		}
+	}
+	writer.resolveConvertible()
+
+	// the conversions are written once the convertible types are known, so that only the packages they use are imported
+	writer.namesByPath = map[string]string{}
+	functions := new(bytes.Buffer)
+	for _, c := range writer.conversions {
+		writer.writeConversion(functions, c)
+	}
+	genutils.WriteFormattedSourceFile("conversions", g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
+		genutils.WriteImports(buf, writer.namesByPath)
+		buf.Write(functions.Bytes())
+	})
+
+	return nil
+}
+
+// isSerializedStruct returns true if the given type is a struct whose exported fields all have a json tag,
+// as opposed to the helper structs of the API packages, such as the union visitors
+func isSerializedStruct(goType types.Type) bool {
+	structType, isStruct := goType.Underlying().(*types.Struct)
+	if !isStruct {
+		return false
+	}
+	for i := 0; i < structType.NumFields(); i++ {
+		if _, hasJSONTag := reflect.StructTag(structType.Tag(i)).Lookup("json"); structType.Field(i).Exported() && !hasJSONTag {
+			return false
+		}
+	}
+	return true
+}
+
+// conversion is the conversion of a struct type into the struct type of the same name of the other package
+type conversion struct {
+	name    string
+	in, out *types.Named
+}
+
+// functionName returns the name of the exported conversion function, such as `Convert_v1alpha1_Container_To_v1alpha2_Container`
+func (c conversion) functionName() string {
+	return fmt.Sprintf("Convert_%s_%s_To_%s_%s", c.in.Obj().Pkg().Name(), c.name, c.out.Obj().Pkg().Name(), c.name)
+}
+
+// autoFunctionName returns the name of the generated function that converts the fields that can be converted automatically
+func (c conversion) autoFunctionName() string {
+	return "autoConvert_" + strings.TrimPrefix(c.functionName(), "Convert_")
+}
+
+// fieldConversion is the conversion of a field of a struct type: either the statements that convert it,
+// or the reason why it requires a manual conversion
+type fieldConversion struct {
+	field      string
+	statements string
+	reason     string
+}
+
+// conversionWriter writes the conversion functions between the types of two packages
+type conversionWriter struct {
+	pkg *types.Package
+	// rootImports contains the packages imported by the Go files of the package, indexed by the name under which they are imported
+	rootImports map[string]*types.Package
+	// namesByPath contains the names of the packages imported by the generated code, indexed by import path
+	namesByPath map[string]string
+	// enumValues contains the values of the `kubebuilder:validation:Enum` marker of the enum types of both packages
+	enumValues map[types.Object][]string
+	// conversions are the conversions of the struct types defined in both packages, in both directions, sorted by type name
+	conversions []conversion
+	// handWritten contains the names of the exported conversion functions hand-written in the package
+	handWritten map[string]bool
+	// convertible contains the names of the exported conversion functions that are either hand-written or generated
+	convertible map[string]bool
+}
+
+// resolveConvertible finds the types whose exported conversion function can be generated:
+// the types whose fields can all be converted automatically, assuming that the conversions they depend on are available.
+func (w *conversionWriter) resolveConvertible() {
+	for _, c := range w.conversions {
+		w.convertible[c.functionName()] = true
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, c := range w.conversions {
+			name := c.functionName()
+			if !w.convertible[name] || w.handWritten[name] {
+				continue
+			}
+			for _, field := range w.fieldConversions(c) {
+				if field.reason != "" {
+					w.convertible[name] = false
+					changed = true
+					break
+				}
+			}
+		}
+	}
+}
+
+// writeConversion writes the `autoConvert_` function of the given conversion,
+// and its exported `Convert_` function if it can be generated
+func (w *conversionWriter) writeConversion(buf *bytes.Buffer, c conversion) {
+	fields := w.fieldConversions(c)
+	inType, outType := w.typeString(c.in), w.typeString(c.out)
+	automatic := true
+	for _, field := range fields {
+		automatic = automatic && field.reason == ""
+	}
+	if automatic {
+		fmt.Fprintf(buf, `
+
+// %[1]s converts all the fields of a %[2]s %[3]s into a %[4]s %[3]s.`, c.autoFunctionName(), c.in.Obj().Pkg().Name(), c.name, c.out.Obj().Pkg().Name())
+	} else {
+		fmt.Fprintf(buf, `
+
+// %[1]s converts the fields of a %[2]s %[3]s that can be converted automatically into a %[4]s %[3]s.
+// The other fields, flagged with a WARNING, should be converted by the hand-written %[5]s function.`, c.autoFunctionName(), c.in.Obj().Pkg().Name(), c.name, c.out.Obj().Pkg().Name(), c.functionName())
+	}
+	fmt.Fprintf(buf, `
+func %s(in *%s, out *%s) error {`, c.autoFunctionName(), inType, outType)
+	for _, field := range fields {
+		if field.reason != "" {
+			fmt.Fprintf(buf, `
+	// WARNING: in.%s requires manual conversion: %s`, field.field, field.reason)
+			continue
+		}
+		buf.WriteString("\n" + field.statements)
+	}
+	buf.WriteString(`
+	return nil
+}`)
+
+	if w.convertible[c.functionName()] && !w.handWritten[c.functionName()] {
+		fmt.Fprintf(buf, `
+
+// %[1]s converts a %[2]s %[3]s into a %[4]s %[3]s.
+func %[1]s(in *%[5]s, out *%[6]s) error {
+	return %[7]s(in, out)
+}`, c.functionName(), c.in.Obj().Pkg().Name(), c.name, c.out.Obj().Pkg().Name(), inType, outType, c.autoFunctionName())
+	}
+}
+
+// fieldConversions returns the conversions of the fields of the `in` type of the given conversion, in declaration order
+func (w *conversionWriter) fieldConversions(c conversion) []fieldConversion {
+	inStruct, outStruct := c.in.Underlying().(*types.Struct), c.out.Underlying().(*types.Struct)
+	outFields := map[string]*types.Var{}
+	for i := 0; i < outStruct.NumFields(); i++ {
+		outFields[outStruct.Field(i).Name()] = outStruct.Field(i)
+	}
+	var fields []fieldConversion
+	for i := 0; i < inStruct.NumFields(); i++ {
+		field := inStruct.Field(i)
+		if !field.Exported() {
+			continue
+		}
+		outField, exists := outFields[field.Name()]
+		if !exists {
+			fields = append(fields, fieldConversion{field: field.Name(), reason: fmt.Sprintf("it doesn't exist in %s", w.describe(c.out))})
+			continue
+		}
+		statements, reason := w.valueConversion("in."+field.Name(), "out."+field.Name(), field.Type(), outField.Type(), 0)
+		fields = append(fields, fieldConversion{field: field.Name(), statements: statements, reason: reason})
+	}
+	return fields
+}
+
+// valueConversion returns the statements that convert the `in` expression of type `a` into the `out` expression of type `b`,
+// at the given depth of nested lists and maps, or the reason why it cannot be converted automatically
+func (w *conversionWriter) valueConversion(in, out string, a, b types.Type, depth int) (string, string) {
+	if types.Identical(a, b) {
+		return fmt.Sprintf("%s = %s", out, in), ""
+	}
+	aNamed, aIsNamed := a.(*types.Named)
+	bNamed, bIsNamed := b.(*types.Named)
+	if aIsNamed && bIsNamed && aNamed.Obj().Name() == bNamed.Obj().Name() {
+		_, aIsStruct := a.Underlying().(*types.Struct)
+		_, bIsStruct := b.Underlying().(*types.Struct)
+		if aIsStruct && bIsStruct {
+			c := conversion{name: aNamed.Obj().Name(), in: aNamed, out: bNamed}
+			if !w.convertible[c.functionName()] {
+				return "", fmt.Sprintf("%s requires the hand-written %s function", w.describe(a), c.functionName())
+			}
+			return fmt.Sprintf(`if err := %s(%s, %s); err != nil {
+	return err
+}`, c.functionName(), addressOf(in), addressOf(out)), ""
+		}
+		aBasic, aIsBasic := a.Underlying().(*types.Basic)
+		bBasic, bIsBasic := b.Underlying().(*types.Basic)
+		if aIsBasic && bIsBasic && types.Identical(aBasic, bBasic) {
+			if missing := w.missingEnumValues(aNamed, bNamed); len(missing) > 0 {
+				return "", fmt.Sprintf("the %s values %s have no equivalent in %s", w.describe(a), strings.Join(missing, ", "), w.describe(b))
+			}
+			return fmt.Sprintf("%s = %s(%s)", out, w.typeString(b), in), ""
+		}
+	}
+
+	cannotConvert := fmt.Sprintf("%s cannot be converted into %s", w.describe(a), w.describe(b))
+	switch aType := a.Underlying().(type) {
+	case *types.Pointer:
+		bType, isPointer := b.Underlying().(*types.Pointer)
+		if !isPointer {
+			return "", cannotConvert
+		}
+		elem, reason := w.valueConversion("*"+in, "*"+out, aType.Elem(), bType.Elem(), depth)
+		if reason != "" {
+			return "", reason
+		}
+		return fmt.Sprintf(`if %s != nil {
+	%s = new(%s)
+	%s
+}`, in, out, w.typeString(bType.Elem()), indent(elem)), ""
+	case *types.Slice:
+		bType, isSlice := b.Underlying().(*types.Slice)
+		if !isSlice {
+			return "", cannotConvert
+		}
+		index := depthName("i", depth)
+		elem, reason := w.valueConversion(in+"["+index+"]", out+"["+index+"]", aType.Elem(), bType.Elem(), depth+1)
+		if reason != "" {
+			return "", reason
+		}
+		return fmt.Sprintf(`if %[1]s != nil {
+	%[2]s = make(%[3]s, len(%[1]s))
+	for %[4]s := range %[1]s {
+		%[5]s
+	}
+}`, in, out, w.typeString(b), index, indent(indent(elem))), ""
+	case *types.Map:
+		bType, isMap := b.Underlying().(*types.Map)
+		if !isMap || !types.Identical(aType.Key(), bType.Key()) {
+			return "", cannotConvert
+		}
+		key, value, converted := depthName("key", depth), depthName("value", depth), depthName("converted", depth)
+		elem, reason := w.valueConversion(value, converted, aType.Elem(), bType.Elem(), depth+1)
+		if reason != "" {
+			return "", reason
+		}
+		return fmt.Sprintf(`if %[1]s != nil {
+	%[2]s = make(%[3]s, len(%[1]s))
+	for %[4]s, %[5]s := range %[1]s {
+		var %[6]s %[7]s
+		%[8]s
+		%[2]s[%[4]s] = %[6]s
+	}
+}`, in, out, w.typeString(b), key, value, converted, w.typeString(bType.Elem()), indent(indent(elem))), ""
+	}
+	return "", cannotConvert
+}
+
+// missingEnumValues returns the enum values of the `a` type that are not enum values of the `b` type,
+// if both types are enum types
+func (w *conversionWriter) missingEnumValues(a, b *types.Named) []string {
+	aValues, bValues := w.enumValues[a.Obj()], w.enumValues[b.Obj()]
+	if len(aValues) == 0 || len(bValues) == 0 {
+		return nil
+	}
+	var missing []string
+	for _, value := range aValues {
+		found := false
+		for _, bValue := range bValues {
+			found = found || value == bValue
+		}
+		if !found {
+			missing = append(missing, strconv.Quote(value))
+		}
+	}
+	return missing
+}
+
+// qualifier returns the name under which the generated code refers to the given package,
+// which is the name under which the package is imported in the Go files of the generated package, if any
+func (w *conversionWriter) qualifier(pkg *types.Package) string {
+	if pkg.Path() == w.pkg.Path() {
+		return ""
+	}
+	if name, known := w.namesByPath[pkg.Path()]; known {
+		return name
+	}
+	name := pkg.Name()
+	var importNames []string
+	for importName, imported := range w.rootImports {
+		if imported.Path() == pkg.Path() {
+			importNames = append(importNames, importName)
+		}
+	}
+	if len(importNames) > 0 {
+		sort.Strings(importNames)
+		name = importNames[0]
+	}
+	base := name
+	for i := 2; w.isImportName(name); i++ {
+		name = base + strconv.Itoa(i)
+	}
+	w.namesByPath[pkg.Path()] = name
+	return name
+}
+
+// isImportName returns true if the given name is already used by a package imported by the generated code
+func (w *conversionWriter) isImportName(name string) bool {
+	for _, used := range w.namesByPath {
+		if used == name {
+			return true
+		}
+	}
+	return false
+}
+
+// typeString returns the Go expression of the given type in the generated code
+func (w *conversionWriter) typeString(goType types.Type) string {
+	return types.TypeString(goType, w.qualifier)
+}
+
+// describe returns the description of the given type in the comments of the generated code,
+// which qualifies the types of the other packages with their package name, without importing them
+func (w *conversionWriter) describe(goType types.Type) string {
+	return types.TypeString(goType, func(pkg *types.Package) string {
+		if pkg.Path() == w.pkg.Path() {
+			return ""
+		}
+		return pkg.Name()
+	})
+}
+
+// depthName returns the name of a variable of the generated code at the given depth of nested lists and maps
+func depthName(name string, depth int) string {
+	if depth == 0 {
+		return name
+	}
+	return name + strconv.Itoa(depth)
+}
+
+// addressOf returns the expression of the address of the given addressable expression
+func addressOf(expr string) string {
+	if strings.HasPrefix(expr, "*") {
+		return expr[1:]
+	}
+	return "&" + expr
+}
+
+// indent indents all the lines of the given statements but the first one
+func indent(statements string) string {
+	return strings.ReplaceAll(statements, "\n", "\n\t")
+}
@@ -0,0 +1,168 @@
+package crds
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// explainField is a field of the `kubectl explain` documentation tree of a CRD
+type explainField struct {
+	// Name is the Json name of the field
+	Name string `json:"name"`
+	// Type is the type of the field, as displayed by `kubectl explain`, such as `<[]Object>`
+	Type string `json:"type"`
+	// Required is true if the field is required
+	Required bool `json:"required,omitempty"`
+	// Description is the description of the field
+	Description string `json:"description,omitempty"`
+	// Fields are the fields of the field objects, or of the objects of the field lists and maps
+	Fields []explainField `json:"fields,omitempty"`
+}
+
+// explainVersion is the `kubectl explain` documentation tree of a version of a CRD
+type explainVersion struct {
+	Version     string         `json:"version"`
+	Description string         `json:"description,omitempty"`
+	Fields      []explainField `json:"fields"`
+	// Undocumented are the paths of the fields without description, which `kubectl explain` shows without documentation
+	Undocumented []string `json:"undocumented"`
+}
+
+// explainReport is the `kubectl explain` documentation tree of a CRD
+type explainReport struct {
+	CRD      string           `json:"crd"`
+	Kind     string           `json:"kind"`
+	Versions []explainVersion `json:"versions"`
+}
+
+// explainCRD returns the `kubectl explain` documentation tree of the given CRD
+func explainCRD(crd *apiext.CustomResourceDefinition) explainReport {
+	report := explainReport{
+		CRD:  crd.Name,
+		Kind: crd.Spec.Names.Kind,
+	}
+	for _, version := range crd.Spec.Versions {
+		explained := explainVersion{
+			Version:      version.Name,
+			Fields:       []explainField{},
+			Undocumented: []string{},
+		}
+		if version.Schema != nil && version.Schema.OpenAPIV3Schema != nil {
+			explained.Description = version.Schema.OpenAPIV3Schema.Description
+			explained.Fields = explainFields(strings.ToLower(crd.Spec.Names.Kind), version.Schema.OpenAPIV3Schema, &explained.Undocumented)
+		}
+		report.Versions = append(report.Versions, explained)
+	}
+	return report
+}
+
+// explainFields returns the documentation of the fields of the given object schema, sorted by name,
+// and adds the paths of the fields without description to `undocumented`
+func explainFields(path string, schema *apiext.JSONSchemaProps, undocumented *[]string) []explainField {
+	schema = elementSchema(schema)
+	propertyNames := make([]string, 0, len(schema.Properties))
+	for propertyName := range schema.Properties {
+		propertyNames = append(propertyNames, propertyName)
+	}
+	sort.Strings(propertyNames)
+
+	var fields []explainField
+	for _, propertyName := range propertyNames {
+		property := schema.Properties[propertyName]
+		propertyPath := path + "." + propertyName
+		if property.Description == "" {
+			*undocumented = append(*undocumented, propertyPath)
+		}
+		fields = append(fields, explainField{
+			Name:        propertyName,
+			Type:        explainType(&property),
+			Required:    containsString(schema.Required, propertyName),
+			Description: property.Description,
+			Fields:      explainFields(propertyPath, &property, undocumented),
+		})
+	}
+	return fields
+}
+
+// elementSchema returns the schema of the objects of the given list or map schema,
+// or the given schema itself for other schemas
+func elementSchema(schema *apiext.JSONSchemaProps) *apiext.JSONSchemaProps {
+	for {
+		switch {
+		case schema.Type == "array" && schema.Items != nil && schema.Items.Schema != nil:
+			schema = schema.Items.Schema
+		case schema.Type == "object" && len(schema.Properties) == 0 && schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil:
+			schema = schema.AdditionalProperties.Schema
+		default:
+			return schema
+		}
+	}
+}
+
+// explainType returns the type of the given schema, as displayed by `kubectl explain`
+func explainType(schema *apiext.JSONSchemaProps) string {
+	var typeName func(schema *apiext.JSONSchemaProps) string
+	typeName = func(schema *apiext.JSONSchemaProps) string {
+		switch {
+		case schema.Type == "array" && schema.Items != nil && schema.Items.Schema != nil:
+			return "[]" + typeName(schema.Items.Schema)
+		case schema.Type == "object" && len(schema.Properties) == 0 && schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil:
+			return "map[string]" + typeName(schema.AdditionalProperties.Schema)
+		case schema.Type == "object", schema.Type == "" && schema.XPreserveUnknownFields != nil:
+			return "Object"
+		case schema.XIntOrString:
+			return "string"
+		default:
+			return schema.Type
+		}
+	}
+	return "<" + typeName(schema) + ">"
+}
+
+// lostDescriptions returns the paths of the fields whose description, in the original schema,
+// is missing from the edited schema
+func lostDescriptions(path string, original, edited *apiext.JSONSchemaProps) []string {
+	if original == nil || edited == nil {
+		return nil
+	}
+	var lost []string
+	propertyNames := make([]string, 0, len(original.Properties))
+	for propertyName := range original.Properties {
+		propertyNames = append(propertyNames, propertyName)
+	}
+	sort.Strings(propertyNames)
+	for _, propertyName := range propertyNames {
+		originalProperty := original.Properties[propertyName]
+		editedProperty, exists := edited.Properties[propertyName]
+		if originalProperty.Description != "" && (!exists || editedProperty.Description == "") {
+			lost = append(lost, path+"."+propertyName)
+		}
+		if exists {
+			lost = append(lost, lostDescriptions(path+"."+propertyName, &originalProperty, &editedProperty)...)
+		}
+	}
+	if original.Items != nil && edited.Items != nil {
+		lost = append(lost, lostDescriptions(path+"[]", original.Items.Schema, edited.Items.Schema)...)
+	}
+	if original.AdditionalProperties != nil && edited.AdditionalProperties != nil {
+		lost = append(lost, lostDescriptions(path+".*", original.AdditionalProperties.Schema, edited.AdditionalProperties.Schema)...)
+	}
+	return lost
+}
+
+// checkDescriptions returns an error if descriptions of the original CRD versions are missing from the edited CRD,
+// since `kubectl explain` would then show fields without their documentation
+func checkDescriptions(original []apiext.CustomResourceDefinitionVersion, edited *apiext.CustomResourceDefinition) error {
+	for i, version := range edited.Spec.Versions {
+		if version.Schema == nil || original[i].Schema == nil {
+			continue
+		}
+		if lost := lostDescriptions(strings.ToLower(edited.Spec.Names.Kind), original[i].Schema.OpenAPIV3Schema, version.Schema.OpenAPIV3Schema); len(lost) > 0 {
+			return fmt.Errorf("the descriptions of the following fields of the %s version of the %s CRD were lost: %s", version.Name, edited.Name, strings.Join(lost, ", "))
+		}
+	}
+	return nil
+}
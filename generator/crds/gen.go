@@ -3,11 +3,13 @@ package crds
 import (
 	"fmt"
 	"go/ast"
+	"strings"
 
 	"github.com/devfile/api/generator/genutils"
 
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-tools/pkg/crd"
 	crdmarkers "sigs.k8s.io/controller-tools/pkg/crd/markers"
 	"sigs.k8s.io/controller-tools/pkg/genall"
@@ -22,7 +24,44 @@ import (
 // Generator generates CustomResourceDefinition YAML manifests for each root Kubernetes resource.
 //
 // Currently this generates v1 and v1beta1 CRDs for the `DevWorkspace` and `DevWorkspaceTemplate` resources.
-type Generator struct{}
+// The top-level keyed lists of the devworkspace template (components, commands, projects and starter projects)
+// are declared as `map` lists, so that server-side apply merges their elements by key,
+// and elements with duplicate keys are rejected by the API server.
+type Generator struct {
+	// SSAAudit enables the server-side apply audit mode: a `<group>_<plural>.ssa-audit.yaml` report is written next to each CRD,
+	// listing the fields that are likely to behave unexpectedly under server-side apply
+	// (lists and maps that are owned as a whole, or maps of objects whose values are merged field by field),
+	// with the markers that should be added to fix them.
+	SSAAudit bool `marker:"ssaAudit,optional"`
+
+	// Explain enables the explain mode: a `<group>_<plural>.explain.yaml` report is written next to each CRD,
+	// with the documentation tree that `kubectl explain` shows for each field of each version of the CRD,
+	// such as `kubectl explain devworkspace.spec.template.components`, and the list of the undocumented fields.
+	//
+	// Independently of this mode, the generation fails if the descriptions of some fields are lost
+	// while the CRD schemas are post-processed.
+	Explain bool `marker:"explain,optional"`
+
+	// Labels is a comma-separated list of `key=value` labels added to the metadata of the generated CRDs,
+	// such as `app.kubernetes.io/part-of=devfile`.
+	// The list should be quoted if it contains commas or colons.
+	Labels string `marker:",optional"`
+
+	// Annotations is a comma-separated list of `key=value` annotations added to the metadata of the generated CRDs,
+	// such as `"api-approved.kubernetes.io=https://github.com/devfile/api/pull/1"`.
+	// The list should be quoted if it contains commas or colons.
+	Annotations string `marker:",optional"`
+}
+
+// keyedListMapKeys contains the map keys of the top-level keyed lists of the devworkspace template,
+// indexed by the Json name of the list field.
+// They match the `patchMergeKey` of these fields.
+var keyedListMapKeys = map[string]string{
+	"components":      "name",
+	"projects":        "name",
+	"starterProjects": "name",
+	"commands":        "id",
+}
 
 func (Generator) CheckFilter() loader.NodeFilter {
 	return func(node ast.Node) bool {
@@ -40,6 +79,15 @@ func (Generator) RegisterMarkers(into *markers.Registry) error {
 
 // Generate generates the artifacts
 func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	labels, err := parseKeyValues("labels", g.Labels, validation.IsValidLabelValue)
+	if err != nil {
+		return err
+	}
+	annotations, err := parseKeyValues("annotations", g.Annotations, nil)
+	if err != nil {
+		return err
+	}
+
 	parser := &crd.Parser{
 		Collector:           ctx.Collector,
 		Checker:             ctx.Checker,
@@ -107,15 +155,27 @@ func (g Generator) Generate(ctx *genall.GenerationContext) error {
 	for groupKind := range kubeKinds {
 		parser.NeedCRDFor(groupKind, nil)
 		crdRaw := parser.CustomResourceDefinitions[groupKind]
+		originalVersions := []apiext.CustomResourceDefinitionVersion{}
+		for _, apiVersion := range crdRaw.Spec.Versions {
+			originalVersions = append(originalVersions, *apiVersion.DeepCopy())
+		}
 		apiVersions := []string{}
 		for _, apiVersion := range crdRaw.Spec.Versions {
 			apiVersions = append(apiVersions, apiVersion.Name)
 			unionDiscriminators := unionDiscriminatorsByGV[groupKind.WithVersion(apiVersion.Name).GroupVersion()]
 			genutils.AddUnionOneOfConstraints(apiVersion.Schema.OpenAPIV3Schema, unionDiscriminators, false)
+			addListMapKeys(apiVersion.Schema.OpenAPIV3Schema)
+			removeTitles(apiVersion.Schema.OpenAPIV3Schema)
+		}
+		if err := checkDescriptions(originalVersions, &crdRaw); err != nil {
+			return err
 		}
 
 		latestAPIVersion := genutils.LatestKubeLikeVersion(apiVersions)
 
+		crdRaw.Labels = mergeKeyValues(crdRaw.Labels, labels)
+		crdRaw.Annotations = mergeKeyValues(crdRaw.Annotations, annotations)
+
 		for pkg, gv := range parser.GroupVersions {
 			if gv.Group != groupKind.Group || gv.Version != latestAPIVersion {
 				continue
@@ -140,6 +200,20 @@ func (g Generator) Generate(ctx *genall.GenerationContext) error {
 			}
 		}
 
+		if g.SSAAudit {
+			reportName := fmt.Sprintf("%s_%s.ssa-audit.yaml", crdRaw.Spec.Group, crdRaw.Spec.Names.Plural)
+			if err := ctx.WriteYAML(reportName, auditServerSideApply(&crdRaw)); err != nil {
+				return err
+			}
+		}
+
+		if g.Explain {
+			reportName := fmt.Sprintf("%s_%s.explain.yaml", crdRaw.Spec.Group, crdRaw.Spec.Names.Plural)
+			if err := ctx.WriteYAML(reportName, explainCRD(&crdRaw)); err != nil {
+				return err
+			}
+		}
+
 		for i, ver := range crdVersions {
 			copiedCrd := crdRaw.DeepCopy()
 
@@ -175,3 +249,92 @@ func (g Generator) Generate(ctx *genall.GenerationContext) error {
 
 	return nil
 }
+
+// parseKeyValues parses the comma-separated list of `key=value` pairs of the given generator option.
+// Keys should be qualified names, and values are checked with the given validation function if any.
+func parseKeyValues(option string, list string, validateValue func(string) []string) (map[string]string, error) {
+	keyValues := map[string]string{}
+	if strings.TrimSpace(list) == "" {
+		return keyValues, nil
+	}
+	for _, pair := range strings.Split(list, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid %s entry %q: should be <key>=<value>", option, pair)
+		}
+		key, value := parts[0], parts[1]
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid %s key %q: %s", option, key, strings.Join(errs, "; "))
+		}
+		if validateValue != nil {
+			if errs := validateValue(value); len(errs) > 0 {
+				return nil, fmt.Errorf("invalid %s value %q for key %q: %s", option, value, key, strings.Join(errs, "; "))
+			}
+		}
+		if _, exists := keyValues[key]; exists {
+			return nil, fmt.Errorf("duplicate %s key %q", option, key)
+		}
+		keyValues[key] = value
+	}
+	return keyValues, nil
+}
+
+// mergeKeyValues adds the given key-value pairs to the existing ones, which may be nil
+func mergeKeyValues(existing map[string]string, added map[string]string) map[string]string {
+	if len(added) == 0 {
+		return existing
+	}
+	if existing == nil {
+		existing = map[string]string{}
+	}
+	for key, value := range added {
+		existing[key] = value
+	}
+	return existing
+}
+
+// removeTitles removes the titles set by the `devfile:title` markers of the Json schema generator,
+// so that the CRDs are the same whether or not the Json schema generator runs in the same invocation.
+func removeTitles(jsonSchema *apiext.JSONSchemaProps) {
+	genutils.EditJSONSchema(jsonSchema, func(schema *apiext.JSONSchemaProps) (newVisitor genutils.Visitor, stop bool) {
+		if schema != nil {
+			schema.Title = ""
+		}
+		return
+	})
+}
+
+// addListMapKeys declares the keyed lists of the devworkspace template as `map` lists in the given Json schema,
+// with the list key as the only map key.
+// Lists whose elements don't require the key property are left unchanged, since
+// the API server only accepts map keys that are required properties.
+func addListMapKeys(jsonSchema *apiext.JSONSchemaProps) {
+	genutils.EditJSONSchema(jsonSchema, func(schema *apiext.JSONSchemaProps) (newVisitor genutils.Visitor, stop bool) {
+		if schema == nil || schema.Type != "object" {
+			return
+		}
+		for propertyName, mapKey := range keyedListMapKeys {
+			property, found := schema.Properties[propertyName]
+			if !found || property.Type != "array" || property.Items == nil || property.Items.Schema == nil {
+				continue
+			}
+			if !containsString(property.Items.Schema.Required, mapKey) {
+				continue
+			}
+			listType := "map"
+			property.XListType = &listType
+			property.XListMapKeys = []string{mapKey}
+			schema.Properties[propertyName] = property
+		}
+		return
+	})
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
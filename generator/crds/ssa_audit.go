@@ -0,0 +1,123 @@
+package crds
+
+import (
+	"fmt"
+	"sort"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+const (
+	// atomicObjectListFinding is reported for lists of objects without list type: they are atomic,
+	// so a server-side apply of any element takes the ownership of the whole list.
+	atomicObjectListFinding = "atomicObjectList"
+	// atomicScalarListFinding is reported for lists of scalars without list type: they are atomic,
+	// so a server-side apply of any element takes the ownership of the whole list.
+	atomicScalarListFinding = "atomicScalarList"
+	// mapOfObjectsFinding is reported for maps of objects without map type: the fields of each map value
+	// are owned separately, which is rarely the expected behavior for map values.
+	mapOfObjectsFinding = "mapOfObjects"
+)
+
+// listKeyCandidates are the names of the properties that are proposed as list map keys, by order of preference
+var listKeyCandidates = []string{"name", "id", "key"}
+
+// ssaFinding is a field of a CRD schema that is likely to behave unexpectedly under server-side apply
+type ssaFinding struct {
+	// Path is the path of the field in the CRD schema, with `[]` for list elements and `*` for map values
+	Path string `json:"path"`
+	// Kind is the kind of the finding
+	Kind string `json:"kind"`
+	// Suggestion is the marker that should be added on the GO field to fix the finding
+	Suggestion string `json:"suggestion"`
+}
+
+// ssaVersionAudit contains the findings of a given version of a CRD
+type ssaVersionAudit struct {
+	Version  string       `json:"version"`
+	Findings []ssaFinding `json:"findings"`
+}
+
+// ssaAuditReport is the server-side apply audit report of a CRD
+type ssaAuditReport struct {
+	CRD      string            `json:"crd"`
+	Versions []ssaVersionAudit `json:"versions"`
+}
+
+// auditServerSideApply returns the server-side apply audit report of the given CRD
+func auditServerSideApply(crd *apiext.CustomResourceDefinition) ssaAuditReport {
+	report := ssaAuditReport{
+		CRD: crd.Name,
+	}
+	for _, version := range crd.Spec.Versions {
+		audit := ssaVersionAudit{
+			Version:  version.Name,
+			Findings: []ssaFinding{},
+		}
+		if version.Schema != nil && version.Schema.OpenAPIV3Schema != nil {
+			auditSchema("", version.Schema.OpenAPIV3Schema, &audit.Findings)
+		}
+		report.Versions = append(report.Versions, audit)
+	}
+	return report
+}
+
+func auditSchema(path string, schema *apiext.JSONSchemaProps, findings *[]ssaFinding) {
+	switch schema.Type {
+	case "object":
+		propertyNames := make([]string, 0, len(schema.Properties))
+		for propertyName := range schema.Properties {
+			propertyNames = append(propertyNames, propertyName)
+		}
+		sort.Strings(propertyNames)
+		for _, propertyName := range propertyNames {
+			property := schema.Properties[propertyName]
+			auditSchema(path+"."+propertyName, &property, findings)
+		}
+
+		if schema.AdditionalProperties == nil || schema.AdditionalProperties.Schema == nil {
+			return
+		}
+		values := schema.AdditionalProperties.Schema
+		if values.Type == "object" && len(values.Properties) > 0 && schema.XMapType == nil {
+			*findings = append(*findings, ssaFinding{
+				Path:       path,
+				Kind:       mapOfObjectsFinding,
+				Suggestion: "+mapType=atomic",
+			})
+		}
+		auditSchema(path+".*", values, findings)
+
+	case "array":
+		if schema.Items == nil || schema.Items.Schema == nil {
+			return
+		}
+		items := schema.Items.Schema
+		if schema.XListType == nil {
+			if items.Type == "object" {
+				*findings = append(*findings, ssaFinding{
+					Path:       path,
+					Kind:       atomicObjectListFinding,
+					Suggestion: suggestListMarkers(items),
+				})
+			} else {
+				*findings = append(*findings, ssaFinding{
+					Path:       path,
+					Kind:       atomicScalarListFinding,
+					Suggestion: "+listType=set if the elements are unique, +listType=atomic otherwise",
+				})
+			}
+		}
+		auditSchema(path+"[]", items, findings)
+	}
+}
+
+// suggestListMarkers returns the markers that should be added on a list of objects with the given element schema
+func suggestListMarkers(items *apiext.JSONSchemaProps) string {
+	for _, candidate := range listKeyCandidates {
+		if property, exists := items.Properties[candidate]; exists && property.Type == "string" && containsString(items.Required, candidate) {
+			return fmt.Sprintf("+listType=map +listMapKey=%s", candidate)
+		}
+	}
+	return "+listType=atomic, or add a required key property and use +listType=map"
+}
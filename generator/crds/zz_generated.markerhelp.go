@@ -16,8 +16,25 @@ func (Generator) Help() *markers.DefinitionHelp {
 		Category: "",
 		DetailedHelp: markers.DetailedHelp{
 			Summary: "generates CustomResourceDefinition YAML manifests for each root Kubernetes resource. ",
-			Details: "Currently this generates v1 and v1beta1 CRDs for the `DevWorkspace` and `DevWorkspaceTemplate` resources.",
+			Details: "Currently this generates v1 and v1beta1 CRDs for the `DevWorkspace` and `DevWorkspaceTemplate` resources. The top-level keyed lists of the devworkspace template (components, commands, projects and starter projects) are declared as `map` lists, so that server-side apply merges their elements by key, and elements with duplicate keys are rejected by the API server.",
+		},
+		FieldHelp: map[string]markers.DetailedHelp{
+			"SSAAudit": {
+				Summary: "enables the server-side apply audit mode: a `<group>_<plural>.ssa-audit.yaml` report is written next to each CRD, listing the fields that are likely to behave unexpectedly under server-side apply (lists and maps that are owned as a whole, or maps of objects whose values are merged field by field), with the markers that should be added to fix them.",
+				Details: "",
+			},
+			"Explain": {
+				Summary: "enables the explain mode: a `<group>_<plural>.explain.yaml` report is written next to each CRD, with the documentation tree that `kubectl explain` shows for each field of each version of the CRD, such as `kubectl explain devworkspace.spec.template.components`, and the list of the undocumented fields. ",
+				Details: "Independently of this mode, the generation fails if the descriptions of some fields are lost while the CRD schemas are post-processed.",
+			},
+			"Labels": {
+				Summary: "is a comma-separated list of `key=value` labels added to the metadata of the generated CRDs, such as `app.kubernetes.io/part-of=devfile`. The list should be quoted if it contains commas or colons.",
+				Details: "",
+			},
+			"Annotations": {
+				Summary: "is a comma-separated list of `key=value` annotations added to the metadata of the generated CRDs, such as `\"api-approved.kubernetes.io=https://github.com/devfile/api/pull/1\"`. The list should be quoted if it contains commas or colons.",
+				Details: "",
+			},
 		},
-		FieldHelp: map[string]markers.DetailedHelp{},
 	}
 }
@@ -0,0 +1,258 @@
+package deepcopy
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	ctdeepcopy "sigs.k8s.io/controller-tools/pkg/deepcopy"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+// deepcopyFileName is the name of the file written by the controller-tools deepcopy generator
+const deepcopyFileName = "zz_generated.deepcopy.go"
+
+var (
+	// ShallowCopyFieldMarker is associated with a field that should be shallow-copied by the generated DeepCopy methods
+	ShallowCopyFieldMarker = markers.Must(markers.MakeDefinition("devfile:deepcopy:shallow", markers.DescribesField, struct{}{}))
+)
+
+// +controllertools:marker:generateHelp
+
+// Generator generates the DeepCopy implementations of the controller-tools `object` generator,
+// except for the fields annotated with the `devfile:deepcopy:shallow` marker, which are shallow-copied.
+//
+// Shallow-copying is meant for fields that may contain large values, such as inlined Kubernetes manifests or attributes,
+// whose deep copy is costly: the copy and the original then share the content of these fields (maps, slices or pointers),
+// which should be treated as immutable.
+type Generator struct {
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+	// Year specifies the year to substitute for " YEAR" in the header file.
+	Year string `marker:",optional"`
+}
+
+func (g Generator) delegate() ctdeepcopy.Generator {
+	return ctdeepcopy.Generator{HeaderFile: g.HeaderFile, Year: g.Year}
+}
+
+// RegisterMarkers registers the markers of the Generator
+func (g Generator) RegisterMarkers(into *markers.Registry) error {
+	if err := g.delegate().RegisterMarkers(into); err != nil {
+		return err
+	}
+	if err := into.Register(ShallowCopyFieldMarker); err != nil {
+		return err
+	}
+	into.AddHelp(ShallowCopyFieldMarker,
+		markers.SimpleHelp("Devfile", "indicates that the field should be shallow-copied by the generated DeepCopy methods, and that its content should then be treated as immutable"))
+	return nil
+}
+
+func (g Generator) CheckFilter() loader.NodeFilter {
+	return g.delegate().CheckFilter()
+}
+
+// Generate generates the artifacts
+func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	shallowFields := map[*loader.Package]map[string][]string{}
+	for _, root := range ctx.Roots {
+		fieldsByType := map[string][]string{}
+		if err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
+			for _, field := range info.Fields {
+				if field.Markers.Get(ShallowCopyFieldMarker.Name) != nil {
+					fieldsByType[info.Name] = append(fieldsByType[info.Name], field.Name)
+				}
+			}
+		}); err != nil {
+			root.AddError(err)
+			return nil
+		}
+		if len(fieldsByType) > 0 {
+			shallowFields[root] = fieldsByType
+		}
+	}
+
+	delegateCtx := *ctx
+	delegateCtx.OutputRule = &shallowCopyOutputRule{
+		OutputRule:    ctx.OutputRule,
+		shallowFields: shallowFields,
+	}
+	return g.delegate().Generate(&delegateCtx)
+}
+
+// shallowCopyOutputRule is an output rule that removes the deep copy of the shallow-copied fields
+// from the DeepCopy implementations written by the controller-tools deepcopy generator
+type shallowCopyOutputRule struct {
+	genall.OutputRule
+	shallowFields map[*loader.Package]map[string][]string
+}
+
+// Open returns a writer that rewrites the DeepCopy implementations when it is closed,
+// if the package has shallow-copied fields
+func (o *shallowCopyOutputRule) Open(pkg *loader.Package, itemPath string) (io.WriteCloser, error) {
+	fieldsByType, hasShallowFields := o.shallowFields[pkg]
+	if !hasShallowFields || itemPath != deepcopyFileName {
+		return o.OutputRule.Open(pkg, itemPath)
+	}
+	return &shallowCopyWriter{rule: o, pkg: pkg, itemPath: itemPath, fieldsByType: fieldsByType}, nil
+}
+
+// shallowCopyWriter buffers the content of the generated DeepCopy implementations,
+// and writes them through the delegate output rule, once rewritten, when closed
+type shallowCopyWriter struct {
+	bytes.Buffer
+	rule         *shallowCopyOutputRule
+	pkg          *loader.Package
+	itemPath     string
+	fieldsByType map[string][]string
+}
+
+func (w *shallowCopyWriter) Close() error {
+	content, err := removeFieldDeepCopies(w.Bytes(), w.fieldsByType)
+	if err != nil {
+		return err
+	}
+	writer, err := w.rule.OutputRule.Open(w.pkg, w.itemPath)
+	if err != nil {
+		return err
+	}
+	defer writer.Close()
+	_, err = writer.Write(content)
+	return err
+}
+
+// sourceEdit replaces a range of the source code
+type sourceEdit struct {
+	start, end  int
+	replacement string
+}
+
+// removeFieldDeepCopies removes, from the `DeepCopyInto` methods of the given source code, the statements that deep-copy
+// the given fields of each type. Since these methods start by copying the whole struct (`*out = *in`),
+// the removed fields are then shallow-copied. The doc comment of the methods is updated accordingly.
+func removeFieldDeepCopies(source []byte, fieldsByType map[string][]string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", source, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var edits []sourceEdit
+	for _, decl := range file.Decls {
+		funcDecl, isFunc := decl.(*ast.FuncDecl)
+		if !isFunc || funcDecl.Name.Name != "DeepCopyInto" || funcDecl.Recv == nil || len(funcDecl.Recv.List) != 1 {
+			continue
+		}
+		typeName := receiverTypeName(funcDecl.Recv.List[0].Type)
+		fields := fieldsByType[typeName]
+		if len(fields) == 0 {
+			continue
+		}
+		for _, stmt := range funcDecl.Body.List {
+			if copiesField(stmt, fields) {
+				end := fset.Position(stmt.End()).Offset
+				if end < len(source) && source[end] == '\n' {
+					end++
+				}
+				edits = append(edits, sourceEdit{
+					start: fset.Position(stmt.Pos()).Offset,
+					end:   end,
+				})
+			}
+		}
+		if funcDecl.Doc != nil {
+			docEnd := fset.Position(funcDecl.Doc.End()).Offset
+			edits = append(edits, sourceEdit{
+				start:       docEnd,
+				end:         docEnd,
+				replacement: "\n// The following fields are shallow-copied, as specified by the devfile:deepcopy:shallow marker: " + strings.Join(fields, ", ") + ".",
+			})
+		}
+	}
+
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start > edits[j].start })
+	result := append([]byte{}, source...)
+	for _, edit := range edits {
+		result = append(result[:edit.start], append([]byte(edit.replacement), result[edit.end:]...)...)
+	}
+
+	// The removed statements may have been the only users of some imports
+	fset = token.NewFileSet()
+	if file, err = parser.ParseFile(fset, "", result, parser.ParseComments); err != nil {
+		return nil, err
+	}
+	for _, importSpec := range append([]*ast.ImportSpec{}, file.Imports...) {
+		importPath, _ := strconv.Unquote(importSpec.Path.Value)
+		if !astutil.UsesImport(file, importPath) {
+			name := ""
+			if importSpec.Name != nil {
+				name = importSpec.Name.Name
+			}
+			astutil.DeleteNamedImport(fset, file, name, importPath)
+		}
+	}
+	buf := new(bytes.Buffer)
+	if err := format.Node(buf, fset, file); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// receiverTypeName returns the name of the type of a method receiver
+func receiverTypeName(receiverType ast.Expr) string {
+	if star, isStar := receiverType.(*ast.StarExpr); isStar {
+		receiverType = star.X
+	}
+	if ident, isIdent := receiverType.(*ast.Ident); isIdent {
+		return ident.Name
+	}
+	return ""
+}
+
+// copiesField returns true if the given statement of a generated `DeepCopyInto` method deep-copies one of the given fields:
+// `if in.Field != nil { ... }`, `in.Field.DeepCopyInto(&out.Field)` or `out.Field = ...`
+func copiesField(stmt ast.Stmt, fields []string) bool {
+	var head ast.Expr
+	switch stmt := stmt.(type) {
+	case *ast.IfStmt:
+		if binary, isBinary := stmt.Cond.(*ast.BinaryExpr); isBinary {
+			head = binary.X
+		}
+	case *ast.ExprStmt:
+		if call, isCall := stmt.X.(*ast.CallExpr); isCall {
+			if selector, isSelector := call.Fun.(*ast.SelectorExpr); isSelector {
+				head = selector.X
+			}
+		}
+	case *ast.AssignStmt:
+		if len(stmt.Lhs) == 1 {
+			head = stmt.Lhs[0]
+		}
+	}
+	selector, isSelector := head.(*ast.SelectorExpr)
+	if !isSelector {
+		return false
+	}
+	if ident, isIdent := selector.X.(*ast.Ident); !isIdent || (ident.Name != "in" && ident.Name != "out") {
+		return false
+	}
+	for _, field := range fields {
+		if selector.Sel.Name == field {
+			return true
+		}
+	}
+	return false
+}
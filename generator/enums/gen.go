@@ -0,0 +1,160 @@
+package enums
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/generator/genutils"
+	crdmarkers "sigs.k8s.io/controller-tools/pkg/crd/markers"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+// enumMarkerName is the name of the marker that lists the values of an enum type
+const enumMarkerName = "kubebuilder:validation:Enum"
+
+// +controllertools:marker:generateHelp
+
+// Generator generates the `IsValid()` and `Values()` methods of the string types that have the `kubebuilder:validation:Enum` marker,
+// so that consumers check enum values against the generated methods instead of comparing them with string literals.
+//
+// The `Values()` method returns the values listed by the `kubebuilder:validation:Enum` marker, in marker order,
+// through the constants of the enum type when they are declared.
+//
+// The generator also reports an error for the `kubebuilder:validation:Enum` markers set on raw `string` fields,
+// since enum values should be carried by named types, and for the constants of an enum type
+// whose value is not listed by the marker.
+type Generator struct {
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	return crdmarkers.Register(into)
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// enumType is an enum type for which the methods are generated
+type enumType struct {
+	name string
+	// values are the Go expressions of the enum values, in marker order
+	values []string
+}
+
+// Generate generates the artifacts
+func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	for _, root := range ctx.Roots {
+		ctx.Checker.Check(root)
+		root.NeedTypesInfo()
+
+		var enums []enumType
+		if err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
+			for _, field := range info.Fields {
+				if ident, isIdent := field.RawField.Type.(*ast.Ident); isIdent && ident.Name == "string" && field.Markers.Get(enumMarkerName) != nil {
+					root.AddError(fmt.Errorf("field %s/%s has the %s marker, which should be set on a named string type instead", info.Name, field.Name, enumMarkerName))
+				}
+			}
+			enumMarker, isEnum := info.Markers.Get(enumMarkerName).(crdmarkers.Enum)
+			if !isEnum {
+				return
+			}
+			enum, err := buildEnumType(root, info.Name, enumMarker)
+			if err != nil {
+				root.AddError(err)
+				return
+			}
+			enums = append(enums, enum)
+		}); err != nil {
+			root.AddError(err)
+			return nil
+		}
+		if len(enums) == 0 {
+			continue
+		}
+
+		genutils.WriteFormattedSourceFile("enums", g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
+			for _, enum := range enums {
+				buf.WriteString(fmt.Sprintf(`
+// IsValid returns true if the value is one of the values of the %[1]s enum
+func (in %[1]s) IsValid() bool {
+	switch in {
+	case %[2]s:
+		return true
+	}
+	return false
+}
+
+// Values returns the values of the %[1]s enum
+func (%[1]s) Values() []%[1]s {
+	return []%[1]s{%[2]s}
+}
+`, enum.name, strings.Join(enum.values, ", ")))
+			}
+		})
+	}
+
+	return nil
+}
+
+// buildEnumType returns the enum type with the given name and values,
+// checking that its underlying type is a string, and that its constants have one of the enum values
+func buildEnumType(root *loader.Package, name string, enumMarker crdmarkers.Enum) (enumType, error) {
+	enum := enumType{name: name}
+	typeName, isTypeName := root.Types.Scope().Lookup(name).(*types.TypeName)
+	if !isTypeName {
+		return enum, fmt.Errorf("type %s not found", name)
+	}
+	if basic, isBasic := typeName.Type().Underlying().(*types.Basic); !isBasic || basic.Kind() != types.String {
+		return enum, fmt.Errorf("type %s has the %s marker, but is not a string type", name, enumMarkerName)
+	}
+
+	values := make(map[string]bool, len(enumMarker))
+	for _, value := range enumMarker {
+		values[fmt.Sprint(value)] = true
+	}
+
+	// the constants of the enum type, by value, in declaration order
+	var consts []*types.Const
+	for _, objectName := range root.Types.Scope().Names() {
+		if c, isConst := root.Types.Scope().Lookup(objectName).(*types.Const); isConst && types.Identical(c.Type(), typeName.Type()) {
+			consts = append(consts, c)
+		}
+	}
+	sort.Slice(consts, func(i, j int) bool { return consts[i].Pos() < consts[j].Pos() })
+	constNames := make(map[string]string, len(consts))
+	for _, c := range consts {
+		value := constant.StringVal(c.Val())
+		if !values[value] {
+			return enum, fmt.Errorf("constant %s has the value %q, which is not one of the values of the %s marker of type %s", c.Name(), value, enumMarkerName, name)
+		}
+		if _, exists := constNames[value]; !exists {
+			constNames[value] = c.Name()
+		}
+	}
+
+	for _, value := range enumMarker {
+		if constName, exists := constNames[fmt.Sprint(value)]; exists {
+			enum.values = append(enum.values, constName)
+		} else {
+			enum.values = append(enum.values, fmt.Sprintf("%s(%s)", name, strconv.Quote(fmt.Sprint(value))))
+		}
+	}
+	return enum, nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+)
+
+// loadingStep is the name used in place of a generator name for the errors reported while loading the packages
+const loadingStep = "loading"
+
+// generatorError is an error reported during a run, attributed to the generator that reported it
+// and to the root package it relates to.
+type generatorError struct {
+	// generator is the command line name of the generator that reported the error
+	generator string
+	// rootPackage is the path of the root package the error relates to
+	rootPackage string
+	err         error
+}
+
+func (e generatorError) String() string {
+	return fmt.Sprintf("[%s] %s: %v", e.generator, e.rootPackage, e.err)
+}
+
+// errorTracker attributes the errors reported on the loaded packages to the generator that reported them.
+//
+// Generators report errors by adding them to the loaded packages, so the tracker records how many errors
+// each package had before running a generator, and attributes the new ones to this generator.
+type errorTracker struct {
+	roots []*loader.Package
+	// seen is the number of errors of each package already attributed
+	seen   map[*packages.Package]int
+	errors []generatorError
+}
+
+func newErrorTracker(roots []*loader.Package) *errorTracker {
+	return &errorTracker{
+		roots: roots,
+		seen:  map[*packages.Package]int{},
+	}
+}
+
+// collect attributes to the given generator all the errors reported on the packages since the previous call,
+// and returns the number of new errors.
+//
+// Type errors are skipped, since they're probably just from partial typechecking in crd-gen.
+func (t *errorTracker) collect(generator string) int {
+	newErrors := 0
+	for _, root := range t.roots {
+		packages.Visit([]*packages.Package{root.Package}, nil, func(pkg *packages.Package) {
+			for _, err := range pkg.Errors[t.seen[pkg]:] {
+				if err.Kind == packages.TypeError {
+					continue
+				}
+				t.errors = append(t.errors, generatorError{
+					generator:   generator,
+					rootPackage: root.PkgPath,
+					err:         err,
+				})
+				newErrors++
+			}
+			t.seen[pkg] = len(pkg.Errors)
+		})
+	}
+	return newErrors
+}
+
+// add attributes to the given generator an error that it returned, and which relates to all the root packages
+func (t *errorTracker) add(generator string, err error) {
+	rootPackages := make([]string, 0, len(t.roots))
+	for _, root := range t.roots {
+		rootPackages = append(rootPackages, root.PkgPath)
+	}
+	t.errors = append(t.errors, generatorError{
+		generator:   generator,
+		rootPackage: strings.Join(rootPackages, ","),
+		err:         err,
+	})
+}
+
+// print prints all the collected errors, and returns true if there was at least one
+func (t *errorTracker) print(out io.Writer) bool {
+	for _, err := range t.errors {
+		fmt.Fprintln(out, err)
+	}
+	return len(t.errors) > 0
+}
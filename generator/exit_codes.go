@@ -0,0 +1,36 @@
+package main
+
+import "errors"
+
+// Exit codes of the generator, which allow CI pipelines to branch on the class of failure
+const (
+	// exitGenerationErrors is used when errors were reported, and none of the generators ran successfully
+	exitGenerationErrors = 1
+	// exitInvalidOptions is used when the command line flags, arguments or generator options are invalid
+	exitInvalidOptions = 2
+	// exitCheckDrift is used in check mode, when files on disk are not up to date with the generated artifacts
+	exitCheckDrift = 3
+	// exitPartialSuccess is used when some generators ran successfully, but errors were reported
+	exitPartialSuccess = 4
+)
+
+// exitError is an error that makes the generator exit with the given code
+type exitError struct {
+	error
+	code int
+}
+
+func (e exitError) Unwrap() error {
+	return e.error
+}
+
+// exitCode returns the code with which the generator should exit for the given error.
+// Errors that are not exit errors are reported while validating the command line, before running anything,
+// so they're considered as invalid options.
+func exitCode(err error) int {
+	var exitErr exitError
+	if errors.As(err, &exitErr) {
+		return exitErr.code
+	}
+	return exitInvalidOptions
+}
@@ -0,0 +1,610 @@
+package fixtures
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/generator/genutils"
+	"github.com/devfile/api/generator/schemas"
+	"github.com/iancoleman/strcase"
+	"github.com/lucasjones/reggen"
+	crdmarkers "sigs.k8s.io/controller-tools/pkg/crd/markers"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+	"sigs.k8s.io/yaml"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+const (
+	// defaultCount is the number of fixtures generated for each root type when no count is specified
+	defaultCount = 3
+	// defaultPackage is the name of the GO package of the generated constructors when no package is specified
+	defaultPackage = "fixtures"
+	// maxDepth is the depth of nested objects beyond which optional fields are not generated anymore
+	maxDepth = 6
+	// maxItems is the maximum number of elements of the generated lists and maps
+	maxItems = 3
+	// maxPatternAttempts is the number of strings generated from a pattern before giving up matching the length constraints
+	maxPatternAttempts = 1000
+	// shortEndpointNameLength is the maximum length of the endpoint names when the `shortenEndpointNameLength` option
+	// of the `devfile:jsonschema:generate` marker is set
+	shortEndpointNameLength = 15
+)
+
+// +controllertools:marker:generateHelp
+
+// Generator generates randomized, but schema-valid, fixtures of the types that have the `devfile:jsonschema:generate` annotation,
+// to load-test the controllers and exercise the validation code.
+//
+// Each fixture is generated both as a yaml file (`<version>/<type-name>-<n>.yaml`) and as a GO constructor (`<TypeName><n>()`),
+// in a `zz_generated.<version>.go` file of the package specified by the `package` option, along with a `<TypeName>Fixtures()` function
+// that returns all the fixtures of a type.
+//
+// Fixtures respect the enum values, patterns, length and minimum constraints of the fields, always contain the required fields,
+// and set exactly one member of each union. The options of the `devfile:jsonschema:generate` annotation of the type are honored,
+// such as the omission of the Custom and Plugin union members. Free-form Json fields, such as attributes, are never generated.
+// Fixtures are not guaranteed to be semantically valid: for example, the components referenced by commands are random names.
+//
+// Generation is deterministic for a given seed, so that regenerated fixtures only change when the API types change.
+type Generator struct {
+	// Count is the number of fixtures generated for each type. It defaults to 3.
+	Count int `marker:",optional"`
+
+	// Seed is the seed of the random generation. It defaults to 0.
+	Seed int `marker:",optional"`
+
+	// Package is the name of the GO package of the generated constructors. It defaults to `fixtures`.
+	Package string `marker:",optional"`
+
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	if err := schemas.RegisterGenerateMarker(into); err != nil {
+		return err
+	}
+	return genutils.RegisterTypeModelMarkers(into)
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// Generate generates the artifacts
+func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	count := g.Count
+	if count <= 0 {
+		count = defaultCount
+	}
+	packageName := g.Package
+	if packageName == "" {
+		packageName = defaultPackage
+	}
+
+	for _, root := range ctx.Roots {
+		model, err := genutils.BuildTypeModel(ctx, root, schemas.HasGenerateMarker)
+		if err != nil {
+			root.AddError(err)
+			continue
+		}
+
+		var rootInfos []*markers.TypeInfo
+		if err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
+			if schemas.HasGenerateMarker(info) {
+				rootInfos = append(rootInfos, info)
+			}
+		}); err != nil {
+			root.AddError(err)
+			continue
+		}
+		if len(rootInfos) == 0 {
+			continue
+		}
+		sort.Slice(rootInfos, func(i, j int) bool { return rootInfos[i].Name < rootInfos[j].Name })
+
+		builder := &fixtureBuilder{
+			model:   model,
+			rand:    rand.New(rand.NewSource(int64(g.Seed))),
+			imports: map[string]string{},
+			helpers: map[string]string{},
+		}
+		var constructors strings.Builder
+		for _, info := range rootInfos {
+			typeName, isTypeName := root.Types.Scope().Lookup(info.Name).(*types.TypeName)
+			if !isTypeName {
+				continue
+			}
+			generateMarker, _ := info.Markers.Get("devfile:jsonschema:generate").(schemas.GenerateJSONSchema)
+			builder.skippedMembers = map[string]bool{
+				"Custom": generateMarker.OmitCustomUnionMembers,
+				"Plugin": generateMarker.OmitPluginUnionMembers,
+			}
+			builder.shortenEndpointNameLength = generateMarker.ShortenEndpointNameLength
+
+			typeExpr := builder.typeExpr(types.NewPointer(typeName.Type()))
+			var names []string
+			for i := 1; i <= count; i++ {
+				expr, value, err := builder.value(typeName.Type(), &genutils.TypeRef{Kind: genutils.ObjectKind, Name: modelName(typeName.Type())}, nil, 0)
+				if err != nil {
+					root.AddError(fmt.Errorf("cannot generate a fixture of type %s: %w", info.Name, err))
+					break
+				}
+				content, err := yaml.Marshal(value)
+				if err != nil {
+					root.AddError(err)
+					break
+				}
+				genutils.WriteGeneratedArtifact(ctx, root, fmt.Sprintf("%s/%s-%d.yaml", root.Name, strcase.ToKebab(info.Name), i), string(content))
+
+				name := fmt.Sprintf("%s%d", info.Name, i)
+				names = append(names, name+"()")
+				constructors.WriteString(fmt.Sprintf("\n// %s returns the fixture of the %s/%s-%d.yaml file\nfunc %s() %s {\n\treturn &%s\n}\n",
+					name, root.Name, strcase.ToKebab(info.Name), i, name, typeExpr, expr))
+			}
+			constructors.WriteString(fmt.Sprintf("\n// %sFixtures returns all the fixtures of the %s type\nfunc %sFixtures() []%s {\n\treturn []%s{%s}\n}\n",
+				info.Name, info.Name, info.Name, typeExpr, typeExpr, strings.Join(names, ", ")))
+		}
+
+		content, err := builder.render(ctx, g.HeaderFile, packageName, constructors.String())
+		if err != nil {
+			root.AddError(err)
+			continue
+		}
+		genutils.WriteGeneratedArtifact(ctx, root, "zz_generated."+root.Name+".go", content)
+	}
+	return nil
+}
+
+// fixtureBuilder builds the random values of the types of a type model,
+// both as GO expressions and as Json values
+type fixtureBuilder struct {
+	model *genutils.TypeModel
+	rand  *rand.Rand
+	// imports are the aliases of the packages imported by the GO expressions, by package path
+	imports map[string]string
+	// helpers are the GO source code of the helper functions used by the GO expressions, by function name
+	helpers map[string]string
+	// skippedMembers are the names of the union members that should not be generated
+	skippedMembers map[string]bool
+	// shortenEndpointNameLength indicates that the endpoint names should be shortened
+	shortenEndpointNameLength bool
+}
+
+// render returns the formatted GO source file of the given constructors
+func (b *fixtureBuilder) render(ctx *genall.GenerationContext, headerFile string, packageName string, constructors string) (string, error) {
+	buf := new(bytes.Buffer)
+	if headerFile != "" {
+		header, err := ctx.ReadFile(headerFile)
+		if err != nil {
+			return "", err
+		}
+		buf.Write(bytes.TrimSpace(header))
+		buf.WriteString("\n\n")
+	}
+	buf.WriteString(genutils.GeneratedFileBanner + "\n\npackage " + packageName + "\n")
+
+	// the packages of the values that could not be generated are not used
+	used, err := usedIdentifiers(constructors)
+	if err != nil {
+		return "", err
+	}
+	var paths []string
+	for path, alias := range b.imports {
+		if used[alias] {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	buf.WriteString("\nimport (\n")
+	for _, path := range paths {
+		buf.WriteString(fmt.Sprintf("\t%s %s\n", b.imports[path], strconv.Quote(path)))
+	}
+	buf.WriteString(")\n")
+
+	buf.WriteString(constructors)
+
+	var helperNames []string
+	for name := range b.helpers {
+		helperNames = append(helperNames, name)
+	}
+	sort.Strings(helperNames)
+	for _, name := range helperNames {
+		buf.WriteString("\n" + b.helpers[name] + "\n")
+	}
+
+	content, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// usedIdentifiers returns the identifiers used as selector prefixes (such as package aliases) in the given GO declarations
+func usedIdentifiers(declarations string) (map[string]bool, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package p\n"+declarations, 0)
+	if err != nil {
+		return nil, err
+	}
+	used := map[string]bool{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		if selector, isSelector := node.(*ast.SelectorExpr); isSelector {
+			if ident, isIdent := selector.X.(*ast.Ident); isIdent {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+	return used, nil
+}
+
+// qualifier returns the alias of the given package in the generated GO source file, importing it if necessary
+func (b *fixtureBuilder) qualifier(pkg *types.Package) string {
+	if alias, imported := b.imports[pkg.Path()]; imported {
+		return alias
+	}
+	alias := pkg.Name()
+	for i := 2; b.aliasUsed(alias); i++ {
+		alias = fmt.Sprintf("%s%d", pkg.Name(), i)
+	}
+	b.imports[pkg.Path()] = alias
+	return alias
+}
+
+func (b *fixtureBuilder) aliasUsed(alias string) bool {
+	for _, used := range b.imports {
+		if used == alias {
+			return true
+		}
+	}
+	return false
+}
+
+// typeExpr returns the GO expression of the given type in the generated GO source file
+func (b *fixtureBuilder) typeExpr(goType types.Type) string {
+	return types.TypeString(goType, b.qualifier)
+}
+
+// modelName returns the name of the model type that corresponds to the given named GO type
+func modelName(goType types.Type) string {
+	if named, isNamed := goType.(*types.Named); isNamed {
+		return named.Obj().Name()
+	}
+	return ""
+}
+
+// value returns a random value of the given GO type, that matches the given model type and field markers,
+// as a GO expression and as a Json value
+func (b *fixtureBuilder) value(goType types.Type, ref *genutils.TypeRef, fieldMarkers markers.MarkerValues, depth int) (string, interface{}, error) {
+	if pointer, isPointer := goType.(*types.Pointer); isPointer {
+		expr, value, err := b.value(pointer.Elem(), ref, fieldMarkers, depth)
+		if err != nil {
+			return "", nil, err
+		}
+		if _, isStruct := pointer.Elem().Underlying().(*types.Struct); isStruct {
+			return "&" + expr, value, nil
+		}
+		return b.pointerTo(pointer.Elem(), expr), value, nil
+	}
+
+	switch ref.Kind {
+	case genutils.StringKind:
+		value, err := b.stringValue(fieldMarkers)
+		if err != nil {
+			return "", nil, err
+		}
+		return b.convert(goType, strconv.Quote(value)), value, nil
+	case genutils.BoolKind:
+		value := b.rand.Intn(2) == 0
+		return b.convert(goType, strconv.FormatBool(value)), value, nil
+	case genutils.IntKind:
+		value := b.intValue(fieldMarkers)
+		return b.convert(goType, strconv.Itoa(value)), value, nil
+	case genutils.FloatKind:
+		value := float64(b.intValue(fieldMarkers)) + 0.5
+		return b.convert(goType, strconv.FormatFloat(value, 'f', -1, 64)), value, nil
+	case genutils.EnumKind:
+		enum := b.model.Enum(ref.Name)
+		if enum == nil || len(enum.Values) == 0 {
+			return "", nil, fmt.Errorf("enum %s has no value", ref.Name)
+		}
+		value := enum.Values[b.rand.Intn(len(enum.Values))]
+		return b.convert(goType, strconv.Quote(value)), value, nil
+	case genutils.ObjectKind:
+		object := b.model.Object(ref.Name)
+		if object == nil {
+			return "", nil, fmt.Errorf("unknown object type %s", ref.Name)
+		}
+		return b.objectValue(goType, object, depth)
+	case genutils.ListKind:
+		slice, isSlice := goType.Underlying().(*types.Slice)
+		if !isSlice {
+			return "", nil, fmt.Errorf("type %s is not a slice", goType)
+		}
+		return b.listValue(goType, slice.Elem(), ref.Elem, fieldMarkers, depth)
+	case genutils.MapKind:
+		mapType, isMap := goType.Underlying().(*types.Map)
+		if !isMap {
+			return "", nil, fmt.Errorf("type %s is not a map", goType)
+		}
+		return b.mapValue(goType, mapType, ref.Elem, depth)
+	}
+	return "", nil, fmt.Errorf("free-form Json values of type %s cannot be generated", b.typeExpr(goType))
+}
+
+// convert returns the GO expression that converts the given literal to the given type, if it's not a basic type
+func (b *fixtureBuilder) convert(goType types.Type, literal string) string {
+	if _, isBasic := goType.(*types.Basic); isBasic {
+		return literal
+	}
+	return b.typeExpr(goType) + "(" + literal + ")"
+}
+
+// pointerTo returns the GO expression of a pointer to the given expression of a non-struct type
+func (b *fixtureBuilder) pointerTo(goType types.Type, expr string) string {
+	basic, isBasic := goType.(*types.Basic)
+	if !isBasic {
+		return fmt.Sprintf("func() *%[1]s { v := %[2]s; return &v }()", b.typeExpr(goType), expr)
+	}
+	helper := basic.Name() + "Ptr"
+	b.helpers[helper] = fmt.Sprintf("func %[1]s(v %[2]s) *%[2]s {\n\treturn &v\n}", helper, basic.Name())
+	return helper + "(" + expr + ")"
+}
+
+// stringValue returns a random string that matches the pattern and length markers of a field
+func (b *fixtureBuilder) stringValue(fieldMarkers markers.MarkerValues) (string, error) {
+	minLength, maxLength := 1, 0
+	if marker, isSet := fieldMarkers.Get("kubebuilder:validation:MinLength").(crdmarkers.MinLength); isSet {
+		minLength = int(marker)
+	}
+	if marker, isSet := fieldMarkers.Get("kubebuilder:validation:MaxLength").(crdmarkers.MaxLength); isSet {
+		maxLength = int(marker)
+	}
+	if marker, isSet := fieldMarkers.Get(shortenedLengthMarker).(int); isSet {
+		maxLength = marker
+	}
+
+	pattern, hasPattern := fieldMarkers.Get("kubebuilder:validation:Pattern").(crdmarkers.Pattern)
+	if !hasPattern {
+		length := minLength + b.rand.Intn(8)
+		if length < 3 {
+			length = 3
+		}
+		if maxLength > 0 && length > maxLength {
+			length = maxLength
+		}
+		letters := make([]byte, length)
+		for i := range letters {
+			letters[i] = byte('a' + b.rand.Intn(26))
+		}
+		return string(letters), nil
+	}
+
+	generator, err := reggen.NewGenerator(string(pattern))
+	if err != nil {
+		return "", fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	// the limit is the maximum number of repetitions of the `*` and `+` operators
+	limit := 8
+	if minLength > limit {
+		limit = minLength
+	}
+	if maxLength > 0 && maxLength < limit {
+		limit = maxLength
+	}
+	for attempt := 0; attempt < maxPatternAttempts; attempt++ {
+		generator.SetSeed(b.rand.Int63())
+		value := generator.Generate(limit)
+		if len(value) >= minLength && (maxLength == 0 || len(value) <= maxLength) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("cannot generate a string that matches the pattern %q with a length between %d and %d", pattern, minLength, maxLength)
+}
+
+// intValue returns a random integer that matches the minimum and maximum markers of a field
+func (b *fixtureBuilder) intValue(fieldMarkers markers.MarkerValues) int {
+	minimum, maximum := 1, 1000
+	if marker, isSet := fieldMarkers.Get("kubebuilder:validation:Minimum").(crdmarkers.Minimum); isSet {
+		minimum = int(marker)
+		if maximum < minimum {
+			maximum = minimum + 1000
+		}
+	}
+	if marker, isSet := fieldMarkers.Get("kubebuilder:validation:Maximum").(crdmarkers.Maximum); isSet {
+		maximum = int(marker)
+		if minimum > maximum {
+			minimum = maximum
+		}
+	}
+	return minimum + b.rand.Intn(maximum-minimum+1)
+}
+
+// listValue returns a random list of 1 to `maxItems` elements,
+// with distinct elements if the field has the `kubebuilder:validation:UniqueItems` marker
+func (b *fixtureBuilder) listValue(goType types.Type, elemType types.Type, elemRef *genutils.TypeRef, fieldMarkers markers.MarkerValues, depth int) (string, interface{}, error) {
+	unique := fieldMarkers.Get("kubebuilder:validation:UniqueItems") == crdmarkers.UniqueItems(true)
+	count := 1 + b.rand.Intn(maxItems)
+	var exprs []string
+	values := []interface{}{}
+	seen := map[string]bool{}
+	for i := 0; i < count; i++ {
+		expr, value, err := b.value(elemType, elemRef, nil, depth)
+		if err != nil {
+			return "", nil, err
+		}
+		if unique {
+			if seen[expr] {
+				continue
+			}
+			seen[expr] = true
+		}
+		exprs = append(exprs, expr)
+		values = append(values, value)
+	}
+	return b.typeExpr(goType) + "{" + strings.Join(exprs, ", ") + "}", values, nil
+}
+
+// mapValue returns a random map of 1 to `maxItems` entries
+func (b *fixtureBuilder) mapValue(goType types.Type, mapType *types.Map, elemRef *genutils.TypeRef, depth int) (string, interface{}, error) {
+	count := 1 + b.rand.Intn(maxItems)
+	var exprs []string
+	values := map[string]interface{}{}
+	for i := 0; i < count; i++ {
+		key, err := b.stringValue(nil)
+		if err != nil {
+			return "", nil, err
+		}
+		if _, exists := values[key]; exists {
+			continue
+		}
+		expr, value, err := b.value(mapType.Elem(), elemRef, nil, depth)
+		if err != nil {
+			return "", nil, err
+		}
+		exprs = append(exprs, b.convert(mapType.Key(), strconv.Quote(key))+": "+expr)
+		values[key] = value
+	}
+	return b.typeExpr(goType) + "{" + strings.Join(exprs, ", ") + "}", values, nil
+}
+
+// objectValue returns a random value of the given object type.
+// Optional fields are randomly generated, apart from the free-form Json ones,
+// and exactly one member of each union is generated.
+func (b *fixtureBuilder) objectValue(goType types.Type, object *genutils.ObjectType, depth int) (string, interface{}, error) {
+	named, isNamed := goType.(*types.Named)
+	if !isNamed {
+		return "", nil, fmt.Errorf("type %s is not a named type", goType)
+	}
+	structType, isStruct := named.Underlying().(*types.Struct)
+	if !isStruct {
+		return "", nil, fmt.Errorf("type %s is not a struct type", goType)
+	}
+	if named.Obj().Pkg().Path() != object.PkgPath {
+		// the type wraps a type with the same name defined in another package
+		wrapped := structType.Field(0)
+		expr, value, err := b.objectValue(wrapped.Type(), object, depth)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("%s{%s: %s}", b.typeExpr(goType), wrapped.Name(), expr), value, nil
+	}
+
+	var exprs []string
+	values := map[string]interface{}{}
+	addField := func(field *genutils.Field, required bool) error {
+		fieldMarkers := field.Markers
+		if b.shortenEndpointNameLength && object.Name == "Endpoint" && field.JSONName == "name" {
+			fieldMarkers = withMarker(fieldMarkers, shortenedLengthMarker, shortEndpointNameLength)
+		}
+		expr, value, err := b.value(field.GoType, field.Type, fieldMarkers, depth+1)
+		if err != nil {
+			if required {
+				return fmt.Errorf("field %s of type %s: %w", field.GoName, object.Name, err)
+			}
+			// optional fields that cannot be generated, such as free-form Json fields, are omitted
+			return nil
+		}
+		exprs = append(exprs, field.GoName+": "+expr)
+		if field.Embedded {
+			for key, embeddedValue := range value.(map[string]interface{}) {
+				values[key] = embeddedValue
+			}
+		} else {
+			values[field.JSONName] = value
+		}
+		return nil
+	}
+
+	members := b.unionMembers(object)
+	for _, field := range object.Fields {
+		if _, isMember := members[field]; isMember || (object.Union != nil && field == object.Union.Discriminator) {
+			continue
+		}
+		required := !field.Optional || field.Embedded
+		if !required && (depth >= maxDepth || b.rand.Intn(2) == 0) {
+			continue
+		}
+		if err := addField(field, required); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if len(members) > 0 {
+		var candidates []*genutils.Field
+		for _, field := range object.Fields {
+			if members[field] {
+				candidates = append(candidates, field)
+			}
+		}
+		// members that cannot be generated, such as the ones that contain free-form Json fields, are skipped
+		var err error
+		generated := false
+		for _, i := range b.rand.Perm(len(candidates)) {
+			fieldCount := len(exprs)
+			if err = addField(candidates[i], true); err == nil && len(exprs) > fieldCount {
+				generated = true
+				break
+			}
+		}
+		if !generated {
+			return "", nil, fmt.Errorf("no member of union %s can be generated: %v", object.Name, err)
+		}
+	}
+	return b.typeExpr(goType) + "{" + strings.Join(exprs, ", ") + "}", values, nil
+}
+
+// unionMembers returns the union members of the given object type, i.e. the members listed by the enum
+// of the union discriminator, if any. Members are mapped to true if they can be generated, or to false if they are skipped.
+// The other fields of a union are generated as regular fields.
+func (b *fixtureBuilder) unionMembers(object *genutils.ObjectType) map[*genutils.Field]bool {
+	members := map[*genutils.Field]bool{}
+	if object.Union == nil {
+		return members
+	}
+	var names map[string]bool
+	if discriminator := object.Union.Discriminator; discriminator != nil && discriminator.Type.Kind == genutils.EnumKind {
+		if enum := b.model.Enum(discriminator.Type.Name); enum != nil {
+			names = map[string]bool{}
+			for _, value := range enum.Values {
+				names[value] = true
+			}
+		}
+	}
+	for _, member := range object.Union.Members {
+		if names == nil || names[member.GoName] {
+			members[member] = !b.skippedMembers[member.GoName]
+		}
+	}
+	return members
+}
+
+// shortenedLengthMarker is a pseudo-marker that overrides the maximum length of a field,
+// for the options of the `devfile:jsonschema:generate` marker that shorten fields
+const shortenedLengthMarker = "devfile:fixtures:maxLength"
+
+// withMarker returns a copy of the given field markers, with the given marker value
+func withMarker(fieldMarkers markers.MarkerValues, name string, value interface{}) markers.MarkerValues {
+	result := markers.MarkerValues{}
+	for markerName, values := range fieldMarkers {
+		result[markerName] = values
+	}
+	result[name] = []interface{}{value}
+	return result
+}
@@ -0,0 +1,106 @@
+package genutils
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+)
+
+// CheckOutputRule is an output rule that doesn't write the artifacts, but compares them with the files
+// the delegate output rule would write, and records the artifacts that are out of date.
+//
+// Artifacts whose location on disk cannot be determined (such as the ones written to the standard output) are not checked.
+type CheckOutputRule struct {
+	genall.OutputRule
+	// Drifted contains the paths of the artifacts whose file is missing or has a different content
+	Drifted []string
+}
+
+// Open returns a writer that compares the artifact content with the file at the same path when it is closed
+func (o *CheckOutputRule) Open(pkg *loader.Package, itemPath string) (io.WriteCloser, error) {
+	path, onDisk, err := ArtifactPath(o.OutputRule, pkg, itemPath)
+	if err != nil {
+		return nil, err
+	}
+	if !onDisk {
+		return nopCloser{ioutil.Discard}, nil
+	}
+	return &checkingWriter{rule: o, path: path}, nil
+}
+
+// IsCheckOutput returns true if the given output rule, or the output rule it delegates to, is a CheckOutputRule.
+// Generators that manipulate the written files directly should not do it when checking the output.
+func IsCheckOutput(rule genall.OutputRule) bool {
+	for {
+		switch wrapper := rule.(type) {
+		case *CheckOutputRule:
+			return true
+		case *CountingOutputRule:
+			rule = wrapper.OutputRule
+		default:
+			return false
+		}
+	}
+}
+
+// ArtifactPath returns the path of the file in which the given output rule writes the given artifact,
+// or false if the artifact isn't written to a file.
+func ArtifactPath(rule genall.OutputRule, pkg *loader.Package, itemPath string) (string, bool, error) {
+	switch rule := UnwrapOutputRule(rule).(type) {
+	case genall.OutputToDirectory:
+		return filepath.Join(string(rule), itemPath), true, nil
+	case genall.OutputArtifacts:
+		return artifactsPath(rule, pkg, itemPath)
+	case *genall.OutputArtifacts:
+		return artifactsPath(*rule, pkg, itemPath)
+	case OutputArtifacts:
+		defaultDir, _, err := rule.parseConfig()
+		if err != nil {
+			return "", false, err
+		}
+		return artifactsPath(genall.OutputArtifacts{Config: genall.OutputToDirectory(defaultDir), Code: rule.Code}, pkg, itemPath)
+	default:
+		return "", false, nil
+	}
+}
+
+func artifactsPath(rule genall.OutputArtifacts, pkg *loader.Package, itemPath string) (string, bool, error) {
+	switch {
+	case pkg == nil:
+		return filepath.Join(string(rule.Config), itemPath), true, nil
+	case rule.Code != "":
+		return filepath.Join(string(rule.Code), itemPath), true, nil
+	case len(pkg.CompiledGoFiles) == 0:
+		return "", false, fmt.Errorf("cannot output to a package with no path on disk")
+	default:
+		return filepath.Join(filepath.Dir(pkg.CompiledGoFiles[0]), itemPath), true, nil
+	}
+}
+
+// checkingWriter buffers the content of an artifact, and compares it with the file at the given path when closed
+type checkingWriter struct {
+	bytes.Buffer
+	rule *CheckOutputRule
+	path string
+}
+
+func (w *checkingWriter) Close() error {
+	existing, err := ioutil.ReadFile(w.path)
+	if err != nil || !bytes.Equal(existing, w.Bytes()) {
+		w.rule.Drifted = append(w.rule.Drifted, w.path)
+	}
+	return nil
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (n nopCloser) Close() error {
+	return nil
+}
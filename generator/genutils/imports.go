@@ -0,0 +1,96 @@
+package genutils
+
+import (
+	"bytes"
+	"go/ast"
+	"go/types"
+	"path"
+	"sort"
+	"strconv"
+
+	"sigs.k8s.io/controller-tools/pkg/loader"
+)
+
+// DevfileAPIPackage is the import path of the devfile API package that provides the helpers
+// used by the code generated for types defined in other packages, such as downstream API packages that embed devfile types
+const DevfileAPIPackage = "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+
+// IsDevfileAPIPackage returns true if the given package is a devfile API package, that defines the unexported helpers
+// used by the generated code, as opposed to an external package that should use the exported helpers of `DevfileAPIPackage`.
+func IsDevfileAPIPackage(pkg *loader.Package) bool {
+	pkg.NeedTypesInfo()
+	return pkg.Types != nil && pkg.Types.Scope().Lookup("visitUnion") != nil
+}
+
+// PackageImports returns the packages imported by the Go files of the given package,
+// indexed by the name under which they are imported.
+func PackageImports(pkg *loader.Package) map[string]*types.Package {
+	pkg.NeedTypesInfo()
+	imports := map[string]*types.Package{}
+	for _, file := range pkg.Syntax {
+		for _, importSpec := range file.Imports {
+			var obj types.Object
+			if importSpec.Name != nil {
+				obj = pkg.TypesInfo.Defs[importSpec.Name]
+			} else {
+				obj = pkg.TypesInfo.Implicits[importSpec]
+			}
+			if pkgName, isPkgName := obj.(*types.PkgName); isPkgName {
+				imports[pkgName.Name()] = pkgName.Imported()
+			}
+		}
+	}
+	return imports
+}
+
+// DevfileAPIQualifier returns the name under which the given package imports the devfile API package,
+// or the default package name if it doesn't import it.
+func DevfileAPIQualifier(imports map[string]*types.Package) string {
+	for name, imported := range imports {
+		if imported.Path() == DevfileAPIPackage {
+			return name
+		}
+	}
+	return path.Base(DevfileAPIPackage)
+}
+
+// UsedImports returns the name of the given imports that are referenced by a qualified identifier in the given nodes,
+// indexed by import path.
+func UsedImports(imports map[string]*types.Package, nodes ...ast.Node) map[string]string {
+	used := map[string]string{}
+	for _, node := range nodes {
+		ast.Inspect(node, func(n ast.Node) bool {
+			if selector, isSelector := n.(*ast.SelectorExpr); isSelector {
+				if ident, isIdent := selector.X.(*ast.Ident); isIdent {
+					if imported, exists := imports[ident.Name]; exists {
+						used[imported.Path()] = ident.Name
+					}
+				}
+			}
+			return true
+		})
+	}
+	return used
+}
+
+// WriteImports writes the import declaration of the given imports, whose names are indexed by import path.
+// Imports are sorted by path, and only named if their name is different from their path (as for standard library packages).
+func WriteImports(buf *bytes.Buffer, namesByPath map[string]string) {
+	if len(namesByPath) == 0 {
+		return
+	}
+	paths := make([]string, 0, len(namesByPath))
+	for importPath := range namesByPath {
+		paths = append(paths, importPath)
+	}
+	sort.Strings(paths)
+	buf.WriteString("\nimport (\n")
+	for _, importPath := range paths {
+		buf.WriteString("\t")
+		if name := namesByPath[importPath]; name != importPath {
+			buf.WriteString(name + " ")
+		}
+		buf.WriteString(strconv.Quote(importPath) + "\n")
+	}
+	buf.WriteString(")\n\n")
+}
@@ -9,8 +9,21 @@ var (
 	UnionMarker = markers.Must(markers.MakeDefinition("union", markers.DescribesType, struct{}{}))
 	// UnionDiscriminatorMarker is the definition of the union discriminator marker, as defined in https://github.com/kubernetes/enhancements/blob/master/keps/sig-api-machinery/20190325-unions.md#proposal
 	UnionDiscriminatorMarker = markers.Must(markers.MakeDefinition("unionDiscriminator", markers.DescribesField, struct{}{}))
+	// HelperTypeMarker is associated with an exported type that is a GO helper of the API (visitor, filter, ...),
+	// and is intentionally not referenced by the types processed by the generators
+	HelperTypeMarker = markers.Must(markers.MakeDefinition("devfile:helper", markers.DescribesType, struct{}{}))
 )
 
+// RegisterHelperMarker registers the `devfile:helper` marker
+func RegisterHelperMarker(into *markers.Registry) error {
+	if err := into.Register(HelperTypeMarker); err != nil {
+		return err
+	}
+	into.AddHelp(HelperTypeMarker,
+		markers.SimpleHelp("Devfile", "indicates that an exported type is a GO helper of the API (visitor, filter, ...), which is intentionally not referenced by the types processed by the generators, and should not be reported by the `validate` generator"))
+	return nil
+}
+
 // RegisterUnionMarkers registers the `union` and `unionDiscriminator` markers
 func RegisterUnionMarkers(into *markers.Registry) error {
 	if err := markers.RegisterAll(into, UnionMarker, UnionDiscriminatorMarker); err != nil {
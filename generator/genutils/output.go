@@ -2,19 +2,51 @@ package genutils
 
 import (
 	"bytes"
+	"errors"
 	"go/format"
 	"io"
+	"os"
+	"path/filepath"
 
 	"sigs.k8s.io/controller-tools/pkg/genall"
 	"sigs.k8s.io/controller-tools/pkg/loader"
 )
 
+// GeneratedFileBanner is the standard banner written at the start of the generated Go source files,
+// which allows tools to recognize them as generated.
+const GeneratedFileBanner = "// Code generated by the devfile generator. DO NOT EDIT."
+
 // WriteFormattedSourceFile creates a Go source file in a given package, dumps to it the content provided by the `writeContents` function
 // and formats the result through go/fmt.
+// The file starts with the content of the given header file if any, followed by the generated file banner.
 // If formatting cannot be applied (due to some syntax error probably), it returns an error.
-func WriteFormattedSourceFile(filename string, ctx *genall.GenerationContext, root *loader.Package, writeContents func(*bytes.Buffer)) {
+func WriteFormattedSourceFile(filename string, headerFile string, ctx *genall.GenerationContext, root *loader.Package, writeContents func(*bytes.Buffer)) {
+	writeFormattedSourceFile(filename, headerFile, false, ctx, root, writeContents)
+}
+
+// WriteFormattedAutogeneratedSourceFile is the same as WriteFormattedSourceFile, but the file starts with the `!ignore_autogenerated` build constraint,
+// like the DeepCopy implementations, so that the generators, which load the packages with the `ignore_autogenerated` tag, don't process it.
+// It should be used for the generated files that declare types.
+func WriteFormattedAutogeneratedSourceFile(filename string, headerFile string, ctx *genall.GenerationContext, root *loader.Package, writeContents func(*bytes.Buffer)) {
+	writeFormattedSourceFile(filename, headerFile, true, ctx, root, writeContents)
+}
+
+func writeFormattedSourceFile(filename string, headerFile string, ignoredByGenerators bool, ctx *genall.GenerationContext, root *loader.Package, writeContents func(*bytes.Buffer)) {
 	buf := new(bytes.Buffer)
-	buf.WriteString(`
+	if ignoredByGenerators {
+		buf.WriteString("//go:build !ignore_autogenerated\n// +build !ignore_autogenerated\n\n")
+	}
+	if headerFile != "" {
+		header, err := ctx.ReadFile(headerFile)
+		if err != nil {
+			root.AddError(err)
+			return
+		}
+		buf.Write(bytes.TrimSpace(header))
+		buf.WriteString("\n\n")
+	}
+	buf.WriteString(GeneratedFileBanner + `
+
 package ` + root.Name + `
 `)
 
@@ -43,3 +75,54 @@ package ` + root.Name + `
 		return
 	}
 }
+
+// CountingOutputRule is an output rule that delegates to another output rule,
+// while counting the artifacts opened through it.
+type CountingOutputRule struct {
+	genall.OutputRule
+	// Count is the number of artifacts opened through the output rule
+	Count int
+}
+
+// Open opens the artifact through the delegate output rule, and counts it
+func (o *CountingOutputRule) Open(pkg *loader.Package, path string) (io.WriteCloser, error) {
+	writer, err := o.OutputRule.Open(pkg, path)
+	if err == nil {
+		o.Count++
+	}
+	return writer, err
+}
+
+// UnwrapOutputRule returns the output rule that the given output rule delegates to,
+// or the given output rule itself if it doesn't delegate to another one.
+func UnwrapOutputRule(rule genall.OutputRule) genall.OutputRule {
+	switch wrapper := rule.(type) {
+	case *CountingOutputRule:
+		return UnwrapOutputRule(wrapper.OutputRule)
+	case *CheckOutputRule:
+		return UnwrapOutputRule(wrapper.OutputRule)
+	default:
+		return rule
+	}
+}
+
+// WriteGeneratedArtifact writes a generated non-Go artifact with the given content through the output rule of the generator.
+// The artifact is not attached to the given root package, so that it can be written in the artifacts config folder,
+// but errors are reported on this package.
+// The artifact name may be a relative path, in which case the missing parent folders are created.
+func WriteGeneratedArtifact(ctx *genall.GenerationContext, root *loader.Package, name string, content string) {
+	outputFile, err := ctx.Open(nil, name)
+	if pathError, isPathError := err.(*os.PathError); isPathError && errors.Is(err, os.ErrNotExist) {
+		if err = os.MkdirAll(filepath.Dir(pathError.Path), os.ModePerm); err == nil {
+			outputFile, err = ctx.Open(nil, name)
+		}
+	}
+	if err != nil {
+		root.AddError(err)
+		return
+	}
+	defer outputFile.Close()
+	if _, err := io.WriteString(outputFile, content); err != nil {
+		root.AddError(err)
+	}
+}
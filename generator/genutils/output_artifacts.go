@@ -0,0 +1,137 @@
+package genutils
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+// +controllertools:marker:generateHelp
+
+// OutputArtifacts outputs artifacts to different locations, depending on
+// whether they're package-associated or not, the same way as the `artifacts` output rule of controller-tools,
+// but also allows writing the non package-associated artifacts of each root package to a distinct directory.
+//
+// Per-root directories are set with `<root>=<dir>` entries in the config directories, separated by `;`,
+// where `<root>` is either the path of a root package as passed to the `paths` option, or its import path.
+// For example `config=./pkg/apis/workspaces/v1alpha1=schemas/v1alpha1;./pkg/apis/workspaces/v1alpha2=schemas/v1alpha2`.
+// An entry without a `<root>=` prefix sets the directory of the root packages that are not listed.
+type OutputArtifacts struct {
+	// Config points to the directory to which to write configuration,
+	// or lists the directories to which to write the configuration of each root package.
+	Config []string
+	// Code overrides the directory in which to write new code (defaults to where the existing code lives).
+	Code genall.OutputToDirectory `marker:",optional"`
+}
+
+// RootsOutput is a group of root packages whose artifacts are written with the same output rule
+type RootsOutput struct {
+	Roots      []*loader.Package
+	OutputRule genall.OutputArtifacts
+}
+
+// Open opens the given artifact with the default config directory, for generators that are not run
+// through `ForRoots`. It fails for non package-associated artifacts if there is no default config directory.
+func (o OutputArtifacts) Open(pkg *loader.Package, itemPath string) (io.WriteCloser, error) {
+	defaultDir, _, err := o.parseConfig()
+	if err != nil {
+		return nil, err
+	}
+	if pkg == nil && defaultDir == "" {
+		return nil, fmt.Errorf("cannot write %s: no default config directory is set", itemPath)
+	}
+	return genall.OutputArtifacts{Config: genall.OutputToDirectory(defaultDir), Code: o.Code}.Open(pkg, itemPath)
+}
+
+// ForRoots groups the given root packages by config directory, and returns the equivalent controller-tools output rule
+// of each group, so that a generator can be run once per group.
+// The groups are returned in the order of their first root package.
+func (o OutputArtifacts) ForRoots(roots []*loader.Package) ([]RootsOutput, error) {
+	defaultDir, rootDirs, err := o.parseConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]bool, len(rootDirs))
+	var groups []RootsOutput
+	groupIndexes := map[string]int{}
+	for _, root := range roots {
+		dir := defaultDir
+		for i, rootDir := range rootDirs {
+			if matchesRoot(rootDir.root, root) {
+				dir = rootDir.dir
+				matched[i] = true
+				break
+			}
+		}
+		if dir == "" {
+			return nil, fmt.Errorf("no config directory is set for root package %s", root.PkgPath)
+		}
+
+		index, exists := groupIndexes[dir]
+		if !exists {
+			index = len(groups)
+			groupIndexes[dir] = index
+			groups = append(groups, RootsOutput{
+				OutputRule: genall.OutputArtifacts{Config: genall.OutputToDirectory(dir), Code: o.Code},
+			})
+		}
+		groups[index].Roots = append(groups[index].Roots, root)
+	}
+
+	for i, rootDir := range rootDirs {
+		if !matched[i] {
+			return nil, fmt.Errorf("the config directory of %q doesn't match any root package", rootDir.root)
+		}
+	}
+	return groups, nil
+}
+
+// rootDirectory is a `<root>=<dir>` entry of the config directories
+type rootDirectory struct {
+	root string
+	dir  string
+}
+
+// parseConfig returns the default config directory and the per-root config directories
+func (o OutputArtifacts) parseConfig() (string, []rootDirectory, error) {
+	defaultDir := ""
+	var rootDirs []rootDirectory
+	for _, entry := range o.Config {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) == 1 {
+			if defaultDir != "" {
+				return "", nil, fmt.Errorf("only one default config directory can be set, but found %q and %q", defaultDir, entry)
+			}
+			defaultDir = entry
+			continue
+		}
+		if parts[0] == "" || parts[1] == "" {
+			return "", nil, fmt.Errorf("invalid config directory %q: should be <root>=<dir>", entry)
+		}
+		rootDirs = append(rootDirs, rootDirectory{root: parts[0], dir: parts[1]})
+	}
+	return defaultDir, rootDirs, nil
+}
+
+// matchesRoot returns true if the given root, as passed in a `<root>=<dir>` entry,
+// is the import path or the directory of the given root package
+func matchesRoot(root string, pkg *loader.Package) bool {
+	if root == pkg.PkgPath {
+		return true
+	}
+	if len(pkg.GoFiles) == 0 {
+		return false
+	}
+	rootDir, err := filepath.Abs(root)
+	if err != nil {
+		return false
+	}
+	return rootDir == filepath.Dir(pkg.GoFiles[0])
+}
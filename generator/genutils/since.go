@@ -0,0 +1,36 @@
+package genutils
+
+import (
+	"fmt"
+
+	"github.com/coreos/go-semver/semver"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+// SinceMarker is the definition of the `devfile:since` marker, which indicates the devfile schema version
+// in which a field was introduced
+var SinceMarker = markers.Must(markers.MakeDefinition("devfile:since", markers.DescribesField, ""))
+
+// RegisterSinceMarker registers the `devfile:since` marker
+func RegisterSinceMarker(into *markers.Registry) error {
+	if err := into.Register(SinceMarker); err != nil {
+		return err
+	}
+	into.AddHelp(SinceMarker,
+		markers.SimpleHelp("Devfile", "indicates the devfile schema version in which this field was introduced, such as `2.2.0`. The field is omitted from the Json schemas generated for older devfile versions, and its use is rejected by the validation of devfiles with an older schema version"))
+	return nil
+}
+
+// FieldSince returns the devfile schema version in which the given field was introduced,
+// according to its `devfile:since` marker, or nil if the field has no such marker
+func FieldSince(typeName string, field markers.FieldInfo) (*semver.Version, error) {
+	since, hasSince := field.Markers.Get(SinceMarker.Name).(string)
+	if !hasSince {
+		return nil, nil
+	}
+	version, err := semver.NewVersion(since)
+	if err != nil {
+		return nil, fmt.Errorf("the devfile:since marker of field %s/%s should be a semver-compatible devfile version: %w", typeName, field.Name, err)
+	}
+	return version, nil
+}
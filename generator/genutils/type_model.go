@@ -0,0 +1,470 @@
+package genutils
+
+import (
+	"fmt"
+	"go/types"
+	"reflect"
+	"sort"
+	"strings"
+
+	crdmarkers "sigs.k8s.io/controller-tools/pkg/crd/markers"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+// TypeKind is the kind of a type in the language-neutral model of the API types
+type TypeKind int
+
+const (
+	// StringKind is a string scalar
+	StringKind TypeKind = iota
+	// IntKind is an integer scalar
+	IntKind
+	// FloatKind is a floating point scalar
+	FloatKind
+	// BoolKind is a boolean scalar
+	BoolKind
+	// JSONKind is a free-form Json value
+	JSONKind
+	// ObjectKind is a reference to an object type of the model
+	ObjectKind
+	// EnumKind is a reference to an enum type of the model
+	EnumKind
+	// ListKind is a list of elements
+	ListKind
+	// MapKind is a map with string keys
+	MapKind
+)
+
+// TypeRef is the type of a field in the language-neutral model of the API types
+type TypeRef struct {
+	Kind TypeKind
+	// Name is the name of the referenced object or enum type, for the ObjectKind and EnumKind kinds
+	Name string
+	// Elem is the type of the elements, for the ListKind and MapKind kinds
+	Elem *TypeRef
+}
+
+// Field is a field of an object type in the language-neutral model of the API types
+type Field struct {
+	// GoName is the name of the field in the GO source code
+	GoName string
+	// JSONName is the name of the field in the Json serialization.
+	// It is empty for embedded fields, whose fields are inlined in the Json serialization.
+	JSONName string
+	Doc      string
+	Type     *TypeRef
+	// Optional indicates that the field can be omitted in the Json serialization
+	Optional bool
+	// Embedded indicates that the field is an embedded object type, whose fields are inlined in the Json serialization
+	Embedded bool
+	// Markers are the markers of the field, as registered by the generator that builds the model
+	Markers markers.MarkerValues
+	// GoType is the GO type of the field, for the generators that emit GO code from the model
+	GoType types.Type
+}
+
+// Union describes the members of an object type that is a K8S union
+type Union struct {
+	// Discriminator is the union discriminator field, if any
+	Discriminator *Field
+	// Members are the mutually-exclusive fields of the union
+	Members []*Field
+}
+
+// ObjectType is an object type in the language-neutral model of the API types
+type ObjectType struct {
+	Name string
+	Doc  string
+	// PkgPath is the path of the GO package that defines the type
+	PkgPath string
+	Fields  []*Field
+	// Union is set if the type is a K8S union
+	Union *Union
+	// Referenced indicates that the type is a root type, or is used as the type of a non-embedded field.
+	// Types that are not referenced are only embedded in other types.
+	Referenced bool
+}
+
+// EnumType is an enum type in the language-neutral model of the API types
+type EnumType struct {
+	Name   string
+	Doc    string
+	Values []string
+}
+
+// TypeModel is a language-neutral model of the API types reachable from a set of root types.
+//
+// It is meant to be used by the generators that emit models of the API types in other languages.
+type TypeModel struct {
+	// Objects are the object types, in the order they were discovered from the root types
+	Objects []*ObjectType
+	// Enums are the enum types, in the order they were discovered from the root types
+	Enums []*EnumType
+
+	objectsByName map[string]*ObjectType
+	enumsByName   map[string]*EnumType
+}
+
+// Object returns the object type with the given name
+func (m *TypeModel) Object(name string) *ObjectType {
+	return m.objectsByName[name]
+}
+
+// Enum returns the enum type with the given name
+func (m *TypeModel) Enum(name string) *EnumType {
+	return m.enumsByName[name]
+}
+
+// InlinedFields returns the fields of the given object type as they appear in the Json serialization,
+// recursively replacing the embedded fields by the fields of the embedded object type.
+func (m *TypeModel) InlinedFields(object *ObjectType) []*Field {
+	var fields []*Field
+	for _, field := range object.Fields {
+		if field.Embedded {
+			fields = append(fields, m.InlinedFields(m.objectsByName[field.Type.Name])...)
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// InlinedUnions returns the unions of the given object type and of all the object types recursively embedded in it.
+func (m *TypeModel) InlinedUnions(object *ObjectType) []*ObjectType {
+	var unions []*ObjectType
+	if object.Union != nil {
+		unions = append(unions, object)
+	}
+	for _, field := range object.Fields {
+		if field.Embedded {
+			unions = append(unions, m.InlinedUnions(m.objectsByName[field.Type.Name])...)
+		}
+	}
+	return unions
+}
+
+// RegisterTypeModelMarkers registers the markers used to build the language-neutral model of the API types
+func RegisterTypeModelMarkers(into *markers.Registry) error {
+	if err := crdmarkers.Register(into); err != nil {
+		return err
+	}
+	return RegisterUnionMarkers(into)
+}
+
+// modelBuilder builds the language-neutral model of the API types by walking through the GO types
+// reachable from the root types, using the markers and documentation of the types defined in the loaded packages.
+type modelBuilder struct {
+	model     *TypeModel
+	collector *markers.Collector
+	// typeInfos contains the marker information of the types, indexed by package path and type name
+	typeInfos map[string]map[string]*markers.TypeInfo
+	// packages contains the loaded packages from which marker information can be retrieved, indexed by path
+	packages map[string]*loader.Package
+	errors   []error
+}
+
+// BuildTypeModel builds the language-neutral model of the API types that are reachable from
+// the types of the given root package for which the isRootType function returns true.
+//
+// Types defined outside the `github.com/devfile/api` module (such as K8S types) are modeled as free-form Json values,
+// apart from embedded ones, whose fields are inlined.
+func BuildTypeModel(ctx *genall.GenerationContext, root *loader.Package, isRootType func(*markers.TypeInfo) bool) (*TypeModel, error) {
+	ctx.Checker.Check(root)
+	root.NeedTypesInfo()
+
+	builder := &modelBuilder{
+		model: &TypeModel{
+			objectsByName: map[string]*ObjectType{},
+			enumsByName:   map[string]*EnumType{},
+		},
+		collector: ctx.Collector,
+		typeInfos: map[string]map[string]*markers.TypeInfo{},
+		packages:  map[string]*loader.Package{},
+	}
+	builder.addPackages(root)
+
+	var rootTypes []string
+	for name, info := range builder.infosForPackage(root.PkgPath) {
+		if isRootType(info) {
+			rootTypes = append(rootTypes, name)
+		}
+	}
+	sort.Strings(rootTypes)
+
+	for _, name := range rootTypes {
+		typeName, isTypeName := root.Types.Scope().Lookup(name).(*types.TypeName)
+		if !isTypeName {
+			continue
+		}
+		named, isNamed := typeName.Type().(*types.Named)
+		if !isNamed {
+			continue
+		}
+		if object := builder.object(named); object != nil {
+			object.Referenced = true
+		}
+	}
+
+	if len(builder.errors) > 0 {
+		return nil, builder.errors[0]
+	}
+	return builder.model, nil
+}
+
+// isAPIPackage returns true if the given package path belongs to the devfile API module
+func isAPIPackage(pkgPath string) bool {
+	return strings.HasPrefix(pkgPath, "github.com/devfile/api/")
+}
+
+// addPackages registers the given package and all the API packages it imports, recursively
+func (b *modelBuilder) addPackages(pkg *loader.Package) {
+	if _, known := b.packages[pkg.PkgPath]; known || !isAPIPackage(pkg.PkgPath) {
+		return
+	}
+	b.packages[pkg.PkgPath] = pkg
+	for _, imported := range pkg.Imports() {
+		b.addPackages(imported)
+	}
+}
+
+// infosForPackage returns the marker information of the types of the package with the given path
+func (b *modelBuilder) infosForPackage(pkgPath string) map[string]*markers.TypeInfo {
+	if infos, collected := b.typeInfos[pkgPath]; collected {
+		return infos
+	}
+	infos := map[string]*markers.TypeInfo{}
+	b.typeInfos[pkgPath] = infos
+	pkg, loaded := b.packages[pkgPath]
+	if !loaded {
+		return infos
+	}
+	if err := markers.EachType(b.collector, pkg, func(info *markers.TypeInfo) {
+		infos[info.Name] = info
+	}); err != nil {
+		b.errors = append(b.errors, err)
+	}
+	return infos
+}
+
+// object returns the object type of the model corresponding to the given named struct type,
+// building it first if necessary.
+func (b *modelBuilder) object(named *types.Named) *ObjectType {
+	structType, isStruct := named.Underlying().(*types.Struct)
+	if !isStruct {
+		return nil
+	}
+	if wrapped := wrappedSameNameType(named, structType); wrapped != nil {
+		return b.object(wrapped)
+	}
+	name := named.Obj().Name()
+	pkgPath := named.Obj().Pkg().Path()
+	if existing, exists := b.model.objectsByName[name]; exists {
+		if existing.PkgPath != pkgPath {
+			b.errors = append(b.errors, fmt.Errorf("type %s is defined in both %s and %s packages: type names should be unique in the language-neutral model", name, existing.PkgPath, pkgPath))
+		}
+		return existing
+	}
+
+	info := b.infosForPackage(pkgPath)[name]
+	object := &ObjectType{
+		Name:    name,
+		PkgPath: pkgPath,
+	}
+	if info != nil {
+		object.Doc = info.Doc
+	}
+	b.model.objectsByName[name] = object
+	b.model.Objects = append(b.model.Objects, object)
+
+	fieldInfos := map[string]markers.FieldInfo{}
+	if info != nil {
+		for _, fieldInfo := range info.Fields {
+			fieldInfos[fieldInfo.Name] = fieldInfo
+		}
+	}
+
+	isUnion := info != nil && info.Markers.Get(UnionMarker.Name) != nil
+	if isUnion {
+		object.Union = &Union{}
+	}
+
+	for i := 0; i < structType.NumFields(); i++ {
+		goField := structType.Field(i)
+		if !goField.Exported() {
+			continue
+		}
+		jsonName, jsonOptions := parseJSONTag(reflect.StructTag(structType.Tag(i)))
+		if jsonName == "-" {
+			continue
+		}
+
+		var fieldInfo markers.FieldInfo
+		var hasInfo bool
+		if goField.Embedded() {
+			fieldInfo, hasInfo = fieldInfos[""]
+			if hasInfo && info != nil {
+				// Several embedded fields: match them by their type name
+				for _, candidate := range info.Fields {
+					if candidate.Name == "" && strings.HasSuffix(types.ExprString(candidate.RawField.Type), goField.Name()) {
+						fieldInfo = candidate
+					}
+				}
+			}
+		} else {
+			fieldInfo, hasInfo = fieldInfos[goField.Name()]
+		}
+
+		field := &Field{
+			GoName: goField.Name(),
+			GoType: goField.Type(),
+		}
+		if hasInfo {
+			field.Doc = fieldInfo.Doc
+			field.Markers = fieldInfo.Markers
+		}
+
+		if goField.Embedded() && jsonName == "" {
+			embedded, isNamed := derefType(goField.Type()).(*types.Named)
+			if !isNamed {
+				b.errors = append(b.errors, fmt.Errorf("embedded field %s of type %s should be a named struct type", goField.Name(), name))
+				continue
+			}
+			embeddedObject := b.object(embedded)
+			if embeddedObject == nil {
+				b.errors = append(b.errors, fmt.Errorf("embedded field %s of type %s should be a named struct type", goField.Name(), name))
+				continue
+			}
+			field.Embedded = true
+			field.Type = &TypeRef{Kind: ObjectKind, Name: embeddedObject.Name}
+			object.Fields = append(object.Fields, field)
+			continue
+		}
+
+		if jsonName == "" {
+			jsonName = goField.Name()
+		}
+		_, isPointer := goField.Type().(*types.Pointer)
+		field.JSONName = jsonName
+		field.Type = b.typeRef(goField.Type())
+		field.Optional = isPointer || jsonOptions["omitempty"] ||
+			(hasInfo && fieldInfo.Markers.Get("optional") != nil)
+		object.Fields = append(object.Fields, field)
+
+		if isUnion {
+			if hasInfo && fieldInfo.Markers.Get(UnionDiscriminatorMarker.Name) != nil {
+				object.Union.Discriminator = field
+			} else {
+				object.Union.Members = append(object.Union.Members, field)
+			}
+		}
+	}
+	return object
+}
+
+// typeRef returns the reference to the model type that corresponds to the given GO type
+func (b *modelBuilder) typeRef(goType types.Type) *TypeRef {
+	switch t := goType.(type) {
+	case *types.Pointer:
+		return b.typeRef(t.Elem())
+	case *types.Basic:
+		switch {
+		case t.Info()&types.IsString != 0:
+			return &TypeRef{Kind: StringKind}
+		case t.Info()&types.IsBoolean != 0:
+			return &TypeRef{Kind: BoolKind}
+		case t.Info()&types.IsInteger != 0:
+			return &TypeRef{Kind: IntKind}
+		case t.Info()&types.IsFloat != 0:
+			return &TypeRef{Kind: FloatKind}
+		}
+		return &TypeRef{Kind: JSONKind}
+	case *types.Slice:
+		if basic, isBasic := t.Elem().(*types.Basic); isBasic && basic.Kind() == types.Byte {
+			return &TypeRef{Kind: StringKind}
+		}
+		return &TypeRef{Kind: ListKind, Elem: b.typeRef(t.Elem())}
+	case *types.Map:
+		if basic, isBasic := t.Key().Underlying().(*types.Basic); isBasic && basic.Info()&types.IsString != 0 {
+			return &TypeRef{Kind: MapKind, Elem: b.typeRef(t.Elem())}
+		}
+		return &TypeRef{Kind: JSONKind}
+	case *types.Named:
+		if t.Obj().Pkg() == nil || !isAPIPackage(t.Obj().Pkg().Path()) {
+			return &TypeRef{Kind: JSONKind}
+		}
+		if _, isStruct := t.Underlying().(*types.Struct); isStruct {
+			object := b.object(t)
+			object.Referenced = true
+			return &TypeRef{Kind: ObjectKind, Name: object.Name}
+		}
+		if enum := b.enum(t); enum != nil {
+			return &TypeRef{Kind: EnumKind, Name: enum.Name}
+		}
+		return b.typeRef(t.Underlying())
+	}
+	return &TypeRef{Kind: JSONKind}
+}
+
+// enum returns the enum type of the model corresponding to the given named type,
+// or nil if the type doesn't have the `kubebuilder:validation:Enum` marker.
+func (b *modelBuilder) enum(named *types.Named) *EnumType {
+	name := named.Obj().Name()
+	if existing, exists := b.model.enumsByName[name]; exists {
+		return existing
+	}
+	info := b.infosForPackage(named.Obj().Pkg().Path())[name]
+	if info == nil {
+		return nil
+	}
+	enumMarker, isEnum := info.Markers.Get("kubebuilder:validation:Enum").(crdmarkers.Enum)
+	if !isEnum {
+		return nil
+	}
+	enum := &EnumType{
+		Name: name,
+		Doc:  info.Doc,
+	}
+	for _, value := range enumMarker {
+		enum.Values = append(enum.Values, fmt.Sprint(value))
+	}
+	b.model.enumsByName[name] = enum
+	b.model.Enums = append(b.model.Enums, enum)
+	return enum
+}
+
+// wrappedSameNameType returns the type embedded in the given struct type if it's its only field,
+// and has the same name (such as a type that exposes, in another package, a type of the `devfile` package
+// to generate its Json schema), since both have the same Json serialization.
+// Otherwise it returns nil.
+func wrappedSameNameType(named *types.Named, structType *types.Struct) *types.Named {
+	if structType.NumFields() != 1 || !structType.Field(0).Embedded() {
+		return nil
+	}
+	if jsonName, _ := parseJSONTag(reflect.StructTag(structType.Tag(0))); jsonName != "" {
+		return nil
+	}
+	embedded, isNamed := structType.Field(0).Type().(*types.Named)
+	if !isNamed || embedded.Obj().Name() != named.Obj().Name() {
+		return nil
+	}
+	return embedded
+}
+
+func derefType(goType types.Type) types.Type {
+	if pointer, isPointer := goType.(*types.Pointer); isPointer {
+		return pointer.Elem()
+	}
+	return goType
+}
+
+// parseJSONTag returns the Json name and the options of the `json` tag of a field
+func parseJSONTag(tag reflect.StructTag) (string, map[string]bool) {
+	parts := strings.Split(tag.Get("json"), ",")
+	options := map[string]bool{}
+	for _, option := range parts[1:] {
+		options[option] = true
+	}
+	return parts[0], options
+}
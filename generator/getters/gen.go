@@ -6,10 +6,15 @@ import (
 	"github.com/devfile/api/generator/genutils"
 	"github.com/elliotchance/orderedmap"
 	"go/ast"
+	"go/types"
+	"k8s.io/apimachinery/pkg/api/resource"
+	crdmarkers "sigs.k8s.io/controller-tools/pkg/crd/markers"
 	"sigs.k8s.io/controller-tools/pkg/genall"
 	"sigs.k8s.io/controller-tools/pkg/loader"
 	"sigs.k8s.io/controller-tools/pkg/markers"
+	"sort"
 	"strconv"
+	"strings"
 )
 
 //go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
@@ -17,27 +22,75 @@ import (
 var (
 	// GetterTypeMarker is associated with a type that's used as the pointer receiver of the getter method
 	GetterTypeMarker = markers.Must(markers.MakeDefinition("devfile:getter:generate", markers.DescribesType, struct{}{}))
+	// GetterFieldMarker is associated with a boolean pointer field to generate its getter method, even if its type isn't annotated
+	GetterFieldMarker = markers.Must(markers.MakeDefinition("devfile:getter:generate", markers.DescribesField, struct{}{}))
+	// SkipTypeMarker is associated with a type for which no getter method should be generated
+	SkipTypeMarker = markers.Must(markers.MakeDefinition("devfile:getter:skip", markers.DescribesType, struct{}{}))
+	// SkipFieldMarker is associated with a field for which no getter method should be generated
+	SkipFieldMarker = markers.Must(markers.MakeDefinition("devfile:getter:skip", markers.DescribesField, struct{}{}))
 	// DefaultFieldMarker is associated with a boolean pointer field to indicate the default boolean value
 	DefaultFieldMarker = markers.Must(markers.MakeDefinition("devfile:default:value", markers.DescribesField, ""))
+	// DefaultSkipFieldMarker is associated with a field whose getter returns the default value, but that the `Default()` method doesn't set
+	DefaultSkipFieldMarker = markers.Must(markers.MakeDefinition("devfile:default:skip", markers.DescribesField, struct{}{}))
+	// QuantityFieldMarker is associated with a string field that contains a quantity, to generate a getter that parses it
+	QuantityFieldMarker = markers.Must(markers.MakeDefinition("devfile:getter:quantity", markers.DescribesField, struct{}{}))
 )
 
+// enumMarkerName is the name of the marker that lists the values of an enum type
+const enumMarkerName = "kubebuilder:validation:Enum"
+
+// devfileErrorsPackage is the import path of the package of the structured errors returned by the generated code
+const devfileErrorsPackage = "github.com/devfile/api/v2/pkg/errors"
+
 // +controllertools:marker:generateHelp
 
 // Generator generates getter methods that are used to return values for the boolean pointer fields.
 //
+// Pointers to strings, integers (`int`, `int32`, `int64`) and `resource.Quantity` values are supported as well,
+// so that the typed configuration CRDs can declare the default values of their settings with the same markers.
+// Pointers to the string types of the package that have the `kubebuilder:validation:Enum` marker are also supported,
+// and their default value must be one of the enum values.
+//
 // The pointer receiver is determined from the `devfile:getter:generate` annotated type.  The method will return the value of the
 // field if it's been set, otherwise it will return the default value specified by the devfile:default:value annotation.
-type Generator struct{}
+// A `Default()` method is also generated on the same pointer receiver, that sets all the unset fields to their default values.
+// The `devfile:default:skip` annotation on a field excludes it from the `Default()` method, for the fields whose value is inherited,
+// for example from a parent devfile, and shouldn't be set before the inherited value is known.
+//
+// Getters can also be scoped per field: the `devfile:getter:generate` annotation on a field generates its getter
+// even if its type isn't annotated, and the `devfile:getter:skip` annotation on a field or a type
+// prevents the generation of the getters of this field or this type.
+//
+// The `devfile:getter:quantity` annotation on a string field, such as a memory limit, generates a `Get<Field>Quantity()` method
+// that returns the field parsed as a `resource.Quantity`, or a `*QuantityError` of the devfile errors package if it is invalid.
+// The parsed values are cached, so that controllers can call these getters at every reconcile without parsing the same values again.
+type Generator struct {
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+}
 
 // RegisterMarkers registers the markers of the Generator
 func (Generator) RegisterMarkers(into *markers.Registry) error {
-	if err := markers.RegisterAll(into, GetterTypeMarker, DefaultFieldMarker); err != nil {
+	if err := markers.RegisterAll(into, GetterTypeMarker, GetterFieldMarker, SkipTypeMarker, SkipFieldMarker, DefaultFieldMarker, DefaultSkipFieldMarker, QuantityFieldMarker); err != nil {
+		return err
+	}
+	if err := crdmarkers.Register(into); err != nil {
 		return err
 	}
 	into.AddHelp(GetterTypeMarker,
 		markers.SimpleHelp("Devfile", "indicates the type that's used as the pointer receiver of the getter method"))
+	into.AddHelp(GetterFieldMarker,
+		markers.SimpleHelp("Devfile", "indicates that the getter method of this boolean pointer field should be generated, even if its type isn't annotated"))
+	into.AddHelp(SkipTypeMarker,
+		markers.SimpleHelp("Devfile", "indicates that no getter method should be generated for the fields of this type"))
+	into.AddHelp(SkipFieldMarker,
+		markers.SimpleHelp("Devfile", "indicates that no getter method should be generated for this field"))
 	into.AddHelp(DefaultFieldMarker,
-		markers.SimpleHelp("Devfile", "indicates the default value of a boolean pointer field"))
+		markers.SimpleHelp("Devfile", "indicates the default value of a boolean pointer field, or of a pointer to a string, an integer, a resource.Quantity or an enum"))
+	into.AddHelp(DefaultSkipFieldMarker,
+		markers.SimpleHelp("Devfile", "indicates that the Default() method shouldn't set this field, whose getter still returns the default value"))
+	into.AddHelp(QuantityFieldMarker,
+		markers.SimpleHelp("Devfile", "indicates that a getter returning the value of this string field parsed as a resource.Quantity should be generated"))
 	return genutils.RegisterUnionMarkers(into)
 
 }
@@ -54,6 +107,111 @@ func (Generator) CheckFilter() loader.NodeFilter {
 type getterInfo struct {
 	funcName   string
 	defaultVal string
+	kind       *valueKind
+	// skipDefault is true if the `Default()` method doesn't set the field
+	skipDefault bool
+}
+
+// quantityGetterInfo stores the info to generate the getter method of a string field that contains a quantity
+type quantityGetterInfo struct {
+	fieldName string
+	jsonName  string
+}
+
+// valueKind describes a kind of pointer field supported by the getters
+type valueKind struct {
+	// name is the name of the kind, used in the names of the helper functions
+	name string
+	// goType is the GO type pointed to by the field
+	goType string
+	// description is the description of the kind in the doc comments of the getters
+	description string
+	// defaultExpr returns the GO expression of the given default value, or an error if it is not a valid value of the kind
+	defaultExpr func(value string) (string, error)
+}
+
+func parseIntDefault(bitSize int) func(string) (string, error) {
+	return func(value string) (string, error) {
+		if _, err := strconv.ParseInt(value, 10, bitSize); err != nil {
+			return "", fmt.Errorf("not a valid %d-bit integer", bitSize)
+		}
+		return value, nil
+	}
+}
+
+// valueKinds contains the kinds of pointer fields supported by the getters, indexed by the GO type they point to
+var valueKinds = map[string]*valueKind{
+	"bool": {name: "Bool", goType: "bool", description: "boolean", defaultExpr: func(value string) (string, error) {
+		if _, err := strconv.ParseBool(value); err != nil {
+			return "", fmt.Errorf("not a true or false value")
+		}
+		return value, nil
+	}},
+	"string": {name: "String", goType: "string", description: "string", defaultExpr: func(value string) (string, error) {
+		return strconv.Quote(value), nil
+	}},
+	"int":   {name: "Int", goType: "int", description: "integer", defaultExpr: parseIntDefault(strconv.IntSize)},
+	"int32": {name: "Int32", goType: "int32", description: "integer", defaultExpr: parseIntDefault(32)},
+	"int64": {name: "Int64", goType: "int64", description: "integer", defaultExpr: parseIntDefault(64)},
+	"resource.Quantity": {name: "Quantity", goType: "resource.Quantity", description: "quantity", defaultExpr: func(value string) (string, error) {
+		if _, err := resource.ParseQuantity(value); err != nil {
+			return "", fmt.Errorf("not a valid quantity")
+		}
+		return fmt.Sprintf("resource.MustParse(%s)", strconv.Quote(value)), nil
+	}},
+}
+
+// enumValueKind returns the kind of the pointers to the given enum type,
+// whose default values must be one of the given enum values
+func enumValueKind(name string, enumMarker crdmarkers.Enum) *valueKind {
+	return &valueKind{name: name, goType: name, description: name, defaultExpr: func(value string) (string, error) {
+		for _, enumValue := range enumMarker {
+			if fmt.Sprint(enumValue) == value {
+				return fmt.Sprintf("%s(%s)", name, strconv.Quote(value)), nil
+			}
+		}
+		return "", fmt.Errorf("not one of the enum values")
+	}}
+}
+
+// collectEnumKinds returns the kinds of the pointers to the enum types of the given package, indexed by the enum type name.
+// Enum types are the string types that have the `kubebuilder:validation:Enum` marker.
+func collectEnumKinds(ctx *genall.GenerationContext, root *loader.Package) (map[string]*valueKind, error) {
+	enumKinds := map[string]*valueKind{}
+	err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
+		enumMarker, isEnum := info.Markers.Get(enumMarkerName).(crdmarkers.Enum)
+		if !isEnum {
+			return
+		}
+		typeName, isTypeName := root.Types.Scope().Lookup(info.Name).(*types.TypeName)
+		if !isTypeName {
+			return
+		}
+		if basic, isBasic := typeName.Type().Underlying().(*types.Basic); isBasic && basic.Kind() == types.String {
+			enumKinds[info.Name] = enumValueKind(info.Name, enumMarker)
+		}
+	})
+	return enumKinds, err
+}
+
+// fieldValueKind returns the kind of the given pointer field type, or nil if it is not a supported pointer type
+func fieldValueKind(fieldType ast.Expr, enumKinds map[string]*valueKind) *valueKind {
+	ptr, isPtr := fieldType.(*ast.StarExpr)
+	if !isPtr {
+		return nil
+	}
+	switch elem := ptr.X.(type) {
+	case *ast.Ident:
+		if kind, isBuiltin := valueKinds[elem.Name]; isBuiltin {
+			return kind
+		}
+		return enumKinds[elem.Name]
+	case *ast.SelectorExpr:
+		if pkg, isIdent := elem.X.(*ast.Ident); isIdent {
+			return valueKinds[pkg.Name+"."+elem.Sel.Name]
+		}
+	}
+	return nil
 }
 
 // Generate generates the artifacts
@@ -62,39 +220,89 @@ func (g Generator) Generate(ctx *genall.GenerationContext) error {
 		ctx.Checker.Check(root)
 		root.NeedTypesInfo()
 
+		enumKinds, err := collectEnumKinds(ctx, root)
+		if err != nil {
+			root.AddError(err)
+			return nil
+		}
+
 		typesToProcess := orderedmap.NewOrderedMap()
+		quantityTypesToProcess := orderedmap.NewOrderedMap()
 		if err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
-			if info.Markers.Get(GetterTypeMarker.Name) != nil {
+			var quantityGetters []quantityGetterInfo
+			for _, field := range info.Fields {
+				if field.Markers.Get(QuantityFieldMarker.Name) == nil {
+					continue
+				}
+				if ident, isIdent := field.RawField.Type.(*ast.Ident); !isIdent || ident.Name != "string" {
+					root.AddError(fmt.Errorf("devfile:getter:quantity marker is specified on %s/%s which is not a string", info.Name, field.Name))
+					continue
+				}
+				quantityGetters = append(quantityGetters, quantityGetterInfo{
+					fieldName: field.Name,
+					jsonName:  strings.Split(field.Tag.Get("json"), ",")[0],
+				})
+			}
+			if len(quantityGetters) > 0 {
+				quantityTypesToProcess.Set(info, quantityGetters)
+			}
+
+			typeRequested := info.Markers.Get(GetterTypeMarker.Name) != nil
+			if info.Markers.Get(SkipTypeMarker.Name) != nil {
+				if typeRequested {
+					root.AddError(fmt.Errorf("type %s has both the devfile:getter:generate and devfile:getter:skip markers", info.Name))
+				}
+				return
+			}
+			fieldsRequested, fieldsSkipped := false, false
+			for _, field := range info.Fields {
+				fieldRequested := field.Markers.Get(GetterFieldMarker.Name) != nil
+				fieldSkipped := field.Markers.Get(SkipFieldMarker.Name) != nil
+				if fieldRequested && fieldSkipped {
+					root.AddError(fmt.Errorf("field %s/%s has both the devfile:getter:generate and devfile:getter:skip markers", info.Name, field.Name))
+				}
+				if fieldRequested && field.Markers.Get(DefaultFieldMarker.Name) == nil {
+					root.AddError(fmt.Errorf("devfile:getter:generate marker is specified on %s/%s which doesn't have the devfile:default:value marker", info.Name, field.Name))
+				}
+				if field.Markers.Get(DefaultSkipFieldMarker.Name) != nil && field.Markers.Get(DefaultFieldMarker.Name) == nil {
+					root.AddError(fmt.Errorf("devfile:default:skip marker is specified on %s/%s which doesn't have the devfile:default:value marker", info.Name, field.Name))
+				}
+				fieldsRequested = fieldsRequested || fieldRequested
+				fieldsSkipped = fieldsSkipped || fieldSkipped
+			}
+
+			if typeRequested || fieldsRequested {
 				var getters []getterInfo
 				for _, field := range info.Fields {
+					if field.Markers.Get(SkipFieldMarker.Name) != nil ||
+						!typeRequested && field.Markers.Get(GetterFieldMarker.Name) == nil {
+						continue
+					}
 					defaultVal := field.Markers.Get(DefaultFieldMarker.Name)
 					if defaultVal != nil {
-						if _, err := strconv.ParseBool(defaultVal.(string)); err != nil {
-							root.AddError(fmt.Errorf("devfile:default:value marker specified on %s/%s does not have a true or false value.  Value is %s", info.Name, field.Name, defaultVal.(string)))
+						//look for boolean pointers, or pointers to the other supported kinds
+						kind := fieldValueKind(field.RawField.Type, enumKinds)
+						if kind == nil {
+							root.AddError(fmt.Errorf("devfile:default:value marker is specified on %s/%s which is not a pointer to a boolean, a string, an integer, a quantity or an enum", info.Name, field.Name))
+							continue
 						}
-
-						//look for boolean pointers
-						if ptr, isPtr := field.RawField.Type.(*ast.StarExpr); isPtr {
-							if ident, ok := ptr.X.(*ast.Ident); ok {
-								if ident.Name == "bool" {
-									getters = append(getters, getterInfo{
-										field.Name,
-										defaultVal.(string),
-									})
-								} else {
-									root.AddError(fmt.Errorf("devfile:default:value marker is specified on %s/%s which is not a boolean pointer", info.Name, field.Name))
-								}
-							}
-						} else {
-							root.AddError(fmt.Errorf("devfile:default:value marker is specified on %s/%s which is not a boolean pointer", info.Name, field.Name))
+						defaultExpr, err := kind.defaultExpr(defaultVal.(string))
+						if err != nil {
+							root.AddError(fmt.Errorf("devfile:default:value marker specified on %s/%s does not have a valid %s value (%v).  Value is %s", info.Name, field.Name, kind.description, err, defaultVal.(string)))
+							continue
 						}
-
+						getters = append(getters, getterInfo{
+							field.Name,
+							defaultExpr,
+							kind,
+							field.Markers.Get(DefaultSkipFieldMarker.Name) != nil,
+						})
 					}
 				}
 				if len(getters) > 0 {
 					typesToProcess.Set(info, getters)
-				} else {
-					root.AddError(fmt.Errorf("type %s does not have the field marker, devfile:default:value specified on a boolean pointer field", info.Name))
+				} else if typeRequested && !fieldsSkipped {
+					root.AddError(fmt.Errorf("type %s does not have the field marker, devfile:default:value specified on a pointer field", info.Name))
 				}
 				return
 			}
@@ -104,31 +312,152 @@ func (g Generator) Generate(ctx *genall.GenerationContext) error {
 			return nil
 		}
 
-		genutils.WriteFormattedSourceFile("getters", ctx, root, func(buf *bytes.Buffer) {
+		genutils.WriteFormattedSourceFile("getters", g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
+			usedKinds := map[*valueKind]bool{}
+			defaultedKinds := map[*valueKind]bool{}
+			for elt := typesToProcess.Front(); elt != nil; elt = elt.Next() {
+				for _, getter := range elt.Value.([]getterInfo) {
+					usedKinds[getter.kind] = true
+					defaultedKinds[getter.kind] = defaultedKinds[getter.kind] || !getter.skipDefault
+				}
+			}
+			imports := map[string]string{}
+			if usedKinds[valueKinds["resource.Quantity"]] || quantityTypesToProcess.Len() > 0 {
+				imports["k8s.io/apimachinery/pkg/api/resource"] = "resource"
+			}
+			if quantityTypesToProcess.Len() > 0 {
+				imports["sync"] = "sync"
+				imports[devfileErrorsPackage] = "devfileerrors"
+			}
+			genutils.WriteImports(buf, imports)
+
 			for elt := typesToProcess.Front(); elt != nil; elt = elt.Next() {
 				cmd := elt.Key.(*markers.TypeInfo)
 				fields := elt.Value.([]getterInfo)
+				propertiesDescription := "boolean properties"
 				for _, getter := range fields {
 					fName := getter.funcName
 					defaultVal := getter.defaultVal
+					if getter.kind.goType != "bool" {
+						propertiesDescription = "properties"
+					}
 					getterMethod := fmt.Sprintf(`
-// Get%[1]s returns the value of the boolean property.  If unset, it's the default value specified in the devfile:default:value marker
-func (in *%[2]s) Get%[1]s() bool {
-return getBoolOrDefault(in.%[1]s, %[3]s)}`, fName, cmd.Name, defaultVal)
+// Get%[1]s returns the value of the %[4]s property.  If unset, it's the default value specified in the devfile:default:value marker
+func (in *%[2]s) Get%[1]s() %[5]s {
+return get%[6]sOrDefault(in.%[1]s, %[3]s)}`, fName, cmd.Name, defaultVal, getter.kind.description, getter.kind.goType, getter.kind.name)
 					buf.WriteString(getterMethod)
 				}
+
+				var defaulted []getterInfo
+				for _, getter := range fields {
+					if !getter.skipDefault {
+						defaulted = append(defaulted, getter)
+					}
+				}
+				if len(defaulted) == 0 {
+					continue
+				}
+				buf.WriteString(fmt.Sprintf(`
+
+// Default sets the unset %s to the default value specified in the devfile:default:value marker
+func (in *%s) Default() {`, propertiesDescription, cmd.Name))
+				for _, getter := range defaulted {
+					buf.WriteString(fmt.Sprintf(`
+set%sDefault(&in.%s, %s)`, getter.kind.name, getter.funcName, getter.defaultVal))
+				}
+				buf.WriteString(`
+}`)
 			}
 
-			internalHelper := `
+			for elt := quantityTypesToProcess.Front(); elt != nil; elt = elt.Next() {
+				cmd := elt.Key.(*markers.TypeInfo)
+				for _, getter := range elt.Value.([]quantityGetterInfo) {
+					buf.WriteString(fmt.Sprintf(`
+
+// Get%[1]sQuantity returns the value of the %[1]s property parsed as a quantity, which is zero if the property is unset,
+// or a *QuantityError if it is not a valid quantity. The parsed values are cached.
+func (in *%[2]s) Get%[1]sQuantity() (resource.Quantity, error) {
+return parseQuantity(%[3]s, in.%[1]s)}`, getter.fieldName, cmd.Name, strconv.Quote(getter.jsonName)))
+				}
+			}
+			if quantityTypesToProcess.Len() > 0 {
+				buf.WriteString(quantityHelpers)
+			}
+
+			kinds := []*valueKind{}
+			for _, kindType := range []string{"bool", "string", "int", "int32", "int64", "resource.Quantity"} {
+				kinds = append(kinds, valueKinds[kindType])
+			}
+			enumNames := []string{}
+			for enumName := range enumKinds {
+				enumNames = append(enumNames, enumName)
+			}
+			sort.Strings(enumNames)
+			for _, enumName := range enumNames {
+				kinds = append(kinds, enumKinds[enumName])
+			}
+			for _, kind := range kinds {
+				if !usedKinds[kind] {
+					continue
+				}
+				buf.WriteString(fmt.Sprintf(`
 
-func getBoolOrDefault(input *bool, defaultVal bool) bool {
+func get%[1]sOrDefault(input *%[2]s, defaultVal %[2]s) %[2]s {
 	if input != nil {
 		return *input 
 	} 
-	return defaultVal }`
-			buf.WriteString(internalHelper)
+	return defaultVal }`, kind.name, kind.goType))
+				if !defaultedKinds[kind] {
+					continue
+				}
+				buf.WriteString(fmt.Sprintf(`
+
+func set%[1]sDefault(input **%[2]s, defaultVal %[2]s) {
+	if *input == nil {
+		*input = &defaultVal
+	} }`, kind.name, kind.goType))
+			}
 		})
 	}
 
 	return nil
 }
+
+// quantityHelpers is the source of the helpers used by the quantity getters
+const quantityHelpers = `
+
+// quantityCacheSize is the maximum number of parsed quantities kept in the cache of the quantity getters
+const quantityCacheSize = 1024
+
+// quantityCache contains the quantities parsed by the quantity getters, indexed by their string value
+var quantityCache = struct {
+	sync.Mutex
+	values map[string]resource.Quantity
+}{values: map[string]resource.Quantity{}}
+
+// parseQuantity parses the given value of the given field as a quantity, which is zero if the value is empty.
+// The parsed quantities are cached, and a deep copy of the cached quantity is returned, so that callers can modify it.
+func parseQuantity(field string, value string) (resource.Quantity, error) {
+	if value == "" {
+		return resource.Quantity{}, nil
+	}
+	quantityCache.Lock()
+	cached, isCached := quantityCache.values[value]
+	quantityCache.Unlock()
+	if isCached {
+		return cached.DeepCopy(), nil
+	}
+
+	parsed, err := resource.ParseQuantity(value)
+	if err != nil {
+		return resource.Quantity{}, &devfileerrors.QuantityError{Field: field, Value: value, Err: err}
+	}
+	quantityCache.Lock()
+	if len(quantityCache.values) >= quantityCacheSize {
+		quantityCache.values = map[string]resource.Quantity{}
+	}
+	quantityCache.values[value] = parsed
+	quantityCache.Unlock()
+	return parsed.DeepCopy(), nil
+}
+`
@@ -16,8 +16,13 @@ func (Generator) Help() *markers.DefinitionHelp {
 		Category: "",
 		DetailedHelp: markers.DetailedHelp{
 			Summary: "generates getter methods that are used to return values for the boolean pointer fields. ",
-			Details: "The pointer receiver is determined from the `devfile:getter:generate` annotated type.  The method will return the value of the field if it's been set, otherwise it will return the default value specified by the devfile:default:value annotation.",
+			Details: "Pointers to strings, integers (`int`, `int32`, `int64`) and `resource.Quantity` values are supported as well, so that the typed configuration CRDs can declare the default values of their settings with the same markers. Pointers to the string types of the package that have the `kubebuilder:validation:Enum` marker are also supported, and their default value must be one of the enum values. \n The pointer receiver is determined from the `devfile:getter:generate` annotated type.  The method will return the value of the field if it's been set, otherwise it will return the default value specified by the devfile:default:value annotation. A `Default()` method is also generated on the same pointer receiver, that sets all the unset fields to their default values. The `devfile:default:skip` annotation on a field excludes it from the `Default()` method, for the fields whose value is inherited, for example from a parent devfile, and shouldn't be set before the inherited value is known. \n Getters can also be scoped per field: the `devfile:getter:generate` annotation on a field generates its getter even if its type isn't annotated, and the `devfile:getter:skip` annotation on a field or a type prevents the generation of the getters of this field or this type. \n The `devfile:getter:quantity` annotation on a string field, such as a memory limit, generates a `Get<Field>Quantity()` method that returns the field parsed as a `resource.Quantity`, or a `*QuantityError` of the devfile errors package if it is invalid. The parsed values are cached, so that controllers can call these getters at every reconcile without parsing the same values again.",
+		},
+		FieldHelp: map[string]markers.DetailedHelp{
+			"HeaderFile": {
+				Summary: "specifies the header text (e.g. license) to prepend to generated files.",
+				Details: "",
+			},
 		},
-		FieldHelp: map[string]markers.DetailedHelp{},
 	}
 }
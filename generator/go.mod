@@ -7,10 +7,13 @@ require (
 	github.com/elliotchance/orderedmap v1.3.0
 	github.com/go-toolsmith/astcopy v1.0.0
 	github.com/iancoleman/strcase v0.1.2
+	github.com/lucasjones/reggen v0.0.0-20200904144131-37ba4fa293bb
+	github.com/santhosh-tekuri/jsonschema v1.2.4
 	github.com/spf13/cobra v1.2.1
 	golang.org/x/tools v0.1.5
 	gomodules.xyz/orderedmap v0.1.0
 	k8s.io/apiextensions-apiserver v0.21.3
 	k8s.io/apimachinery v0.21.3
 	sigs.k8s.io/controller-tools v0.6.2
+	sigs.k8s.io/yaml v1.2.0
 )
@@ -0,0 +1,233 @@
+package graphql
+
+import (
+	"fmt"
+	"go/ast"
+	"regexp"
+	"strings"
+
+	"github.com/devfile/api/generator/genutils"
+	"github.com/devfile/api/generator/schemas"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+const (
+	// jsonScalar is the name of the custom GraphQL scalar used for free-form Json values, such as attributes
+	jsonScalar = "JSON"
+	// unionSuffix is appended to the name of a K8S union type to build the name of the corresponding GraphQL union
+	unionSuffix = "Member"
+)
+
+// graphqlName matches the valid GraphQL names
+var graphqlName = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// +controllertools:marker:generateHelp
+
+// Generator generates a GraphQL schema (SDL) from the GO source code of the Kubernetes API
+//
+// An object type is generated for each GO structure reachable from the types that have the `devfile:jsonschema:generate` annotation.
+// Embedded structures are inlined, K8S unions are additionally described by a GraphQL union of their object members,
+// and free-form values (such as attributes or K8S types) are typed with the `JSON` custom scalar.
+// A `<package>.graphql` file is generated for each K8S API version.
+type Generator struct{}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	if err := schemas.RegisterGenerateMarker(into); err != nil {
+		return err
+	}
+	return genutils.RegisterTypeModelMarkers(into)
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// Generate generates the artifacts
+func (Generator) Generate(ctx *genall.GenerationContext) error {
+	for _, root := range ctx.Roots {
+		model, err := genutils.BuildTypeModel(ctx, root, schemas.HasGenerateMarker)
+		if err != nil {
+			root.AddError(err)
+			continue
+		}
+		if len(model.Objects) == 0 {
+			continue
+		}
+
+		content, err := render(model)
+		if err != nil {
+			root.AddError(err)
+			continue
+		}
+		genutils.WriteGeneratedArtifact(ctx, root, root.Name+".graphql", content)
+	}
+	return nil
+}
+
+// render returns the GraphQL SDL of the given type model
+func render(model *genutils.TypeModel) (string, error) {
+	sdl := &sdlWriter{
+		model:        model,
+		invalidEnums: map[string]bool{},
+	}
+	for _, enum := range model.Enums {
+		for _, value := range enum.Values {
+			if !isValidEnumValue(value) {
+				sdl.invalidEnums[enum.Name] = true
+			}
+		}
+	}
+
+	sdl.WriteString("# " + strings.TrimPrefix(genutils.GeneratedFileBanner, "// ") + "\n")
+	sdl.writeDescription("", "A free-form Json value")
+	sdl.WriteString("scalar " + jsonScalar + "\n")
+
+	for _, object := range model.Objects {
+		if !object.Referenced {
+			continue
+		}
+		if err := sdl.writeObject(object); err != nil {
+			return "", err
+		}
+	}
+
+	for _, object := range model.Objects {
+		if object.Union != nil {
+			sdl.writeUnion(object)
+		}
+	}
+
+	for _, enum := range model.Enums {
+		if !sdl.invalidEnums[enum.Name] {
+			sdl.writeEnum(enum)
+		}
+	}
+	return sdl.String(), nil
+}
+
+// sdlWriter writes the GraphQL SDL of the types of a type model
+type sdlWriter struct {
+	strings.Builder
+	model *genutils.TypeModel
+	// invalidEnums contains the enums whose values are not valid GraphQL enum values, and which are typed as strings
+	invalidEnums map[string]bool
+}
+
+func (w *sdlWriter) writeDescription(indent string, doc string) {
+	doc = strings.TrimSpace(doc)
+	if doc == "" {
+		return
+	}
+	doc = strings.ReplaceAll(doc, `"""`, `\"""`)
+	w.WriteString("\n" + indent + `"""` + "\n")
+	for _, line := range strings.Split(doc, "\n") {
+		if line == "" {
+			w.WriteString("\n")
+			continue
+		}
+		w.WriteString(indent + line + "\n")
+	}
+	w.WriteString(indent + `"""` + "\n")
+}
+
+func (w *sdlWriter) writeObject(object *genutils.ObjectType) error {
+	w.writeDescription("", object.Doc)
+	if object.Doc == "" {
+		w.WriteString("\n")
+	}
+	w.WriteString("type " + object.Name + " {\n")
+
+	fields := w.model.InlinedFields(object)
+	if len(fields) == 0 {
+		// GraphQL object types require at least one field
+		w.writeDescription("  ", "Placeholder field: this type has no fields")
+		w.WriteString("  _: Boolean\n")
+	}
+	for _, field := range fields {
+		if !graphqlName.MatchString(field.JSONName) || strings.HasPrefix(field.JSONName, "__") {
+			return fmt.Errorf("field %s of type %s has Json name %q, which is not a valid GraphQL field name", field.GoName, object.Name, field.JSONName)
+		}
+		w.writeDescription("  ", field.Doc)
+		w.WriteString("  " + field.JSONName + ": " + w.typeName(field.Type, !field.Optional) + "\n")
+	}
+	w.WriteString("}\n")
+	return nil
+}
+
+// writeUnion writes a GraphQL union of the object members of the given K8S union type
+func (w *sdlWriter) writeUnion(object *genutils.ObjectType) {
+	var members []string
+	var memberFields []string
+	seen := map[string]bool{}
+	for _, member := range object.Union.Members {
+		if member.Type.Kind != genutils.ObjectKind || seen[member.Type.Name] {
+			continue
+		}
+		seen[member.Type.Name] = true
+		members = append(members, member.Type.Name)
+		memberFields = append(memberFields, "`"+member.JSONName+"`")
+	}
+	if len(members) == 0 {
+		return
+	}
+
+	w.writeDescription("", fmt.Sprintf("Object members of the %s union: only one of the %s fields can be set.", object.Name, strings.Join(memberFields, ", ")))
+	w.WriteString("union " + object.Name + unionSuffix + " = " + strings.Join(members, " | ") + "\n")
+}
+
+func (w *sdlWriter) writeEnum(enum *genutils.EnumType) {
+	w.writeDescription("", enum.Doc)
+	if enum.Doc == "" {
+		w.WriteString("\n")
+	}
+	w.WriteString("enum " + enum.Name + " {\n")
+	for _, value := range enum.Values {
+		w.WriteString("  " + value + "\n")
+	}
+	w.WriteString("}\n")
+}
+
+// typeName returns the GraphQL type reference of the given model type
+func (w *sdlWriter) typeName(typeRef *genutils.TypeRef, nonNull bool) string {
+	var name string
+	switch typeRef.Kind {
+	case genutils.StringKind:
+		name = "String"
+	case genutils.IntKind:
+		name = "Int"
+	case genutils.FloatKind:
+		name = "Float"
+	case genutils.BoolKind:
+		name = "Boolean"
+	case genutils.ObjectKind:
+		name = typeRef.Name
+	case genutils.EnumKind:
+		name = typeRef.Name
+		if w.invalidEnums[typeRef.Name] {
+			name = "String"
+		}
+	case genutils.ListKind:
+		name = "[" + w.typeName(typeRef.Elem, true) + "]"
+	default:
+		// GraphQL has no map type: maps are typed as free-form Json values
+		name = jsonScalar
+	}
+	if nonNull {
+		return name + "!"
+	}
+	return name
+}
+
+// isValidEnumValue returns true if the given value is a valid GraphQL enum value
+func isValidEnumValue(value string) bool {
+	return graphqlName.MatchString(value) && value != "true" && value != "false" && value != "null"
+}
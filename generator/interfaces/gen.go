@@ -24,8 +24,15 @@ var (
 
 // Generator generates GO source code required for the API
 //
-// Generated source code mainly consists in interface implementations to manage unions and keyed top-level lists
-type Generator struct{}
+// Generated source code mainly consists in interface implementations to manage unions and keyed top-level lists.
+//
+// It can also process the packages of downstream modules that embed devfile types: in this case, the generated code
+// relies on the exported helpers of the devfile API package (such as `v1alpha2.NormalizeUnion`),
+// and imports the packages referenced by the union member types.
+type Generator struct {
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+}
 
 // RegisterMarkers registers the markers of the Generator
 func (Generator) RegisterMarkers(into *markers.Registry) error {
@@ -34,6 +41,9 @@ func (Generator) RegisterMarkers(into *markers.Registry) error {
 	}
 	into.AddHelp(toplevelListMarker,
 		markers.SimpleHelp("Devfile", "indicates that a given field of the Devfile body structure is a top-level list that should be managed through strategic merge patch during parent of plugin overriding."))
+	if err := genutils.RegisterHelperMarker(into); err != nil {
+		return err
+	}
 	return genutils.RegisterUnionMarkers(into)
 }
 
@@ -52,14 +62,25 @@ func (g Generator) Generate(ctx *genall.GenerationContext) error {
 
 		root.NeedTypesInfo()
 
+		imports := genutils.PackageImports(root)
+		// helpers is the qualifier of the devfile API helpers used by the generated code
+		helpers := ""
+		if !genutils.IsDevfileAPIPackage(root) {
+			helpers = genutils.DevfileAPIQualifier(imports) + "."
+		}
+
 		unions := orderedmap.NewOrderedMap()
 		toplevelListContainers := orderedmap.NewOrderedMap()
 		keyed := orderedmap.NewOrderedMap()
+		kinds := []string{}
 		if err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
 			if info.Markers.Get(genutils.UnionMarker.Name) != nil {
 				unions.Set(info.Name, info)
 				return
 			}
+			if isKubeKind(info) {
+				kinds = append(kinds, info.Name)
+			}
 			for i, field := range info.Fields {
 				if field.Markers.Get(toplevelListMarker.Name) != nil {
 					toplevelListContainers.Set(info.Name, info)
@@ -75,7 +96,7 @@ func (g Generator) Generate(ctx *genall.GenerationContext) error {
 			return nil
 		}
 
-		genutils.WriteFormattedSourceFile("keyed_definitions", ctx, root, func(buf *bytes.Buffer) {
+		genutils.WriteFormattedSourceFile("keyed_definitions", g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
 			for elt := keyed.Front(); elt != nil; elt = elt.Next() {
 				typeName := elt.Key.(string)
 				field := elt.Value.(*markers.FieldInfo)
@@ -88,17 +109,25 @@ func (keyed ` + typeName + `) Key() string {
 			}
 		})
 
-		genutils.WriteFormattedSourceFile("toplevellistcontainer_definitions", ctx, root, func(buf *bytes.Buffer) {
+		extractKeys := "extractKeys"
+		if helpers != "" {
+			extractKeys = helpers + "ExtractKeys"
+		}
+
+		genutils.WriteFormattedSourceFile("toplevellistcontainer_definitions", g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
+			if helpers != "" && toplevelListContainers.Len() > 0 {
+				genutils.WriteImports(buf, map[string]string{genutils.DevfileAPIPackage: strings.TrimSuffix(helpers, ".")})
+			}
 			for elt := toplevelListContainers.Front(); elt != nil; elt = elt.Next() {
 				typeName := elt.Key.(string)
 				theType := elt.Value.(*markers.TypeInfo)
 				buf.WriteString(`
-func (container ` + typeName + `) GetToplevelLists() TopLevelLists {
-	return TopLevelLists{`)
+func (container ` + typeName + `) GetToplevelLists() ` + helpers + `TopLevelLists {
+	return ` + helpers + `TopLevelLists{`)
 				for _, field := range theType.Fields {
 					if field.Markers.Get(toplevelListMarker.Name) != nil {
 						buf.WriteString(`
-		"` + field.Name + `": extractKeys(container.` + field.Name + `),`)
+		"` + field.Name + `": ` + extractKeys + `(container.` + field.Name + `),`)
 					}
 				}
 				buf.WriteString(`
@@ -108,12 +137,31 @@ func (container ` + typeName + `) GetToplevelLists() TopLevelLists {
 			}
 		})
 
-		genutils.WriteFormattedSourceFile("union_definitions", ctx, root, func(buf *bytes.Buffer) {
-			buf.WriteString(`
-import (
-	"reflect"
-)
+		genutils.WriteFormattedSourceFile("kinds", g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
+			for _, kind := range kinds {
+				buf.WriteString(`
+// ` + kind + `Kind is the kind of the ` + kind + ` resource
+const ` + kind + `Kind = "` + kind + `"
+
+// ` + kind + `GroupVersionKind is the GroupVersionKind of the ` + kind + ` resource in this API version
+var ` + kind + `GroupVersionKind = SchemeGroupVersion.WithKind(` + kind + `Kind)
 `)
+			}
+		})
+
+		genutils.WriteFormattedSourceFile("union_definitions", g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
+			unionImports := map[string]string{"reflect": "reflect"}
+			if helpers != "" {
+				unionImports[genutils.DevfileAPIPackage] = strings.TrimSuffix(helpers, ".")
+			}
+			for elt := unions.Front(); elt != nil; elt = elt.Next() {
+				for _, field := range elt.Value.(*markers.TypeInfo).Fields {
+					for importPath, name := range genutils.UsedImports(imports, field.RawField.Type) {
+						unionImports[importPath] = name
+					}
+				}
+			}
+			genutils.WriteImports(buf, unionImports)
 			for elt := unions.Front(); elt != nil; elt = elt.Next() {
 				typeName := elt.Key.(string)
 				theType := elt.Value.(*markers.TypeInfo)
@@ -133,7 +181,9 @@ import (
 
 				buf.WriteString(`
 var ` + visitorType + ` reflect.Type = reflect.TypeOf(` + visitorName + `{})
-
+`)
+				if helpers == "" {
+					buf.WriteString(`
 func (union ` + typeName + `) Visit(visitor ` + visitorName + `) error {
 	return visitUnion(union, visitor)
 }
@@ -146,8 +196,23 @@ func (union *` + typeName + `) Normalize() error {
 func (union *` + typeName + `) Simplify() {
 	simplifyUnion(union, ` + visitorType + `)
 }
-
+`)
+				} else {
+					buf.WriteString(`
+func (union ` + typeName + `) Visit(visitor ` + visitorName + `) error {
+	return ` + helpers + `VisitUnion(union, visitor)
+}
+func (union *` + typeName + `) Normalize() error {
+	return ` + helpers + `NormalizeUnion(union, (*string)(&union.` + discriminatorName + `), ` + visitorType + `)
+}
+func (union *` + typeName + `) Simplify() {
+	` + helpers + `SimplifyUnion(union, (*string)(&union.` + discriminatorName + `), ` + visitorType + `)
+}
+`)
+				}
+				buf.WriteString(`
 // +k8s:deepcopy-gen=false
+// +devfile:helper
 type ` + visitorName + ` struct {`)
 
 				for elt := fieldMap.Front(); elt != nil; elt = elt.Next() {
@@ -165,3 +230,18 @@ type ` + visitorName + ` struct {`)
 
 	return nil
 }
+
+// isKubeKind returns true if the given type is a K8S resource (or resource list), that is
+// a struct type that embeds the K8S `TypeMeta`.
+func isKubeKind(info *markers.TypeInfo) bool {
+	for _, field := range info.Fields {
+		if len(field.RawField.Names) > 0 {
+			continue
+		}
+		if selector, isSelector := field.RawField.Type.(*ast.SelectorExpr); isSelector &&
+			selector.Sel.Name == "TypeMeta" {
+			return true
+		}
+	}
+	return false
+}
@@ -16,8 +16,13 @@ func (Generator) Help() *markers.DefinitionHelp {
 		Category: "",
 		DetailedHelp: markers.DetailedHelp{
 			Summary: "generates GO source code required for the API ",
-			Details: "Generated source code mainly consists in interface implementations to manage unions and keyed top-level lists",
+			Details: "Generated source code mainly consists in interface implementations to manage unions and keyed top-level lists. \n It can also process the packages of downstream modules that embed devfile types: in this case, the generated code relies on the exported helpers of the devfile API package (such as `v1alpha2.NormalizeUnion`), and imports the packages referenced by the union member types.",
+		},
+		FieldHelp: map[string]markers.DetailedHelp{
+			"HeaderFile": {
+				Summary: "specifies the header text (e.g. license) to prepend to generated files.",
+				Details: "",
+			},
 		},
-		FieldHelp: map[string]markers.DetailedHelp{},
 	}
 }
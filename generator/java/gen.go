@@ -0,0 +1,270 @@
+package java
+
+import (
+	"fmt"
+	"go/ast"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/generator/genutils"
+	"github.com/devfile/api/generator/schemas"
+	"github.com/iancoleman/strcase"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+// defaultBasePackage is the Java package under which the classes of each K8S API version are generated by default
+const defaultBasePackage = "io.devfile.api"
+
+// javaIdentifier matches the valid Java identifiers
+var javaIdentifier = regexp.MustCompile(`^[_$A-Za-z][_$0-9A-Za-z]*$`)
+
+// javaKeywords are the reserved Java keywords and literals, that cannot be used as field names
+var javaKeywords = map[string]bool{
+	"abstract": true, "assert": true, "boolean": true, "break": true, "byte": true, "case": true, "catch": true,
+	"char": true, "class": true, "const": true, "continue": true, "default": true, "do": true, "double": true,
+	"else": true, "enum": true, "extends": true, "final": true, "finally": true, "float": true, "for": true,
+	"goto": true, "if": true, "implements": true, "import": true, "instanceof": true, "int": true,
+	"interface": true, "long": true, "native": true, "new": true, "package": true, "private": true,
+	"protected": true, "public": true, "return": true, "short": true, "static": true, "strictfp": true,
+	"super": true, "switch": true, "synchronized": true, "this": true, "throw": true, "throws": true,
+	"transient": true, "try": true, "void": true, "volatile": true, "true": true, "false": true, "null": true,
+}
+
+// +controllertools:marker:generateHelp
+
+// Generator generates Jackson-annotated Java classes from the GO source code of the Kubernetes API
+//
+// A Java class is generated for each GO structure reachable from the types that have the `devfile:jsonschema:generate` annotation,
+// with embedded structures inlined, and a Java enum is generated for each type that has the `kubebuilder:validation:Enum` annotation.
+// For each K8S union, the class also provides a method that returns the Json name of the union member that is set.
+// Classes are written with a source jar layout: the classes of each K8S API version are generated in the `<basePackage>.<package>` Java package.
+type Generator struct {
+	// BasePackage is the Java package under which the classes of each K8S API version are generated.
+	// It defaults to `io.devfile.api`.
+	BasePackage string `marker:"basePackage,optional"`
+}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	if err := schemas.RegisterGenerateMarker(into); err != nil {
+		return err
+	}
+	return genutils.RegisterTypeModelMarkers(into)
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// Generate generates the artifacts
+func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	basePackage := g.BasePackage
+	if basePackage == "" {
+		basePackage = defaultBasePackage
+	}
+
+	for _, root := range ctx.Roots {
+		model, err := genutils.BuildTypeModel(ctx, root, schemas.HasGenerateMarker)
+		if err != nil {
+			root.AddError(err)
+			continue
+		}
+
+		javaPackage := basePackage + "." + root.Name
+		packageFolder := strings.ReplaceAll(javaPackage, ".", "/")
+		for _, enum := range model.Enums {
+			content, err := renderEnum(javaPackage, enum)
+			if err != nil {
+				root.AddError(err)
+				continue
+			}
+			genutils.WriteGeneratedArtifact(ctx, root, path.Join(packageFolder, enum.Name+".java"), content)
+		}
+		for _, object := range model.Objects {
+			if !object.Referenced {
+				continue
+			}
+			content, err := renderClass(javaPackage, model, object)
+			if err != nil {
+				root.AddError(err)
+				continue
+			}
+			genutils.WriteGeneratedArtifact(ctx, root, path.Join(packageFolder, object.Name+".java"), content)
+		}
+	}
+	return nil
+}
+
+func writeHeader(source *strings.Builder, javaPackage string, imports ...string) {
+	source.WriteString(genutils.GeneratedFileBanner + "\n\n")
+	source.WriteString("package " + javaPackage + ";\n\n")
+	for _, imported := range imports {
+		source.WriteString("import " + imported + ";\n")
+	}
+}
+
+func writeJavadoc(source *strings.Builder, indent string, doc string) {
+	doc = strings.TrimSpace(doc)
+	if doc == "" {
+		return
+	}
+	// Unicode escapes are processed in Java comments, and `*/` would end the comment
+	doc = strings.ReplaceAll(doc, `\u`, `\\u`)
+	doc = strings.ReplaceAll(doc, "*/", "*&#47;")
+	source.WriteString(indent + "/**\n")
+	for _, line := range strings.Split(doc, "\n") {
+		source.WriteString(strings.TrimRight(indent+" * "+line, " ") + "\n")
+	}
+	source.WriteString(indent + " */\n")
+}
+
+func renderEnum(javaPackage string, enum *genutils.EnumType) (string, error) {
+	var source strings.Builder
+	writeHeader(&source, javaPackage, "com.fasterxml.jackson.annotation.JsonProperty")
+	source.WriteString("\n")
+	writeJavadoc(&source, "", enum.Doc)
+	source.WriteString("public enum " + enum.Name + " {\n")
+	for i, value := range enum.Values {
+		constantName := strcase.ToScreamingSnake(value)
+		if !javaIdentifier.MatchString(constantName) {
+			constantName = "_" + constantName
+		}
+		if !javaIdentifier.MatchString(constantName) {
+			return "", fmt.Errorf("value %q of enum %s cannot be converted to a valid Java enum constant name", value, enum.Name)
+		}
+		separator := ","
+		if i == len(enum.Values)-1 {
+			separator = ";"
+		}
+		source.WriteString("    @JsonProperty(" + strconv.Quote(value) + ")\n")
+		source.WriteString("    " + constantName + separator + "\n")
+	}
+	source.WriteString("}\n")
+	return source.String(), nil
+}
+
+// javaField is a field of a generated Java class
+type javaField struct {
+	*genutils.Field
+	name     string
+	javaType string
+}
+
+func renderClass(javaPackage string, model *genutils.TypeModel, object *genutils.ObjectType) (string, error) {
+	var fields []javaField
+	fieldsByJSONName := map[string]javaField{}
+	imports := map[string]bool{
+		"com.fasterxml.jackson.annotation.JsonIgnoreProperties": true,
+		"com.fasterxml.jackson.annotation.JsonInclude":          true,
+		"com.fasterxml.jackson.annotation.JsonProperty":         true,
+	}
+	for _, field := range model.InlinedFields(object) {
+		name := field.JSONName
+		if javaKeywords[name] {
+			name += "_"
+		}
+		if !javaIdentifier.MatchString(name) {
+			return "", fmt.Errorf("field %s of type %s has Json name %q, which is not a valid Java field name", field.GoName, object.Name, field.JSONName)
+		}
+		javaField := javaField{
+			Field:    field,
+			name:     name,
+			javaType: typeName(field.Type, imports),
+		}
+		fields = append(fields, javaField)
+		fieldsByJSONName[field.JSONName] = javaField
+	}
+
+	unions := model.InlinedUnions(object)
+	if len(unions) > 0 {
+		imports["com.fasterxml.jackson.annotation.JsonIgnore"] = true
+	}
+
+	var source strings.Builder
+	var sortedImports []string
+	for imported := range imports {
+		sortedImports = append(sortedImports, imported)
+	}
+	sort.Strings(sortedImports)
+	writeHeader(&source, javaPackage, sortedImports...)
+	source.WriteString("\n")
+	writeJavadoc(&source, "", object.Doc)
+	source.WriteString("@JsonInclude(JsonInclude.Include.NON_NULL)\n")
+	source.WriteString("@JsonIgnoreProperties(ignoreUnknown = true)\n")
+	source.WriteString("public class " + object.Name + " {\n")
+
+	for _, field := range fields {
+		source.WriteString("\n")
+		writeJavadoc(&source, "    ", field.Doc)
+		if field.Optional {
+			source.WriteString("    @JsonProperty(" + strconv.Quote(field.JSONName) + ")\n")
+		} else {
+			source.WriteString("    @JsonProperty(value = " + strconv.Quote(field.JSONName) + ", required = true)\n")
+		}
+		source.WriteString("    private " + field.javaType + " " + field.name + ";\n")
+	}
+
+	for _, field := range fields {
+		accessorSuffix := strcase.ToCamel(field.JSONName)
+		source.WriteString("\n")
+		source.WriteString("    public " + field.javaType + " get" + accessorSuffix + "() {\n")
+		source.WriteString("        return " + field.name + ";\n")
+		source.WriteString("    }\n\n")
+		source.WriteString("    public void set" + accessorSuffix + "(" + field.javaType + " " + field.name + ") {\n")
+		source.WriteString("        this." + field.name + " = " + field.name + ";\n")
+		source.WriteString("    }\n")
+	}
+
+	for _, union := range unions {
+		source.WriteString("\n")
+		writeJavadoc(&source, "    ", fmt.Sprintf("Returns the Json name of the member of the %s union that is set, or null if none is set.", union.Name))
+		source.WriteString("    @JsonIgnore\n")
+		source.WriteString("    public String get" + union.Name + "Member() {\n")
+		for _, member := range union.Union.Members {
+			source.WriteString("        if (" + fieldsByJSONName[member.JSONName].name + " != null) {\n")
+			source.WriteString("            return " + strconv.Quote(member.JSONName) + ";\n")
+			source.WriteString("        }\n")
+		}
+		source.WriteString("        return null;\n")
+		source.WriteString("    }\n")
+	}
+
+	source.WriteString("}\n")
+	return source.String(), nil
+}
+
+// typeName returns the Java type of the given model type, and records the classes to import
+func typeName(typeRef *genutils.TypeRef, imports map[string]bool) string {
+	switch typeRef.Kind {
+	case genutils.StringKind:
+		return "String"
+	case genutils.IntKind:
+		return "Integer"
+	case genutils.FloatKind:
+		return "Double"
+	case genutils.BoolKind:
+		return "Boolean"
+	case genutils.ObjectKind, genutils.EnumKind:
+		return typeRef.Name
+	case genutils.ListKind:
+		imports["java.util.List"] = true
+		return "List<" + typeName(typeRef.Elem, imports) + ">"
+	case genutils.MapKind:
+		imports["java.util.Map"] = true
+		return "Map<String, " + typeName(typeRef.Elem, imports) + ">"
+	default:
+		imports["com.fasterxml.jackson.databind.JsonNode"] = true
+		return "JsonNode"
+	}
+}
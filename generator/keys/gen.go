@@ -0,0 +1,168 @@
+package keys
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/devfile/api/generator/genutils"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+	"sigs.k8s.io/yaml"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+// defaultRegistryFile is the name of the registry file read in the package folder when no registry file is specified
+const defaultRegistryFile = "keys.yaml"
+
+// +controllertools:marker:generateHelp
+
+// Generator generates Go constants for the well-known attribute keys, annotation names and label names
+// listed in a registry file, so that consumers don't have to repeat raw strings.
+//
+// The registry file is a yaml file with `attributes`, `annotations` and `labels` lists,
+// whose entries have a `name`, a `key` and a `description`.
+// A constant is generated for each entry, named after the entry name followed by the `Attribute`, `Annotation` or `Label` suffix,
+// and documented by the entry description.
+// Annotation and label keys must be valid Kubernetes qualified names.
+type Generator struct {
+	// RegistryFile is the path of the registry file.
+	// It defaults to the `keys.yaml` file in the folder of the package.
+	RegistryFile string `marker:",optional"`
+
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+}
+
+// registry is the content of the registry file
+type registry struct {
+	Attributes  []registryEntry `json:"attributes,omitempty"`
+	Annotations []registryEntry `json:"annotations,omitempty"`
+	Labels      []registryEntry `json:"labels,omitempty"`
+}
+
+// registryEntry is a well-known key of the registry file
+type registryEntry struct {
+	// Name is the name of the generated constant, without its suffix
+	Name string `json:"name"`
+	// Key is the value of the generated constant
+	Key string `json:"key"`
+	// Description is the documentation of the generated constant
+	Description string `json:"description,omitempty"`
+}
+
+// keyKind describes a list of the registry file
+type keyKind struct {
+	field       string
+	suffix      string
+	entries     []registryEntry
+	validateKey func(string) []string
+}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	return nil
+}
+
+// Generate generates the artifacts
+func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	for _, root := range ctx.Roots {
+		registryFile := g.RegistryFile
+		if registryFile == "" {
+			if len(root.GoFiles) == 0 {
+				root.AddError(fmt.Errorf("cannot locate the %s registry file of package %s, since it has no Go file", defaultRegistryFile, root.PkgPath))
+				continue
+			}
+			registryFile = filepath.Join(filepath.Dir(root.GoFiles[0]), defaultRegistryFile)
+		}
+
+		content, err := ctx.ReadFile(registryFile)
+		if err != nil {
+			root.AddError(err)
+			continue
+		}
+		keys := registry{}
+		if err := yaml.UnmarshalStrict(content, &keys); err != nil {
+			root.AddError(fmt.Errorf("invalid registry file %s: %w", registryFile, err))
+			continue
+		}
+
+		kinds := []keyKind{
+			{field: "attributes", suffix: "Attribute", entries: keys.Attributes},
+			{field: "annotations", suffix: "Annotation", entries: keys.Annotations, validateKey: validation.IsQualifiedName},
+			{field: "labels", suffix: "Label", entries: keys.Labels, validateKey: validation.IsQualifiedName},
+		}
+		if !validateRegistry(root, registryFile, kinds) {
+			continue
+		}
+
+		genutils.WriteFormattedSourceFile("keys", g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
+			for _, kind := range kinds {
+				if len(kind.entries) == 0 {
+					continue
+				}
+				buf.WriteString(fmt.Sprintf("\n// Well-known %s\nconst (\n", kind.field))
+				for i, entry := range kind.entries {
+					if i > 0 {
+						buf.WriteString("\n")
+					}
+					constName := entry.Name + kind.suffix
+					if entry.Description != "" {
+						for _, line := range strings.Split(strings.TrimSpace(constName+" "+entry.Description), "\n") {
+							buf.WriteString("// " + line + "\n")
+						}
+					}
+					buf.WriteString(fmt.Sprintf("%s = %s\n", constName, strconv.Quote(entry.Key)))
+				}
+				buf.WriteString(")\n")
+			}
+		})
+	}
+	return nil
+}
+
+// validateRegistry reports an error on the root package for each invalid entry of the registry file,
+// and returns true if all the entries are valid
+func validateRegistry(root *loader.Package, registryFile string, kinds []keyKind) bool {
+	valid := true
+	addError := func(kind keyKind, entry registryEntry, reason string) {
+		root.AddError(fmt.Errorf("invalid entry %q in the %s of registry file %s: %s", entry.Name, kind.field, registryFile, reason))
+		valid = false
+	}
+
+	constNames := map[string]bool{}
+	for _, kind := range kinds {
+		keys := map[string]bool{}
+		for _, entry := range kind.entries {
+			constName := entry.Name + kind.suffix
+			if entry.Name == "" || !token.IsIdentifier(constName) || !unicode.IsUpper([]rune(constName)[0]) {
+				addError(kind, entry, "the name should be an exported Go identifier")
+			} else if constNames[constName] {
+				addError(kind, entry, fmt.Sprintf("the %s constant is already defined", constName))
+			}
+			constNames[constName] = true
+
+			if entry.Key == "" {
+				addError(kind, entry, "the key should not be empty")
+				continue
+			}
+			if keys[entry.Key] {
+				addError(kind, entry, fmt.Sprintf("the %q key is already defined", entry.Key))
+			}
+			keys[entry.Key] = true
+			if kind.validateKey != nil {
+				if errs := kind.validateKey(entry.Key); len(errs) > 0 {
+					addError(kind, entry, fmt.Sprintf("the %q key is invalid: %s", entry.Key, strings.Join(errs, "; ")))
+				}
+			}
+		}
+	}
+	return valid
+}
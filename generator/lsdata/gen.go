@@ -0,0 +1,246 @@
+package lsdata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/generator/genutils"
+	"github.com/devfile/api/generator/schemas"
+	crdmarkers "sigs.k8s.io/controller-tools/pkg/crd/markers"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+// +controllertools:marker:generateHelp
+
+// Generator generates the data of the `lsdata` GO package, used by devfile language servers:
+// the object types reachable from the types that have the `devfile:jsonschema:generate` annotation,
+// with the description, type, default value and `devfile:since` version of their fields, their unions,
+// and the values of the enum types.
+//
+// The fields are listed as they appear in the Json serialization: the fields of the embedded types are inlined,
+// and the union discriminators, which are implied by the union member that is set, are omitted.
+//
+// The source file is written in the output folder, which should be the folder of the `lsdata` package.
+type Generator struct {
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+
+	// Package is the name of the GO package of the generated source file. It defaults to `lsdata`.
+	Package string `marker:"package,optional"`
+}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	if err := schemas.RegisterGenerateMarker(into); err != nil {
+		return err
+	}
+	if err := genutils.RegisterSinceMarker(into); err != nil {
+		return err
+	}
+	return genutils.RegisterTypeModelMarkers(into)
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// Generate generates the artifacts
+func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	var model *genutils.TypeModel
+	for _, root := range ctx.Roots {
+		rootModel, err := genutils.BuildTypeModel(ctx, root, schemas.HasGenerateMarker)
+		if err != nil {
+			root.AddError(err)
+			return nil
+		}
+		if len(rootModel.Objects) == 0 {
+			continue
+		}
+		if model != nil {
+			return fmt.Errorf("the lsdata generator expects a single K8S API package with types annotated with the +devfile:jsonschema:generate comment marker")
+		}
+		model = rootModel
+	}
+	if model == nil {
+		return fmt.Errorf("the lsdata generator requires a K8S API package with types annotated with the +devfile:jsonschema:generate comment marker")
+	}
+
+	buf := new(bytes.Buffer)
+	if g.HeaderFile != "" {
+		header, err := ctx.ReadFile(g.HeaderFile)
+		if err != nil {
+			return err
+		}
+		buf.Write(bytes.TrimSpace(header))
+		buf.WriteString("\n\n")
+	}
+	packageName := g.Package
+	if packageName == "" {
+		packageName = "lsdata"
+	}
+	buf.WriteString(genutils.GeneratedFileBanner + `
+
+package ` + packageName + `
+
+// objects are the object types, by name
+var objects = map[string]*Object{`)
+
+	var objects []*genutils.ObjectType
+	for _, object := range model.Objects {
+		if object.Referenced {
+			objects = append(objects, object)
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Name < objects[j].Name
+	})
+	for _, object := range objects {
+		if err := writeObject(buf, model, object); err != nil {
+			return err
+		}
+	}
+	buf.WriteString(`
+}
+
+// enums are the values of the enum types, by name
+var enums = map[string][]string{`)
+	enums := append([]*genutils.EnumType{}, model.Enums...)
+	sort.Slice(enums, func(i, j int) bool {
+		return enums[i].Name < enums[j].Name
+	})
+	for _, enum := range enums {
+		buf.WriteString("\n" + strconv.Quote(enum.Name) + ": {")
+		for _, value := range enum.Values {
+			buf.WriteString(strconv.Quote(value) + ", ")
+		}
+		buf.WriteString("},")
+	}
+	buf.WriteString(`
+}
+`)
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	writer, err := ctx.Open(nil, "zz_generated.lsdata.go")
+	if err != nil {
+		return err
+	}
+	defer writer.Close()
+	_, err = writer.Write(source)
+	return err
+}
+
+// writeObject writes the literal of the given object type
+func writeObject(buf *bytes.Buffer, model *genutils.TypeModel, object *genutils.ObjectType) error {
+	discriminators := map[*genutils.Field]bool{}
+	var unions [][]string
+	for _, union := range model.InlinedUnions(object) {
+		if union.Union.Discriminator != nil {
+			discriminators[union.Union.Discriminator] = true
+		}
+		var members []string
+		for _, member := range union.Union.Members {
+			members = append(members, member.JSONName)
+		}
+		unions = append(unions, members)
+	}
+
+	buf.WriteString(fmt.Sprintf(`
+%s: {
+Name: %s,
+Description: %s,
+Fields: []Field{`, strconv.Quote(object.Name), strconv.Quote(object.Name), strconv.Quote(description(object.Doc))))
+	for _, field := range model.InlinedFields(object) {
+		if discriminators[field] {
+			continue
+		}
+		buf.WriteString(fmt.Sprintf(`
+{Name: %s, Description: %s, Type: %s`, strconv.Quote(field.JSONName), strconv.Quote(description(field.Doc)), typeLiteral(field.Type)))
+		if !field.Optional {
+			buf.WriteString(", Required: true")
+		}
+		if defaultMarker, hasDefault := field.Markers.Get("kubebuilder:default").(crdmarkers.Default); hasDefault {
+			defaultValue, err := defaultString(defaultMarker.Value)
+			if err != nil {
+				return fmt.Errorf("invalid default value of field %s of type %s: %w", field.GoName, object.Name, err)
+			}
+			buf.WriteString(", Default: " + strconv.Quote(defaultValue))
+		}
+		if since, hasSince := field.Markers.Get(genutils.SinceMarker.Name).(string); hasSince {
+			buf.WriteString(", Since: " + strconv.Quote(since))
+		}
+		buf.WriteString("},")
+	}
+	buf.WriteString(`
+},`)
+	if len(unions) > 0 {
+		buf.WriteString(`
+Unions: [][]string{`)
+		for _, members := range unions {
+			buf.WriteString("\n{")
+			for _, member := range members {
+				buf.WriteString(strconv.Quote(member) + ", ")
+			}
+			buf.WriteString("},")
+		}
+		buf.WriteString(`
+},`)
+	}
+	buf.WriteString(`
+},`)
+	return nil
+}
+
+// typeLiteral returns the literal of the `Type` that corresponds to the given type of the model
+func typeLiteral(typeRef *genutils.TypeRef) string {
+	switch typeRef.Kind {
+	case genutils.StringKind:
+		return "Type{Kind: StringKind}"
+	case genutils.IntKind:
+		return "Type{Kind: IntegerKind}"
+	case genutils.FloatKind:
+		return "Type{Kind: NumberKind}"
+	case genutils.BoolKind:
+		return "Type{Kind: BooleanKind}"
+	case genutils.ObjectKind:
+		return fmt.Sprintf("Type{Kind: ObjectKind, Name: %s}", strconv.Quote(typeRef.Name))
+	case genutils.EnumKind:
+		return fmt.Sprintf("Type{Kind: EnumKind, Name: %s}", strconv.Quote(typeRef.Name))
+	case genutils.ListKind:
+		return fmt.Sprintf("Type{Kind: ListKind, Elem: &%s}", typeLiteral(typeRef.Elem))
+	case genutils.MapKind:
+		return fmt.Sprintf("Type{Kind: MapKind, Elem: &%s}", typeLiteral(typeRef.Elem))
+	default:
+		return "Type{Kind: AnyKind}"
+	}
+}
+
+// description returns the description of a type or a field from its documentation
+func description(doc string) string {
+	return strings.TrimSpace(doc)
+}
+
+// defaultString returns the given default value as written in a devfile: as is for strings, or in Json otherwise
+func defaultString(value interface{}) (string, error) {
+	if str, isString := value.(string); isString {
+		return str, nil
+	}
+	content, err := json.Marshal(value)
+	return string(content), err
+}
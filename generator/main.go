@@ -6,15 +6,36 @@ import (
 	"github.com/devfile/api/generator/getters"
 	"io"
 	"os"
+	"runtime/debug"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/devfile/api/generator/audit"
+	"github.com/devfile/api/generator/conversions"
 	"github.com/devfile/api/generator/crds"
+	"github.com/devfile/api/generator/deepcopy"
+	"github.com/devfile/api/generator/enums"
+	"github.com/devfile/api/generator/fixtures"
+	"github.com/devfile/api/generator/genutils"
+	"github.com/devfile/api/generator/graphql"
 	"github.com/devfile/api/generator/interfaces"
+	"github.com/devfile/api/generator/java"
+	"github.com/devfile/api/generator/keys"
+	"github.com/devfile/api/generator/lsdata"
+	"github.com/devfile/api/generator/mergers"
 	"github.com/devfile/api/generator/overrides"
+	"github.com/devfile/api/generator/pointers"
+	"github.com/devfile/api/generator/python"
+	"github.com/devfile/api/generator/rust"
 	"github.com/devfile/api/generator/schemas"
+	"github.com/devfile/api/generator/since"
+	"github.com/devfile/api/generator/stringers"
+	"github.com/devfile/api/generator/uihints"
 	"github.com/devfile/api/generator/validate"
+	"github.com/devfile/api/generator/versions"
+	"github.com/devfile/api/generator/views"
 	"github.com/spf13/cobra"
-	"sigs.k8s.io/controller-tools/pkg/deepcopy"
 	"sigs.k8s.io/controller-tools/pkg/genall"
 	"sigs.k8s.io/controller-tools/pkg/genall/help"
 	prettyhelp "sigs.k8s.io/controller-tools/pkg/genall/help/pretty"
@@ -29,13 +50,30 @@ var (
 	// each turns into a command line option,
 	// and has options for output forms.
 	allGenerators = map[string]genall.Generator{
-		"overrides":  overrides.Generator{},
-		"interfaces": interfaces.Generator{},
-		"crds":       crds.Generator{},
-		"deepcopy":   deepcopy.Generator{},
-		"schemas":    schemas.Generator{},
-		"validate":   validate.Generator{},
-		"getters":    getters.Generator{},
+		"overrides":   overrides.Generator{},
+		"interfaces":  interfaces.Generator{},
+		"crds":        crds.Generator{},
+		"deepcopy":    deepcopy.Generator{},
+		"schemas":     schemas.Generator{},
+		"validate":    validate.Generator{},
+		"getters":     getters.Generator{},
+		"graphql":     graphql.Generator{},
+		"python":      python.Generator{},
+		"java":        java.Generator{},
+		"rust":        rust.Generator{},
+		"keys":        keys.Generator{},
+		"stringers":   stringers.Generator{},
+		"uihints":     uihints.Generator{},
+		"enums":       enums.Generator{},
+		"fixtures":    fixtures.Generator{},
+		"since":       since.Generator{},
+		"audit":       audit.Generator{},
+		"versions":    versions.Generator{},
+		"lsdata":      lsdata.Generator{},
+		"pointers":    pointers.Generator{},
+		"views":       views.Generator{},
+		"mergers":     mergers.Generator{},
+		"conversions": conversions.Generator{},
 	}
 
 	// allOutputRules defines the list of all known output rules, giving
@@ -47,57 +85,77 @@ var (
 		"dir":       genall.OutputToDirectory(""),
 		"none":      genall.OutputToNothing,
 		"stdout":    genall.OutputToStdout,
-		"artifacts": genall.OutputArtifacts{},
+		"artifacts": genutils.OutputArtifacts{},
 	}
 
-	// optionsRegistry contains all the marker definitions used to process command line options
-	optionsRegistry = &markers.Registry{}
+	// optionsRegistry contains all the marker definitions used to process command line options.
+	// It is built on first use by loadOptionsRegistry, so that the commands that don't process generator options,
+	// such as --version, don't pay for it.
+	optionsRegistry     *markers.Registry
+	optionsRegistryErr  error
+	optionsRegistryOnce sync.Once
 )
 
-func init() {
+// loadOptionsRegistry returns the registry of the marker definitions used to process command line options,
+// building it on first use
+func loadOptionsRegistry() (*markers.Registry, error) {
+	optionsRegistryOnce.Do(func() {
+		optionsRegistry, optionsRegistryErr = newOptionsRegistry()
+		if optionsRegistryErr != nil {
+			optionsRegistryErr = fmt.Errorf("cannot register the generator options, run `generator selftest` for details: %w", optionsRegistryErr)
+		}
+	})
+	return optionsRegistry, optionsRegistryErr
+}
+
+// newOptionsRegistry builds the registry of the marker definitions used to process command line options:
+// the options markers of the generators, and the per-generator and default output rule markers
+func newOptionsRegistry() (*markers.Registry, error) {
+	registry := &markers.Registry{}
 	for genName, gen := range allGenerators {
 		// make the generator options marker itself
-		defn := markers.Must(markers.MakeDefinition(genName, markers.DescribesPackage, gen))
-		if err := optionsRegistry.Register(defn); err != nil {
-			panic(err)
-		}
-		if helpGiver, hasHelp := gen.(genall.HasHelp); hasHelp {
-			if help := helpGiver.Help(); help != nil {
-				optionsRegistry.AddHelp(defn, help)
-			}
+		if err := registerOptionsMarker(registry, genName, gen); err != nil {
+			return nil, fmt.Errorf("generator %s: %w", genName, err)
 		}
 
 		// make per-generation output rule markers
 		for ruleName, rule := range allOutputRules {
-			ruleMarker := markers.Must(markers.MakeDefinition(fmt.Sprintf("output:%s:%s", genName, ruleName), markers.DescribesPackage, rule))
-			if err := optionsRegistry.Register(ruleMarker); err != nil {
-				panic(err)
-			}
-			if helpGiver, hasHelp := rule.(genall.HasHelp); hasHelp {
-				if help := helpGiver.Help(); help != nil {
-					optionsRegistry.AddHelp(ruleMarker, help)
-				}
+			if err := registerOptionsMarker(registry, fmt.Sprintf("output:%s:%s", genName, ruleName), rule); err != nil {
+				return nil, fmt.Errorf("output rule %s of generator %s: %w", ruleName, genName, err)
 			}
 		}
 	}
 
 	// make "default output" output rule markers
 	for ruleName, rule := range allOutputRules {
-		ruleMarker := markers.Must(markers.MakeDefinition("output:"+ruleName, markers.DescribesPackage, rule))
-		if err := optionsRegistry.Register(ruleMarker); err != nil {
-			panic(err)
-		}
-		if helpGiver, hasHelp := rule.(genall.HasHelp); hasHelp {
-			if help := helpGiver.Help(); help != nil {
-				optionsRegistry.AddHelp(ruleMarker, help)
-			}
+		if err := registerOptionsMarker(registry, "output:"+ruleName, rule); err != nil {
+			return nil, fmt.Errorf("output rule %s: %w", ruleName, err)
 		}
 	}
 
 	// add in the common options markers
-	if err := genall.RegisterOptionsMarkers(optionsRegistry); err != nil {
-		panic(err)
+	if err := genall.RegisterOptionsMarkers(registry); err != nil {
+		return nil, err
+	}
+	return registry, nil
+}
+
+// registerOptionsMarker registers the package marker with the given name, whose arguments are the fields of the given options,
+// along with the help of the options if any
+func registerOptionsMarker(registry *markers.Registry, name string, options interface{}) error {
+	defn, err := markers.MakeDefinition(name, markers.DescribesPackage, options)
+	if err != nil {
+		return err
+	}
+	if err := registry.Register(defn); err != nil {
+		return err
+	}
+	if helpGiver, hasHelp := options.(genall.HasHelp); hasHelp {
+		if help := helpGiver.Help(); help != nil {
+			registry.AddHelp(defn, help)
+		}
 	}
+	return nil
 }
 
 // noUsageError suppresses usage printing when it occurs
@@ -105,16 +163,50 @@ func init() {
 // out usage in only certain situations).
 type noUsageError struct{ error }
 
+func (e noUsageError) Unwrap() error {
+	return e.error
+}
+
+// generatorOptionArgs checks that the positional arguments of the root command are generator options,
+// since cobra only accepts the arguments of a root command with sub-commands when they name a sub-command.
+// Arguments that are neither generator names nor `key=value` or `prefix:...` options are reported
+// as unknown commands, so that a misspelled sub-command isn't taken for a generator.
+func generatorOptionArgs(c *cobra.Command, args []string) error {
+	for _, arg := range args {
+		if _, isGenerator := allGenerators[arg]; isGenerator || strings.ContainsAny(arg, ":=") {
+			continue
+		}
+		suggestions := ""
+		if commands := c.SuggestionsFor(arg); len(commands) > 0 {
+			suggestions = "\n\nDid you mean this?\n\t" + strings.Join(commands, "\n\t")
+		}
+		return fmt.Errorf("unknown command or generator %q for %q%s", arg, c.CommandPath(), suggestions)
+	}
+	return nil
+}
+
 func main() {
+	audit.KnownGenerators = allGenerators
+
 	helpLevel := 0
 	whichLevel := 0
 	showVersion := false
+	summaryFormat := ""
+	failFast := false
+	check := false
+	helpFormat := ""
+	headerFile := ""
+	cpuProfile := ""
+	memProfile := ""
 
 	cmd := &cobra.Command{
 		Use:   "generator",
 		Short: "Generates various types of files from the `workspaces` K8S API source code.",
 		Long:  "Generates additional GO source files (for devfile overriding, union support, deep-copy), K8S CRD YAML files and Json Schemas from the from the `workspaces` K8S API source code.",
 		Example: `
+# Audit the markers and serialization tags of the workspaces K8S APIs, and report the markers consumed by no generator and the inconsistent json and yaml tags
+generator audit "paths=./pkg/apis/workspaces/v1alpha2;./pkg/apis/workspaces/v1alpha1;./pkg/devfile"
+
 # Generate Plugin Overrides based on the workspaces/v1alpha2 K8S API
 generator overrides:isForPluginOverrides=true paths=./pkg/apis/workspaces/v1alpha2
 
@@ -124,17 +216,102 @@ generator overrides:isForPluginOverrides=false paths=./pkg/apis/workspaces/v1alp
 # Generate Interface Implementations based on the workspaces/v1alpha2 K8S API
 generator interfaces paths=./pkg/apis/workspaces/v1alpha2
 
+# Validate the workspaces/v1alpha2 K8S API (unions, and coverage of the exported types by the generators),
+# and generate the union members table used by the runtime union checks
+generator validate:runtimeChecks=true paths=./pkg/apis/workspaces/v1alpha2
+
 # Generate Boolean Getter implementations based on the workspaces/v1alpha2 K8S API
 generator getters paths=./pkg/apis/workspaces/v1alpha2
 
+# Generate the human-readable String() and Summary() methods of the workspaces/v1alpha2 K8S API types, to be used in logs
+generator stringers paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate the GetByPointer() and SetByPointer() methods of the workspaces/v1alpha2 K8S API types, that access the value designated by a JSON pointer
+generator pointers paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate the read-only views of the workspaces/v1alpha2 K8S API types, that controllers can read without defensive DeepCopies
+generator views paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate the application of the parent and plugin overrides to the workspaces/v1alpha2 K8S API types, without going through a strategic merge patch
+generator mergers paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate the conversion functions between the workspaces/v1alpha1 and workspaces/v1alpha2 K8S APIs in the v1alpha1 package,
+# flagging the fields that require a hand-written conversion
+generator conversions "paths=./pkg/apis/workspaces/v1alpha1;./pkg/apis/workspaces/v1alpha2"
+
+# Generate the table of the fields annotated with the devfile:since marker, used by the devfile validation to reject the fields not supported by the schema version
+generator since "paths=./pkg/apis/workspaces/v1alpha2;./pkg/devfile"
+
+# Generate the supported devfile schema versions, and the features introduced in each of them, in the versions package
+generator versions output:versions:dir=./pkg/apis/workspaces/versions "paths=./pkg/apis/workspaces/v1alpha2;./pkg/devfile"
+
 # Generate K8S CRDs based on the workspaces/v1alpha2 K8S API
 generator crds output:crds:artifacts:config=crds paths=./pkg/apis/workspaces/v1alpha2
 
+# Generate K8S CRDs based on the workspaces/v1alpha2 K8S API, with a server-side apply audit report for each CRD
+generator crds:ssaAudit=true output:crds:artifacts:config=crds paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate K8S CRDs based on the workspaces/v1alpha2 K8S API, with the 'kubectl explain' documentation tree of each CRD
+generator crds:explain=true output:crds:artifacts:config=crds paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate K8S CRDs based on the workspaces/v1alpha2 K8S API, with additional labels and annotations in the CRD metadata
+generator 'crds:labels="app.kubernetes.io/part-of=devfile",annotations="api-approved.kubernetes.io=https://github.com/devfile/api"' output:crds:artifacts:config=crds paths=./pkg/apis/workspaces/v1alpha2
+
 # Generate DeepCopy implementations based on the workspaces/v1alpha2 K8S API
+# (fields annotated with the devfile:deepcopy:shallow marker are shallow-copied)
 generator deepcopy paths=./pkg/apis/workspaces/v1alpha2
 
 # Generate JsonSchemas based on the workspaces/v1alpha2 K8S API
 generator schemas output:schemas:artifacts:config=schemas paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate K8S CRDs based on the workspaces/v1alpha1 and workspaces/v1alpha2 K8S APIs, each one in its own folder
+generator crds "output:crds:artifacts:config=./pkg/apis/workspaces/v1alpha1=crds/v1alpha1;./pkg/apis/workspaces/v1alpha2=crds/v1alpha2" "paths=./pkg/apis/workspaces/v1alpha1;./pkg/apis/workspaces/v1alpha2"
+
+# Generate the JsonSchemas of the 2.1.0 devfile version based on the workspaces/v1alpha2 K8S API, without the fields introduced after 2.1.0
+generator schemas:version=2.1.0 output:schemas:artifacts:config=schemas paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate JsonSchemas based on the workspaces/v1alpha2 K8S API, rendering the schemas of the generated types with 4 worker goroutines
+generator schemas:parallelism=4 output:schemas:artifacts:config=schemas paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate JsonSchemas based on the workspaces/v1alpha2 K8S API, with the documentation of the allowed values of enums
+generator schemas:enumDescriptions=true output:schemas:artifacts:config=schemas paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate JsonSchemas based on the workspaces/v1alpha2 K8S API, applying a RFC 6902 Json patch to each emitted schema
+generator schemas:transform=schemas.jsonpatch output:schemas:artifacts:config=schemas paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate JsonSchemas based on the workspaces/v1alpha2 K8S API, with the relative $ref targets rewritten to the URL of the published schemas
+generator 'schemas:refBase="https://devfile.io/schemas/2.2.0/"' output:schemas:artifacts:config=schemas paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate JsonSchemas based on the workspaces/v1alpha2 K8S API, and embed them in the 'schemas' GO package of the output folder
+generator --header-file generator/header.go.txt schemas:embedPackage=schemas output:schemas:artifacts:config=schemas paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate the GraphQL schema based on the workspaces/v1alpha2 K8S API
+generator graphql output:graphql:artifacts:config=graphql paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate the Python models based on the workspaces/v1alpha2 K8S API
+generator python output:python:artifacts:config=python paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate the Java classes based on the workspaces/v1alpha2 K8S API, in the io.devfile.api.v1alpha2 Java package
+generator java:basePackage=io.devfile.api output:java:artifacts:config=java paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate the Rust structs based on the workspaces/v1alpha2 K8S API
+generator rust output:rust:artifacts:config=rust paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate 10 randomized, but schema-valid, fixtures of each root type of the workspaces/v1alpha2 K8S API,
+# as yaml files and as the GO constructors of a testfixtures package
+generator fixtures:count=10,seed=42,package=testfixtures output:fixtures:artifacts:config=test/fixtures paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate the constants of the well-known attribute keys, annotation names and label names listed in the pkg/devfile/keys/keys.yaml registry file
+generator keys paths=./pkg/devfile/keys
+
+# Generate the Markdown documentation of the devfile-specific markers
+generator overrides interfaces getters stringers schemas since -w --format markdown
+
+# Generate DeepCopy implementations and JsonSchemas, and print out the timing summary of each generator
+generator --summary text deepcopy schemas output:schemas:artifacts:config=schemas paths=./pkg/apis/workspaces/v1alpha2
+
+# Generate JsonSchemas, and write the CPU and memory profiles of the run, to be analyzed with 'go tool pprof'
+generator --cpuprofile cpu.pprof --memprofile mem.pprof schemas output:schemas:artifacts:config=schemas paths=./pkg/apis/workspaces/v1alpha2
 `,
 		RunE: func(c *cobra.Command, rawOpts []string) error {
 			// print version if asked for it
@@ -150,29 +327,76 @@ generator schemas output:schemas:artifacts:config=schemas paths=./pkg/apis/works
 
 			// print the marker docs if we asked for them, then bail
 			if whichLevel > 0 {
-				return printMarkerDocs(c, rawOpts, whichLevel)
+				return printMarkerDocs(c, rawOpts, whichLevel, helpFormat)
+			}
+			if helpFormat != "" {
+				return fmt.Errorf("the --format flag can only be used along with the -w flag")
 			}
 
 			// otherwise, set up the runtime for actually running the generators
-			rt, err := genall.FromOptions(optionsRegistry, rawOpts)
+			registry, err := loadOptionsRegistry()
+			if err != nil {
+				return noUsageError{exitError{err, exitGenerationErrors}}
+			}
+			rt, err := genall.FromOptions(registry, rawOpts)
 			if err != nil {
 				return err
 			}
 			if len(rt.Generators) == 0 {
 				return fmt.Errorf("no generators specified")
 			}
+			if headerFile != "" {
+				applyHeaderFile(rt, headerFile)
+			}
+
+			if summaryFormat != "" && summaryFormat != textSummary && summaryFormat != jsonSummary {
+				return fmt.Errorf("unknown summary format %q, should be one of: %s, %s", summaryFormat, textSummary, jsonSummary)
+			}
 
-			if hadErrs := rt.Run(); hadErrs {
-				// don't obscure the actual error with a bunch of usage
-				return noUsageError{fmt.Errorf("not all generators ran successfully")}
+			stopProfiling, err := startProfiling(cpuProfile, memProfile)
+			if err != nil {
+				return err
+			}
+			result := runGenerators(rt, runOptions{
+				withSummary: summaryFormat != "",
+				failFast:    failFast,
+				check:       check,
+			})
+			if err := stopProfiling(); err != nil {
+				return noUsageError{exitError{err, exitGenerationErrors}}
+			}
+			if summaryFormat != "" {
+				if err := newRunSummary(rt.Roots, result.summaries).print(c.OutOrStderr(), summaryFormat); err != nil {
+					return exitError{err, exitGenerationErrors}
+				}
+			}
+			// don't obscure the actual error with a bunch of usage
+			switch {
+			case result.hadErrors && len(result.succeeded) > 0:
+				return noUsageError{exitError{fmt.Errorf("only some generators ran successfully: %s", strings.Join(result.succeeded, ", ")), exitPartialSuccess}}
+			case result.hadErrors:
+				return noUsageError{exitError{fmt.Errorf("not all generators ran successfully"), exitGenerationErrors}}
+			case len(result.drifted) > 0:
+				return noUsageError{exitError{fmt.Errorf("some files are not up to date with the generated artifacts"), exitCheckDrift}}
 			}
 			return nil
 		},
 		SilenceUsage: true, // silence the usage, then print it out ourselves if it wasn't suppressed
+		Args:         generatorOptionArgs,
 	}
+	cmd.AddCommand(newConfigCRDCommand())
+	cmd.AddCommand(newValidateAgainstSchemaCommand())
+	cmd.AddCommand(newSelftestCommand())
 	cmd.Flags().CountVarP(&whichLevel, "which-markers", "w", "print out all markers available with the requested generators\n(up to -www for the most detailed output, or -wwww for json output)")
+	cmd.Flags().StringVar(&helpFormat, "format", "", "print out the markers with the given format (only 'markdown' is supported),\nwhich documents the devfile-specific markers of the requested generators")
 	cmd.Flags().CountVarP(&helpLevel, "detailed-help", "h", "print out more detailed help\n(up to -hhh for the most detailed output, or -hhhh for json output)")
 	cmd.Flags().BoolVar(&showVersion, "version", false, "show version")
+	cmd.Flags().StringVar(&summaryFormat, "summary", "", "print out a summary of the run at the end, with the wall time, processed types and written artifacts of each generator\n(either 'text' or 'json')")
+	cmd.Flags().StringVar(&headerFile, "header-file", "", "prepend the content of the given file (e.g. license) to the Go source files generated\nby the overrides, interfaces, getters, stringers, enums, fixtures, keys, since, versions, lsdata, pointers, views, mergers, conversions, validate and deepcopy generators,\nand by the schemas generator with the embedPackage option, unless they specify their own header file")
+	cmd.Flags().BoolVar(&check, "check", false, "check that the files on disk are up to date with the generated artifacts, without writing them,\nand print out the out-of-date ones")
+	cmd.Flags().BoolVar(&failFast, "fail-fast", false, "stop at the first generator that reports an error,\ninstead of running all the generators and printing all the errors at the end")
+	cmd.Flags().StringVar(&cpuProfile, "cpuprofile", "", "write the CPU profile of the generation run into the given file,\nto be analyzed with 'go tool pprof'")
+	cmd.Flags().StringVar(&memProfile, "memprofile", "", "write the memory profile of the generation run into the given file, at the end of the run,\nto be analyzed with 'go tool pprof' (use '-sample_index=alloc_space' for the allocations of the whole run)")
 	cmd.Flags().Bool("help", false, "print out usage and a summary of options")
 	oldUsage := cmd.UsageFunc()
 	cmd.SetUsageFunc(func(c *cobra.Command) error {
@@ -182,11 +406,19 @@ generator schemas output:schemas:artifacts:config=schemas paths=./pkg/apis/works
 		if helpLevel == 0 {
 			helpLevel = summaryHelp
 		}
+		registry, err := loadOptionsRegistry()
+		if err != nil {
+			return err
+		}
 		fmt.Fprintf(c.OutOrStderr(), "\n\nOptions\n\n")
-		return helpForLevels(c.OutOrStdout(), c.OutOrStderr(), helpLevel, optionsRegistry, help.SortByOption)
+		return helpForLevels(c.OutOrStdout(), c.OutOrStderr(), helpLevel, registry, help.SortByOption)
 	})
 
-	if err := cmd.Execute(); err != nil {
+	if executed, err := cmd.ExecuteC(); err != nil {
+		if executed != cmd {
+			// sub-commands print out their own errors
+			os.Exit(exitCode(err))
+		}
 		if _, noUsage := err.(noUsageError); !noUsage {
 			// print the usage unless we suppressed it
 			if err := cmd.Usage(); err != nil {
@@ -194,20 +426,265 @@ generator schemas output:schemas:artifacts:config=schemas paths=./pkg/apis/works
 			}
 		}
 		fmt.Fprintf(cmd.OutOrStderr(), "run `%[1]s %[2]s -w` to see all available markers, or `%[1]s %[2]s -h` for usage\n", cmd.CalledAs(), strings.Join(os.Args[1:], " "))
-		os.Exit(1)
+		os.Exit(exitCode(err))
+	}
+}
+
+// applyHeaderFile sets the given header file on all the code-emitting generators
+// that don't specify their own header file through their options.
+func applyHeaderFile(rt *genall.Runtime, headerFile string) {
+	for _, gen := range rt.Generators {
+		switch g := (*gen).(type) {
+		case overrides.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case interfaces.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case versions.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case schemas.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case getters.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case keys.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case stringers.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case validate.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case deepcopy.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case enums.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case fixtures.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case since.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case lsdata.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case pointers.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case views.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case mergers.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		case conversions.Generator:
+			if g.HeaderFile == "" {
+				g.HeaderFile = headerFile
+			}
+			*gen = g
+		}
 	}
 }
 
+// runOptions drives how the generators are run
+type runOptions struct {
+	// withSummary enables collecting the telemetry data of each generator
+	withSummary bool
+	// failFast stops the run at the first generator that reports an error,
+	// instead of running all the generators and collecting all their errors
+	failFast bool
+	// check compares the generated artifacts with the files on disk instead of writing them
+	check bool
+}
+
+// runResult is the outcome of a run of the generators
+type runResult struct {
+	// hadErrors is true if errors were reported while loading the packages or running the generators
+	hadErrors bool
+	// succeeded contains the names of the generators that ran without errors
+	succeeded []string
+	// drifted contains the paths of the out-of-date files, in check mode
+	drifted []string
+	// summaries contains the telemetry data of each generator, when requested
+	summaries []generatorSummary
+}
+
+// runGenerators runs the generators of the runtime one after the other, the same way `genall.Runtime.Run` does,
+// but releases the memory retained by each generator before running the next one,
+// so that the memory footprint of a run is bounded by the most expensive generator
+// instead of growing with the number of generators.
+//
+// The errors reported by the generators are printed at the end of the run, each one with the name of the generator
+// that reported it and the related root package.
+// In check mode, the out-of-date files are printed at the end of the run.
+func runGenerators(rt *genall.Runtime, options runOptions) runResult {
+	errors := newErrorTracker(rt.Roots)
+	errors.collect(loadingStep)
+
+	var result runResult
+	for i, gen := range rt.Generators {
+		name := generatorName(*gen)
+		ctx := rt.GenerationContext // make a shallow copy
+
+		// don't pass a typechecker to generators that don't provide a filter
+		// to avoid accidents
+		if _, needsChecking := (*gen).(genall.NeedsTypeChecking); !needsChecking {
+			ctx.Checker = nil
+		}
+
+		start := time.Now()
+		artifactsWritten, drifted, err := generate(*gen, ctx, rt.OutputRules.ForGenerator(gen), options.check)
+		wallTime := time.Since(start)
+		if err != nil {
+			errors.add(name, err)
+		}
+		failed := errors.collect(name) > 0 || err != nil
+		if !failed {
+			result.succeeded = append(result.succeeded, name)
+		}
+		result.drifted = append(result.drifted, drifted...)
+
+		if options.withSummary {
+			summary := generatorSummary{
+				Generator:        name,
+				WallTime:         wallTime.Seconds(),
+				ArtifactsWritten: artifactsWritten,
+				Failed:           failed,
+			}
+			if summary.TypesProcessed, err = countProcessedTypes(*gen, rt.Roots); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
+			result.summaries = append(result.summaries, summary)
+		}
+
+		if failed && options.failFast {
+			var skipped []string
+			for _, remaining := range rt.Generators[i+1:] {
+				skipped = append(skipped, generatorName(*remaining))
+			}
+			if len(skipped) > 0 {
+				fmt.Fprintf(os.Stderr, "generator %q failed, skipping the remaining generators: %s\n", name, strings.Join(skipped, ", "))
+			}
+			break
+		}
+
+		// Generators build their intermediate data (parsers, schemas, ASTs) in local structures,
+		// which become garbage as soon as they return: give the memory back before the next one.
+		debug.FreeOSMemory()
+	}
+
+	result.hadErrors = errors.print(os.Stderr)
+	for _, path := range result.drifted {
+		fmt.Fprintf(os.Stderr, "%s is out of date\n", path)
+	}
+	return result
+}
+
+// generate runs the generator with the given output rule, and returns the number of written artifacts.
+// In check mode, the artifacts are not written, and the paths of the out-of-date files are returned.
+//
+// When the output rule is the `artifacts` rule with per-root config directories, the generator is run once
+// for each group of root packages that share the same config directory, so that generators don't have to know about it.
+func generate(gen genall.Generator, ctx genall.GenerationContext, outputRule genall.OutputRule, check bool) (int, []string, error) {
+	artifacts, isArtifacts := outputRule.(genutils.OutputArtifacts)
+	if !isArtifacts {
+		return generateWithOutputRule(gen, ctx, outputRule, check)
+	}
+
+	groups, err := artifacts.ForRoots(ctx.Roots)
+	if err != nil {
+		return 0, nil, err
+	}
+	artifactsWritten := 0
+	var drifted []string
+	for _, group := range groups {
+		groupCtx := ctx
+		groupCtx.Roots = group.Roots
+		written, groupDrifted, err := generateWithOutputRule(gen, groupCtx, group.OutputRule, check)
+		artifactsWritten += written
+		drifted = append(drifted, groupDrifted...)
+		if err != nil {
+			return artifactsWritten, drifted, err
+		}
+	}
+	return artifactsWritten, drifted, nil
+}
+
+func generateWithOutputRule(gen genall.Generator, ctx genall.GenerationContext, outputRule genall.OutputRule, check bool) (int, []string, error) {
+	var checkRule *genutils.CheckOutputRule
+	if check {
+		checkRule = &genutils.CheckOutputRule{OutputRule: outputRule}
+		outputRule = checkRule
+	}
+	countingRule := &genutils.CountingOutputRule{OutputRule: outputRule}
+	ctx.OutputRule = countingRule
+	err := gen.Generate(&ctx)
+	if checkRule != nil {
+		return countingRule.Count, checkRule.Drifted, err
+	}
+	return countingRule.Count, nil, err
+}
+
 // printMarkerDocs prints out marker help for the given generators specified in
-// the rawOptions, at the given level.
-func printMarkerDocs(c *cobra.Command, rawOptions []string, whichLevel int) error {
+// the rawOptions, at the given level, or in the given format if any.
+func printMarkerDocs(c *cobra.Command, rawOptions []string, whichLevel int, format string) error {
 	// just grab a registry so we don't lag while trying to load roots
 	// (like we'd do if we just constructed the full runtime).
-	reg, err := genall.RegistryFromOptions(optionsRegistry, rawOptions)
+	registry, err := loadOptionsRegistry()
+	if err != nil {
+		return noUsageError{exitError{err, exitGenerationErrors}}
+	}
+	reg, err := genall.RegistryFromOptions(registry, rawOptions)
 	if err != nil {
 		return err
 	}
 
+	switch format {
+	case "":
+	case markdownFormat:
+		return markdownMarkerDocs(c.OutOrStdout(), reg)
+	default:
+		return fmt.Errorf("unknown marker documentation format %q, only %q is supported", format, markdownFormat)
+	}
+
 	return helpForLevels(c.OutOrStdout(), c.OutOrStderr(), whichLevel, reg, help.SortByCategory)
 }
 
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/controller-tools/pkg/genall/help"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+const markdownFormat = "markdown"
+
+// isDevfileMarker returns true if the marker is defined by the devfile generators,
+// as opposed to the markers defined by controller-tools and the generator options.
+func isDevfileMarker(marker help.MarkerDoc) bool {
+	return strings.HasPrefix(marker.Name, "devfile:") ||
+		marker.Name == "union" ||
+		marker.Name == "unionDiscriminator"
+}
+
+// markdownMarkerDocs writes a Markdown documentation page of all the devfile-specific markers
+// of the given registry, grouped by category.
+func markdownMarkerDocs(out io.Writer, reg *markers.Registry) error {
+	var content strings.Builder
+	content.WriteString("# Devfile generator markers\n\n")
+	content.WriteString("This page documents the comment markers specific to the devfile generators, that can be used in the `workspaces` K8S API source code.\n")
+	content.WriteString("It is generated with `generator <generators...> -w --format markdown`: do not edit it manually.\n")
+
+	for _, category := range help.ByCategory(reg, help.SortByCategory) {
+		var devfileMarkers []help.MarkerDoc
+		for _, marker := range category.Markers {
+			if isDevfileMarker(marker) {
+				devfileMarkers = append(devfileMarkers, marker)
+			}
+		}
+		if len(devfileMarkers) == 0 {
+			continue
+		}
+		// markers with the same name, that apply to different targets, should be documented in a stable order
+		sort.SliceStable(devfileMarkers, func(i, j int) bool {
+			if devfileMarkers[i].Name != devfileMarkers[j].Name {
+				return devfileMarkers[i].Name < devfileMarkers[j].Name
+			}
+			return devfileMarkers[i].Target < devfileMarkers[j].Target
+		})
+
+		categoryName := category.Category
+		if categoryName == "" {
+			categoryName = "Other"
+		}
+		fmt.Fprintf(&content, "\n## %s\n", categoryName)
+
+		for _, marker := range devfileMarkers {
+			fmt.Fprintf(&content, "\n### `+%s`\n\n", marker.Name)
+			fmt.Fprintf(&content, "Applies to: **%s**\n", marker.Target)
+			if marker.DeprecatedInFavorOf != nil {
+				fmt.Fprintf(&content, "\n**Deprecated**: use `+%s` instead.\n", *marker.DeprecatedInFavorOf)
+			}
+			writeMarkdownDescription(&content, marker.DetailedHelp)
+
+			switch {
+			case marker.Empty():
+			case marker.AnonymousField():
+				fmt.Fprintf(&content, "\nValue: `%s`\n", marker.Fields[0].TypeString())
+			default:
+				content.WriteString("\n| Argument | Type | Optional | Description |\n")
+				content.WriteString("|----------|------|----------|-------------|\n")
+				for _, field := range marker.Fields {
+					description := strings.TrimSpace(field.Summary + " " + field.Details)
+					fmt.Fprintf(&content, "| `%s` | `%s` | %t | %s |\n",
+						field.Name, field.TypeString(), field.Optional, strings.ReplaceAll(description, "|", "\\|"))
+				}
+			}
+		}
+	}
+
+	_, err := io.WriteString(out, content.String())
+	return err
+}
+
+func writeMarkdownDescription(content *strings.Builder, detailedHelp help.DetailedHelp) {
+	summary := strings.TrimSpace(detailedHelp.Summary)
+	if summary != "" {
+		// marker summaries are written to follow the marker name: capitalize them to make them standalone sentences
+		fmt.Fprintf(content, "\n%s%s\n", strings.ToUpper(summary[:1]), summary[1:])
+	}
+	if details := strings.TrimSpace(detailedHelp.Details); details != "" {
+		fmt.Fprintf(content, "\n%s\n", details)
+	}
+}
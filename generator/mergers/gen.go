@@ -0,0 +1,881 @@
+package mergers
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/generator/genutils"
+	"github.com/devfile/api/generator/overrides"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+const (
+	patchStrategyTagKey = "patchStrategy"
+	patchMergeKeyTagKey = "patchMergeKey"
+)
+
+// overridesSuffixes are the suffixes of the names of the parent and plugin override types generated by the overrides generator
+var overridesSuffixes = []string{"ParentOverride", "PluginOverride"}
+
+// +controllertools:marker:generateHelp
+
+// Generator generates the GO code that applies the parent and plugin overrides, generated by the overrides generator,
+// to the `devfile:overrides:generate` annotated type and the struct types reachable from it, without going through
+// a Json strategic merge patch.
+//
+// For each struct type `T`, the generated `mergeParentOverride(*TParentOverride)` and `mergePluginOverride(*TPluginOverride)`
+// methods apply the overrides as a strategic merge patch would:
+//
+// - the fields that are set in the override replace the fields of `T`,
+//
+// - the lists with the `merge` patch strategy and a patch merge key are merged item by item, the items of the override
+// being applied to the items that have the same key, or appended,
+//
+// - the maps are merged entry by entry, a null free-form Json value removing the entry,
+//
+// - when an override sets a member of a union, the other members and the discriminator of the union are cleared.
+//
+// The exported `MergeParentOverrides` and `MergePluginOverrides` functions apply a list of overrides to a copy
+// of the `devfile:overrides:generate` annotated type.
+//
+// The generated unit tests check, for each struct type, with fuzzed objects, that an empty override doesn't change
+// an object, and that applying an override twice gives the same result as applying it once.
+type Generator struct {
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+}
+
+// RegisterMarkers registers the markers of the Generator, which are the markers of the overrides generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	return overrides.Generator{}.RegisterMarkers(into)
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// Generate generates the artifacts
+func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	for _, root := range ctx.Roots {
+		ctx.Checker.Check(root)
+		root.NeedTypesInfo()
+
+		writer := &mergerWriter{
+			pkg:          root.Types,
+			source:       newImportNames(root),
+			tests:        newImportNames(root),
+			typeInfos:    map[string]*markers.TypeInfo{},
+			queued:       map[string]bool{},
+			rawJSONTypes: map[string]bool{},
+			keyedLists:   map[string]string{},
+		}
+		var rootInfo *markers.TypeInfo
+		if err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
+			writer.typeInfos[info.Name] = info
+			if info.Markers.Get(overrides.TypeMarker.Name) != nil {
+				rootInfo = info
+			}
+		}); err != nil {
+			root.AddError(err)
+			return nil
+		}
+		if rootInfo == nil {
+			continue
+		}
+
+		rootType := lookupNamed(root.Types, rootInfo.Name)
+		if rootType == nil {
+			continue
+		}
+		writer.rootType = rootType
+		mergers, tests := new(bytes.Buffer), new(bytes.Buffer)
+		for _, suffix := range overridesSuffixes {
+			overridesType := lookupNamed(root.Types, suffix+"s")
+			if overridesType == nil {
+				root.AddError(fmt.Errorf("type %ss should be generated by the overrides generator before generating the mergers", suffix))
+				continue
+			}
+			writer.merger(suffix, rootType, overridesType)
+			for len(writer.queue) > 0 {
+				next := writer.queue[0]
+				writer.queue = writer.queue[1:]
+				writer.writeMerger(mergers, next)
+				writer.writeMergerTest(tests, next)
+			}
+			writer.writeMergeFunction(mergers, suffix, rootType, overridesType)
+		}
+		if len(writer.errors) > 0 {
+			for _, err := range writer.errors {
+				root.AddError(err)
+			}
+			continue
+		}
+
+		writer.source.namesByPath["bytes"] = "bytes"
+		// the merge methods reference the override types, and should not be processed by the generators that generate them
+		genutils.WriteFormattedAutogeneratedSourceFile("mergers", g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
+			genutils.WriteImports(buf, writer.source.namesByPath)
+			buf.Write(mergers.Bytes())
+			buf.WriteString(mergeHelpers)
+		})
+
+		writer.tests.namesByPath["testing"] = "testing"
+		writer.tests.namesByPath["github.com/google/gofuzz"] = "fuzz"
+		writer.tests.namesByPath["github.com/stretchr/testify/assert"] = "assert"
+		fuzzer := writer.fuzzer()
+		genutils.WriteFormattedSourceFile("mergers_test", g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
+			genutils.WriteImports(buf, writer.tests.namesByPath)
+			buf.WriteString(fuzzer)
+			buf.Write(tests.Bytes())
+		})
+	}
+
+	return nil
+}
+
+// lookupNamed returns the named type with the given name in the given package, or nil if it doesn't exist
+func lookupNamed(pkg *types.Package, name string) *types.Named {
+	typeName, isTypeName := pkg.Scope().Lookup(name).(*types.TypeName)
+	if !isTypeName {
+		return nil
+	}
+	named, _ := typeName.Type().(*types.Named)
+	return named
+}
+
+// importNames are the names of the packages imported by a generated file
+type importNames struct {
+	pkg *types.Package
+	// rootImports contains the packages imported by the Go files of the package, indexed by the name under which they are imported
+	rootImports map[string]*types.Package
+	// namesByPath contains the names of the packages imported by the generated file, indexed by import path
+	namesByPath map[string]string
+}
+
+func newImportNames(root *loader.Package) *importNames {
+	return &importNames{
+		pkg:         root.Types,
+		rootImports: genutils.PackageImports(root),
+		namesByPath: map[string]string{},
+	}
+}
+
+// qualifier returns the name under which the generated file refers to the given package,
+// which is the name under which the package is imported in the Go files of the generated package, if any
+func (i *importNames) qualifier(pkg *types.Package) string {
+	if pkg.Path() == i.pkg.Path() {
+		return ""
+	}
+	if name, known := i.namesByPath[pkg.Path()]; known {
+		return name
+	}
+	name := pkg.Name()
+	var importNames []string
+	for importName, imported := range i.rootImports {
+		if imported.Path() == pkg.Path() {
+			importNames = append(importNames, importName)
+		}
+	}
+	if len(importNames) > 0 {
+		sort.Strings(importNames)
+		name = importNames[0]
+	}
+	base := name
+	for n := 2; i.isImportName(name); n++ {
+		name = base + strconv.Itoa(n)
+	}
+	i.namesByPath[pkg.Path()] = name
+	return name
+}
+
+// isImportName returns true if the given name is already used by a package imported by the generated file
+func (i *importNames) isImportName(name string) bool {
+	for _, used := range i.namesByPath {
+		if used == name {
+			return true
+		}
+	}
+	return false
+}
+
+// typeString returns the Go expression of the given type in the generated file
+func (i *importNames) typeString(goType types.Type) string {
+	return types.TypeString(goType, i.qualifier)
+}
+
+// mergerPair is a struct type, and the override type whose merge method is generated for it
+type mergerPair struct {
+	suffix   string
+	base     *types.Named
+	override *types.Named
+}
+
+// method returns the name of the merge method of the pair
+func (p mergerPair) method() string {
+	return "merge" + p.suffix
+}
+
+// mergerWriter writes the merge methods of the types of a package
+type mergerWriter struct {
+	pkg *types.Package
+	// rootType is the `devfile:overrides:generate` annotated type, whose override types are the root override types, such as `ParentOverrides`
+	rootType *types.Named
+	// source and tests are the names of the packages imported by the generated source and test files
+	source *importNames
+	tests  *importNames
+	// typeInfos contains the marker information of the types of the package, indexed by name
+	typeInfos map[string]*markers.TypeInfo
+	// queued contains the names of the merge methods already queued, as `Type.method`
+	queued map[string]bool
+	// queue contains the pairs whose merge method remains to be written
+	queue []mergerPair
+	// rawJSONTypes contains the Go expressions, in the test file, of the free-form Json types reachable from the merged types
+	rawJSONTypes map[string]bool
+	// keyedLists contains the names of the merge key fields of the items of the merged lists, indexed by the Go expression of the list type in the test file
+	keyedLists map[string]string
+	errors     []error
+}
+
+// merger returns the name of the merge method of the given struct type with the given override type,
+// and queues the writing of this method if needed
+func (w *mergerWriter) merger(suffix string, base *types.Named, override *types.Named) string {
+	pair := mergerPair{suffix: suffix, base: base, override: override}
+	if base != w.rootType && override.Obj().Name() != base.Obj().Name()+suffix {
+		w.errors = append(w.errors, fmt.Errorf("type %s cannot be merged with type %s: its override type should be %s",
+			base.Obj().Name(), override.Obj().Name(), base.Obj().Name()+suffix))
+		return pair.method()
+	}
+	key := base.Obj().Name() + "." + pair.method()
+	if !w.queued[key] {
+		w.queued[key] = true
+		w.queue = append(w.queue, pair)
+	}
+	return pair.method()
+}
+
+// isLocalStruct returns true if the given type is a struct type defined in the generated package
+func (w *mergerWriter) isLocalStruct(goType types.Type) bool {
+	named, isNamed := goType.(*types.Named)
+	if !isNamed || named.Obj().Pkg() != w.pkg {
+		return false
+	}
+	_, isStruct := named.Underlying().(*types.Struct)
+	return isStruct
+}
+
+// isRawJSON returns true if the given type is a free-form Json struct type defined in another package,
+// such as `apiext.JSON` or `runtime.RawExtension`, whose Json value is held by its `Raw` field
+func (w *mergerWriter) isRawJSON(goType types.Type) bool {
+	named, isNamed := goType.(*types.Named)
+	if !isNamed || named.Obj().Pkg() == w.pkg {
+		return false
+	}
+	structType, isStruct := named.Underlying().(*types.Struct)
+	if !isStruct {
+		return false
+	}
+	for i := 0; i < structType.NumFields(); i++ {
+		if field := structType.Field(i); field.Name() == "Raw" {
+			return types.Identical(field.Type(), types.NewSlice(types.Typ[types.Byte]))
+		}
+	}
+	return false
+}
+
+// isBasic returns true if the underlying type of the given type is a builtin scalar type, such as `string` or `int`
+func isBasic(goType types.Type) bool {
+	_, basic := goType.Underlying().(*types.Basic)
+	return basic
+}
+
+// isNillable returns true if the zero value of the given type is nil
+func isNillable(goType types.Type) bool {
+	switch goType.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Map, *types.Interface:
+		return true
+	}
+	return false
+}
+
+// isSetCondition returns the Go condition that checks that the given expression, of the given type, is not the zero value
+func isSetCondition(goType types.Type, expr string) string {
+	return zeroCondition(goType, expr, false)
+}
+
+// isUnsetCondition returns the Go condition that checks that the given expression, of the given type, is the zero value
+func isUnsetCondition(goType types.Type, expr string) string {
+	return zeroCondition(goType, expr, true)
+}
+
+// zeroCondition returns the Go condition that compares the given expression, of the given type, with the zero value,
+// or an empty string if the type has no comparable zero value
+func zeroCondition(goType types.Type, expr string, isZero bool) string {
+	operator := " != "
+	if isZero {
+		operator = " == "
+	}
+	if isNillable(goType) {
+		return expr + operator + "nil"
+	}
+	if basic, isBasic := goType.Underlying().(*types.Basic); isBasic {
+		switch {
+		case basic.Info()&types.IsString != 0:
+			return expr + operator + `""`
+		case basic.Info()&types.IsBoolean != 0:
+			if isZero {
+				return "!" + expr
+			}
+			return expr
+		case basic.Info()&types.IsNumeric != 0:
+			return expr + operator + "0"
+		}
+	}
+	return ""
+}
+
+// zeroValue returns the Go expression of the zero value of the given type
+func (w *mergerWriter) zeroValue(goType types.Type) string {
+	if isNillable(goType) {
+		return "nil"
+	}
+	if basic, isBasic := goType.Underlying().(*types.Basic); isBasic {
+		switch {
+		case basic.Info()&types.IsString != 0:
+			return `""`
+		case basic.Info()&types.IsBoolean != 0:
+			return "false"
+		}
+		return "0"
+	}
+	return w.source.typeString(goType) + "{}"
+}
+
+// convert returns the Go expression that converts the given expression, of the `from` type, to the `to` type
+func (w *mergerWriter) convert(expr string, from types.Type, to types.Type) string {
+	if types.Identical(from, to) {
+		return expr
+	}
+	return w.source.typeString(to) + "(" + expr + ")"
+}
+
+// lookupField returns the field of the given struct type that has the given name, or nil if it doesn't exist
+func lookupField(structType *types.Struct, name string) *types.Var {
+	for i := 0; i < structType.NumFields(); i++ {
+		if field := structType.Field(i); field.Name() == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// jsonField returns the field of the given struct type whose Json name is the given one, or nil if it doesn't exist
+func jsonField(structType *types.Struct, jsonName string) *types.Var {
+	for i := 0; i < structType.NumFields(); i++ {
+		if strings.Split(reflect.StructTag(structType.Tag(i)).Get("json"), ",")[0] == jsonName {
+			return structType.Field(i)
+		}
+	}
+	return nil
+}
+
+// overrideFieldName returns the name of the field of the override type that overrides the given field
+func overrideFieldName(field *types.Var, suffix string) string {
+	if field.Embedded() {
+		return field.Name() + suffix
+	}
+	return field.Name()
+}
+
+// discriminator returns the name of the union discriminator field of the given type, if any, and whether the type is a union
+func (w *mergerWriter) discriminator(typeName string) (string, bool) {
+	info := w.typeInfos[typeName]
+	if info == nil || info.Markers.Get(genutils.UnionMarker.Name) == nil {
+		return "", false
+	}
+	for _, field := range info.Fields {
+		if field.Markers.Get(genutils.UnionDiscriminatorMarker.Name) != nil {
+			return field.Name, true
+		}
+	}
+	return "", true
+}
+
+// writeMerger writes the merge method of the given pair
+func (w *mergerWriter) writeMerger(buf *bytes.Buffer, pair mergerPair) {
+	baseName, overrideName := pair.base.Obj().Name(), pair.override.Obj().Name()
+	baseStruct, isStruct := pair.base.Underlying().(*types.Struct)
+	overrideStruct, isOverrideStruct := pair.override.Underlying().(*types.Struct)
+	if !isStruct || !isOverrideStruct {
+		w.errors = append(w.errors, fmt.Errorf("type %s cannot be merged with type %s: both should be structs", baseName, overrideName))
+		return
+	}
+
+	body := new(bytes.Buffer)
+	discriminator, isUnion := w.discriminator(baseName)
+	if isUnion {
+		w.writeUnionMerge(body, pair, baseStruct, overrideStruct, discriminator)
+	}
+	for i := 0; i < baseStruct.NumFields(); i++ {
+		field := baseStruct.Field(i)
+		if !field.Exported() || field.Name() == discriminator {
+			continue
+		}
+		overrideField := lookupField(overrideStruct, overrideFieldName(field, pair.suffix))
+		if overrideField == nil {
+			// the field cannot be overridden
+			continue
+		}
+		w.writeFieldMerge(body, pair, baseName+"."+field.Name(), reflect.StructTag(baseStruct.Tag(i)),
+			"in."+field.Name(), field.Type(), "override."+overrideField.Name(), overrideField.Type())
+	}
+
+	fmt.Fprintf(buf, `
+
+// %[3]s applies the given %[2]s to the %[1]s
+func (in *%[1]s) %[3]s(override *%[2]s) {%[4]s
+}`, baseName, overrideName, pair.method(), body.String())
+}
+
+// writeUnionMerge writes the code that clears the members of a union, and its discriminator,
+// that are not set in the override when the override sets a member of the union
+func (w *mergerWriter) writeUnionMerge(buf *bytes.Buffer, pair mergerPair, baseStruct *types.Struct, overrideStruct *types.Struct, discriminator string) {
+	var conditions []string
+	clears := new(bytes.Buffer)
+	if discriminator != "" {
+		fmt.Fprintf(clears, `
+		in.%s = %s`, discriminator, w.zeroValue(lookupField(baseStruct, discriminator).Type()))
+	}
+	for i := 0; i < baseStruct.NumFields(); i++ {
+		field := baseStruct.Field(i)
+		if !field.Exported() || field.Name() == discriminator {
+			continue
+		}
+		overrideField := lookupField(overrideStruct, overrideFieldName(field, pair.suffix))
+		if overrideField == nil {
+			fmt.Fprintf(clears, `
+		in.%s = %s`, field.Name(), w.zeroValue(field.Type()))
+			continue
+		}
+		condition := isSetCondition(overrideField.Type(), "override."+overrideField.Name())
+		if condition == "" {
+			w.errors = append(w.errors, fmt.Errorf("member %s of union %s should be a pointer or a scalar", field.Name(), pair.base.Obj().Name()))
+			continue
+		}
+		conditions = append(conditions, condition)
+		fmt.Fprintf(clears, `
+		if %s {
+			in.%s = %s
+		}`, isUnsetCondition(overrideField.Type(), "override."+overrideField.Name()), field.Name(), w.zeroValue(field.Type()))
+	}
+	if len(conditions) == 0 {
+		return
+	}
+	fmt.Fprintf(buf, `
+	if %s {%s
+	}`, strings.Join(conditions, " || "), clears.String())
+}
+
+// writeFieldMerge writes the code that applies the given override expression to the given base expression
+func (w *mergerWriter) writeFieldMerge(buf *bytes.Buffer, pair mergerPair, fieldName string, tag reflect.StructTag,
+	baseExpr string, baseType types.Type, overrideExpr string, overrideType types.Type) {
+	unsupported := func() {
+		w.errors = append(w.errors, fmt.Errorf("field %s of type %s cannot be merged with type %s",
+			fieldName, w.source.typeString(baseType), w.source.typeString(overrideType)))
+	}
+	overridePointer, overrideIsPointer := overrideType.Underlying().(*types.Pointer)
+
+	switch {
+	case isBasic(baseType):
+		switch {
+		case overrideIsPointer && isBasic(overridePointer.Elem()):
+			fmt.Fprintf(buf, `
+	if %[2]s != nil {
+		%[1]s = %[3]s
+	}`, baseExpr, overrideExpr, w.convert("*"+overrideExpr, overridePointer.Elem(), baseType))
+		case isBasic(overrideType):
+			fmt.Fprintf(buf, `
+	if %[2]s {
+		%[1]s = %[3]s
+	}`, baseExpr, isSetCondition(overrideType, overrideExpr), w.convert(overrideExpr, overrideType, baseType))
+		default:
+			unsupported()
+		}
+		return
+	case w.isLocalStruct(baseType):
+		overrideNamed, isNamed := overrideType.(*types.Named)
+		if !isNamed {
+			unsupported()
+			return
+		}
+		fmt.Fprintf(buf, `
+	%[1]s.%[3]s(&%[2]s)`, baseExpr, overrideExpr, w.merger(pair.suffix, baseType.(*types.Named), overrideNamed))
+		return
+	case w.isRawJSON(baseType):
+		if !types.Identical(baseType, overrideType) {
+			unsupported()
+			return
+		}
+		fmt.Fprintf(buf, `
+	if len(%[2]s.Raw) > 0 {
+		%[1]s.Raw = append([]byte(nil), %[2]s.Raw...)
+	}`, baseExpr, overrideExpr)
+		return
+	}
+
+	switch base := baseType.Underlying().(type) {
+	case *types.Pointer:
+		if !overrideIsPointer {
+			unsupported()
+			return
+		}
+		switch {
+		case isBasic(base.Elem()) && isBasic(overridePointer.Elem()):
+			fmt.Fprintf(buf, `
+	if %[2]s != nil {
+		value := %[3]s
+		%[1]s = &value
+	}`, baseExpr, overrideExpr, w.convert("*"+overrideExpr, overridePointer.Elem(), base.Elem()))
+		case w.isLocalStruct(base.Elem()):
+			overrideNamed, isNamed := overridePointer.Elem().(*types.Named)
+			if !isNamed {
+				unsupported()
+				return
+			}
+			fmt.Fprintf(buf, `
+	if %[2]s != nil {
+		if %[1]s == nil {
+			%[1]s = &%[3]s{}
+		}
+		%[1]s.%[4]s(%[2]s)
+	}`, baseExpr, overrideExpr, w.source.typeString(base.Elem()), w.merger(pair.suffix, base.Elem().(*types.Named), overrideNamed))
+		default:
+			unsupported()
+		}
+	case *types.Slice:
+		overrideSlice, isSlice := overrideType.Underlying().(*types.Slice)
+		if !isSlice {
+			unsupported()
+			return
+		}
+		switch {
+		case isBasic(base.Elem()) && types.Identical(base.Elem(), overrideSlice.Elem()):
+			fmt.Fprintf(buf, `
+	if %[2]s != nil {
+		%[1]s = make(%[3]s, len(%[2]s))
+		copy(%[1]s, %[2]s)
+	}`, baseExpr, overrideExpr, w.source.typeString(baseType))
+		case isBasic(base.Elem()) && isBasic(overrideSlice.Elem()):
+			fmt.Fprintf(buf, `
+	if %[2]s != nil {
+		%[1]s = make(%[3]s, len(%[2]s))
+		for i := range %[2]s {
+			%[1]s[i] = %[4]s
+		}
+	}`, baseExpr, overrideExpr, w.source.typeString(baseType), w.convert(overrideExpr+"[i]", overrideSlice.Elem(), base.Elem()))
+		case w.isLocalStruct(base.Elem()):
+			overrideNamed, isNamed := overrideSlice.Elem().(*types.Named)
+			if !isNamed {
+				unsupported()
+				return
+			}
+			method := w.merger(pair.suffix, base.Elem().(*types.Named), overrideNamed)
+			mergeKey := tag.Get(patchMergeKeyTagKey)
+			if mergeKey == "" || !containsPatchStrategy(tag, genutils.MergePatchStrategy) {
+				fmt.Fprintf(buf, `
+	if %[2]s != nil {
+		%[1]s = make(%[3]s, len(%[2]s))
+		for i := range %[2]s {
+			%[1]s[i].%[4]s(&%[2]s[i])
+		}
+	}`, baseExpr, overrideExpr, w.source.typeString(baseType), method)
+				return
+			}
+			baseKey := jsonField(base.Elem().Underlying().(*types.Struct), mergeKey)
+			overrideKey := jsonField(overrideNamed.Underlying().(*types.Struct), mergeKey)
+			if baseKey == nil || overrideKey == nil || !isBasic(baseKey.Type()) || !isBasic(overrideKey.Type()) {
+				w.errors = append(w.errors, fmt.Errorf("field %s has the %s patch merge key, which should be a scalar field of its items", fieldName, mergeKey))
+				return
+			}
+			w.keyedLists[w.tests.typeString(baseType)] = baseKey.Name()
+			w.keyedLists[w.tests.typeString(overrideType)] = overrideKey.Name()
+			fmt.Fprintf(buf, `
+	if len(%[2]s) > 0 {
+		merged := append(%[7]s(nil), %[1]s...)
+		var overridden []int
+		for i := range %[2]s {
+			index := -1
+			for j := range merged {
+				if merged[j].%[4]s == %[5]s {
+					index = j
+					break
+				}
+			}
+			if index < 0 {
+				merged = append(merged, %[3]s{})
+				index = len(merged) - 1
+			}
+			merged[index].%[6]s(&%[2]s[i])
+			overridden = appendMissingIndex(overridden, index)
+		}
+		order := mergedListOrder(len(%[1]s), overridden)
+		%[1]s = make(%[7]s, len(order))
+		for i, index := range order {
+			%[1]s[i] = merged[index]
+		}
+	}`, baseExpr, overrideExpr, w.source.typeString(base.Elem()), baseKey.Name(),
+				w.convert(overrideExpr+"[i]."+overrideKey.Name(), overrideKey.Type(), baseKey.Type()), method, w.source.typeString(baseType))
+		default:
+			unsupported()
+		}
+	case *types.Map:
+		overrideMap, isMap := overrideType.Underlying().(*types.Map)
+		if !isMap || !isBasic(base.Key()) || !types.Identical(base.Key(), overrideMap.Key()) {
+			unsupported()
+			return
+		}
+		var entryMerge string
+		switch {
+		case isBasic(base.Elem()) && isBasic(overrideMap.Elem()):
+			entryMerge = fmt.Sprintf(`
+			%[1]s[key] = %[2]s`, baseExpr, w.convert("value", overrideMap.Elem(), base.Elem()))
+		case w.isRawJSON(base.Elem()) && types.Identical(base.Elem(), overrideMap.Elem()):
+			entryMerge = fmt.Sprintf(`
+			if isJSONNull(value.Raw) {
+				delete(%[1]s, key)
+				continue
+			}
+			%[1]s[key] = %[2]s{Raw: append([]byte(nil), value.Raw...)}`, baseExpr, w.source.typeString(base.Elem()))
+		default:
+			unsupported()
+			return
+		}
+		fmt.Fprintf(buf, `
+	if len(%[2]s) > 0 {
+		if %[1]s == nil {
+			%[1]s = make(%[3]s, len(%[2]s))
+		}
+		for key, value := range %[2]s {%[4]s
+		}
+	}`, baseExpr, overrideExpr, w.source.typeString(baseType), entryMerge)
+	default:
+		unsupported()
+	}
+}
+
+// containsPatchStrategy returns true if the given field tag defines the given patch strategy
+func containsPatchStrategy(tag reflect.StructTag, strategy string) bool {
+	for _, s := range strings.Split(tag.Get(patchStrategyTagKey), ",") {
+		if s == strategy {
+			return true
+		}
+	}
+	return false
+}
+
+// writeMergeFunction writes the exported function that applies a list of overrides of the given type to the given root type
+func (w *mergerWriter) writeMergeFunction(buf *bytes.Buffer, suffix string, rootType *types.Named, overridesType *types.Named) {
+	rootName, overridesName := rootType.Obj().Name(), overridesType.Obj().Name()
+	fmt.Fprintf(buf, `
+
+// Merge%[2]s returns a copy of the given %[1]s, on which the given %[2]s are applied in order,
+// as a strategic merge patch would apply them. The nil overrides are ignored, and the given %[1]s is not modified.
+func Merge%[2]s(base *%[1]s, overrides ...*%[2]s) *%[1]s {
+	merged := base.DeepCopy()
+	if merged == nil {
+		merged = &%[1]s{}
+	}
+	for _, override := range overrides {
+		if override != nil {
+			merged.merge%[3]s(override)
+		}
+	}
+	return merged
+}`, rootName, overridesName, suffix)
+}
+
+// registerRawJSONTypes registers the free-form Json types reachable from the given type,
+// which should be fuzzed as valid Json values
+func (w *mergerWriter) registerRawJSONTypes(goType types.Type, visited map[types.Type]bool) {
+	if visited[goType] {
+		return
+	}
+	visited[goType] = true
+	if w.isRawJSON(goType) {
+		w.rawJSONTypes[w.tests.typeString(goType)] = true
+		return
+	}
+	switch t := goType.Underlying().(type) {
+	case *types.Pointer:
+		w.registerRawJSONTypes(t.Elem(), visited)
+	case *types.Slice:
+		w.registerRawJSONTypes(t.Elem(), visited)
+	case *types.Map:
+		w.registerRawJSONTypes(t.Elem(), visited)
+	case *types.Struct:
+		if w.isLocalStruct(goType) {
+			for i := 0; i < t.NumFields(); i++ {
+				w.registerRawJSONTypes(t.Field(i).Type(), visited)
+			}
+		}
+	}
+}
+
+// writeMergerTest writes the unit test of the merge method of the given pair
+func (w *mergerWriter) writeMergerTest(buf *bytes.Buffer, pair mergerPair) {
+	visited := map[types.Type]bool{}
+	w.registerRawJSONTypes(pair.base, visited)
+	w.registerRawJSONTypes(pair.override, visited)
+	fmt.Fprintf(buf, `
+
+func Test%[1]s%[3]s(t *testing.T) {
+	for seed := int64(0); seed < mergersFuzzIterations; seed++ {
+		fuzzer := newMergersFuzzer(seed)
+		base, override := &%[1]s{}, &%[2]s{}
+		fuzzer.Fuzz(base)
+		fuzzer.Fuzz(override)
+
+		unchanged := base.DeepCopy()
+		unchanged.%[4]s(&%[2]s{})
+		if !assert.Equal(t, base, unchanged, "an empty %[2]s should not change the %[1]s (seed %%d)", seed) {
+			return
+		}
+
+		once := base.DeepCopy()
+		once.%[4]s(override)
+		twice := once.DeepCopy()
+		twice.%[4]s(override)
+		if !assert.Equal(t, once, twice, "applying a %[2]s twice should give the same %[1]s as applying it once (seed %%d)", seed) {
+			return
+		}
+	}
+}`, pair.base.Obj().Name(), pair.override.Obj().Name(), strings.Title(pair.method()), pair.method())
+}
+
+// fuzzer returns the declaration of the fuzzer used by the unit tests of the merge methods
+func (w *mergerWriter) fuzzer() string {
+	var rawJSONTypes []string
+	for rawJSONType := range w.rawJSONTypes {
+		rawJSONTypes = append(rawJSONTypes, rawJSONType)
+	}
+	sort.Strings(rawJSONTypes)
+	funcs := new(bytes.Buffer)
+	for _, rawJSONType := range rawJSONTypes {
+		fmt.Fprintf(funcs, `
+		func(value *%s, c fuzz.Continue) {
+			value.Raw = []byte(mergersFuzzJSON[c.Intn(len(mergersFuzzJSON))])
+		},`, rawJSONType)
+	}
+	var keyedLists []string
+	for keyedList := range w.keyedLists {
+		keyedLists = append(keyedLists, keyedList)
+	}
+	sort.Strings(keyedLists)
+	for _, keyedList := range keyedLists {
+		// lists with duplicate keys are invalid, and are not merged consistently
+		fmt.Fprintf(funcs, `
+		func(value *%[1]s, c fuzz.Continue) {
+			c.FuzzNoCustom(value)
+			if *value == nil {
+				return
+			}
+			unique := (*value)[:0]
+			for _, item := range *value {
+				duplicate := false
+				for _, existing := range unique {
+					if existing.%[2]s == item.%[2]s {
+						duplicate = true
+						break
+					}
+				}
+				if !duplicate {
+					unique = append(unique, item)
+				}
+			}
+			*value = unique
+		},`, keyedList, w.keyedLists[keyedList])
+	}
+	return fmt.Sprintf(`
+// mergersFuzzIterations is the number of fuzzed objects and overrides on which each merge method is tested
+const mergersFuzzIterations = 20
+
+// mergersFuzzStrings are the values of the fuzzed strings, few enough for the keys of the fuzzed list items to match
+var mergersFuzzStrings = []string{"", "a", "b"}
+
+// mergersFuzzJSON are the values of the fuzzed free-form Json values
+var mergersFuzzJSON = []string{"null", "\"a\"", "1", "[\"a\"]", "{\"a\":\"b\"}", "{\"a\":null,\"b\":{\"c\":1}}"}
+
+func newMergersFuzzer(seed int64) *fuzz.Fuzzer {
+	return fuzz.NewWithSeed(seed).NilChance(0.3).NumElements(0, 3).MaxDepth(12).Funcs(
+		func(value *string, c fuzz.Continue) {
+			*value = mergersFuzzStrings[c.Intn(len(mergersFuzzStrings))]
+		},%s
+	)
+}`, funcs.String())
+}
+
+// mergeHelpers are the helpers used by the generated merge methods
+const mergeHelpers = `
+
+// containsIndex returns true if the given indexes contain the given index
+func containsIndex(indexes []int, index int) bool {
+	for _, existing := range indexes {
+		if existing == index {
+			return true
+		}
+	}
+	return false
+}
+
+// appendMissingIndex appends the given index to the given indexes, unless they already contain it
+func appendMissingIndex(indexes []int, index int) []int {
+	if containsIndex(indexes, index) {
+		return indexes
+	}
+	return append(indexes, index)
+}
+
+// mergedListOrder returns the order of the items of a list merged by a strategic merge patch, as indexes in a list
+// made of the base items, followed by the items added by the override.
+// The overridden items keep the order of the override, and the other base items are inserted among them
+// with the best effort to keep the order of the base list.
+func mergedListOrder(baseLength int, overridden []int) []int {
+	var baseOnly []int
+	for index := 0; index < baseLength; index++ {
+		if !containsIndex(overridden, index) {
+			baseOnly = append(baseOnly, index)
+		}
+	}
+	order := make([]int, 0, len(baseOnly)+len(overridden))
+	i, j := 0, 0
+	for i < len(baseOnly) || j < len(overridden) {
+		if j >= len(overridden) || (i < len(baseOnly) && overridden[j] < baseLength && baseOnly[i] < overridden[j]) {
+			order = append(order, baseOnly[i])
+			i++
+		} else {
+			order = append(order, overridden[j])
+			j++
+		}
+	}
+	return order
+}
+
+// isJSONNull returns true if the given Json value is empty or null, which removes a free-form Json value
+func isJSONNull(data []byte) bool {
+	trimmed := bytes.TrimSpace(data)
+	return len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null"))
+}
+`
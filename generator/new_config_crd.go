@@ -0,0 +1,194 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+)
+
+// configCRDFile is a file of the package scaffolded by the new-config-crd command
+type configCRDFile struct {
+	name     string
+	template *template.Template
+}
+
+// configCRDParameters are the parameters of the templates of the new-config-crd command
+type configCRDParameters struct {
+	Group         string
+	Version       string
+	Kind          string
+	Plural        string
+	SchemaVersion string
+}
+
+var (
+	kindPattern    = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+	versionPattern = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]+)?$`)
+
+	configCRDFiles = []configCRDFile{
+		{"doc.go", template.Must(template.New("doc.go").Parse(`// Package {{.Version}} contains API Schema definitions for the {{.Group}} {{.Version}} API group
+// +k8s:deepcopy-gen=package,register
+// +groupName={{.Group}}
+// +devfile:jsonschema:version={{.SchemaVersion}}
+package {{.Version}}
+`))},
+		{"register.go", template.Must(template.New("register.go").Parse(`// NOTE: Boilerplate only.  Ignore this file.
+
+// Package {{.Version}} contains API Schema definitions for the {{.Group}} {{.Version}} API group
+// +k8s:deepcopy-gen=package,register
+// +groupName={{.Group}}
+package {{.Version}}
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: "{{.Group}}", Version: "{{.Version}}"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
+`))},
+		{"{{.Plural}}_types.go", template.Must(template.New("types.go").Parse(`package {{.Version}}
+
+import (
+	"github.com/devfile/api/v2/pkg/attributes"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// {{.Kind}}Spec defines the settings of the {{.Kind}} configuration
+// +devfile:getter:generate
+type {{.Kind}}Spec struct {
+	// Whether the feature configured by this setting is enabled
+	// +optional
+	// +devfile:default:value=false
+	Enabled *bool ` + "`" + `json:"enabled,omitempty"` + "`" + `
+
+	// Name of the storage class used by the configured workspaces
+	// +optional
+	// +devfile:default:value=standard
+	StorageClassName *string ` + "`" + `json:"storageClassName,omitempty"` + "`" + `
+
+	// Size of the storage requested by the configured workspaces
+	// +optional
+	// +devfile:default:value=1Gi
+	StorageSize *resource.Quantity ` + "`" + `json:"storageSize,omitempty"` + "`" + `
+
+	// Map of implementation-dependant free-form YAML attributes.
+	// +optional
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Attributes attributes.Attributes ` + "`" + `json:"attributes,omitempty"` + "`" + `
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// {{.Kind}} is the Schema for the {{.Plural}} API
+// +kubebuilder:resource:path={{.Plural}},scope=Namespaced
+// +devfile:jsonschema:generate
+// +kubebuilder:storageversion
+type {{.Kind}} struct {
+	metav1.TypeMeta   ` + "`" + `json:",inline"` + "`" + `
+	metav1.ObjectMeta ` + "`" + `json:"metadata,omitempty"` + "`" + `
+
+	Spec {{.Kind}}Spec ` + "`" + `json:"spec,omitempty"` + "`" + `
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// {{.Kind}}List contains a list of {{.Kind}}
+type {{.Kind}}List struct {
+	metav1.TypeMeta ` + "`" + `json:",inline"` + "`" + `
+	metav1.ListMeta ` + "`" + `json:"metadata,omitempty"` + "`" + `
+	Items           []{{.Kind}} ` + "`" + `json:"items"` + "`" + `
+}
+
+func init() {
+	SchemeBuilder.Register(&{{.Kind}}{}, &{{.Kind}}List{})
+}
+`))},
+	}
+)
+
+// newConfigCRDCommand returns the command that scaffolds the API package of a typed configuration CRD
+func newConfigCRDCommand() *cobra.Command {
+	parameters := configCRDParameters{Version: "v1alpha1", SchemaVersion: "0.1.0"}
+	output := filepath.Join("pkg", "apis", "config")
+	cmd := &cobra.Command{
+		Use:   "new-config-crd <kind>",
+		Short: "Scaffolds the API package of a typed configuration CRD, built with the devfile generators.",
+		Long: `Scaffolds the API package of a typed configuration CRD, in the <output>/<version> folder (pkg/apis/config/<version> by default).
+
+The scaffolded package contains the doc.go and register.go files of the API group, and a <kind>_types.go file
+with the configuration type, its list type, and a spec with example settings:
+- optional pointer fields whose default values are declared with the devfile:default:value marker,
+  and returned by the getters generated by the getters generator,
+- a resource.Quantity field, validated as a quantity in the K8S CRD and the Json schema,
+- a free-form attributes field, based on the devfile Attributes.
+
+The example settings should then be replaced by the actual settings of the configuration.
+The build.sh script generates the K8S CRD, the DeepCopy implementations, the getters and the Json schema
+of every package of the pkg/apis/config folder.`,
+		Example: `
+# Scaffold the API package of the DevWorkspaceOperatorConfig CRD in the pkg/apis/config/v1alpha1 folder
+generator new-config-crd DevWorkspaceOperatorConfig --group controller.devfile.io
+
+# Scaffold the API package of a v1beta1 configuration CRD in another folder
+generator new-config-crd ToolsConfig --group tools.devfile.io --api-version v1beta1 --output pkg/apis/tools
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			parameters.Kind = args[0]
+			if !kindPattern.MatchString(parameters.Kind) {
+				return fmt.Errorf("invalid kind %q: it should be a GO type name starting with an uppercase letter", parameters.Kind)
+			}
+			if !versionPattern.MatchString(parameters.Version) {
+				return fmt.Errorf("invalid version %q: it should be a K8S API version, such as v1alpha1 or v1", parameters.Version)
+			}
+			if parameters.Group == "" {
+				return fmt.Errorf("the API group should be given with the --group flag")
+			}
+			parameters.Plural = strings.ToLower(parameters.Kind) + "s"
+
+			folder := filepath.Join(output, parameters.Version)
+			if _, err := os.Stat(folder); err == nil {
+				return fmt.Errorf("the %s folder already exists", folder)
+			}
+			if err := os.MkdirAll(folder, 0755); err != nil {
+				return err
+			}
+			for _, file := range configCRDFiles {
+				name := strings.ReplaceAll(file.name, "{{.Plural}}", parameters.Plural)
+				var content strings.Builder
+				if err := file.template.Execute(&content, parameters); err != nil {
+					return err
+				}
+				if err := ioutil.WriteFile(filepath.Join(folder, name), []byte(content.String()), 0644); err != nil {
+					return err
+				}
+				fmt.Fprintln(c.OutOrStdout(), filepath.Join(folder, name))
+			}
+			return nil
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVar(&parameters.Group, "group", "", "API group of the configuration CRD, such as controller.devfile.io")
+	cmd.Flags().StringVar(&parameters.Version, "api-version", parameters.Version, "K8S API version of the configuration CRD, which is also the name of the scaffolded package")
+	cmd.Flags().StringVar(&parameters.SchemaVersion, "schema-version", parameters.SchemaVersion, "semver-compatible version of the Json schema of the configuration CRD")
+	cmd.Flags().StringVarP(&output, "output", "o", output, "folder in which the package folder is created")
+	return cmd
+}
@@ -7,6 +7,7 @@ import (
 
 	"go/printer"
 	"go/token"
+	"go/types"
 	"io"
 	"regexp"
 	"strings"
@@ -38,29 +39,45 @@ type FieldOverridesInclude struct {
 
 var (
 	overridesFieldMarker = markers.Must(markers.MakeDefinition("devfile:overrides:include", markers.DescribesField, FieldOverridesInclude{}))
-	overridesTypeMarker  = markers.Must(markers.MakeDefinition("devfile:overrides:generate", markers.DescribesType, struct{}{}))
+	// TypeMarker is associated with the type for which the parent and plugin overrides are generated
+	TypeMarker = markers.Must(markers.MakeDefinition("devfile:overrides:generate", markers.DescribesType, struct{}{}))
 )
 
 // +controllertools:marker:generateHelp
 
 // Generator generates additional GO code for the overriding of elements in devfile parent or plugins.
+//
+// It can also process the packages of downstream modules that embed devfile types: in this case, the generated types
+// are based on the `OverridesBase` type of the devfile API, and fields whose type is defined in an imported package
+// that provides the corresponding override type (such as `v1alpha2.ContainerComponentParentOverride`) use this override type.
+//
+// The optional fields of builtin scalar types (such as `string`, `bool` or `int`) are generated as pointers,
+// so that overriding a field with its zero value (such as an empty `memoryLimit`) is distinguished from not overriding it.
 type Generator struct {
 
 	// IsForPluginOverrides indicates that the generated code should be done for plugin overrides.
 	// When false, the parent overrides are generated
 	IsForPluginOverrides bool `marker:"isForPluginOverrides,optional"`
 
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+
 	suffix            string
 	rootTypeToProcess typeToProcess
+	// imports contains the packages imported by the processed package, indexed by the name under which they are imported
+	imports map[string]*types.Package
+	// devfileAPIQualifier is the name under which the processed package imports the devfile API package,
+	// or is empty if the processed package is the devfile API package itself
+	devfileAPIQualifier string
 }
 
 // RegisterMarkers registers the markers of the Generator
 func (Generator) RegisterMarkers(into *markers.Registry) error {
-	if err := markers.RegisterAll(into, overridesFieldMarker, overridesTypeMarker); err != nil {
+	if err := markers.RegisterAll(into, overridesFieldMarker, TypeMarker); err != nil {
 		return err
 	}
 	into.AddHelp(overridesFieldMarker, FieldOverridesInclude{}.Help())
-	into.AddHelp(overridesTypeMarker, markers.SimpleHelp("Overrides", "indicates that a type should be selected to create Overrides for it"))
+	into.AddHelp(TypeMarker, markers.SimpleHelp("Overrides", "indicates that a type should be selected to create Overrides for it"))
 	return genutils.RegisterUnionMarkers(into)
 }
 
@@ -82,12 +99,12 @@ func (g Generator) Generate(ctx *genall.GenerationContext) error {
 		var rootStructToOverride *markers.TypeInfo
 		packageTypes := map[string]*markers.TypeInfo{}
 		if err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
-			if info.Markers.Get(overridesTypeMarker.Name) != nil {
+			if info.Markers.Get(TypeMarker.Name) != nil {
 				if rootStructToOverride == nil {
 					rootStructToOverride = info
 				} else {
 					root.AddError(fmt.Errorf("Marker %v should be added to only one Struct type, but was added on %v and %v",
-						overridesTypeMarker.Name,
+						TypeMarker.Name,
 						rootStructToOverride.Name,
 						info.Name,
 					))
@@ -101,7 +118,7 @@ func (g Generator) Generate(ctx *genall.GenerationContext) error {
 
 		if rootStructToOverride == nil {
 			root.AddError(fmt.Errorf("Marker %v should be added to at least one Struct type",
-				overridesTypeMarker.Name,
+				TypeMarker.Name,
 			))
 			return nil
 		}
@@ -121,20 +138,29 @@ func (g Generator) Generate(ctx *genall.GenerationContext) error {
 			MandatoryKey:     "",
 		}
 
+		g.imports = genutils.PackageImports(root)
+		g.devfileAPIQualifier = ""
+		if !genutils.IsDevfileAPIPackage(root) {
+			g.devfileAPIQualifier = genutils.DevfileAPIQualifier(g.imports)
+			if _, imported := g.imports[g.devfileAPIQualifier]; !imported {
+				g.imports[g.devfileAPIQualifier] = types.NewPackage(genutils.DevfileAPIPackage, g.devfileAPIQualifier)
+			}
+		}
+
 		overrides := g.process(root, packageTypes)
+		nodes := make([]ast.Node, 0, len(overrides))
+		for _, override := range overrides {
+			nodes = append(nodes, override)
+		}
+		imports := genutils.UsedImports(g.imports, nodes...)
 
 		fileNamePart := "parent_overrides"
 		if g.IsForPluginOverrides {
 			fileNamePart = "plugin_overrides"
 		}
 
-		genutils.WriteFormattedSourceFile(fileNamePart, ctx, root, func(buf *bytes.Buffer) {
-			buf.WriteString(`
-import (
-	attributes "github.com/devfile/api/v2/pkg/attributes"
-)
-
-`)
+		genutils.WriteFormattedSourceFile(fileNamePart, g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
+			genutils.WriteImports(buf, imports)
 			config.Fprint(buf, root.Fset, overrides)
 			buf.WriteString(`
 func (overrides ` + g.rootTypeToProcess.OverrideTypeName + `) isOverride() {}
@@ -216,8 +242,8 @@ func (g Generator) createOverride(newTypeToProcess typeToProcess, packageTypes m
 	}
 
 	overrideGenDecl := astcopy.GenDecl(typeToOverride.RawDecl)
-	if typeToOverride.Markers.Get(overridesTypeMarker.Name) != nil {
-		overrideGenDecl.Doc = updateComments(overrideGenDecl, overrideGenDecl.Doc, `.*`, ` *\+`+overridesTypeMarker.Name+` *`)
+	if typeToOverride.Markers.Get(TypeMarker.Name) != nil {
+		overrideGenDecl.Doc = updateComments(overrideGenDecl, overrideGenDecl.Doc, `.*`, ` *\+`+TypeMarker.Name+` *`)
 	}
 	if newTypeToProcess.DropEnumAnnotation {
 		overrideGenDecl.Doc = updateComments(
@@ -233,6 +259,12 @@ func (g Generator) createOverride(newTypeToProcess typeToProcess, packageTypes m
 		` *`+regexp.QuoteMeta("+devfile:getter:generate")+`.*`,
 	)
 
+	overrideGenDecl.Doc = updateComments(
+		overrideGenDecl, overrideGenDecl.Doc,
+		`.*`,
+		` *`+regexp.QuoteMeta("+devfile:stringer:generate")+`.*`,
+	)
+
 	overrideGenDecl.Doc = updateComments(
 		overrideGenDecl, overrideGenDecl.Doc,
 		`.*`,
@@ -265,8 +297,15 @@ func (g Generator) createOverride(newTypeToProcess typeToProcess, packageTypes m
 			if astField, isField := cursor.Node().(*ast.Field); isField {
 				if newTypeToProcess == g.rootTypeToProcess &&
 					cursor.Index() == 0 {
+					var overridesBase ast.Expr = &ast.Ident{Name: "OverridesBase"}
+					if g.devfileAPIQualifier != "" {
+						overridesBase = &ast.SelectorExpr{
+							X:   &ast.Ident{Name: g.devfileAPIQualifier},
+							Sel: &ast.Ident{Name: "OverridesBase"},
+						}
+					}
 					cursor.InsertBefore(&ast.Field{
-						Type: &ast.Ident{Name: "OverridesBase"},
+						Type: overridesBase,
 						Tag:  &ast.BasicLit{Kind: token.STRING, Value: "`json:\",inline\"`"},
 					})
 				}
@@ -330,6 +369,23 @@ func (g Generator) createOverride(newTypeToProcess typeToProcess, packageTypes m
 					`.*`,
 					` *`+regexp.QuoteMeta("+devfile:default:value")+` *=.*`,
 				)
+				astField.Doc = updateComments(
+					astField, astField.Doc,
+					`.*`,
+					` *`+regexp.QuoteMeta("+devfile:default:skip")+` *`,
+				)
+				astField.Doc = updateComments(
+					astField, astField.Doc,
+					`.*`,
+					` *`+regexp.QuoteMeta("+devfile:getter:generate")+` *`,
+				)
+
+				// Remove the quantity getters for overrides, since the overridden values are only merged, not used
+				astField.Doc = updateComments(
+					astField, astField.Doc,
+					`.*`,
+					` *`+regexp.QuoteMeta("+devfile:getter:quantity")+` *`,
+				)
 
 				processFieldType := func(ident *ast.Ident) *typeToProcess {
 					typeToOverride, existsInPackage := packageTypes[ident.Name]
@@ -343,6 +399,18 @@ func (g Generator) createOverride(newTypeToProcess typeToProcess, packageTypes m
 					}
 				}
 
+				// Fields whose type is defined in an imported package use the override type of this package, if any
+				processImportedFieldType := func(selector *ast.SelectorExpr) {
+					ident, isIdent := selector.X.(*ast.Ident)
+					if !isIdent {
+						return
+					}
+					if imported, exists := g.imports[ident.Name]; exists &&
+						imported.Scope().Lookup(selector.Sel.Name+g.suffix) != nil {
+						selector.Sel.Name = selector.Sel.Name + g.suffix
+					}
+				}
+
 				var fieldTypeToProcess *typeToProcess
 
 				switch fieldType := astField.Type.(type) {
@@ -353,8 +421,18 @@ func (g Generator) createOverride(newTypeToProcess typeToProcess, packageTypes m
 						if fieldTypeToProcess != nil {
 							fieldTypeToProcess.MandatoryKey = strings.Title(genutils.GetPatchMergeKey(&field))
 						}
+					case *ast.SelectorExpr:
+						processImportedFieldType(elementType)
 					}
+				case *ast.SelectorExpr:
+					processImportedFieldType(fieldType)
 				case *ast.Ident:
+					if g.isOptionalScalar(fieldType, field, newTypeToProcess) {
+						// Use a pointer, so that overriding with the zero value (such as an empty string or `false`)
+						// can be distinguished from not overriding the field at all
+						astField.Type = &ast.StarExpr{X: fieldType}
+						break
+					}
 					fieldTypeToProcess = processFieldType(fieldType)
 					if field.Markers.Get(genutils.UnionDiscriminatorMarker.Name) != nil {
 						enumValues := []string{}
@@ -380,6 +458,8 @@ func (g Generator) createOverride(newTypeToProcess typeToProcess, packageTypes m
 					switch elementType := fieldType.X.(type) {
 					case *ast.Ident:
 						fieldTypeToProcess = processFieldType(elementType)
+					case *ast.SelectorExpr:
+						processImportedFieldType(elementType)
 					}
 				case *ast.MapType:
 					switch elementType := fieldType.Key.(type) {
@@ -389,6 +469,8 @@ func (g Generator) createOverride(newTypeToProcess typeToProcess, packageTypes m
 					switch elementType := fieldType.Value.(type) {
 					case *ast.Ident:
 						fieldTypeToProcess = processFieldType(elementType)
+					case *ast.SelectorExpr:
+						processImportedFieldType(elementType)
 					}
 				default:
 				}
@@ -405,6 +487,34 @@ func (g Generator) createOverride(newTypeToProcess typeToProcess, packageTypes m
 	return overrideGenDecl, moreTypesToAdd, errors
 }
 
+// scalarTypes are the builtin types whose override fields are generated as pointers
+var scalarTypes = map[string]bool{
+	"string":  true,
+	"bool":    true,
+	"int":     true,
+	"int32":   true,
+	"int64":   true,
+	"float32": true,
+	"float64": true,
+}
+
+// isOptionalScalar returns true if the given field of the given type to process has a builtin scalar type,
+// and is optional in the override type.
+//
+// The mandatory key of list items, and the members of unions, keep their non-pointer type:
+// they are always set when overriding an element, or their presence is already driven by the union discriminator.
+func (g Generator) isOptionalScalar(fieldType *ast.Ident, field markers.FieldInfo, typeToProcess typeToProcess) bool {
+	if !scalarTypes[fieldType.Name] {
+		return false
+	}
+	if field.Name == typeToProcess.MandatoryKey ||
+		typeToProcess.TypeInfo.Markers.Get(genutils.UnionMarker.Name) != nil {
+		return false
+	}
+	jsonTag := field.Tag.Get("json")
+	return jsonTag != "" && jsonTag != "-" && !strings.Contains(jsonTag, ",inline")
+}
+
 // writeFormatted outputs the given code, after gofmt-ing it.  If we couldn't gofmt,
 // we write the unformatted code for debugging purposes.
 func (g Generator) writeOut(ctx *genall.GenerationContext, root *loader.Package, outBytes []byte) {
@@ -39,14 +39,18 @@ func (Generator) Help() *markers.DefinitionHelp {
 	return &markers.DefinitionHelp{
 		Category: "",
 		DetailedHelp: markers.DetailedHelp{
-			Summary: "generates additional GO code for the overriding of elements in devfile parent or plugins.",
-			Details: "",
+			Summary: "generates additional GO code for the overriding of elements in devfile parent or plugins. ",
+			Details: "It can also process the packages of downstream modules that embed devfile types: in this case, the generated types are based on the `OverridesBase` type of the devfile API, and fields whose type is defined in an imported package that provides the corresponding override type (such as `v1alpha2.ContainerComponentParentOverride`) use this override type. \n The optional fields of builtin scalar types (such as `string`, `bool` or `int`) are generated as pointers, so that overriding a field with its zero value (such as an empty `memoryLimit`) is distinguished from not overriding it.",
 		},
 		FieldHelp: map[string]markers.DetailedHelp{
 			"IsForPluginOverrides": {
 				Summary: "indicates that the generated code should be done for plugin overrides. When false, the parent overrides are generated",
 				Details: "",
 			},
+			"HeaderFile": {
+				Summary: "specifies the header text (e.g. license) to prepend to generated files.",
+				Details: "",
+			},
 			"suffix": {
 				Summary: "",
 				Details: "",
@@ -55,6 +59,14 @@ func (Generator) Help() *markers.DefinitionHelp {
 				Summary: "",
 				Details: "",
 			},
+			"imports": {
+				Summary: "contains the packages imported by the processed package, indexed by the name under which they are imported",
+				Details: "",
+			},
+			"devfileAPIQualifier": {
+				Summary: "is the name under which the processed package imports the devfile API package, or is empty if the processed package is the devfile API package itself",
+				Details: "",
+			},
 		},
 	}
 }
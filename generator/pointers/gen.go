@@ -0,0 +1,565 @@
+package pointers
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/generator/genutils"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+var (
+	// PointerTypeMarker is associated with a type for which the `GetByPointer()` and `SetByPointer()` methods should be generated
+	PointerTypeMarker = markers.Must(markers.MakeDefinition("devfile:pointer:generate", markers.DescribesType, struct{}{}))
+)
+
+// devfileErrorsPackage is the import path of the package of the sentinel errors wrapped by the generated code
+const devfileErrorsPackage = "github.com/devfile/api/v2/pkg/errors"
+
+// +controllertools:marker:generateHelp
+
+// Generator generates `GetByPointer()` and `SetByPointer()` methods for the `devfile:pointer:generate` annotated types,
+// that get and set the value designated by a JSON pointer (RFC 6901), such as `/components/3/container/image`,
+// with typed accessors generated for each type reachable from the annotated types, instead of evaluating the pointer by reflection.
+//
+// Pointer tokens are the Json names of the fields, with the fields of the embedded types inlined,
+// the indexes of the list elements, and the keys of the map entries.
+// The types that are not defined in the devfile API module, such as `resource.Quantity`, are accessed as a whole.
+type Generator struct {
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	if err := markers.RegisterAll(into, PointerTypeMarker); err != nil {
+		return err
+	}
+	into.AddHelp(PointerTypeMarker,
+		markers.SimpleHelp("Devfile", "indicates that the GetByPointer() and SetByPointer() methods, that access the value designated by a JSON pointer, should be generated for this type"))
+	return nil
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// Generate generates the artifacts
+func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	for _, root := range ctx.Roots {
+		ctx.Checker.Check(root)
+		root.NeedTypesInfo()
+
+		var pointerTypes []*markers.TypeInfo
+		if err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
+			if info.Markers.Get(PointerTypeMarker.Name) != nil {
+				pointerTypes = append(pointerTypes, info)
+			}
+		}); err != nil {
+			root.AddError(err)
+			return nil
+		}
+		if len(pointerTypes) == 0 {
+			continue
+		}
+
+		writer := &pointerWriter{
+			pkg:         root.Types,
+			rootImports: genutils.PackageImports(root),
+			namesByPath: map[string]string{
+				"fmt":                "fmt",
+				"strconv":            "strconv",
+				"strings":            "strings",
+				devfileErrorsPackage: "devfileerrors",
+			},
+			accessors: map[string]types.Type{},
+		}
+		methods := new(bytes.Buffer)
+		for _, info := range pointerTypes {
+			typeName, isTypeName := root.Types.Scope().Lookup(info.Name).(*types.TypeName)
+			if !isTypeName {
+				continue
+			}
+			if _, isStruct := typeName.Type().Underlying().(*types.Struct); !isStruct {
+				root.AddError(fmt.Errorf("the devfile:pointer:generate marker is specified on type %s, which is not a struct", info.Name))
+				continue
+			}
+			writer.writeMethods(methods, info.Name, writer.accessor(typeName.Type()))
+		}
+		accessors := new(bytes.Buffer)
+		for len(writer.queue) > 0 {
+			next := writer.queue[0]
+			writer.queue = writer.queue[1:]
+			writer.writeAccessors(accessors, next)
+		}
+		if len(writer.errors) > 0 {
+			for _, err := range writer.errors {
+				root.AddError(err)
+			}
+			continue
+		}
+
+		genutils.WriteFormattedSourceFile("pointers", g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
+			genutils.WriteImports(buf, writer.namesByPath)
+			buf.Write(methods.Bytes())
+			buf.Write(accessors.Bytes())
+			buf.WriteString(pointerHelpers)
+		})
+	}
+
+	return nil
+}
+
+// pointerWriter writes the JSON pointer accessors of the types of a package
+type pointerWriter struct {
+	pkg *types.Package
+	// rootImports contains the packages imported by the Go files of the package, indexed by the name under which they are imported
+	rootImports map[string]*types.Package
+	// namesByPath contains the names of the packages imported by the generated code, indexed by import path
+	namesByPath map[string]string
+	// accessors contains the types whose accessors are generated, indexed by the suffix of the names of their accessors
+	accessors map[string]types.Type
+	// queue contains the types whose accessors remain to be written
+	queue  []types.Type
+	errors []error
+}
+
+// qualifier returns the name under which the generated code refers to the given package,
+// which is the name under which the package is imported in the Go files of the generated package, if any
+func (w *pointerWriter) qualifier(pkg *types.Package) string {
+	if pkg.Path() == w.pkg.Path() {
+		return ""
+	}
+	if name, known := w.namesByPath[pkg.Path()]; known {
+		return name
+	}
+	name := pkg.Name()
+	var importNames []string
+	for importName, imported := range w.rootImports {
+		if imported.Path() == pkg.Path() {
+			importNames = append(importNames, importName)
+		}
+	}
+	if len(importNames) > 0 {
+		sort.Strings(importNames)
+		name = importNames[0]
+	}
+	base := name
+	for i := 2; w.isImportName(name); i++ {
+		name = base + strconv.Itoa(i)
+	}
+	w.namesByPath[pkg.Path()] = name
+	return name
+}
+
+// isImportName returns true if the given name is already used by a package imported by the generated code
+func (w *pointerWriter) isImportName(name string) bool {
+	for _, used := range w.namesByPath {
+		if used == name {
+			return true
+		}
+	}
+	return false
+}
+
+// typeString returns the Go expression of the given type in the generated code
+func (w *pointerWriter) typeString(goType types.Type) string {
+	return types.TypeString(goType, w.qualifier)
+}
+
+// accessor returns the suffix of the names of the accessors of the given type, such as `Component` or `EnvVarList`,
+// and queues the writing of these accessors if needed
+func (w *pointerWriter) accessor(goType types.Type) string {
+	suffix := w.accessorSuffix(goType)
+	if suffix == "" {
+		w.errors = append(w.errors, fmt.Errorf("type %s is not supported by JSON pointers", w.typeString(goType)))
+		return ""
+	}
+	if known, exists := w.accessors[suffix]; exists {
+		if !types.Identical(known, goType) {
+			w.errors = append(w.errors, fmt.Errorf("types %s and %s have the same JSON pointer accessor names", w.typeString(known), w.typeString(goType)))
+		}
+		return suffix
+	}
+	w.accessors[suffix] = goType
+	w.queue = append(w.queue, goType)
+	return suffix
+}
+
+// accessorSuffix returns the suffix of the names of the accessors of the given type,
+// or an empty string if the type is not supported
+func (w *pointerWriter) accessorSuffix(goType types.Type) string {
+	switch t := goType.(type) {
+	case *types.Named:
+		if t.Obj().Pkg() == nil || t.Obj().Pkg().Path() == w.pkg.Path() {
+			return t.Obj().Name()
+		}
+		return strings.Title(w.qualifier(t.Obj().Pkg())) + t.Obj().Name()
+	case *types.Basic:
+		return strings.Title(t.Name())
+	case *types.Pointer:
+		if elem := w.accessorSuffix(t.Elem()); elem != "" {
+			return elem + "Pointer"
+		}
+	case *types.Slice:
+		if elem := w.accessorSuffix(t.Elem()); elem != "" {
+			return elem + "List"
+		}
+	case *types.Map:
+		if elem := w.accessorSuffix(t.Elem()); elem != "" {
+			return elem + "Map"
+		}
+	}
+	return ""
+}
+
+// valueKind is the way the values of a type are traversed by JSON pointers
+type valueKind int
+
+const (
+	// leafKind values are accessed as a whole
+	leafKind valueKind = iota
+	structKind
+	listKind
+	mapKind
+	pointerKind
+)
+
+// kindOf returns the way the values of the given type are traversed by JSON pointers
+func kindOf(goType types.Type) valueKind {
+	switch t := goType.Underlying().(type) {
+	case *types.Pointer:
+		return pointerKind
+	case *types.Struct:
+		if named, isNamed := goType.(*types.Named); isNamed && isAPIType(named) {
+			return structKind
+		}
+	case *types.Slice:
+		if basic, isBasic := t.Elem().Underlying().(*types.Basic); !isBasic || basic.Kind() != types.Byte {
+			return listKind
+		}
+	case *types.Map:
+		if basic, isBasic := t.Key().Underlying().(*types.Basic); isBasic && basic.Kind() == types.String {
+			return mapKind
+		}
+	}
+	return leafKind
+}
+
+// isAPIType returns true if the given type is defined in the devfile API module
+func isAPIType(named *types.Named) bool {
+	return named.Obj().Pkg() != nil && strings.HasPrefix(named.Obj().Pkg().Path(), "github.com/devfile/api/")
+}
+
+// writeMethods writes the exported `GetByPointer()` and `SetByPointer()` methods of the given type
+func (w *pointerWriter) writeMethods(buf *bytes.Buffer, typeName string, accessor string) {
+	fmt.Fprintf(buf, `
+
+// GetByPointer returns the value designated by the given JSON pointer (RFC 6901), such as `+"`/components/3/container/image`"+`,
+// with the GO type of the designated field, such as string, *bool or []Component. Pointer fields are returned as is, even if they are nil.
+// The returned error wraps ErrNotFound if the designated value doesn't exist.
+func (in *%[1]s) GetByPointer(pointer string) (interface{}, error) {
+	tokens, err := parseJSONPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	value, err := getByPointer%[2]s(in, tokens)
+	if err != nil {
+		return nil, fmt.Errorf("JSON pointer %%q: %%w", pointer, err)
+	}
+	return value, nil
+}
+
+// SetByPointer sets the value designated by the given JSON pointer (RFC 6901), such as `+"`/components/3/container/image`"+`.
+// The value should have the GO type of the designated field, but the values of named types with a basic underlying type,
+// such as EndpointExposure, can also be given as their underlying type, and the values of pointer fields as the pointed-to type or nil.
+// The `+"`-`"+` token designates the end of a list, to append an element to it.
+// Unset pointers and missing map entries along the path are created, but union discriminators are not updated.
+// The returned error wraps ErrNotFound if a list element or a field along the path doesn't exist, and the value is left unchanged if an error is returned.
+func (in *%[1]s) SetByPointer(pointer string, value interface{}) error {
+	tokens, err := parseJSONPointer(pointer)
+	if err != nil {
+		return err
+	}
+	if err := setByPointer%[2]s(in, tokens, value); err != nil {
+		return fmt.Errorf("JSON pointer %%q: %%w", pointer, err)
+	}
+	return nil
+}`, typeName, accessor)
+}
+
+// writeAccessors writes the unexported accessors of the given type
+func (w *pointerWriter) writeAccessors(buf *bytes.Buffer, goType types.Type) {
+	suffix := w.accessorSuffix(goType)
+	typeString := w.typeString(goType)
+	switch kindOf(goType) {
+	case structKind:
+		w.writeStructAccessors(buf, goType, suffix, typeString)
+	case listKind:
+		elem := goType.Underlying().(*types.Slice).Elem()
+		fmt.Fprintf(buf, `
+
+func getByPointer%[1]s(in *%[2]s, tokens []string) (interface{}, error) {
+	if len(tokens) == 0 {
+		return *in, nil
+	}
+	index, err := pointerListIndex(tokens[0], len(*in))
+	if err != nil {
+		return nil, err
+	}
+	return getByPointer%[3]s(&(*in)[index], tokens[1:])
+}
+
+func setByPointer%[1]s(in *%[2]s, tokens []string, value interface{}) error {
+	if len(tokens) == 0 {`, suffix, typeString, w.accessor(elem))
+		w.writeAssignment(buf, goType, typeString)
+		fmt.Fprintf(buf, `
+	}
+	if tokens[0] == "-" && len(tokens) == 1 {
+		var element %[1]s
+		if err := setByPointer%[2]s(&element, nil, value); err != nil {
+			return err
+		}
+		*in = append(*in, element)
+		return nil
+	}
+	index, err := pointerListIndex(tokens[0], len(*in))
+	if err != nil {
+		return err
+	}
+	return setByPointer%[2]s(&(*in)[index], tokens[1:], value)
+}`, w.typeString(elem), w.accessor(elem))
+	case mapKind:
+		elem := goType.Underlying().(*types.Map).Elem()
+		fmt.Fprintf(buf, `
+
+func getByPointer%[1]s(in *%[2]s, tokens []string) (interface{}, error) {
+	if len(tokens) == 0 {
+		return *in, nil
+	}
+	element, found := (*in)[tokens[0]]
+	if !found {
+		return nil, fmt.Errorf("%%w: no entry %%q", devfileerrors.ErrNotFound, tokens[0])
+	}
+	return getByPointer%[3]s(&element, tokens[1:])
+}
+
+func setByPointer%[1]s(in *%[2]s, tokens []string, value interface{}) error {
+	if len(tokens) == 0 {`, suffix, typeString, w.accessor(elem))
+		w.writeAssignment(buf, goType, typeString)
+		fmt.Fprintf(buf, `
+	}
+	element := (*in)[tokens[0]]
+	if err := setByPointer%[1]s(&element, tokens[1:], value); err != nil {
+		return err
+	}
+	if *in == nil {
+		*in = make(%[2]s)
+	}
+	(*in)[tokens[0]] = element
+	return nil
+}`, w.accessor(elem), typeString)
+	case pointerKind:
+		elem := goType.Underlying().(*types.Pointer).Elem()
+		elemString := w.typeString(elem)
+		fmt.Fprintf(buf, `
+
+func getByPointer%[1]s(in *%[2]s, tokens []string) (interface{}, error) {
+	if len(tokens) == 0 {
+		return *in, nil
+	}
+	if *in == nil {
+		return nil, pointerFieldNotFound(tokens[0])
+	}
+	return getByPointer%[3]s(*in, tokens)
+}
+
+func setByPointer%[1]s(in *%[2]s, tokens []string, value interface{}) error {
+	if len(tokens) == 0 {
+		switch v := value.(type) {
+		case %[2]s:
+			*in = v
+		case %[4]s:
+			*in = &v
+		case nil:
+			*in = nil
+		default:
+			return pointerTypeError(value, %[5]q)
+		}
+		return nil
+	}
+	element := *in
+	if element == nil {
+		element = new(%[4]s)
+	}
+	if err := setByPointer%[3]s(element, tokens, value); err != nil {
+		return err
+	}
+	*in = element
+	return nil
+}`, suffix, typeString, w.accessor(elem), elemString, typeString)
+	default:
+		fmt.Fprintf(buf, `
+
+func getByPointer%[1]s(in *%[2]s, tokens []string) (interface{}, error) {
+	if len(tokens) > 0 {
+		return nil, pointerFieldNotFound(tokens[0])
+	}
+	return *in, nil
+}
+
+func setByPointer%[1]s(in *%[2]s, tokens []string, value interface{}) error {
+	if len(tokens) > 0 {
+		return pointerFieldNotFound(tokens[0])
+	}`, suffix, typeString)
+		w.writeAssignment(buf, goType, typeString)
+		buf.WriteString(`
+}`)
+	}
+}
+
+// writeStructAccessors writes the unexported accessors of the given struct type,
+// that dispatch the first token to the accessors of the field with this Json name
+func (w *pointerWriter) writeStructAccessors(buf *bytes.Buffer, goType types.Type, suffix string, typeString string) {
+	fields := w.jsonFields(goType.Underlying().(*types.Struct), "in", typeString)
+	fmt.Fprintf(buf, `
+
+func getByPointer%[1]s(in *%[2]s, tokens []string) (interface{}, error) {
+	if len(tokens) == 0 {
+		return *in, nil
+	}
+	switch tokens[0] {`, suffix, typeString)
+	for _, field := range fields {
+		fmt.Fprintf(buf, `
+	case %q:
+		return getByPointer%s(&%s, tokens[1:])`, field.jsonName, w.accessor(field.goType), field.expr)
+	}
+	fmt.Fprintf(buf, `
+	}
+	return nil, pointerFieldNotFound(tokens[0])
+}
+
+func setByPointer%[1]s(in *%[2]s, tokens []string, value interface{}) error {
+	if len(tokens) == 0 {`, suffix, typeString)
+	w.writeAssignment(buf, goType, typeString)
+	buf.WriteString(`
+	}
+	switch tokens[0] {`)
+	for _, field := range fields {
+		fmt.Fprintf(buf, `
+	case %q:
+		return setByPointer%s(&%s, tokens[1:], value)`, field.jsonName, w.accessor(field.goType), field.expr)
+	}
+	buf.WriteString(`
+	}
+	return pointerFieldNotFound(tokens[0])
+}`)
+}
+
+// writeAssignment writes the statements that assign the `value` variable to `*in`, and return,
+// or return an error if it doesn't have the given type or, for named types with a basic underlying type, this underlying type
+func (w *pointerWriter) writeAssignment(buf *bytes.Buffer, goType types.Type, typeString string) {
+	fmt.Fprintf(buf, `
+	switch v := value.(type) {
+	case %s:
+		*in = v`, typeString)
+	if basic, isBasic := goType.Underlying().(*types.Basic); isBasic && !types.Identical(basic, goType) {
+		fmt.Fprintf(buf, `
+	case %s:
+		*in = %s(v)`, basic.Name(), typeString)
+	}
+	fmt.Fprintf(buf, `
+	default:
+		return pointerTypeError(value, %q)
+	}
+	return nil`, typeString)
+}
+
+// jsonField is a field of a struct, as it appears in the Json serialization
+type jsonField struct {
+	jsonName string
+	// expr is the Go expression of the field, relative to the `in` struct pointer
+	expr   string
+	goType types.Type
+}
+
+// jsonFields returns the fields of the given struct, whose value is given by the `expr` expression,
+// with the fields of the embedded structs inlined, as they appear in the Json serialization
+func (w *pointerWriter) jsonFields(structType *types.Struct, expr string, typeString string) []jsonField {
+	var fields []jsonField
+	for i := 0; i < structType.NumFields(); i++ {
+		field := structType.Field(i)
+		if !field.Exported() {
+			continue
+		}
+		jsonName := strings.Split(reflect.StructTag(structType.Tag(i)).Get("json"), ",")[0]
+		if jsonName == "-" {
+			continue
+		}
+		if jsonName == "" && field.Embedded() {
+			embedded, isStruct := field.Type().Underlying().(*types.Struct)
+			if !isStruct {
+				w.errors = append(w.errors, fmt.Errorf("embedded field %s of type %s is not supported by JSON pointers", field.Name(), typeString))
+				continue
+			}
+			fields = append(fields, w.jsonFields(embedded, expr+"."+field.Name(), typeString)...)
+			continue
+		}
+		if jsonName == "" {
+			jsonName = field.Name()
+		}
+		fields = append(fields, jsonField{jsonName: jsonName, expr: expr + "." + field.Name(), goType: field.Type()})
+	}
+	return fields
+}
+
+// pointerHelpers are the helper functions used by the generated accessors
+const pointerHelpers = `
+
+// parseJSONPointer returns the unescaped reference tokens of the given JSON pointer.
+// The empty pointer designates the whole value, and has no tokens.
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q: it should start with a slash", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// pointerListIndex returns the list index designated by the given token, if it designates an element of a list of the given length
+func pointerListIndex(token string, length int) (int, error) {
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || index >= length || strconv.Itoa(index) != token {
+		return 0, fmt.Errorf("%w: no list element %q", devfileerrors.ErrNotFound, token)
+	}
+	return index, nil
+}
+
+func pointerFieldNotFound(token string) error {
+	return fmt.Errorf("%w: no field %q", devfileerrors.ErrNotFound, token)
+}
+
+func pointerTypeError(value interface{}, expected string) error {
+	return fmt.Errorf("cannot set a %T value where a %s value is expected", value, expected)
+}
+`
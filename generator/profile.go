@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+)
+
+// startProfiling starts writing the CPU profile of the run into the given file, if any.
+// It returns a function to call at the end of the run, which stops the CPU profiling
+// and writes the heap profile into the given file, if any.
+//
+// The profiles can be analyzed with `go tool pprof build/generator <profile>`.
+// The heap profile also records the allocations of the whole run, which are displayed with the `-sample_index=alloc_space` option.
+func startProfiling(cpuProfile, memProfile string) (stop func() error, err error) {
+	var cpuProfileFile *os.File
+	if cpuProfile != "" {
+		if cpuProfileFile, err = os.Create(cpuProfile); err != nil {
+			return nil, fmt.Errorf("cannot create the CPU profile: %w", err)
+		}
+		if err := pprof.StartCPUProfile(cpuProfileFile); err != nil {
+			cpuProfileFile.Close()
+			return nil, fmt.Errorf("cannot start the CPU profiling: %w", err)
+		}
+	}
+	return func() error {
+		if cpuProfileFile != nil {
+			pprof.StopCPUProfile()
+			if err := cpuProfileFile.Close(); err != nil {
+				return fmt.Errorf("cannot write the CPU profile: %w", err)
+			}
+		}
+		if memProfile != "" {
+			return writeHeapProfile(memProfile)
+		}
+		return nil
+	}, nil
+}
+
+// writeHeapProfile writes the heap profile of the run into the given file
+func writeHeapProfile(memProfile string) error {
+	memProfileFile, err := os.Create(memProfile)
+	if err != nil {
+		return fmt.Errorf("cannot create the memory profile: %w", err)
+	}
+	defer memProfileFile.Close()
+	// get up-to-date statistics of the memory in use
+	runtime.GC()
+	if err := pprof.WriteHeapProfile(memProfileFile); err != nil {
+		return fmt.Errorf("cannot write the memory profile: %w", err)
+	}
+	return memProfileFile.Close()
+}
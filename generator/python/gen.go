@@ -0,0 +1,202 @@
+package python
+
+import (
+	"fmt"
+	"go/ast"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/generator/genutils"
+	"github.com/devfile/api/generator/schemas"
+	"github.com/iancoleman/strcase"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+// pythonIdentifier matches the valid Python identifiers
+var pythonIdentifier = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// reservedNames are the names that cannot be used as model attribute names as-is:
+// Python keywords, and attributes of the pydantic `BaseModel` class.
+var reservedNames = map[string]bool{
+	"and": true, "as": true, "assert": true, "async": true, "await": true, "break": true, "class": true,
+	"continue": true, "def": true, "del": true, "elif": true, "else": true, "except": true, "finally": true,
+	"for": true, "from": true, "global": true, "if": true, "import": true, "in": true, "is": true,
+	"lambda": true, "nonlocal": true, "not": true, "or": true, "pass": true, "raise": true, "return": true,
+	"try": true, "while": true, "with": true, "yield": true, "None": true, "True": true, "False": true,
+	"copy": true, "dict": true, "json": true, "schema": true, "construct": true, "validate": true,
+}
+
+// +controllertools:marker:generateHelp
+
+// Generator generates typed Python models (pydantic v2) from the GO source code of the Kubernetes API
+//
+// A pydantic model is generated for each GO structure reachable from the types that have the `devfile:jsonschema:generate` annotation,
+// with snake-case attribute names aliased to the Json field names, and embedded structures inlined.
+// A Python `Enum` is generated for each type that has the `kubebuilder:validation:Enum` annotation, such as union discriminators.
+// A `<package>.py` module is generated for each K8S API version.
+type Generator struct{}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	if err := schemas.RegisterGenerateMarker(into); err != nil {
+		return err
+	}
+	return genutils.RegisterTypeModelMarkers(into)
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// Generate generates the artifacts
+func (Generator) Generate(ctx *genall.GenerationContext) error {
+	for _, root := range ctx.Roots {
+		model, err := genutils.BuildTypeModel(ctx, root, schemas.HasGenerateMarker)
+		if err != nil {
+			root.AddError(err)
+			continue
+		}
+		if len(model.Objects) == 0 {
+			continue
+		}
+
+		content, err := render(model)
+		if err != nil {
+			root.AddError(err)
+			continue
+		}
+		genutils.WriteGeneratedArtifact(ctx, root, root.Name+".py", content)
+	}
+	return nil
+}
+
+// render returns the Python module of the given type model
+func render(model *genutils.TypeModel) (string, error) {
+	var module strings.Builder
+	module.WriteString("# " + strings.TrimPrefix(genutils.GeneratedFileBanner, "// ") + "\n")
+	module.WriteString(`
+from __future__ import annotations
+
+from enum import Enum
+from typing import Any, Dict, List, Optional
+
+from pydantic import BaseModel, ConfigDict, Field
+`)
+
+	for _, enum := range model.Enums {
+		if err := writeEnum(&module, enum); err != nil {
+			return "", err
+		}
+	}
+
+	var classNames []string
+	for _, object := range model.Objects {
+		if !object.Referenced {
+			continue
+		}
+		if err := writeObject(&module, model, object); err != nil {
+			return "", err
+		}
+		classNames = append(classNames, object.Name)
+	}
+
+	// Resolve the forward references between models
+	module.WriteString("\n\n")
+	for _, className := range classNames {
+		module.WriteString(className + ".model_rebuild()\n")
+	}
+	return module.String(), nil
+}
+
+func writeDocString(module *strings.Builder, indent string, doc string) {
+	doc = strings.TrimSpace(doc)
+	if doc == "" {
+		return
+	}
+	doc = strings.ReplaceAll(doc, `\`, `\\`)
+	doc = strings.ReplaceAll(doc, `"""`, `\"\"\"`)
+	module.WriteString(indent + `"""` + "\n")
+	for _, line := range strings.Split(doc, "\n") {
+		if line == "" {
+			module.WriteString("\n")
+			continue
+		}
+		module.WriteString(indent + line + "\n")
+	}
+	module.WriteString(indent + `"""` + "\n\n")
+}
+
+func writeEnum(module *strings.Builder, enum *genutils.EnumType) error {
+	module.WriteString("\n\nclass " + enum.Name + "(str, Enum):\n")
+	writeDocString(module, "    ", enum.Doc)
+	for _, value := range enum.Values {
+		memberName := strcase.ToScreamingSnake(value)
+		if !pythonIdentifier.MatchString(memberName) {
+			memberName = "_" + memberName
+		}
+		if !pythonIdentifier.MatchString(memberName) {
+			return fmt.Errorf("value %q of enum %s cannot be converted to a valid Python enum member name", value, enum.Name)
+		}
+		module.WriteString("    " + memberName + " = " + strconv.Quote(value) + "\n")
+	}
+	return nil
+}
+
+func writeObject(module *strings.Builder, model *genutils.TypeModel, object *genutils.ObjectType) error {
+	module.WriteString("\n\nclass " + object.Name + "(BaseModel):\n")
+	writeDocString(module, "    ", object.Doc)
+	module.WriteString("    model_config = ConfigDict(populate_by_name=True)\n")
+
+	for _, field := range model.InlinedFields(object) {
+		attributeName := strcase.ToSnake(field.JSONName)
+		if reservedNames[attributeName] {
+			attributeName += "_"
+		}
+		if !pythonIdentifier.MatchString(attributeName) || strings.HasPrefix(attributeName, "model_") {
+			return fmt.Errorf("field %s of type %s has Json name %q, which cannot be converted to a valid Python attribute name", field.GoName, object.Name, field.JSONName)
+		}
+
+		fieldType := typeName(field.Type)
+		fieldArgs := []string{"alias=" + strconv.Quote(field.JSONName)}
+		if field.Optional {
+			fieldType = "Optional[" + fieldType + "]"
+			fieldArgs = append([]string{"default=None"}, fieldArgs...)
+		}
+		if doc := strings.TrimSpace(field.Doc); doc != "" {
+			fieldArgs = append(fieldArgs, "description="+strconv.Quote(doc))
+		}
+		module.WriteString("\n    " + attributeName + ": " + fieldType + " = Field(" + strings.Join(fieldArgs, ", ") + ")\n")
+	}
+	return nil
+}
+
+// typeName returns the Python type annotation of the given model type
+func typeName(typeRef *genutils.TypeRef) string {
+	switch typeRef.Kind {
+	case genutils.StringKind:
+		return "str"
+	case genutils.IntKind:
+		return "int"
+	case genutils.FloatKind:
+		return "float"
+	case genutils.BoolKind:
+		return "bool"
+	case genutils.ObjectKind, genutils.EnumKind:
+		return typeRef.Name
+	case genutils.ListKind:
+		return "List[" + typeName(typeRef.Elem) + "]"
+	case genutils.MapKind:
+		return "Dict[str, " + typeName(typeRef.Elem) + "]"
+	default:
+		return "Any"
+	}
+}
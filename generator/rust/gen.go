@@ -0,0 +1,296 @@
+package rust
+
+import (
+	"fmt"
+	"go/ast"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/generator/genutils"
+	"github.com/devfile/api/generator/schemas"
+	"github.com/iancoleman/strcase"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+// derives are the traits derived by all the generated Rust types
+const derives = "#[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]"
+
+// rustIdentifier matches the valid Rust identifiers
+var rustIdentifier = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// rustKeywords are the Rust keywords, that must be written as raw identifiers when used as field names
+var rustKeywords = map[string]bool{
+	"as": true, "async": true, "await": true, "break": true, "const": true, "continue": true, "crate": true,
+	"dyn": true, "else": true, "enum": true, "extern": true, "false": true, "fn": true, "for": true, "if": true,
+	"impl": true, "in": true, "let": true, "loop": true, "match": true, "mod": true, "move": true, "mut": true,
+	"pub": true, "ref": true, "return": true, "static": true, "struct": true, "trait": true, "true": true,
+	"type": true, "unsafe": true, "use": true, "where": true, "while": true, "abstract": true, "become": true,
+	"box": true, "do": true, "final": true, "macro": true, "override": true, "priv": true, "try": true,
+	"typeof": true, "unsized": true, "virtual": true, "yield": true,
+}
+
+// +controllertools:marker:generateHelp
+
+// Generator generates serde-annotated Rust structs from the GO source code of the Kubernetes API
+//
+// A Rust struct is generated for each GO structure reachable from the types that have the `devfile:jsonschema:generate` annotation,
+// with embedded structures inlined, and a Rust enum is generated for each type that has the `kubebuilder:validation:Enum` annotation.
+// K8S unions are generated as externally-tagged Rust enums, whose variants are the union members, and which are flattened
+// in the structs that embed them: this matches the Json serialization of the union, in which the member field name is the tag.
+// A `<package>.rs` module is generated for each K8S API version.
+type Generator struct{}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	if err := schemas.RegisterGenerateMarker(into); err != nil {
+		return err
+	}
+	return genutils.RegisterTypeModelMarkers(into)
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// Generate generates the artifacts
+func (Generator) Generate(ctx *genall.GenerationContext) error {
+	for _, root := range ctx.Roots {
+		model, err := genutils.BuildTypeModel(ctx, root, schemas.HasGenerateMarker)
+		if err != nil {
+			root.AddError(err)
+			continue
+		}
+		if len(model.Objects) == 0 {
+			continue
+		}
+
+		content, err := render(model)
+		if err != nil {
+			root.AddError(err)
+			continue
+		}
+		genutils.WriteGeneratedArtifact(ctx, root, root.Name+".rs", content)
+	}
+	return nil
+}
+
+// rustField is a field of a generated Rust struct
+type rustField struct {
+	name     string
+	jsonName string
+	doc      string
+	rustType string
+	optional bool
+	// flatten indicates that the field is a flattened union enum
+	flatten bool
+}
+
+// moduleWriter writes the Rust module of the types of a type model
+type moduleWriter struct {
+	strings.Builder
+	model *genutils.TypeModel
+	// usesMaps indicates that the `BTreeMap` type is used in the module
+	usesMaps bool
+}
+
+// render returns the Rust module of the given type model
+func render(model *genutils.TypeModel) (string, error) {
+	module := &moduleWriter{model: model}
+	for _, enum := range model.Enums {
+		if err := module.writeEnum(enum); err != nil {
+			return "", err
+		}
+	}
+	for _, object := range model.Objects {
+		switch {
+		case object.Union != nil && object.Referenced:
+			return "", fmt.Errorf("union %s is used as the type of a field: only embedded unions are supported", object.Name)
+		case object.Union != nil:
+			if err := module.writeUnion(object); err != nil {
+				return "", err
+			}
+		case object.Referenced:
+			if err := module.writeStruct(object); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	header := genutils.GeneratedFileBanner + "\n\nuse serde::{Deserialize, Serialize};\n"
+	if module.usesMaps {
+		header += "use std::collections::BTreeMap;\n"
+	}
+	return header + module.String(), nil
+}
+
+func (w *moduleWriter) writeDoc(indent string, doc string) {
+	doc = strings.TrimSpace(doc)
+	if doc == "" {
+		return
+	}
+	for _, line := range strings.Split(doc, "\n") {
+		w.WriteString(strings.TrimRight(indent+"/// "+line, " ") + "\n")
+	}
+}
+
+func (w *moduleWriter) writeEnum(enum *genutils.EnumType) error {
+	w.WriteString("\n")
+	w.writeDoc("", enum.Doc)
+	w.WriteString(derives + "\n")
+	w.WriteString("pub enum " + enum.Name + " {\n")
+	for _, value := range enum.Values {
+		variantName := strcase.ToCamel(value)
+		if !rustIdentifier.MatchString(variantName) {
+			return fmt.Errorf("value %q of enum %s cannot be converted to a valid Rust enum variant name", value, enum.Name)
+		}
+		w.WriteString("    #[serde(rename = " + strconv.Quote(value) + ")]\n")
+		w.WriteString("    " + variantName + ",\n")
+	}
+	w.WriteString("}\n")
+	return nil
+}
+
+// writeUnion writes a K8S union as an externally-tagged Rust enum
+func (w *moduleWriter) writeUnion(object *genutils.ObjectType) error {
+	w.WriteString("\n")
+	w.writeDoc("", object.Doc)
+	w.WriteString(derives + "\n")
+	w.WriteString("pub enum " + object.Name + " {\n")
+	for _, member := range object.Union.Members {
+		variantName := strcase.ToCamel(member.JSONName)
+		if !rustIdentifier.MatchString(variantName) {
+			return fmt.Errorf("member %s of union %s has Json name %q, which cannot be converted to a valid Rust enum variant name", member.GoName, object.Name, member.JSONName)
+		}
+		w.writeDoc("    ", member.Doc)
+		w.WriteString("    #[serde(rename = " + strconv.Quote(member.JSONName) + ")]\n")
+		w.WriteString("    " + variantName + "(" + w.typeName(member.Type) + "),\n")
+	}
+	w.WriteString("}\n")
+	return nil
+}
+
+func (w *moduleWriter) writeStruct(object *genutils.ObjectType) error {
+	fields, err := w.structFields(object)
+	if err != nil {
+		return err
+	}
+
+	w.WriteString("\n")
+	w.writeDoc("", object.Doc)
+	w.WriteString(derives + "\n")
+	w.WriteString("pub struct " + object.Name + " {\n")
+	for i, field := range fields {
+		if i > 0 {
+			w.WriteString("\n")
+		}
+		w.writeDoc("    ", field.doc)
+		switch {
+		case field.flatten:
+			w.WriteString("    #[serde(flatten)]\n")
+		case field.optional:
+			w.WriteString("    #[serde(rename = " + strconv.Quote(field.jsonName) + ", default, skip_serializing_if = \"Option::is_none\")]\n")
+		default:
+			w.WriteString("    #[serde(rename = " + strconv.Quote(field.jsonName) + ")]\n")
+		}
+		fieldType := field.rustType
+		if field.optional {
+			fieldType = "Option<" + fieldType + ">"
+		}
+		w.WriteString("    pub " + field.name + ": " + fieldType + ",\n")
+	}
+	w.WriteString("}\n")
+	return nil
+}
+
+// structFields returns the fields of the Rust struct generated for the given object type.
+//
+// Embedded object types are inlined, apart from unions, which are replaced by their discriminator field,
+// followed by a flattened field of the union enum type.
+func (w *moduleWriter) structFields(object *genutils.ObjectType) ([]rustField, error) {
+	var fields []rustField
+	for _, field := range object.Fields {
+		if !field.Embedded {
+			rustField, err := w.structField(object, field)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, rustField)
+			continue
+		}
+
+		embedded := w.model.Object(field.Type.Name)
+		if embedded.Union == nil {
+			embeddedFields, err := w.structFields(embedded)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, embeddedFields...)
+			continue
+		}
+
+		if discriminator := embedded.Union.Discriminator; discriminator != nil {
+			rustField, err := w.structField(embedded, discriminator)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, rustField)
+		}
+		fields = append(fields, rustField{
+			name:     strcase.ToSnake(embedded.Name),
+			doc:      field.Doc,
+			rustType: embedded.Name,
+			optional: true,
+			flatten:  true,
+		})
+	}
+	return fields, nil
+}
+
+func (w *moduleWriter) structField(object *genutils.ObjectType, field *genutils.Field) (rustField, error) {
+	name := strcase.ToSnake(field.JSONName)
+	if !rustIdentifier.MatchString(name) {
+		return rustField{}, fmt.Errorf("field %s of type %s has Json name %q, which cannot be converted to a valid Rust field name", field.GoName, object.Name, field.JSONName)
+	}
+	if rustKeywords[name] {
+		name = "r#" + name
+	}
+	return rustField{
+		name:     name,
+		jsonName: field.JSONName,
+		doc:      field.Doc,
+		rustType: w.typeName(field.Type),
+		optional: field.Optional,
+	}, nil
+}
+
+// typeName returns the Rust type of the given model type
+func (w *moduleWriter) typeName(typeRef *genutils.TypeRef) string {
+	switch typeRef.Kind {
+	case genutils.StringKind:
+		return "String"
+	case genutils.IntKind:
+		return "i64"
+	case genutils.FloatKind:
+		return "f64"
+	case genutils.BoolKind:
+		return "bool"
+	case genutils.ObjectKind, genutils.EnumKind:
+		return typeRef.Name
+	case genutils.ListKind:
+		return "Vec<" + w.typeName(typeRef.Elem) + ">"
+	case genutils.MapKind:
+		w.usesMaps = true
+		return "BTreeMap<String, " + w.typeName(typeRef.Elem) + ">"
+	default:
+		return "serde_json::Value"
+	}
+}
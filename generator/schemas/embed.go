@@ -0,0 +1,132 @@
+package schemas
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+
+	"github.com/devfile/api/generator/genutils"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+)
+
+// embedFileName is the name of the GO source file that embeds the schemas, in the output folder
+const embedFileName = "zz_generated.embed.go"
+
+// embedSource is the source of the GO file that embeds the schemas of each folder of the output folder
+// (`latest`, and the versioned folders), and retrieves them by devfile version
+const embedSource = `
+// Package %[1]s embeds the Json schemas generated from the K8S API, so that they can be retrieved
+// by devfile version, without reading the schema files.
+package %[1]s
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+const (
+	latestFolder = "latest"
+	versionFile  = "jsonSchemaVersion.txt"
+	// DevfileSchema is the name of the file of the devfile schema
+	DevfileSchema = "devfile.json"
+)
+
+// schemaFiles contains the Json schemas of the folders of this package
+//go:embed */*.json */jsonSchemaVersion.txt
+var schemaFiles embed.FS
+
+var (
+	foldersByVersion     map[string]string
+	foldersByVersionOnce sync.Once
+)
+
+// folders returns the folders of the schemas, by devfile version, read from the jsonSchemaVersion.txt file of each folder.
+// When several folders contain the schemas of the same devfile version, the versioned folder is preferred to the latest one.
+func folders() map[string]string {
+	foldersByVersionOnce.Do(func() {
+		foldersByVersion = map[string]string{}
+		entries, _ := fs.ReadDir(schemaFiles, ".")
+		for _, entry := range entries {
+			content, err := fs.ReadFile(schemaFiles, path.Join(entry.Name(), versionFile))
+			if err != nil {
+				continue
+			}
+			schemaVersion := strings.TrimSpace(string(content))
+			if _, err := version.ParseSemantic(schemaVersion); err != nil {
+				continue
+			}
+			if _, found := foldersByVersion[schemaVersion]; !found || entry.Name() != latestFolder {
+				foldersByVersion[schemaVersion] = entry.Name()
+			}
+		}
+	})
+	return foldersByVersion
+}
+
+// Versions returns the devfile versions whose schemas are embedded, sorted from the lowest to the highest version
+func Versions() []string {
+	var versions []string
+	for schemaVersion := range folders() {
+		versions = append(versions, schemaVersion)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return version.MustParseSemantic(versions[i]).LessThan(version.MustParseSemantic(versions[j]))
+	})
+	return versions
+}
+
+// GetSchema returns the Json schema of the devfiles of the given version (such as ` + "`2.2.0`" + `),
+// or of the latest version when the version is empty or ` + "`latest`" + `.
+// The returned error wraps ErrUnsupportedVersion when no schema is embedded for this version.
+func GetSchema(schemaVersion string) ([]byte, error) {
+	return GetSchemaFile(schemaVersion, DevfileSchema)
+}
+
+// GetSchemaFile returns the Json schema file with the given name (such as ` + "`dev-workspace.json`" + `) of the given version,
+// or of the latest version when the version is empty or ` + "`latest`" + `.
+// The returned error wraps ErrUnsupportedVersion when no schema is embedded for this version,
+// and ErrNotFound when this version has no schema file with the given name.
+func GetSchemaFile(schemaVersion, name string) ([]byte, error) {
+	folder := latestFolder
+	if schemaVersion != "" && schemaVersion != latestFolder {
+		var found bool
+		if folder, found = folders()[schemaVersion]; !found {
+			return nil, fmt.Errorf("%%w: no Json schema is embedded for the devfile version %%s", devfileerrors.ErrUnsupportedVersion, schemaVersion)
+		}
+	}
+	content, err := fs.ReadFile(schemaFiles, path.Join(folder, name))
+	if err != nil {
+		return nil, fmt.Errorf("%%w: no %%s Json schema is embedded in the %%s folder", devfileerrors.ErrNotFound, name, folder)
+	}
+	return content, nil
+}
+`
+
+// writeEmbedFile writes, in the output folder, the GO source file of the given package
+// that embeds the generated schemas
+func (g Generator) writeEmbedFile(ctx *genall.GenerationContext) error {
+	buf := new(bytes.Buffer)
+	if g.HeaderFile != "" {
+		header, err := ctx.ReadFile(g.HeaderFile)
+		if err != nil {
+			return err
+		}
+		buf.Write(bytes.TrimSpace(header))
+		buf.WriteString("\n\n")
+	}
+	buf.WriteString(genutils.GeneratedFileBanner + "\n")
+	fmt.Fprintf(buf, embedSource, g.EmbedPackage)
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return writeFile(ctx, "", embedFileName, rawContent(source))
+}
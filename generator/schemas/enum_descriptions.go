@@ -0,0 +1,191 @@
+package schemas
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/generator/genutils"
+	"gomodules.xyz/orderedmap"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+)
+
+// enumValueDocs contains the documentation of each value of a GO enum type,
+// taken from the comments of the string constants declared with this type
+type enumValueDocs map[string]string
+
+// collectEnumValueDocs returns the documentation of the values of the enum types declared in the given root package,
+// and in the packages of the devfile API module that it imports, recursively.
+// Enum types whose constants have no comments are ignored.
+func collectEnumValueDocs(root *loader.Package) []enumValueDocs {
+	var allDocs []enumValueDocs
+	visited := map[string]bool{}
+	var visit func(pkg *loader.Package)
+	visit = func(pkg *loader.Package) {
+		if visited[pkg.PkgPath] || !strings.HasPrefix(pkg.PkgPath, "github.com/devfile/api/") {
+			return
+		}
+		visited[pkg.PkgPath] = true
+		pkg.NeedSyntax()
+
+		docsByType := map[string]enumValueDocs{}
+		var typeNames []string
+		for _, file := range pkg.Syntax {
+			for _, decl := range file.Decls {
+				genDecl, isGenDecl := decl.(*ast.GenDecl)
+				if !isGenDecl || genDecl.Tok != token.CONST {
+					continue
+				}
+				for _, spec := range genDecl.Specs {
+					valueSpec := spec.(*ast.ValueSpec)
+					typeIdent, isIdent := valueSpec.Type.(*ast.Ident)
+					if !isIdent || len(valueSpec.Values) != 1 {
+						continue
+					}
+					literal, isLiteral := valueSpec.Values[0].(*ast.BasicLit)
+					if !isLiteral || literal.Kind != token.STRING {
+						continue
+					}
+					value, err := strconv.Unquote(literal.Value)
+					if err != nil {
+						continue
+					}
+					doc := valueSpec.Doc
+					if doc == nil && len(genDecl.Specs) == 1 {
+						doc = genDecl.Doc
+					}
+					docs, exists := docsByType[typeIdent.Name]
+					if !exists {
+						docs = enumValueDocs{}
+						docsByType[typeIdent.Name] = docs
+						typeNames = append(typeNames, typeIdent.Name)
+					}
+					docs[value] = strings.Join(strings.Fields(doc.Text()), " ")
+				}
+			}
+		}
+		for _, typeName := range typeNames {
+			if docs := docsByType[typeName]; docs.documented() {
+				allDocs = append(allDocs, docs)
+			}
+		}
+
+		for _, imported := range pkg.Imports() {
+			visit(imported)
+		}
+	}
+	visit(root)
+	return allDocs
+}
+
+func (docs enumValueDocs) documented() bool {
+	for _, doc := range docs {
+		if doc != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// enumDescriptions returns the documentation of each of the given enum values.
+//
+// The enum type is the one whose values include all the given values, since the enum schemas of
+// the generated override types only allow a subset of the values of the original enum type.
+// It returns nil if there is no such enum type, or if several ones provide different documentations.
+func enumDescriptions(allDocs []enumValueDocs, values []string) []string {
+	var descriptions []string
+	for _, docs := range allDocs {
+		candidate := make([]string, 0, len(values))
+		for _, value := range values {
+			doc, exists := docs[value]
+			if !exists {
+				candidate = nil
+				break
+			}
+			candidate = append(candidate, doc)
+		}
+		if candidate == nil {
+			continue
+		}
+		if descriptions != nil && strings.Join(descriptions, "\n") != strings.Join(candidate, "\n") {
+			return nil
+		}
+		descriptions = candidate
+	}
+	return descriptions
+}
+
+// enumValues returns the string values of the given enum schema
+func enumValues(enum []apiext.JSON) []string {
+	var values []string
+	for _, raw := range enum {
+		var value string
+		if err := json.Unmarshal(raw.Raw, &value); err != nil {
+			return nil
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+// addEnumDescriptions appends the documentation of the enum values to the description of the enum schemas
+func addEnumDescriptions(jsonSchema *apiext.JSONSchemaProps, allDocs []enumValueDocs) {
+	genutils.EditJSONSchema(jsonSchema, func(schema *apiext.JSONSchemaProps) (newVisitor genutils.Visitor, stop bool) {
+		if schema == nil || len(schema.Enum) == 0 {
+			return
+		}
+		values := enumValues(schema.Enum)
+		descriptions := enumDescriptions(allDocs, values)
+		if descriptions == nil {
+			return
+		}
+		lines := []string{"Allowed values:"}
+		for i, value := range values {
+			line := "- `" + value + "`"
+			if descriptions[i] != "" {
+				line += ": " + descriptions[i]
+			}
+			lines = append(lines, line)
+		}
+		if schema.Description != "" {
+			schema.Description += "\n\n"
+		}
+		schema.Description += strings.Join(lines, "\n")
+		return
+	})
+}
+
+// addMarkdownEnumDescriptions adds the non-standard `markdownEnumDescriptions` attribute,
+// used by IDEs such as VSCode to document each value during completion, to the enum schemas of the given schema
+func addMarkdownEnumDescriptions(value interface{}, allDocs []enumValueDocs) {
+	switch value := value.(type) {
+	case *orderedmap.OrderedMap:
+		for _, key := range value.Keys() {
+			child, _ := value.Get(key)
+			addMarkdownEnumDescriptions(child, allDocs)
+		}
+		enum, hasEnum := value.Get("enum")
+		rawValues, isList := enum.([]interface{})
+		if !hasEnum || !isList {
+			return
+		}
+		var values []string
+		for _, rawValue := range rawValues {
+			stringValue, isString := rawValue.(string)
+			if !isString {
+				return
+			}
+			values = append(values, stringValue)
+		}
+		if descriptions := enumDescriptions(allDocs, values); descriptions != nil {
+			value.Set("markdownEnumDescriptions", descriptions)
+		}
+	case []interface{}:
+		for _, item := range value {
+			addMarkdownEnumDescriptions(item, allDocs)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"go/ast"
+	"io"
+	"net/url"
 	"os"
 	"regexp"
 	"strings"
@@ -42,13 +44,34 @@ type GenerateJSONSchema struct {
 
 	ShortenEndpointNameLength bool `marker:",optional"`
 
+	// PluginFlavor indicates that the plugin flavor of the Json schema should also be generated from this type, in a `<schema name>-plugin.json` file.
+	// The plugin flavor omits the top-level fields annotated with the `devfile:jsonschema:omitInPlugin` marker, which cannot be used in plugins.
+	PluginFlavor bool `marker:",optional"`
+
 	// Title indicates the content ot the Json Schema `title` attribute
 	Title string `marker:",optional"`
 }
 
+// +controllertools:marker:generateHelp:category=Devfile
+
+// Title sets a human-friendly title (such as `Container Component`) on the Json schema generated for a type or a field,
+// separate from the GO type name, for the documentation and form UIs built from the Json schemas.
+//
+// The title of a type is used for all the fields of this type, unless the field has its own title.
+type Title string
+
+// ApplyToSchema sets the title on the schema generated for the type or the field
+func (t Title) ApplyToSchema(schema *apiext.JSONSchemaProps) error {
+	schema.Title = string(t)
+	return nil
+}
+
 var (
 	jsonschemaVersionMarker  = markers.Must(markers.MakeDefinition("devfile:jsonschema:version", markers.DescribesPackage, ""))
 	jsonschemaGenerateMarker = markers.Must(markers.MakeDefinition("devfile:jsonschema:generate", markers.DescribesType, GenerateJSONSchema{}))
+	titleTypeMarker          = markers.Must(markers.MakeDefinition("devfile:title", markers.DescribesType, Title("")))
+	titleFieldMarker         = markers.Must(markers.MakeDefinition("devfile:title", markers.DescribesField, Title("")))
+	omitInPluginMarker       = markers.Must(markers.MakeDefinition("devfile:jsonschema:omitInPlugin", markers.DescribesField, struct{}{}))
 )
 
 // +controllertools:marker:generateHelp
@@ -57,20 +80,129 @@ var (
 //
 // A JSON Schema is generated for each GO structure that had the `devfile:jsonschema:generate` annotation.
 // The semver-compatible version of JSON Schemas is defined by the `devfile:jsonschema:version` annotation on the package. Typically in the `doc.go` file.
-type Generator struct{}
+type Generator struct {
+
+	// Version is the devfile version of the generated Json schemas, used as the name of the output folder.
+	// When set, the schemas of the latest K8S API version are written in the `<version>` folder,
+	// and the files of the `latest` folder are then atomically replaced, one by one, with a copy of them.
+	// It should match the version defined by the `devfile:jsonschema:version` annotation,
+	// or be an older devfile version: the schemas of an older version are only written in the `<version>` folder,
+	// and omit the fields introduced after this version, according to their `devfile:since` marker.
+	// When unset, the schemas of the latest K8S API version are only written in the `latest` folder.
+	Version string `marker:"version,optional"`
+
+	// EnumDescriptions documents the allowed values of enum schemas, based on the comments of the GO string constants
+	// declared with the corresponding enum type: the documentation of each value is appended to the description of the enum schema,
+	// and added as a non-standard `markdownEnumDescriptions` attribute in the IDE-targeted variants of the schemas,
+	// so that IDEs can explain each value during completion.
+	EnumDescriptions bool `marker:"enumDescriptions,optional"`
+
+	// Transform is the path of a RFC 6902 Json patch file, applied to each emitted schema (including the IDE-targeted variants),
+	// for downstream-specific tweaks of the schemas.
+	// When the generator is used as a library, schemas can also be post-processed with `RegisterPostProcessor`.
+	Transform string `marker:"transform,optional"`
+
+	// RefBase is the URL where the schemas are published (such as `https://devfile.io/schemas/2.2.0/`).
+	// When set, the relative `$ref` targets of the emitted schemas, which point to other schema files
+	// (such as the references added by the `transform` option or by post-processors), are rewritten
+	// as absolute URLs resolved against it, so that the hosted schemas resolve without manual editing.
+	// The references of the IDE-targeted variants are resolved against the `ide-targeted` sub-folder of this URL.
+	// Local references (`#/...`) and absolute references are kept unchanged.
+	RefBase string `marker:"refBase,optional"`
+
+	// Parallelism is the number of worker goroutines that post-process, marshal and render the schemas of the generated types,
+	// once their flattened schemas are built. It defaults to the number of CPUs.
+	// The schemas are written in the same order whatever the parallelism, so that the output is deterministic.
+	Parallelism int `marker:"parallelism,optional"`
+
+	// EmbedPackage is the name of a GO package in which the schemas are embedded.
+	// When set, a GO source file of this package is written in the output folder: it embeds the schemas of its
+	// `latest` and versioned folders with `go:embed`, and exposes a `GetSchema(version string)` function,
+	// so that library consumers can validate devfiles offline without shipping the schema files separately.
+	EmbedPackage string `marker:"embedPackage,optional"`
+
+	// HeaderFile specifies the header text (e.g. license) to prepend to the GO source file
+	// written when EmbedPackage is set.
+	HeaderFile string `marker:",optional"`
+}
+
+// paragraphBreakRegexp matches the line breaks of the GO comments that separate paragraphs, but not list items
+var paragraphBreakRegexp = regexp.MustCompile(" \\n ([^-])")
+
+const (
+	latestSchemaFolder = "latest"
+	// stagingLatestSchemaFolder is the folder in which the copy of the versioned schemas is written,
+	// before replacing the `latest` folder
+	stagingLatestSchemaFolder = ".latest.staging"
+)
 
 // RegisterMarkers registers the markers of the Generator
 func (Generator) RegisterMarkers(into *markers.Registry) error {
-	if err := markers.RegisterAll(into, jsonschemaVersionMarker, jsonschemaGenerateMarker); err != nil {
+	if err := markers.RegisterAll(into, titleTypeMarker, titleFieldMarker, omitInPluginMarker); err != nil {
+		return err
+	}
+	if err := genutils.RegisterSinceMarker(into); err != nil {
+		return err
+	}
+	into.AddHelp(omitInPluginMarker,
+		markers.SimpleHelp("Devfile", "indicates that this top-level field is omitted from the plugin flavor of the Json schemas, since it cannot be used in plugins"))
+	into.AddHelp(titleTypeMarker, Title("").Help())
+	into.AddHelp(titleFieldMarker, Title("").Help())
+	if err := RegisterGenerateMarker(into); err != nil {
 		return err
 	}
 	if err := crdmarkers.Register(into); err != nil {
 		return err
 	}
-	into.AddHelp(jsonschemaGenerateMarker, GenerateJSONSchema{}.Help())
+	if err := RegisterVersionMarker(into); err != nil {
+		return err
+	}
+	return genutils.RegisterUnionMarkers(into)
+}
+
+// RegisterVersionMarker registers the `devfile:jsonschema:version` marker.
+// It is meant to be used by the other generators that derive artifacts from the devfile version of the Json schemas.
+func RegisterVersionMarker(into *markers.Registry) error {
+	if err := into.Register(jsonschemaVersionMarker); err != nil {
+		return err
+	}
 	into.AddHelp(jsonschemaVersionMarker,
 		markers.SimpleHelp("Devfile", "defines the semver-compatible version of the Json schemas that will be generated from the K8S API"))
-	return genutils.RegisterUnionMarkers(into)
+	return nil
+}
+
+// PackageVersion returns the devfile version of the Json schemas generated from the given package,
+// defined by its `devfile:jsonschema:version` marker, or nil if the package has no such marker
+func PackageVersion(collector *markers.Collector, root *loader.Package) (*semver.Version, error) {
+	packageMarkers, err := markers.PackageMarkers(collector, root)
+	if err != nil {
+		return nil, err
+	}
+	markerValue, hasVersion := packageMarkers.Get(jsonschemaVersionMarker.Name).(string)
+	if !hasVersion {
+		return nil, nil
+	}
+	version, err := semver.NewVersion(markerValue)
+	if err != nil {
+		return nil, fmt.Errorf("the devfile:jsonschema:version marker of package %s should be a semver-compatible devfile version: %w", root.Name, err)
+	}
+	return version, nil
+}
+
+// RegisterGenerateMarker registers the `devfile:jsonschema:generate` marker.
+// It is meant to be used by the other generators that process the same root types as the Json schemas.
+func RegisterGenerateMarker(into *markers.Registry) error {
+	if err := into.Register(jsonschemaGenerateMarker); err != nil {
+		return err
+	}
+	into.AddHelp(jsonschemaGenerateMarker, GenerateJSONSchema{}.Help())
+	return nil
+}
+
+// HasGenerateMarker returns true if a Json schema is generated for the given type,
+// i.e. if it has the `devfile:jsonschema:generate` marker
+func HasGenerateMarker(info *markers.TypeInfo) bool {
+	return info.Markers.Get(jsonschemaGenerateMarker.Name) != nil
 }
 
 type toGenerate struct {
@@ -79,6 +211,7 @@ type toGenerate struct {
 	devfileSchemaVersion *semver.Version
 	unionDiscriminators  []markers.FieldInfo
 	jsonschemaRequested  []*markers.TypeInfo
+	typesByName          map[string]*markers.TypeInfo
 }
 
 func (Generator) CheckFilter() loader.NodeFilter {
@@ -112,7 +245,8 @@ func (g Generator) Generate(ctx *genall.GenerationContext) error {
 
 	for _, root := range ctx.Roots {
 		forRoot := toGenerate{
-			version: root.Name,
+			version:     root.Name,
+			typesByName: map[string]*markers.TypeInfo{},
 		}
 
 		ctx.Checker.Check(root)
@@ -122,6 +256,7 @@ func (g Generator) Generate(ctx *genall.GenerationContext) error {
 		parser.NeedPackage(root)
 
 		if err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
+			forRoot.typesByName[info.Name] = info
 			if info.Markers.Get(genutils.UnionMarker.Name) != nil {
 				for _, field := range info.Fields {
 					if field.Markers.Get(genutils.UnionDiscriminatorMarker.Name) != nil {
@@ -130,7 +265,7 @@ func (g Generator) Generate(ctx *genall.GenerationContext) error {
 				}
 				return
 			}
-			if info.Markers.Get(jsonschemaGenerateMarker.Name) != nil {
+			if HasGenerateMarker(info) {
 				forRoot.jsonschemaRequested = append(forRoot.jsonschemaRequested, info)
 				return
 			}
@@ -203,21 +338,113 @@ This is not the case in the "%s' API group:
 		}
 	}
 
+	// When checking the output, the files on disk are not modified, so the versioned schemas
+	// are directly compared with the ones of the `latest` folder, instead of going through the staging folder.
+	checkOutput := genutils.IsCheckOutput(ctx.OutputRule)
+
+	var outputVersion *semver.Version
+	var outputDirectory string
+	if g.Version != "" {
+		var err error
+		if outputVersion, err = semver.NewVersion(g.Version); err != nil {
+			return fmt.Errorf("the 'version' option of the schemas generator should be a valid semver-compatible devfile version: %w", err)
+		}
+		if outputDirectory, err = getOutputDirectory(ctx); err != nil {
+			return err
+		}
+		// Cleanup the leftovers of a previously interrupted run
+		if !checkOutput {
+			if err := os.RemoveAll(filepath.Join(outputDirectory, stagingLatestSchemaFolder)); err != nil {
+				return err
+			}
+		}
+	}
+	refreshLatest := false
+
+	var patch jsonPatch
+	if g.Transform != "" {
+		var err error
+		if patch, err = readJSONPatch(ctx, g.Transform); err != nil {
+			return err
+		}
+	}
+	var refBase *url.URL
+	if g.RefBase != "" {
+		var err error
+		if refBase, err = parseRefBase(g.RefBase); err != nil {
+			return err
+		}
+	}
+	transformed := patch != nil || len(postProcessors) > 0 || refBase != nil
+
 	for root, toDo := range toGenerateByPackage {
+		isLatestAPIVersion := toDo.version == genutils.LatestKubeLikeVersion(apiVersionsByAPIGroup[toDo.groupName])
+		schemaFolders := []string{latestSchemaFolder}
+		schemaVersion := toDo.devfileSchemaVersion
+		// unionMembersToSkip are the union members introduced after the devfile version of the schemas
+		var unionMembersToSkip []string
+		switch {
+		case !isLatestAPIVersion:
+			schemaFolders = []string{toDo.version}
+		case outputVersion != nil && outputVersion.LessThan(*toDo.devfileSchemaVersion):
+			// The schemas of an older devfile version are only written in their own folder
+			schemaFolders = []string{outputVersion.String()}
+			schemaVersion = outputVersion
+			var typeIdents []crd.TypeIdent
+			for _, typeToProcess := range toDo.jsonschemaRequested {
+				typeIdents = append(typeIdents, crd.TypeIdent{Package: root, Name: typeToProcess.Name})
+			}
+			var err error
+			if unionMembersToSkip, err = omitFieldsIntroducedAfter(parser, typeIdents, outputVersion); err != nil {
+				root.AddError(err)
+				return nil
+			}
+		case outputVersion != nil:
+			if !outputVersion.Equal(*toDo.devfileSchemaVersion) {
+				root.AddError(fmt.Errorf("the 'version' option of the schemas generator (%s) is more recent than the devfile version defined by the +devfile:jsonschema:version comment marker (%s)",
+					outputVersion.String(), toDo.devfileSchemaVersion.String()))
+				return nil
+			}
+			if checkOutput {
+				schemaFolders = []string{outputVersion.String(), latestSchemaFolder}
+			} else {
+				schemaFolders = []string{outputVersion.String(), stagingLatestSchemaFolder}
+				refreshLatest = true
+			}
+		}
+
+		var enumDocs []enumValueDocs
+		if g.EnumDescriptions {
+			enumDocs = collectEnumValueDocs(root)
+		}
+
+		// The flattened schemas are built sequentially, since the parser is not safe for concurrent use.
+		var jobs []*schemaJob
 		for _, typeToProcess := range toDo.jsonschemaRequested {
 			typeIdent := crd.TypeIdent{
 				Package: root,
 				Name:    typeToProcess.Name,
 			}
-			schemaGenerateMarker := typeToProcess.Markers.Get(jsonschemaGenerateMarker.Name).(GenerateJSONSchema)
 			parser.NeedFlattenedSchemaFor(typeIdent)
-			currentJSONSchema, found := parser.FlattenedSchemata[typeIdent]
+			flattenedSchema, found := parser.FlattenedSchemata[typeIdent]
 			if !found {
 				root.AddError(fmt.Errorf("Json schema for type " + typeIdent.Package.Name + "/" + typeIdent.Name + " could not be generated"))
 				continue
 			}
+			// The flattened schema is copied, so that the workers don't share any part of their schemas,
+			// and the flattened schema of the processed type is released: it is not needed anymore and can be large.
+			jobs = append(jobs, &schemaJob{typeInfo: typeToProcess, schema: flattenedSchema.DeepCopy()})
+			delete(parser.FlattenedSchemata, typeIdent)
+		}
 
-			fieldsToSkip := []string{}
+		// renderSchemas post-processes and marshals the schemas of a type, in a worker goroutine.
+		// It only reads the shared state, and keeps the rendered artifacts in the job.
+		renderSchemas := func(job *schemaJob) error {
+			typeToProcess := job.typeInfo
+			currentJSONSchema := *job.schema
+			schemaGenerateMarker := typeToProcess.Markers.Get(jsonschemaGenerateMarker.Name).(GenerateJSONSchema)
+
+			fieldsToSkip := append([]string{}, unionMembersToSkip...)
 			if schemaGenerateMarker.OmitCustomUnionMembers {
 				fieldsToSkip = append(fieldsToSkip, "Custom")
 			}
@@ -232,14 +459,9 @@ This is not the case in the "%s' API group:
 				if schema == nil || schema.Description == "" {
 					return
 				}
-				regex, err := regexp.Compile(" \\n ([^-])")
-				if err != nil {
-					root.AddError(err)
-					return
-				}
 				schema.Description = strings.ReplaceAll(schema.Description, " \t", "\n")
 				schema.Description = strings.ReplaceAll(schema.Description, " \n - ", "\n- ")
-				schema.Description = regex.ReplaceAllString(schema.Description, "\n\n$1")
+				schema.Description = paragraphBreakRegexp.ReplaceAllString(schema.Description, "\n\n$1")
 				return
 			})
 
@@ -270,7 +492,7 @@ This is not the case in the "%s' API group:
 			})
 
 			if schemaGenerateMarker.Title == "" {
-				schemaGenerateMarker.Title = typeToProcess.Name + " schema - Version " + toDo.devfileSchemaVersion.String()
+				schemaGenerateMarker.Title = typeToProcess.Name + " schema - Version " + schemaVersion.String()
 			}
 
 			(&currentJSONSchema).Title = schemaGenerateMarker.Title
@@ -302,97 +524,327 @@ This is not the case in the "%s' API group:
 				return
 			})
 
-			jsonSchema, err := json.MarshalIndent(&currentJSONSchema, "", "  ")
-			if err != nil {
-				return err
+			if g.EnumDescriptions {
+				addEnumDescriptions(&currentJSONSchema, enumDocs)
 			}
 
-			genutils.EditJSONSchema(
-				&currentJSONSchema,
-				func(schema *apiext.JSONSchemaProps) (newVisitor genutils.Visitor, stop bool) {
-					if schema != nil {
-						schema.Default = nil
+			schemaBaseName := strcase.ToKebab(typeToProcess.Name)
+
+			// writeSchema writes the main schema and the IDE-targeted variant of the given flavor of the schema.
+			// The given schema is modified to build the IDE-targeted variant.
+			writeSchema := func(jsonSchema *apiext.JSONSchemaProps, schemaFileName string) error {
+				// The main schema is streamed to its artifacts right away,
+				// since the schema is modified below to build the IDE-targeted variant.
+				mainContent := jsonEncoder(jsonSchema)
+				if transformed {
+					jsonSchemaMap, err := toOrderedMap(jsonSchema)
+					if err != nil {
+						return err
 					}
-					return
-				})
+					if jsonSchemaMap, err = transformSchema(jsonSchemaMap, schemaFileName, false, patch, refBase); err != nil {
+						return err
+					}
+					mainContent = jsonEncoder(jsonSchemaMap)
+				}
+				if err := job.addArtifact("", schemaFileName, mainContent); err != nil {
+					return err
+				}
 
-			ideTargetedSchemasExplanation :=
-				`IDE-targeted variants of the schemas provide the following difference compared to the main schemas:
-- They contain additional non-standard ` + "`markdownDescription`" + ` attributes that are used by IDEs such a VSCode
-to provide markdown-rendered documentation hovers. 
-- They don't contain ` + "`default`" + ` attributes, since this triggers unwanted addition of defaulted fields during completion in IDEs.`
+				genutils.EditJSONSchema(
+					jsonSchema,
+					func(schema *apiext.JSONSchemaProps) (newVisitor genutils.Visitor, stop bool) {
+						if schema != nil {
+							schema.Default = nil
+						}
+						return
+					})
 
-			(&currentJSONSchema).Title = (&currentJSONSchema).Title + " - IDE-targeted variant"
-			(&currentJSONSchema).Description = (&currentJSONSchema).Description + "\n\n" + ideTargetedSchemasExplanation
+				jsonSchema.Title = jsonSchema.Title + " - IDE-targeted variant"
+				jsonSchema.Description = jsonSchema.Description + "\n\n" + ideTargetedSchemasExplanation
 
-			ideTargetedJsonSchema, err := json.MarshalIndent(&currentJSONSchema, "", "  ")
-			if err != nil {
-				return err
+				// The schema is converted to an ordered map on which the markdown descriptions are added.
+				ideTargetedJsonSchemaMap, err := toOrderedMap(jsonSchema)
+				if err != nil {
+					return err
+				}
+				addMarkdownDescription(ideTargetedJsonSchemaMap)
+				if g.EnumDescriptions {
+					addMarkdownEnumDescriptions(ideTargetedJsonSchemaMap, enumDocs)
+				}
+				if transformed {
+					if ideTargetedJsonSchemaMap, err = transformSchema(ideTargetedJsonSchemaMap, schemaFileName, true, patch, refBase); err != nil {
+						return err
+					}
+				}
+				return job.addArtifact("ide-targeted", schemaFileName, jsonEncoder(ideTargetedJsonSchemaMap))
 			}
-			ideTargetedJsonSchemaMap := orderedmap.New()
-			err = json.Unmarshal(ideTargetedJsonSchema, ideTargetedJsonSchemaMap)
-			if err != nil {
-				return err
+
+			// The plugin flavor is copied before the main schema is modified to build its IDE-targeted variant
+			var pluginJSONSchema *apiext.JSONSchemaProps
+			if schemaGenerateMarker.PluginFlavor {
+				pluginJSONSchema = currentJSONSchema.DeepCopy()
+				omitted := omittedInPlugin(typeToProcess, toDo.typesByName)
+				for _, propertyName := range omitted {
+					delete(pluginJSONSchema.Properties, propertyName)
+				}
+				pluginJSONSchema.Title = pluginJSONSchema.Title + " - Plugin flavor"
+				pluginJSONSchema.Description = pluginJSONSchema.Description + "\n\n" +
+					"The plugin flavor of the schema validates the devfiles of plugins, which cannot contain the following top-level fields: `" +
+					strings.Join(omitted, "`, `") + "`."
 			}
-			addMarkdownDescription(ideTargetedJsonSchemaMap)
-			ideTargetedJsonSchema, err = json.MarshalIndent(ideTargetedJsonSchemaMap, "", "  ")
 
-			schemaBaseName := strcase.ToKebab(typeToProcess.Name)
-			schemaFolder := "latest"
-			if toDo.version != genutils.LatestKubeLikeVersion(apiVersionsByAPIGroup[toDo.groupName]) {
-				schemaFolder = toDo.version
+			if err := writeSchema(&currentJSONSchema, schemaBaseName+".json"); err != nil {
+				return err
 			}
-			folderForIdeTargetedSchemas := filepath.Join(schemaFolder, "ide-targeted")
-			schemaFileName := schemaBaseName + ".json"
-			err = writeFile(ctx, schemaFolder, schemaFileName, jsonSchema)
-			if err != nil {
-				root.AddError(err)
+			if pluginJSONSchema != nil {
+				if err := writeSchema(pluginJSONSchema, schemaBaseName+"-plugin.json"); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		runSchemaJobs(jobs, g.Parallelism, renderSchemas)
+
+		// The artifacts are written in the order of the types, so that the output doesn't depend on the scheduling of the workers
+		for _, job := range jobs {
+			if job.err != nil {
+				root.AddError(job.err)
 				return nil
 			}
-			err = writeFile(ctx, folderForIdeTargetedSchemas, "Readme.md", []byte(ideTargetedSchemasExplanation))
+			for _, artifact := range job.artifacts {
+				if err := writeFiles(ctx, schemaFolders, artifact.subFolder, artifact.fileName, rawContent(artifact.content)); err != nil {
+					root.AddError(err)
+					return nil
+				}
+			}
+		}
+		if len(jobs) > 0 {
+			err := writeFiles(ctx, schemaFolders, "ide-targeted", "Readme.md", rawContent([]byte(ideTargetedSchemasExplanation)))
 			if err != nil {
 				root.AddError(err)
 				return nil
 			}
-			err = writeFile(ctx, folderForIdeTargetedSchemas, schemaFileName, ideTargetedJsonSchema)
+			err = writeFiles(ctx, schemaFolders, "", "jsonSchemaVersion.txt", rawContent([]byte(schemaVersion.String())))
 			if err != nil {
 				root.AddError(err)
 				return nil
 			}
-			err = writeFile(ctx, schemaFolder, "jsonSchemaVersion.txt", []byte(toDo.devfileSchemaVersion.String()))
+			err = writeFiles(ctx, schemaFolders, "", "k8sApiVersion.txt", rawContent([]byte(root.Name)))
 			if err != nil {
 				root.AddError(err)
 				return nil
 			}
-			err = writeFile(ctx, schemaFolder, "k8sApiVersion.txt", []byte(root.Name))
+		}
+
+		// Release the data related to the processed package before moving to the next one
+		delete(toGenerateByPackage, root)
+	}
+
+	if refreshLatest {
+		if err := replaceLatestFolder(outputDirectory); err != nil {
+			return err
+		}
+	}
+	if g.EmbedPackage != "" {
+		return g.writeEmbedFile(ctx)
+	}
+	return nil
+}
+
+// ideTargetedSchemasExplanation is the explanation added to the description of the IDE-targeted variants of the schemas
+const ideTargetedSchemasExplanation = `IDE-targeted variants of the schemas provide the following difference compared to the main schemas:
+- They contain additional non-standard ` + "`markdownDescription`" + ` attributes that are used by IDEs such a VSCode
+to provide markdown-rendered documentation hovers. 
+- They don't contain ` + "`default`" + ` attributes, since this triggers unwanted addition of defaulted fields during completion in IDEs.`
+
+// omittedInPlugin returns the Json names of the top-level fields of the given type that are annotated with
+// the `devfile:jsonschema:omitInPlugin` marker, including the fields of the inlined structs of the same package
+func omittedInPlugin(info *markers.TypeInfo, typesByName map[string]*markers.TypeInfo) []string {
+	omitted := []string{}
+	for _, field := range info.Fields {
+		jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if jsonName == "" && strings.Contains(field.Tag.Get("json"), "inline") {
+			if ident, isIdent := field.RawField.Type.(*ast.Ident); isIdent {
+				if inlined, exists := typesByName[ident.Name]; exists {
+					omitted = append(omitted, omittedInPlugin(inlined, typesByName)...)
+				}
+			}
+			continue
+		}
+		if field.Markers.Get(omitInPluginMarker.Name) != nil {
+			omitted = append(omitted, jsonName)
+		}
+	}
+	return omitted
+}
+
+// omitFieldsIntroducedAfter removes the fields introduced after the given devfile version, according to their `devfile:since` marker,
+// from the schemas of the given types and of the types they reference.
+// It returns the GO names of the union members introduced after the given devfile version,
+// which are omitted when the union constraints are added, so that the union discriminator values are updated too.
+func omitFieldsIntroducedAfter(parser *crd.Parser, typeIdents []crd.TypeIdent, devfileVersion *semver.Version) ([]string, error) {
+	for _, typeIdent := range typeIdents {
+		parser.NeedSchemaFor(typeIdent)
+	}
+	// the pre-releases of a devfile version already contain the fields of this version
+	releaseVersion := *devfileVersion
+	releaseVersion.PreRelease = ""
+
+	var unionMembers []string
+	for typeIdent, typeSchema := range parser.Schemata {
+		info := parser.Types[typeIdent]
+		if info == nil {
+			continue
+		}
+		isUnion := info.Markers.Get(genutils.UnionMarker.Name) != nil
+		for _, field := range info.Fields {
+			since, err := genutils.FieldSince(info.Name, field)
 			if err != nil {
-				root.AddError(err)
-				return nil
+				return nil, err
+			}
+			if since == nil || !releaseVersion.LessThan(*since) {
+				continue
+			}
+			if isUnion {
+				unionMembers = append(unionMembers, field.Name)
+				continue
+			}
+			jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+			delete(typeSchema.Properties, jsonName)
+			required := []string{}
+			for _, requiredName := range typeSchema.Required {
+				if requiredName != jsonName {
+					required = append(required, requiredName)
+				}
+			}
+			if len(typeSchema.Required) > 0 {
+				typeSchema.Required = required
 			}
 		}
+		parser.Schemata[typeIdent] = typeSchema
+	}
+	return unionMembers, nil
+}
+
+// getOutputDirectory returns the directory in which the schema artifacts are written
+func getOutputDirectory(ctx *genall.GenerationContext) (string, error) {
+	switch rule := genutils.UnwrapOutputRule(ctx.OutputRule).(type) {
+	case genall.OutputArtifacts:
+		return string(rule.Config), nil
+	case *genall.OutputArtifacts:
+		return string(rule.Config), nil
+	case genall.OutputToDirectory:
+		return string(rule), nil
+	default:
+		return "", fmt.Errorf("the 'version' option of the schemas generator requires the schemas to be output to a directory")
+	}
+}
+
+// replaceLatestFolder replaces the files of the `latest` schema folder with the ones of the staging folder, in which
+// the copy of the versioned schemas has been written, then removes the staging folder.
+// Each file is replaced by renaming, so that it is never left partially written,
+// and the other files of the `latest` folder, such as the ones written by other generators, are kept.
+func replaceLatestFolder(outputDirectory string) error {
+	latestFolder := filepath.Join(outputDirectory, latestSchemaFolder)
+	stagingFolder := filepath.Join(outputDirectory, stagingLatestSchemaFolder)
+
+	err := filepath.Walk(stagingFolder, func(stagingPath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		relativePath, err := filepath.Rel(stagingFolder, stagingPath)
+		if err != nil {
+			return err
+		}
+		latestPath := filepath.Join(latestFolder, relativePath)
+		if err := os.MkdirAll(filepath.Dir(latestPath), 0755); err != nil {
+			return err
+		}
+		return os.Rename(stagingPath, latestPath)
+	})
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(stagingFolder)
+}
+
+// contentWriter streams the content of an artifact to the given writer
+type contentWriter func(io.Writer) error
+
+// rawContent returns a contentWriter that writes the given bytes as-is
+func rawContent(content []byte) contentWriter {
+	return func(w io.Writer) error {
+		_, err := w.Write(content)
+		return err
+	}
+}
+
+// jsonEncoder returns a contentWriter that streams the indented Json representation of the given value,
+// without building the whole serialized document in memory beforehand.
+func jsonEncoder(value interface{}) contentWriter {
+	return func(w io.Writer) error {
+		encoder := json.NewEncoder(&trimFinalNewlineWriter{writer: w})
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	}
+}
+
+// trimFinalNewlineWriter drops the trailing newline added by the Json encoder,
+// so that the streamed artifacts are identical to the ones produced by `json.MarshalIndent`.
+type trimFinalNewlineWriter struct {
+	writer         io.Writer
+	pendingNewline bool
+}
+
+func (w *trimFinalNewlineWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if w.pendingNewline {
+		if _, err := w.writer.Write([]byte{'\n'}); err != nil {
+			return 0, err
+		}
+		w.pendingNewline = false
+	}
+	toWrite := p
+	if p[len(p)-1] == '\n' {
+		toWrite = p[:len(p)-1]
+		w.pendingNewline = true
+	}
+	if _, err := w.writer.Write(toWrite); err != nil {
+		return 0, err
 	}
+	return len(p), nil
+}
 
+// writeFiles writes the same artifact in the given sub-folder of each of the given schema folders
+func writeFiles(ctx *genall.GenerationContext, schemaFolders []string, subFolder, schemaFileName string, content contentWriter) error {
+	for _, schemaFolder := range schemaFolders {
+		if err := writeFile(ctx, filepath.Join(schemaFolder, subFolder), schemaFileName, content); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func writeFile(ctx *genall.GenerationContext, schemaFolder, schemaFileName string, jsonSchema []byte) error {
-	err := doWriteFile(ctx, schemaFolder, schemaFileName, jsonSchema)
+func writeFile(ctx *genall.GenerationContext, schemaFolder, schemaFileName string, content contentWriter) error {
+	err := doWriteFile(ctx, schemaFolder, schemaFileName, content)
 	if pathError, isPathError := err.(*os.PathError); isPathError &&
 		errors.Is(err, os.ErrNotExist) {
 		os.MkdirAll(filepath.Dir(pathError.Path), os.ModePerm)
-		err = writeFile(ctx, schemaFolder, schemaFileName, jsonSchema)
+		err = writeFile(ctx, schemaFolder, schemaFileName, content)
 	}
 	return err
 }
 
-func doWriteFile(ctx *genall.GenerationContext, schemaFolder, schemaFileName string, jsonSchema []byte) error {
+func doWriteFile(ctx *genall.GenerationContext, schemaFolder, schemaFileName string, content contentWriter) error {
 	writer, err := ctx.Open(nil, filepath.Join(schemaFolder, schemaFileName))
 	if err != nil {
 		return err
 	}
 	defer writer.Close()
-	_, err = writer.Write(jsonSchema)
-	return err
+	return content(writer)
 }
 
 func addMarkdownDescription(orderedMap *orderedmap.OrderedMap) {
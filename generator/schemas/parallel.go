@@ -0,0 +1,66 @@
+package schemas
+
+import (
+	"bytes"
+	"runtime"
+	"sync"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+// schemaJob is the rendering of the schemas of a type, which is processed by a worker goroutine
+type schemaJob struct {
+	typeInfo *markers.TypeInfo
+	// schema is the flattened schema of the type, owned by the job
+	schema *apiext.JSONSchemaProps
+
+	// artifacts are the rendered artifacts, in writing order
+	artifacts []schemaArtifact
+	// err is the error that stopped the rendering
+	err error
+}
+
+// schemaArtifact is a rendered artifact, written in the given sub-folder of each schema folder
+type schemaArtifact struct {
+	subFolder string
+	fileName  string
+	content   []byte
+}
+
+// addArtifact renders the given content, to be written once all the jobs are done
+func (job *schemaJob) addArtifact(subFolder, fileName string, content contentWriter) error {
+	buffer := &bytes.Buffer{}
+	if err := content(buffer); err != nil {
+		return err
+	}
+	job.artifacts = append(job.artifacts, schemaArtifact{subFolder: subFolder, fileName: fileName, content: buffer.Bytes()})
+	return nil
+}
+
+// runSchemaJobs processes the given jobs with the given number of worker goroutines (the number of CPUs if not positive),
+// and returns when all the jobs are done. The error of each job is kept in the job.
+func runSchemaJobs(jobs []*schemaJob, parallelism int, process func(job *schemaJob) error) {
+	if parallelism <= 0 {
+		parallelism = runtime.NumCPU()
+	}
+	if parallelism > len(jobs) {
+		parallelism = len(jobs)
+	}
+	toProcess := make(chan *schemaJob)
+	var workers sync.WaitGroup
+	for i := 0; i < parallelism; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for job := range toProcess {
+				job.err = process(job)
+			}
+		}()
+	}
+	for _, job := range jobs {
+		toProcess <- job
+	}
+	close(toProcess)
+	workers.Wait()
+}
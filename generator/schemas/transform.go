@@ -0,0 +1,400 @@
+package schemas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gomodules.xyz/orderedmap"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+)
+
+// PostProcessor post-processes a Json schema emitted by the Json schema generator, before it is written.
+// The schema is provided as an ordered map, so that the order of the schema attributes is kept in the written file.
+// `ideTargeted` is true for the IDE-targeted variant of the schema.
+type PostProcessor func(schemaFileName string, ideTargeted bool, schema *orderedmap.OrderedMap) error
+
+var postProcessors []PostProcessor
+
+// RegisterPostProcessor registers a function that post-processes each Json schema emitted by the Json schema generator,
+// so that downstream projects using the generator as a library can tweak the schemas without forking the generator.
+// Post-processors are applied in registration order, after the patch of the `transform` option.
+// Since the schemas of several types are rendered concurrently, post-processors can be called concurrently
+// (on distinct schemas), and should be safe for concurrent use.
+func RegisterPostProcessor(postProcessor PostProcessor) {
+	postProcessors = append(postProcessors, postProcessor)
+}
+
+// jsonPatchOperation is an operation of a RFC 6902 Json patch
+type jsonPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+
+	// value is the decoded value of the operation, with objects decoded as ordered maps
+	value interface{}
+}
+
+// jsonPatch is a RFC 6902 Json patch
+type jsonPatch []jsonPatchOperation
+
+// readJSONPatch reads the RFC 6902 Json patch of the given file, and checks that its operations are well-formed
+func readJSONPatch(ctx *genall.GenerationContext, patchFile string) (jsonPatch, error) {
+	content, err := ctx.ReadFile(patchFile)
+	if err != nil {
+		return nil, err
+	}
+	var patch jsonPatch
+	if err := json.Unmarshal(content, &patch); err != nil {
+		return nil, fmt.Errorf("invalid Json patch file %s: %w", patchFile, err)
+	}
+	for i := range patch {
+		operation := &patch[i]
+		invalid := func(reason string) error {
+			return fmt.Errorf("invalid operation %d of Json patch file %s: %s", i, patchFile, reason)
+		}
+		if _, err := parseJSONPointer(operation.Path); err != nil {
+			return nil, invalid(err.Error())
+		}
+		switch operation.Op {
+		case "add", "replace", "test":
+			if operation.Value == nil {
+				return nil, invalid(fmt.Sprintf("the %q operation requires a value", operation.Op))
+			}
+			if operation.value, err = decodeOrderedJSON(operation.Value); err != nil {
+				return nil, invalid(err.Error())
+			}
+		case "move", "copy":
+			if _, err := parseJSONPointer(operation.From); err != nil {
+				return nil, invalid(err.Error())
+			}
+			if operation.Op == "move" && strings.HasPrefix(operation.Path, operation.From+"/") {
+				return nil, invalid("a value cannot be moved into one of its children")
+			}
+		case "remove":
+		default:
+			return nil, invalid(fmt.Sprintf("unknown operation %q", operation.Op))
+		}
+	}
+	return patch, nil
+}
+
+// decodeOrderedJSON decodes the given Json value, with objects decoded as ordered maps
+func decodeOrderedJSON(raw json.RawMessage) (interface{}, error) {
+	wrapper := orderedmap.New()
+	if err := json.Unmarshal([]byte(`{"value":`+string(raw)+`}`), wrapper); err != nil {
+		return nil, err
+	}
+	value, _ := wrapper.Get("value")
+	return value, nil
+}
+
+// toOrderedMap converts the given value to an ordered map through its Json representation
+func toOrderedMap(value interface{}) (*orderedmap.OrderedMap, error) {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	orderedMap := orderedmap.New()
+	if err := json.Unmarshal(content, orderedMap); err != nil {
+		return nil, err
+	}
+	return orderedMap, nil
+}
+
+// transformSchema applies the given Json patch, if any, and then the registered post-processors, to the given schema.
+// The relative `$ref` targets of the transformed schema are finally resolved against the given base URL, if any.
+func transformSchema(schema *orderedmap.OrderedMap, schemaFileName string, ideTargeted bool, patch jsonPatch, refBase *url.URL) (*orderedmap.OrderedMap, error) {
+	if patch != nil {
+		patched, err := patch.apply(schema)
+		if err != nil {
+			return nil, fmt.Errorf("cannot transform the %s schema: %w", schemaFileName, err)
+		}
+		patchedSchema, isObject := patched.(*orderedmap.OrderedMap)
+		if !isObject {
+			return nil, fmt.Errorf("cannot transform the %s schema: the patched schema is not a Json object", schemaFileName)
+		}
+		schema = patchedSchema
+	}
+	for _, postProcessor := range postProcessors {
+		if err := postProcessor(schemaFileName, ideTargeted, schema); err != nil {
+			return nil, fmt.Errorf("cannot post-process the %s schema: %w", schemaFileName, err)
+		}
+	}
+	if refBase != nil {
+		if ideTargeted {
+			refBase = refBase.ResolveReference(&url.URL{Path: "ide-targeted/"})
+		}
+		if err := rewriteRefs(schema, refBase); err != nil {
+			return nil, fmt.Errorf("cannot rewrite the references of the %s schema: %w", schemaFileName, err)
+		}
+	}
+	return schema, nil
+}
+
+// parseRefBase parses the value of the `refBase` option, which should be an absolute URL.
+// A trailing slash is added if missing, so that the references are resolved inside the folder of the URL.
+func parseRefBase(refBase string) (*url.URL, error) {
+	base, err := url.Parse(refBase)
+	if err != nil || !base.IsAbs() || base.Host == "" {
+		return nil, fmt.Errorf("the 'refBase' option of the schemas generator should be an absolute URL, but is %q", refBase)
+	}
+	if !strings.HasSuffix(base.Path, "/") {
+		base.Path += "/"
+	}
+	return base, nil
+}
+
+// rewriteRefs resolves the relative `$ref` targets found in the given Json value against the given base URL
+func rewriteRefs(value interface{}, base *url.URL) error {
+	switch typed := value.(type) {
+	case *orderedmap.OrderedMap:
+		for _, key := range typed.Keys() {
+			child, _ := typed.Get(key)
+			if ref, isString := child.(string); isString && key == "$ref" {
+				if strings.HasPrefix(ref, "#") {
+					continue
+				}
+				target, err := url.Parse(ref)
+				if err != nil {
+					return fmt.Errorf("invalid reference %q: %w", ref, err)
+				}
+				if !target.IsAbs() {
+					typed.Set(key, base.ResolveReference(target).String())
+				}
+				continue
+			}
+			if err := rewriteRefs(child, base); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, item := range typed {
+			if err := rewriteRefs(item, base); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// apply applies the operations of the patch to the given document, and returns the patched document
+func (patch jsonPatch) apply(document interface{}) (interface{}, error) {
+	for i, operation := range patch {
+		var err error
+		if document, err = operation.apply(document); err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s) failed: %w", i, operation.Op, operation.Path, err)
+		}
+	}
+	return document, nil
+}
+
+func (operation jsonPatchOperation) apply(document interface{}) (interface{}, error) {
+	path, _ := parseJSONPointer(operation.Path)
+	switch operation.Op {
+	case "add":
+		return addValue(document, path, orderedmap.DeepCopyJSONValue(operation.value))
+	case "remove":
+		return removeValue(document, path)
+	case "replace":
+		return replaceValue(document, path, orderedmap.DeepCopyJSONValue(operation.value))
+	case "move", "copy":
+		from, _ := parseJSONPointer(operation.From)
+		value, err := getValue(document, from)
+		if err != nil {
+			return nil, err
+		}
+		if operation.Op == "move" {
+			if document, err = removeValue(document, from); err != nil {
+				return nil, err
+			}
+		} else {
+			value = orderedmap.DeepCopyJSONValue(value)
+		}
+		return addValue(document, path, value)
+	case "test":
+		value, err := getValue(document, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(unorderedJSON(value), unorderedJSON(operation.value)) {
+			return nil, fmt.Errorf("the value doesn't match the expected one")
+		}
+		return document, nil
+	default:
+		return nil, fmt.Errorf("unknown operation %q", operation.Op)
+	}
+}
+
+// parseJSONPointer returns the unescaped reference tokens of the given RFC 6901 Json pointer
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid Json pointer %q: it should start with '/'", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// parseArrayIndex parses the given reference token as an index of an array of the given length.
+// The `-` token, which references the end of the array, is only accepted if `allowEnd` is true.
+func parseArrayIndex(token string, length int, allowEnd bool) (int, error) {
+	if token == "-" && allowEnd {
+		return length, nil
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || strings.HasPrefix(token, "+") || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	if index > length || (index == length && !allowEnd) {
+		return 0, fmt.Errorf("array index %d is out of bounds", index)
+	}
+	return index, nil
+}
+
+// getValue returns the value referenced by the given reference tokens
+func getValue(document interface{}, path []string) (interface{}, error) {
+	value := document
+	for _, token := range path {
+		switch container := value.(type) {
+		case *orderedmap.OrderedMap:
+			child, exists := container.Get(token)
+			if !exists {
+				return nil, fmt.Errorf("the %q member doesn't exist", token)
+			}
+			value = child
+		case []interface{}:
+			index, err := parseArrayIndex(token, len(container), false)
+			if err != nil {
+				return nil, err
+			}
+			value = container[index]
+		default:
+			return nil, fmt.Errorf("cannot reference %q in a value that is neither an object nor an array", token)
+		}
+	}
+	return value, nil
+}
+
+// updateParent calls the given function with the parent of the value referenced by the given reference tokens,
+// and returns the document in which the parent is replaced by the result of the function.
+// Arrays have to be replaced in their own parent, since inserting or removing elements may reallocate them.
+func updateParent(document interface{}, path []string, update func(parent interface{}, token string) (interface{}, error)) (interface{}, error) {
+	if len(path) == 1 {
+		return update(document, path[0])
+	}
+	child, err := getValue(document, path[:1])
+	if err != nil {
+		return nil, err
+	}
+	updatedChild, err := updateParent(child, path[1:], update)
+	if err != nil {
+		return nil, err
+	}
+	switch container := document.(type) {
+	case *orderedmap.OrderedMap:
+		container.Set(path[0], updatedChild)
+	case []interface{}:
+		index, _ := parseArrayIndex(path[0], len(container), false)
+		container[index] = updatedChild
+	}
+	return document, nil
+}
+
+func addValue(document interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return updateParent(document, path, func(parent interface{}, token string) (interface{}, error) {
+		switch container := parent.(type) {
+		case *orderedmap.OrderedMap:
+			container.Set(token, value)
+			return container, nil
+		case []interface{}:
+			index, err := parseArrayIndex(token, len(container), true)
+			if err != nil {
+				return nil, err
+			}
+			container = append(container, nil)
+			copy(container[index+1:], container[index:])
+			container[index] = value
+			return container, nil
+		default:
+			return nil, fmt.Errorf("cannot add %q to a value that is neither an object nor an array", token)
+		}
+	})
+}
+
+func removeValue(document interface{}, path []string) (interface{}, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("the whole document cannot be removed")
+	}
+	return updateParent(document, path, func(parent interface{}, token string) (interface{}, error) {
+		switch container := parent.(type) {
+		case *orderedmap.OrderedMap:
+			if _, exists := container.Get(token); !exists {
+				return nil, fmt.Errorf("the %q member doesn't exist", token)
+			}
+			container.Delete(token)
+			return container, nil
+		case []interface{}:
+			index, err := parseArrayIndex(token, len(container), false)
+			if err != nil {
+				return nil, err
+			}
+			return append(container[:index], container[index+1:]...), nil
+		default:
+			return nil, fmt.Errorf("cannot remove %q from a value that is neither an object nor an array", token)
+		}
+	})
+}
+
+func replaceValue(document interface{}, path []string, value interface{}) (interface{}, error) {
+	if _, err := getValue(document, path); err != nil {
+		return nil, err
+	}
+	if len(path) == 0 {
+		return value, nil
+	}
+	return updateParent(document, path, func(parent interface{}, token string) (interface{}, error) {
+		switch container := parent.(type) {
+		case *orderedmap.OrderedMap:
+			container.Set(token, value)
+		case []interface{}:
+			index, _ := parseArrayIndex(token, len(container), false)
+			container[index] = value
+		}
+		return parent, nil
+	})
+}
+
+// unorderedJSON converts the ordered maps of the given Json value to standard maps,
+// so that Json values can be compared regardless of the order of object members
+func unorderedJSON(value interface{}) interface{} {
+	switch value := value.(type) {
+	case *orderedmap.OrderedMap:
+		result := map[string]interface{}{}
+		for _, key := range value.Keys() {
+			child, _ := value.Get(key)
+			result[key] = unorderedJSON(child)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, child := range value {
+			result[i] = unorderedJSON(child)
+		}
+		return result
+	default:
+		return value
+	}
+}
@@ -31,6 +31,10 @@ func (GenerateJSONSchema) Help() *markers.DefinitionHelp {
 				Summary: "",
 				Details: "",
 			},
+			"PluginFlavor": {
+				Summary: "indicates that the plugin flavor of the Json schema should also be generated from this type, in a `<schema name>-plugin.json` file. The plugin flavor omits the top-level fields annotated with the `devfile:jsonschema:omitInPlugin` marker, which cannot be used in plugins.",
+				Details: "",
+			},
 			"Title": {
 				Summary: "indicates the content ot the Json Schema `title` attribute",
 				Details: "",
@@ -46,6 +50,46 @@ func (Generator) Help() *markers.DefinitionHelp {
 			Summary: "generates JSON schemas from the GO source code of the Kubernetes API ",
 			Details: "A JSON Schema is generated for each GO structure that had the `devfile:jsonschema:generate` annotation. The semver-compatible version of JSON Schemas is defined by the `devfile:jsonschema:version` annotation on the package. Typically in the `doc.go` file.",
 		},
+		FieldHelp: map[string]markers.DetailedHelp{
+			"Version": {
+				Summary: "is the devfile version of the generated Json schemas, used as the name of the output folder. When set, the schemas of the latest K8S API version are written in the `<version>` folder, and the files of the `latest` folder are then atomically replaced, one by one, with a copy of them. It should match the version defined by the `devfile:jsonschema:version` annotation, or be an older devfile version: the schemas of an older version are only written in the `<version>` folder, and omit the fields introduced after this version, according to their `devfile:since` marker. When unset, the schemas of the latest K8S API version are only written in the `latest` folder.",
+				Details: "",
+			},
+			"EnumDescriptions": {
+				Summary: "documents the allowed values of enum schemas, based on the comments of the GO string constants declared with the corresponding enum type: the documentation of each value is appended to the description of the enum schema, and added as a non-standard `markdownEnumDescriptions` attribute in the IDE-targeted variants of the schemas, so that IDEs can explain each value during completion.",
+				Details: "",
+			},
+			"Transform": {
+				Summary: "is the path of a RFC 6902 Json patch file, applied to each emitted schema (including the IDE-targeted variants), for downstream-specific tweaks of the schemas. When the generator is used as a library, schemas can also be post-processed with `RegisterPostProcessor`.",
+				Details: "",
+			},
+			"RefBase": {
+				Summary: "is the URL where the schemas are published (such as `https://devfile.io/schemas/2.2.0/`). When set, the relative `$ref` targets of the emitted schemas, which point to other schema files (such as the references added by the `transform` option or by post-processors), are rewritten as absolute URLs resolved against it, so that the hosted schemas resolve without manual editing. The references of the IDE-targeted variants are resolved against the `ide-targeted` sub-folder of this URL. Local references (`#/...`) and absolute references are kept unchanged.",
+				Details: "",
+			},
+			"Parallelism": {
+				Summary: "is the number of worker goroutines that post-process, marshal and render the schemas of the generated types, once their flattened schemas are built. It defaults to the number of CPUs. The schemas are written in the same order whatever the parallelism, so that the output is deterministic.",
+				Details: "",
+			},
+			"EmbedPackage": {
+				Summary: "is the name of a GO package in which the schemas are embedded. When set, a GO source file of this package is written in the output folder: it embeds the schemas of its `latest` and versioned folders with `go:embed`, and exposes a `GetSchema(version string)` function, so that library consumers can validate devfiles offline without shipping the schema files separately.",
+				Details: "",
+			},
+			"HeaderFile": {
+				Summary: "specifies the header text (e.g. license) to prepend to the GO source file written when EmbedPackage is set.",
+				Details: "",
+			},
+		},
+	}
+}
+
+func (Title) Help() *markers.DefinitionHelp {
+	return &markers.DefinitionHelp{
+		Category: "Devfile",
+		DetailedHelp: markers.DetailedHelp{
+			Summary: "sets a human-friendly title (such as `Container Component`) on the Json schema generated for a type or a field, separate from the GO type name, for the documentation and form UIs built from the Json schemas. ",
+			Details: "The title of a type is used for all the fields of this type, unless the field has its own title.",
+		},
 		FieldHelp: map[string]markers.DetailedHelp{},
 	}
 }
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/generator/audit"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+// selftestCheck is a check of the generator wiring, which returns the problems it found
+type selftestCheck struct {
+	name string
+	run  func() []string
+}
+
+// newSelftestCommand returns the command that checks the wiring of the generator itself
+func newSelftestCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "selftest",
+		Short: "Checks the registry of the generators, their marker definitions and their wiring into the generator command.",
+		Long: `Checks the registry of the generators, their marker definitions and their wiring into the generator command,
+so that a mistake in a new generator is reported with all its details instead of breaking every generation run:
+- the options and output rule markers of all the generators can be registered,
+- the markers of each generator can be registered, and two generators don't define the same marker with different arguments,
+  since one of the definitions would silently replace the other,
+- each generator documents its options,
+- each generator that has a header file option gets the --header-file flag applied,
+- the audit generator knows all the generators, and each generator is reported under its own name in the run summary.
+
+Each check is printed out with its problems, and the command fails if any check found a problem.`,
+		Example: `
+# Check the generator after adding a new generator to its registry
+generator selftest
+`,
+		Args: cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			failed := 0
+			for _, check := range selftestChecks() {
+				problems := check.run()
+				if len(problems) == 0 {
+					fmt.Fprintf(c.OutOrStdout(), "ok    %s\n", check.name)
+					continue
+				}
+				failed++
+				fmt.Fprintf(c.OutOrStdout(), "FAIL  %s\n", check.name)
+				for _, problem := range problems {
+					fmt.Fprintf(c.OutOrStdout(), "      - %s\n", problem)
+				}
+			}
+			if failed > 0 {
+				return noUsageError{exitError{fmt.Errorf("%d self-test check(s) failed", failed), exitGenerationErrors}}
+			}
+			return nil
+		},
+		SilenceUsage: true,
+	}
+}
+
+// selftestChecks returns the checks of the generator wiring, in the order they are run
+func selftestChecks() []selftestCheck {
+	return []selftestCheck{
+		{"options registry", checkOptionsRegistry},
+		{"marker definitions", checkMarkerDefinitions},
+		{"generator help", checkGeneratorHelp},
+		{"header file wiring", checkHeaderFileWiring},
+		{"generator names", checkGeneratorNames},
+	}
+}
+
+// sortedGeneratorNames returns the names of all the known generators, sorted alphabetically
+func sortedGeneratorNames() []string {
+	names := make([]string, 0, len(allGenerators))
+	for name := range allGenerators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// checkOptionsRegistry checks that the options and output rule markers of all the generators can be registered
+func checkOptionsRegistry() []string {
+	if _, err := newOptionsRegistry(); err != nil {
+		return []string{err.Error()}
+	}
+	return nil
+}
+
+// checkMarkerDefinitions checks that the markers of each generator can be registered,
+// and that no marker is defined by several generators with different arguments
+func checkMarkerDefinitions() []string {
+	var problems []string
+	type definition struct {
+		generator string
+		output    reflect.Type
+	}
+	definitions := map[string]definition{}
+	for _, name := range sortedGeneratorNames() {
+		registry := &markers.Registry{}
+		if err := allGenerators[name].RegisterMarkers(registry); err != nil {
+			problems = append(problems, fmt.Sprintf("generator %s: cannot register its markers: %v", name, err))
+			continue
+		}
+		for _, defn := range registry.AllDefinitions() {
+			key := fmt.Sprintf("%s (%s)", defn.Name, targetName(defn.Target))
+			other, isDefined := definitions[key]
+			if !isDefined {
+				definitions[key] = definition{generator: name, output: defn.Output}
+				continue
+			}
+			if other.output != defn.Output {
+				problems = append(problems, fmt.Sprintf("marker %s is defined with the arguments %v by the %s generator, but %v by the %s generator",
+					key, other.output, other.generator, defn.Output, name))
+			}
+		}
+	}
+	sort.Strings(problems)
+	return problems
+}
+
+func targetName(target markers.TargetType) string {
+	switch target {
+	case markers.DescribesPackage:
+		return "package"
+	case markers.DescribesType:
+		return "type"
+	case markers.DescribesField:
+		return "field"
+	default:
+		return fmt.Sprintf("target %d", target)
+	}
+}
+
+// checkGeneratorHelp checks that each generator documents its options
+func checkGeneratorHelp() []string {
+	var problems []string
+	for _, name := range sortedGeneratorNames() {
+		helpGiver, hasHelp := allGenerators[name].(genall.HasHelp)
+		if !hasHelp || helpGiver.Help() == nil {
+			problems = append(problems, fmt.Sprintf("generator %s has no help: add the `+controllertools:marker:generateHelp` marker to its type and run `go generate`", name))
+		}
+	}
+	return problems
+}
+
+// checkHeaderFileWiring checks that the --header-file flag is applied to each generator that has a `HeaderFile` option
+func checkHeaderFileWiring() []string {
+	const headerFile = "selftest-header.txt"
+	var problems []string
+	for _, name := range sortedGeneratorNames() {
+		if _, hasHeaderFile := reflect.TypeOf(allGenerators[name]).FieldByName("HeaderFile"); !hasHeaderFile {
+			continue
+		}
+		gen := allGenerators[name]
+		rt := &genall.Runtime{Generators: genall.Generators{&gen}}
+		applyHeaderFile(rt, headerFile)
+		if reflect.ValueOf(gen).FieldByName("HeaderFile").String() != headerFile {
+			problems = append(problems, fmt.Sprintf("generator %s has a HeaderFile option, but is not handled by applyHeaderFile", name))
+		}
+	}
+	return problems
+}
+
+// checkGeneratorNames checks that the audit generator knows all the generators,
+// and that each generator is reported under its own name in the run summary
+func checkGeneratorNames() []string {
+	var problems []string
+	if !reflect.DeepEqual(audit.KnownGenerators, allGenerators) {
+		problems = append(problems, "the audit generator doesn't know all the generators: audit.KnownGenerators should be set to allGenerators")
+	}
+	for _, name := range sortedGeneratorNames() {
+		if reportedName := generatorName(allGenerators[name]); reportedName != name {
+			problems = append(problems, fmt.Sprintf("generator %s is reported as %s in the run summary: each generator should have its own type",
+				name, strings.TrimSpace(reportedName)))
+		}
+	}
+	return problems
+}
@@ -0,0 +1,119 @@
+package since
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/generator/genutils"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+// +controllertools:marker:generateHelp
+
+// Generator generates the `FieldsSince()` function, which returns the devfile schema version
+// in which each field annotated with the `devfile:since` marker was introduced,
+// indexed by the name of the GO type of the field, then by the Json name of the field.
+//
+// The devfile validation uses it to reject the fields that are not supported by the schema version of a devfile,
+// so that the `devfile:since` markers are the single source of truth of the version-gated fields,
+// for both the Json schemas and the validation.
+type Generator struct {
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	return genutils.RegisterSinceMarker(into)
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// sinceField is a field annotated with the `devfile:since` marker
+type sinceField struct {
+	typeName string
+	jsonName string
+	since    string
+}
+
+// Generate generates the artifacts
+func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	for _, root := range ctx.Roots {
+		ctx.Checker.Check(root)
+		root.NeedTypesInfo()
+
+		var fields []sinceField
+		if err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
+			for _, field := range info.Fields {
+				version, err := genutils.FieldSince(info.Name, field)
+				if err != nil {
+					root.AddError(err)
+					continue
+				}
+				if version == nil {
+					continue
+				}
+				jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+				if jsonName == "" || jsonName == "-" {
+					root.AddError(fmt.Errorf("the devfile:since marker is set on field %s/%s, which is not serialized with its own Json name", info.Name, field.Name))
+					continue
+				}
+				fields = append(fields, sinceField{typeName: info.Name, jsonName: jsonName, since: version.String()})
+			}
+		}); err != nil {
+			root.AddError(err)
+			return nil
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		sort.Slice(fields, func(i, j int) bool {
+			if fields[i].typeName != fields[j].typeName {
+				return fields[i].typeName < fields[j].typeName
+			}
+			return fields[i].jsonName < fields[j].jsonName
+		})
+
+		genutils.WriteFormattedSourceFile("since", g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
+			buf.WriteString(`
+// FieldsSince returns the devfile schema version in which each field annotated with the devfile:since marker was introduced,
+// indexed by the name of the GO type of the field, then by the Json name of the field
+func FieldsSince() map[string]map[string]string {
+	return map[string]map[string]string{`)
+			for i, field := range fields {
+				if i == 0 || fields[i-1].typeName != field.typeName {
+					if i > 0 {
+						buf.WriteString(`
+		},`)
+					}
+					buf.WriteString(fmt.Sprintf(`
+		%s: {`, strconv.Quote(field.typeName)))
+				}
+				buf.WriteString(fmt.Sprintf(`
+			%s: %s,`, strconv.Quote(field.jsonName), strconv.Quote(field.since)))
+			}
+			buf.WriteString(`
+		},
+	}
+}
+`)
+		})
+	}
+
+	return nil
+}
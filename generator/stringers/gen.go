@@ -0,0 +1,400 @@
+package stringers
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/generator/genutils"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+var (
+	// StringerTypeMarker is associated with a type for which the `String()` and `Summary()` methods should be generated
+	StringerTypeMarker = markers.Must(markers.MakeDefinition("devfile:stringer:generate", markers.DescribesType, struct{}{}))
+	// StringerFieldMarker is associated with a key field that should be described by the generated `String()` and `Summary()` methods
+	StringerFieldMarker = markers.Must(markers.MakeDefinition("devfile:stringer:field", markers.DescribesField, struct{}{}))
+)
+
+// +controllertools:marker:generateHelp
+
+// Generator generates human-readable `String()` and `Summary()` methods for the `devfile:stringer:generate` annotated types,
+// to be used in logs instead of the `%+v` dump of the whole object.
+//
+// The `Summary()` method returns a compact description of the object, such as `Component{name: tools, componentType: container}`,
+// with the fields annotated with the `devfile:stringer:field` marker and, for each union embedded in the type, the name of its set member.
+// Lists and maps are described by their size.
+//
+// The `String()` method additionally describes the `devfile:stringer:field` annotated fields of the set union members,
+// such as `Component{name: tools, componentType: container, container: {image: quay.io/devfile/universal-developer-image}}`,
+// and the lists of annotated types by the summary of their elements.
+type Generator struct {
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	if err := markers.RegisterAll(into, StringerTypeMarker, StringerFieldMarker); err != nil {
+		return err
+	}
+	into.AddHelp(StringerTypeMarker,
+		markers.SimpleHelp("Devfile", "indicates that the human-readable String() and Summary() methods should be generated for this type"))
+	into.AddHelp(StringerFieldMarker,
+		markers.SimpleHelp("Devfile", "indicates that this key field should be described by the generated String() and Summary() methods"))
+	return genutils.RegisterUnionMarkers(into)
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// Generate generates the artifacts
+func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	for _, root := range ctx.Roots {
+		ctx.Checker.Check(root)
+		root.NeedTypesInfo()
+
+		writer := &stringerWriter{
+			root:      root,
+			typeInfos: map[string]*markers.TypeInfo{},
+			imports:   map[string]bool{"strings": true},
+		}
+		var stringerTypes []*markers.TypeInfo
+		if err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
+			writer.typeInfos[info.Name] = info
+			if info.Markers.Get(StringerTypeMarker.Name) != nil {
+				stringerTypes = append(stringerTypes, info)
+			}
+		}); err != nil {
+			root.AddError(err)
+			return nil
+		}
+		if len(stringerTypes) == 0 {
+			continue
+		}
+
+		methods := new(bytes.Buffer)
+		for _, info := range stringerTypes {
+			writer.writeMethods(methods, info)
+		}
+		if len(writer.errors) > 0 {
+			for _, err := range writer.errors {
+				root.AddError(err)
+			}
+			continue
+		}
+
+		genutils.WriteFormattedSourceFile("stringers", g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
+			imports := make([]string, 0, len(writer.imports))
+			for imported := range writer.imports {
+				imports = append(imports, strconv.Quote(imported))
+			}
+			sort.Strings(imports)
+			buf.WriteString("\nimport (\n" + strings.Join(imports, "\n") + "\n)\n")
+			buf.Write(methods.Bytes())
+			buf.WriteString(`
+
+// formatStringerFields formats the fields described by the generated String() and Summary() methods
+func formatStringerFields(typeName string, fields []string) string {
+	return typeName + "{" + strings.Join(fields, ", ") + "}"
+}
+`)
+		})
+	}
+
+	return nil
+}
+
+// stringerWriter writes the `String()` and `Summary()` methods of the types of a package
+type stringerWriter struct {
+	root *loader.Package
+	// typeInfos contains the marker information of the types of the package, indexed by name
+	typeInfos map[string]*markers.TypeInfo
+	// imports contains the packages imported by the generated methods
+	imports map[string]bool
+	// depth is the nesting level of the union member descriptions being written, used to name the local variables
+	depth  int
+	errors []error
+}
+
+func (w *stringerWriter) writeMethods(buf *bytes.Buffer, info *markers.TypeInfo) {
+	fmt.Fprintf(buf, `
+
+// Summary returns a compact description of the %[1]s, with its key fields and the type of its unions, to be used in logs
+func (in %[1]s) Summary() string {
+	var fields []string
+`, info.Name)
+	w.writeFields(buf, info, "in", "fields", false)
+	fmt.Fprintf(buf, `	return formatStringerFields(%q, fields)
+}
+
+// String returns a human-readable description of the %[2]s, that also describes the key fields of its set union members
+func (in %[2]s) String() string {
+	var fields []string
+`, info.Name, info.Name)
+	w.writeFields(buf, info, "in", "fields", true)
+	fmt.Fprintf(buf, `	return formatStringerFields(%q, fields)
+}`, info.Name)
+}
+
+// hasFields returns true if the given type, or one of the types embedded in it, has annotated fields or unions to describe
+func (w *stringerWriter) hasFields(info *markers.TypeInfo) bool {
+	if info.Markers.Get(genutils.UnionMarker.Name) != nil {
+		return true
+	}
+	for _, field := range info.Fields {
+		if field.Markers.Get(StringerFieldMarker.Name) != nil {
+			return true
+		}
+		if embedded := w.embeddedTypeInfo(field); embedded != nil && w.hasFields(embedded) {
+			return true
+		}
+	}
+	return false
+}
+
+// embeddedTypeInfo returns the marker information of the type of the given field
+// if it is a type of the package embedded in its parent type, or nil otherwise
+func (w *stringerWriter) embeddedTypeInfo(field markers.FieldInfo) *markers.TypeInfo {
+	if field.Name != "" {
+		return nil
+	}
+	fieldType := field.RawField.Type
+	if star, isStar := fieldType.(*ast.StarExpr); isStar {
+		fieldType = star.X
+	}
+	ident, isIdent := fieldType.(*ast.Ident)
+	if !isIdent {
+		return nil
+	}
+	return w.typeInfos[ident.Name]
+}
+
+// writeFields writes the statements that append the descriptions of the annotated fields and unions of the given type,
+// whose value is given by the `expr` expression, to the `fields` slice variable.
+// In detailed mode, the set union members are also described.
+func (w *stringerWriter) writeFields(buf *bytes.Buffer, info *markers.TypeInfo, expr string, fields string, detailed bool) {
+	if info.Markers.Get(genutils.UnionMarker.Name) != nil {
+		w.writeUnion(buf, info, expr, fields, detailed)
+		return
+	}
+	for _, field := range info.Fields {
+		if embedded := w.embeddedTypeInfo(field); embedded != nil {
+			w.writeFields(buf, embedded, expr+"."+embedded.Name, fields, detailed)
+			continue
+		}
+		if field.Markers.Get(StringerFieldMarker.Name) == nil {
+			continue
+		}
+		w.writeField(buf, info, field, expr+"."+field.Name, fields, detailed)
+	}
+}
+
+// writeField writes the statement that appends the description of the given field to the `fields` slice variable
+func (w *stringerWriter) writeField(buf *bytes.Buffer, info *markers.TypeInfo, field markers.FieldInfo, expr string, fields string, detailed bool) {
+	jsonName := fieldJSONName(field)
+	fieldType := w.root.TypesInfo.TypeOf(field.RawField.Type)
+	if fieldType == nil {
+		w.errors = append(w.errors, fmt.Errorf("cannot resolve the type of field %s/%s", info.Name, field.Name))
+		return
+	}
+
+	condition, value := w.describe(expr, fieldType)
+	if value == "" {
+		if list, isList := fieldType.Underlying().(*types.Slice); isList && detailed {
+			if elementValue := w.describeElement(list.Elem()); elementValue != "" {
+				fmt.Fprintf(buf, `	if len(%[1]s) > 0 {
+		elements := make([]string, 0, len(%[1]s))
+		for _, element := range %[1]s {
+			elements = append(elements, %[2]s)
+		}
+		%[3]s = append(%[3]s, %[4]q+strings.Join(elements, ", ")+"]")
+	}
+`, expr, elementValue, fields, jsonName+": [")
+				return
+			}
+		}
+		switch fieldType.Underlying().(type) {
+		case *types.Slice, *types.Map:
+			condition, value = "len("+expr+") > 0", "strconv.Itoa(len("+expr+"))"
+			w.imports["strconv"] = true
+		default:
+			w.errors = append(w.errors, fmt.Errorf("the devfile:stringer:field marker is specified on %s/%s, whose type %s cannot be described: only scalars, lists, maps and devfile:stringer:generate annotated types are supported", info.Name, field.Name, fieldType.String()))
+			return
+		}
+	}
+	fmt.Fprintf(buf, `	if %s {
+		%s = append(%s, %q + %s)
+	}
+`, condition, fields, fields, jsonName+": ", value)
+}
+
+// describe returns the condition under which the value of the given expression should be described,
+// and the string expression that describes it.
+// It returns an empty description for the types that are not scalars or annotated types, such as lists and maps.
+func (w *stringerWriter) describe(expr string, exprType types.Type) (string, string) {
+	if pointer, isPointer := exprType.(*types.Pointer); isPointer {
+		if _, value := w.describe("(*"+expr+")", pointer.Elem()); value != "" {
+			if named, isNamed := pointer.Elem().(*types.Named); isNamed && w.isStringerType(named) {
+				value = expr + ".Summary()"
+			}
+			return expr + " != nil", value
+		}
+		return "", ""
+	}
+	if named, isNamed := exprType.(*types.Named); isNamed && w.isStringerType(named) {
+		return "true", expr + ".Summary()"
+	}
+	basic, isBasic := exprType.Underlying().(*types.Basic)
+	if !isBasic {
+		return "", ""
+	}
+	switch {
+	case basic.Info()&types.IsString != 0:
+		return expr + ` != ""`, toBasic(expr, exprType, "string")
+	case basic.Info()&types.IsBoolean != 0:
+		w.imports["strconv"] = true
+		return expr, "strconv.FormatBool(" + toBasic(expr, exprType, "bool") + ")"
+	case basic.Info()&types.IsNumeric != 0:
+		w.imports["fmt"] = true
+		return expr + " != 0", "fmt.Sprint(" + expr + ")"
+	}
+	return "", ""
+}
+
+// describeElement returns the string expression that describes an `element` variable of the given type,
+// in the detailed description of a list, or an empty string if lists of this type are described by their size.
+func (w *stringerWriter) describeElement(elementType types.Type) string {
+	if named, isNamed := elementType.(*types.Named); isNamed && w.isStringerType(named) {
+		return "element.Summary()"
+	}
+	if basic, isBasic := elementType.Underlying().(*types.Basic); isBasic && basic.Info()&types.IsString != 0 {
+		return toBasic("element", elementType, "string")
+	}
+	return ""
+}
+
+// isStringerType returns true if the given type is a type of the package annotated with the `devfile:stringer:generate` marker
+func (w *stringerWriter) isStringerType(named *types.Named) bool {
+	if named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != w.root.PkgPath {
+		return false
+	}
+	info, exists := w.typeInfos[named.Obj().Name()]
+	return exists && info.Markers.Get(StringerTypeMarker.Name) != nil
+}
+
+// writeUnion writes the statements that append the name of the set member of the given union, whose value is given
+// by the `expr` expression, to the `fields` slice variable.
+// The union is described by its discriminator Json name, or `type` if it has no discriminator.
+// If no member is set, the discriminator value is used.
+// In detailed mode, the set member is also described, by its value if it is annotated with the `devfile:stringer:field` marker,
+// and by its own annotated fields.
+func (w *stringerWriter) writeUnion(buf *bytes.Buffer, info *markers.TypeInfo, expr string, fields string, detailed bool) {
+	unionName := "type"
+	var discriminator *markers.FieldInfo
+	var members []markers.FieldInfo
+	for i, field := range info.Fields {
+		if field.Markers.Get(genutils.UnionDiscriminatorMarker.Name) != nil {
+			discriminator = &info.Fields[i]
+			unionName = fieldJSONName(field)
+			continue
+		}
+		members = append(members, field)
+	}
+
+	buf.WriteString("	switch {\n")
+	for _, member := range members {
+		memberExpr := expr + "." + member.Name
+		memberType := w.root.TypesInfo.TypeOf(member.RawField.Type)
+		if memberType == nil {
+			w.errors = append(w.errors, fmt.Errorf("cannot resolve the type of union member %s/%s", info.Name, member.Name))
+			continue
+		}
+		var condition string
+		switch memberType.Underlying().(type) {
+		case *types.Pointer, *types.Slice, *types.Map:
+			condition = memberExpr + " != nil"
+		default:
+			condition, _ = w.describe(memberExpr, memberType)
+		}
+		if condition == "" {
+			w.errors = append(w.errors, fmt.Errorf("union member %s/%s of type %s should be a pointer, a list, a map or a scalar", info.Name, member.Name, memberType.String()))
+			continue
+		}
+		memberJSONName := fieldJSONName(member)
+		fmt.Fprintf(buf, `	case %s:
+		%s = append(%s, %q)
+`, condition, fields, fields, unionName+": "+memberJSONName)
+
+		if !detailed {
+			continue
+		}
+		if member.Markers.Get(StringerFieldMarker.Name) != nil {
+			if _, value := w.describe(memberExpr, memberType); value != "" {
+				// the member is known to be set
+				fmt.Fprintf(buf, "		%s = append(%s, %q+%s)\n", fields, fields, memberJSONName+": ", value)
+			} else {
+				w.writeField(buf, info, member, memberExpr, fields, detailed)
+			}
+		}
+		pointer, isPointer := memberType.(*types.Pointer)
+		if !isPointer {
+			continue
+		}
+		named, isNamed := pointer.Elem().(*types.Named)
+		if !isNamed || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != w.root.PkgPath {
+			continue
+		}
+		memberInfo, exists := w.typeInfos[named.Obj().Name()]
+		if !exists || !w.hasFields(memberInfo) {
+			continue
+		}
+		w.depth++
+		memberFields := "memberFields" + strconv.Itoa(w.depth)
+		if w.depth == 1 {
+			memberFields = "memberFields"
+		}
+		fmt.Fprintf(buf, "		var %s []string\n", memberFields)
+		w.writeFields(buf, memberInfo, memberExpr, memberFields, detailed)
+		fmt.Fprintf(buf, `		if len(%[1]s) > 0 {
+			%[2]s = append(%[2]s, formatStringerFields(%[3]q, %[1]s))
+		}
+`, memberFields, fields, memberJSONName+": ")
+		w.depth--
+	}
+	if discriminator != nil {
+		fmt.Fprintf(buf, `	case %[1]s != "":
+		%[2]s = append(%[2]s, %[3]q + string(%[1]s))
+`, expr+"."+discriminator.Name, fields, unionName+": ")
+	}
+	buf.WriteString("	}\n")
+}
+
+// toBasic returns the given expression converted to the given basic type, if its type is a named type
+func toBasic(expr string, exprType types.Type, basicType string) string {
+	if _, isNamed := exprType.(*types.Named); isNamed {
+		return basicType + "(" + expr + ")"
+	}
+	return expr
+}
+
+// fieldJSONName returns the Json name of the given field, or its GO name if it has no Json name
+func fieldJSONName(field markers.FieldInfo) string {
+	if jsonName := strings.Split(field.Tag.Get("json"), ",")[0]; jsonName != "" {
+		return jsonName
+	}
+	return field.Name
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"text/tabwriter"
+	"time"
+
+	"golang.org/x/tools/go/packages"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+const (
+	textSummary = "text"
+	jsonSummary = "json"
+)
+
+// generatorSummary contains the telemetry data of a single generator run
+type generatorSummary struct {
+	// Generator is the name of the generator, as used on the command line
+	Generator string `json:"generator"`
+	// WallTime is the wall time spent in the generator, in seconds
+	WallTime float64 `json:"wallTimeSeconds"`
+	// TypesProcessed is the number of types of the root packages that carry markers of the generator
+	TypesProcessed int `json:"typesProcessed"`
+	// ArtifactsWritten is the number of artifacts written by the generator
+	ArtifactsWritten int `json:"artifactsWritten"`
+	// Failed indicates that the generator returned an error, or reported errors on the root packages
+	Failed bool `json:"failed"`
+}
+
+// runSummary contains the telemetry data of a whole generator run
+type runSummary struct {
+	// PackagesLoaded is the number of packages loaded during the run, including the dependencies of the root packages
+	PackagesLoaded int `json:"packagesLoaded"`
+	// WallTime is the wall time spent in all the generators, in seconds
+	WallTime float64 `json:"wallTimeSeconds"`
+	// Generators contains the telemetry data of each generator, in the order they were run
+	Generators []generatorSummary `json:"generators"`
+}
+
+// generatorName returns the command line name of the given generator
+func generatorName(gen genall.Generator) string {
+	for name, known := range allGenerators {
+		if reflect.TypeOf(known) == reflect.TypeOf(gen) {
+			return name
+		}
+	}
+	return reflect.TypeOf(gen).String()
+}
+
+// countProcessedTypes returns the number of types of the given root packages
+// that carry at least one type or field marker registered by the given generator.
+func countProcessedTypes(gen genall.Generator, roots []*loader.Package) (int, error) {
+	registry := &markers.Registry{}
+	if err := gen.RegisterMarkers(registry); err != nil {
+		return 0, err
+	}
+	collector := &markers.Collector{Registry: registry}
+
+	count := 0
+	for _, root := range roots {
+		if err := markers.EachType(collector, root, func(info *markers.TypeInfo) {
+			hasMarkers := len(info.Markers) > 0
+			for _, field := range info.Fields {
+				hasMarkers = hasMarkers || len(field.Markers) > 0
+			}
+			if hasMarkers {
+				count++
+			}
+		}); err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
+// countLoadedPackages returns the number of distinct packages loaded for the given roots and their dependencies
+func countLoadedPackages(roots []*loader.Package) int {
+	var rootPackages []*packages.Package
+	for _, root := range roots {
+		rootPackages = append(rootPackages, root.Package)
+	}
+	count := 0
+	packages.Visit(rootPackages, nil, func(*packages.Package) {
+		count++
+	})
+	return count
+}
+
+// newRunSummary builds the run summary from the telemetry data of each generator
+func newRunSummary(roots []*loader.Package, generators []generatorSummary) runSummary {
+	summary := runSummary{
+		PackagesLoaded: countLoadedPackages(roots),
+		Generators:     generators,
+	}
+	for _, gen := range generators {
+		summary.WallTime += gen.WallTime
+	}
+	return summary
+}
+
+// print prints the summary in the given format
+func (s runSummary) print(out io.Writer, format string) error {
+	switch format {
+	case jsonSummary:
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(s)
+	case textSummary:
+		fmt.Fprintf(out, "\nGeneration summary: %d packages loaded, %s total wall time\n\n", s.PackagesLoaded, formatSeconds(s.WallTime))
+		writer := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(writer, "GENERATOR\tWALL TIME\tTYPES\tARTIFACTS\tSTATUS")
+		for _, gen := range s.Generators {
+			status := "ok"
+			if gen.Failed {
+				status = "failed"
+			}
+			fmt.Fprintf(writer, "%s\t%s\t%d\t%d\t%s\n", gen.Generator, formatSeconds(gen.WallTime), gen.TypesProcessed, gen.ArtifactsWritten, status)
+		}
+		return writer.Flush()
+	default:
+		return fmt.Errorf("unknown summary format %q, should be one of: %s, %s", format, textSummary, jsonSummary)
+	}
+}
+
+func formatSeconds(seconds float64) string {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Millisecond).String()
+}
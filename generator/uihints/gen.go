@@ -0,0 +1,307 @@
+package uihints
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/devfile/api/generator/genutils"
+	"github.com/devfile/api/generator/schemas"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+var (
+	// OrderMarker is associated with a field to set its position in the forms of its type
+	OrderMarker = markers.Must(markers.MakeDefinition("devfile:ui:order", markers.DescribesField, 0))
+	// WidgetMarker is associated with a field to override the widget inferred from its type
+	WidgetMarker = markers.Must(markers.MakeDefinition("devfile:ui:widget", markers.DescribesField, ""))
+	// GroupMarker is associated with a field to set the label of the group of fields it is displayed in
+	GroupMarker = markers.Must(markers.MakeDefinition("devfile:ui:group", markers.DescribesField, ""))
+	// LabelMarker is associated with a field to override the label inferred from its Json name
+	LabelMarker = markers.Must(markers.MakeDefinition("devfile:ui:label", markers.DescribesField, ""))
+)
+
+// widgets are the widgets that can be set with the `devfile:ui:widget` marker
+var widgets = []string{"checkbox", "hidden", "json", "keyValue", "list", "map", "number", "object", "password", "select", "text", "textarea"}
+
+// +controllertools:marker:generateHelp
+
+// Generator generates UI hints (field ordering, widget types and group labels) for the types reachable
+// from the types that have the `devfile:jsonschema:generate` annotation, to be consumed by the form builders
+// that render devfile editors, instead of hard-coding the layout of the forms in each frontend.
+//
+// A `<package>.ui-hints.json` file is generated for each K8S API version. It contains the hints of each object type,
+// indexed by type name: the Json names of its fields in display order, the labels of its groups of fields,
+// its unions, and for each field a label, a widget and, for object and enum fields, the name of the field type.
+// The widget of a field is inferred from its type, and can be overridden with the `devfile:ui:widget` marker.
+type Generator struct{}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	if err := markers.RegisterAll(into, OrderMarker, WidgetMarker, GroupMarker, LabelMarker); err != nil {
+		return err
+	}
+	into.AddHelp(OrderMarker,
+		markers.SimpleHelp("Devfile", "sets the position of this field in the forms of its type: the fields with this marker are displayed first, by increasing position, then the other fields in declaration order"))
+	into.AddHelp(WidgetMarker,
+		markers.SimpleHelp("Devfile", "overrides the widget of this field in the forms of its type, which is otherwise inferred from the field type. Should be one of: "+strings.Join(widgets, ", ")))
+	into.AddHelp(GroupMarker,
+		markers.SimpleHelp("Devfile", "sets the label of the group of fields this field is displayed in, in the forms of its type"))
+	into.AddHelp(LabelMarker,
+		markers.SimpleHelp("Devfile", "overrides the label of this field in the forms of its type, which is otherwise inferred from the Json name of the field"))
+	if err := schemas.RegisterGenerateMarker(into); err != nil {
+		return err
+	}
+	return genutils.RegisterTypeModelMarkers(into)
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// Generate generates the artifacts
+func (Generator) Generate(ctx *genall.GenerationContext) error {
+	for _, root := range ctx.Roots {
+		model, err := genutils.BuildTypeModel(ctx, root, schemas.HasGenerateMarker)
+		if err != nil {
+			root.AddError(err)
+			continue
+		}
+		if len(model.Objects) == 0 {
+			continue
+		}
+
+		hints, err := buildHints(model, rootTypeNames(ctx, root))
+		if err != nil {
+			root.AddError(err)
+			continue
+		}
+		content, err := json.MarshalIndent(hints, "", "  ")
+		if err != nil {
+			root.AddError(err)
+			continue
+		}
+		genutils.WriteGeneratedArtifact(ctx, root, root.Name+".ui-hints.json", string(content)+"\n")
+	}
+	return nil
+}
+
+// rootTypeNames returns the names of the types of the given package that have the `devfile:jsonschema:generate` annotation
+func rootTypeNames(ctx *genall.GenerationContext, root *loader.Package) []string {
+	var names []string
+	if err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
+		if schemas.HasGenerateMarker(info) {
+			names = append(names, info.Name)
+		}
+	}); err != nil {
+		root.AddError(err)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// uiHints is the content of the generated file
+type uiHints struct {
+	// Roots are the names of the types from which the forms are built, such as `Devfile`
+	Roots []string `json:"roots"`
+	// Types are the hints of the object types, by type name
+	Types map[string]*typeHints `json:"types"`
+	// Enums are the values of the enum types, by type name
+	Enums map[string][]string `json:"enums,omitempty"`
+}
+
+// typeHints are the hints of the forms of an object type
+type typeHints struct {
+	// Order lists the Json names of the fields, in display order
+	Order []string `json:"order"`
+	// Groups lists the labels of the groups of fields, in display order
+	Groups []string `json:"groups,omitempty"`
+	// Fields are the hints of the fields, by Json name
+	Fields map[string]*fieldHints `json:"fields"`
+	// Unions are the unions of the type, whose members are mutually exclusive
+	Unions []unionHints `json:"unions,omitempty"`
+}
+
+// fieldHints are the hints of a field
+type fieldHints struct {
+	Label  string `json:"label"`
+	Widget string `json:"widget"`
+	Group  string `json:"group,omitempty"`
+	// Type is the name of the object or enum type of the field
+	Type string `json:"type,omitempty"`
+	// Items are the hints of the elements of list and map fields
+	Items    *itemHints `json:"items,omitempty"`
+	Required bool       `json:"required,omitempty"`
+}
+
+// itemHints are the hints of the elements of a list or map field
+type itemHints struct {
+	Widget string `json:"widget"`
+	// Type is the name of the object or enum type of the elements
+	Type string `json:"type,omitempty"`
+}
+
+// unionHints describes a union of an object type
+type unionHints struct {
+	// Discriminator is the Json name of the union discriminator, if any
+	Discriminator string `json:"discriminator,omitempty"`
+	// Members are the Json names of the union members
+	Members []string `json:"members"`
+}
+
+// buildHints builds the UI hints of the referenced object types of the given model
+func buildHints(model *genutils.TypeModel, roots []string) (*uiHints, error) {
+	hints := &uiHints{
+		Roots: roots,
+		Types: map[string]*typeHints{},
+	}
+	for _, object := range model.Objects {
+		if !object.Referenced {
+			continue
+		}
+		objectHints, err := buildTypeHints(model, object)
+		if err != nil {
+			return nil, err
+		}
+		hints.Types[object.Name] = objectHints
+	}
+	for _, enum := range model.Enums {
+		if hints.Enums == nil {
+			hints.Enums = map[string][]string{}
+		}
+		hints.Enums[enum.Name] = enum.Values
+	}
+	return hints, nil
+}
+
+func buildTypeHints(model *genutils.TypeModel, object *genutils.ObjectType) (*typeHints, error) {
+	objectHints := &typeHints{
+		Order:  []string{},
+		Fields: map[string]*fieldHints{},
+	}
+
+	discriminators := map[*genutils.Field]bool{}
+	for _, union := range model.InlinedUnions(object) {
+		unionHints := unionHints{}
+		if union.Union.Discriminator != nil {
+			unionHints.Discriminator = union.Union.Discriminator.JSONName
+			discriminators[union.Union.Discriminator] = true
+		}
+		for _, member := range union.Union.Members {
+			unionHints.Members = append(unionHints.Members, member.JSONName)
+		}
+		objectHints.Unions = append(objectHints.Unions, unionHints)
+	}
+
+	fields := model.InlinedFields(object)
+	sort.SliceStable(fields, func(i, j int) bool {
+		iOrder, iOrdered := fields[i].Markers.Get(OrderMarker.Name).(int)
+		jOrder, jOrdered := fields[j].Markers.Get(OrderMarker.Name).(int)
+		if iOrdered != jOrdered {
+			return iOrdered
+		}
+		return iOrdered && iOrder < jOrder
+	})
+
+	seenGroups := map[string]bool{}
+	for _, field := range fields {
+		hints := &fieldHints{
+			Label:    label(field.JSONName),
+			Required: !field.Optional,
+		}
+		if customLabel, hasLabel := field.Markers.Get(LabelMarker.Name).(string); hasLabel {
+			hints.Label = customLabel
+		}
+		hints.Widget, hints.Type = defaultWidget(field.Type)
+		if hints.Widget == "list" || hints.Widget == "map" {
+			hints.Items = &itemHints{}
+			hints.Items.Widget, hints.Items.Type = defaultWidget(field.Type.Elem)
+		}
+		if discriminators[field] {
+			// the discriminator is implied by the union member that is set
+			hints.Widget = "hidden"
+		}
+		if widget, hasWidget := field.Markers.Get(WidgetMarker.Name).(string); hasWidget {
+			if !isWidget(widget) {
+				return nil, fmt.Errorf("field %s of type %s has the unknown widget %q, which should be one of: %s", field.GoName, object.Name, widget, strings.Join(widgets, ", "))
+			}
+			hints.Widget = widget
+		}
+		if group, hasGroup := field.Markers.Get(GroupMarker.Name).(string); hasGroup {
+			hints.Group = group
+			if !seenGroups[group] {
+				seenGroups[group] = true
+				objectHints.Groups = append(objectHints.Groups, group)
+			}
+		}
+
+		objectHints.Order = append(objectHints.Order, field.JSONName)
+		objectHints.Fields[field.JSONName] = hints
+	}
+	return objectHints, nil
+}
+
+// defaultWidget returns the widget inferred from the given type, and the name of the type for object and enum types
+func defaultWidget(typeRef *genutils.TypeRef) (string, string) {
+	switch typeRef.Kind {
+	case genutils.StringKind:
+		return "text", ""
+	case genutils.IntKind, genutils.FloatKind:
+		return "number", ""
+	case genutils.BoolKind:
+		return "checkbox", ""
+	case genutils.ObjectKind:
+		return "object", typeRef.Name
+	case genutils.EnumKind:
+		return "select", typeRef.Name
+	case genutils.ListKind:
+		return "list", ""
+	case genutils.MapKind:
+		if typeRef.Elem.Kind == genutils.StringKind {
+			return "keyValue", ""
+		}
+		return "map", ""
+	default:
+		return "json", ""
+	}
+}
+
+func isWidget(name string) bool {
+	for _, widget := range widgets {
+		if widget == name {
+			return true
+		}
+	}
+	return false
+}
+
+// label returns the label inferred from the given Json name, such as `Command Line` for `commandLine`
+func label(jsonName string) string {
+	var words []string
+	var word []rune
+	for i, r := range jsonName {
+		if i > 0 && unicode.IsUpper(r) && len(word) > 0 && !unicode.IsUpper(word[len(word)-1]) {
+			words = append(words, string(word))
+			word = nil
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	return strings.Join(words, " ")
+}
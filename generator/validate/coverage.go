@@ -0,0 +1,110 @@
+package validate
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/generator/deepcopy"
+	"github.com/devfile/api/generator/genutils"
+	"github.com/devfile/api/generator/overrides"
+	"github.com/devfile/api/generator/schemas"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+// rootMarkers are the markers of the types from which the generators start processing the API:
+// the Json schemas, overrides, CRDs and K8S runtime objects
+var rootMarkers = []string{
+	"devfile:jsonschema:generate",
+	"devfile:overrides:generate",
+	"kubebuilder:resource",
+	"kubebuilder:object:root",
+	"k8s:deepcopy-gen:interfaces",
+}
+
+// registerCoverageMarkers registers the markers used to check that all the exported types of the API are processed by the generators,
+// including the root markers of the other generators, so that they can be recognized
+func registerCoverageMarkers(into *markers.Registry) error {
+	if err := genutils.RegisterHelperMarker(into); err != nil {
+		return err
+	}
+
+	for _, registerer := range []interface {
+		RegisterMarkers(into *markers.Registry) error
+	}{schemas.Generator{}, overrides.Generator{}, deepcopy.Generator{}} {
+		if err := registerer.RegisterMarkers(into); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkCoverage reports the exported types of the package that are not reachable from any root type of the generators,
+// and would thus be silently skipped by them: no CRD, Json schema or overrides coverage, no getters, ...
+func checkCoverage(root *loader.Package, packageTypes map[string]*markers.TypeInfo) {
+	reachable := map[*types.TypeName]bool{}
+	var visit func(typ types.Type)
+	visit = func(typ types.Type) {
+		switch typ := typ.(type) {
+		case *types.Named:
+			obj := typ.Obj()
+			if obj.Pkg() != root.Types || reachable[obj] {
+				return
+			}
+			reachable[obj] = true
+			visit(typ.Underlying())
+		case *types.Pointer:
+			visit(typ.Elem())
+		case *types.Slice:
+			visit(typ.Elem())
+		case *types.Array:
+			visit(typ.Elem())
+		case *types.Map:
+			visit(typ.Key())
+			visit(typ.Elem())
+		case *types.Struct:
+			for i := 0; i < typ.NumFields(); i++ {
+				visit(typ.Field(i).Type())
+			}
+		}
+	}
+
+	typeNames := make([]string, 0, len(packageTypes))
+	for name := range packageTypes {
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+
+	for _, name := range typeNames {
+		for _, rootMarker := range rootMarkers {
+			if packageTypes[name].Markers.Get(rootMarker) != nil {
+				visit(root.Types.Scope().Lookup(name).Type())
+				break
+			}
+		}
+	}
+
+	for _, name := range typeNames {
+		info := packageTypes[name]
+		if !ast.IsExported(name) || info.Markers.Get(genutils.HelperTypeMarker.Name) != nil {
+			continue
+		}
+		obj, isTypeName := root.Types.Scope().Lookup(name).(*types.TypeName)
+		if !isTypeName || reachable[obj] {
+			continue
+		}
+		switch obj.Type().Underlying().(type) {
+		case *types.Interface, *types.Signature:
+			continue
+		}
+		root.AddError(loader.ErrFromNode(fmt.Errorf(
+			"type `%v` is not reachable from any type processed by the generators (types with the %s markers), so it has no CRD, Json schema or overrides coverage: "+
+				"it should be referenced by the API types, or annotated with the `%s` marker if it is intentionally not part of the API",
+			name,
+			strings.Join(rootMarkers, ", "),
+			genutils.HelperTypeMarker.Name), info.RawSpec))
+	}
+}
@@ -1,9 +1,11 @@
 package validate
 
 import (
+	"bytes"
 	"fmt"
 	"go/ast"
 	"go/types"
+	"sort"
 	"strings"
 
 	"github.com/devfile/api/generator/genutils"
@@ -20,7 +22,22 @@ import (
 // Generator validates the consistency of the API GO code.
 //
 // Validity checks are related to unions, patchStrategy, and optional fields.
-type Generator struct{}
+//
+// It also checks that every exported type of the package is reachable from a type processed by the generators
+// (Json schemas, overrides, CRDs or K8S runtime objects), so that new types, such as status structures,
+// are not silently left without CRD or schema coverage. GO helper types that are intentionally not part of the API
+// should be annotated with the `devfile:helper` marker.
+//
+// It can also generate the table of the union members of the package, which allows checking at runtime,
+// with the `ValidateUnions` function of the package, that only one member is set in each union of a document.
+type Generator struct {
+	// RuntimeChecks generates the `unionMembers` table of the union members of the package,
+	// used by the `ValidateUnions` function to report the Json path of each union whose members are set simultaneously.
+	RuntimeChecks bool `marker:"runtimeChecks,optional"`
+
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+}
 
 // RegisterMarkers registers the markers of the Generator
 func (Generator) RegisterMarkers(into *markers.Registry) error {
@@ -28,6 +45,9 @@ func (Generator) RegisterMarkers(into *markers.Registry) error {
 	if err != nil {
 		return err
 	}
+	if err := registerCoverageMarkers(into); err != nil {
+		return err
+	}
 	return crdmarkers.Register(into)
 }
 
@@ -57,7 +77,11 @@ func (g Generator) Generate(ctx *genall.GenerationContext) error {
 		for _, typeToCheck := range packageTypes {
 			checkUnion(typeToCheck, root, packageTypes)
 		}
+		checkCoverage(root, packageTypes)
 
+		if g.RuntimeChecks {
+			writeUnionMembers(ctx, root, packageTypes, g.HeaderFile)
+		}
 	}
 
 	return nil
@@ -180,3 +204,41 @@ func checkUnion(typeToCheck *markers.TypeInfo, root *loader.Package, packageType
 		checkEnumValues(typeEnumMarkerPtr, enumTypeInfo.RawSpec)
 	}
 }
+
+// writeUnionMembers generates the table of the members of the union types of the package, indexed by union type
+func writeUnionMembers(ctx *genall.GenerationContext, root *loader.Package, packageTypes map[string]*markers.TypeInfo, headerFile string) {
+	var unionNames []string
+	for name, info := range packageTypes {
+		if info.Markers.Get(genutils.UnionMarker.Name) != nil {
+			unionNames = append(unionNames, name)
+		}
+	}
+	sort.Strings(unionNames)
+
+	genutils.WriteFormattedSourceFile("union_members", headerFile, ctx, root, func(buf *bytes.Buffer) {
+		buf.WriteString(`
+import (
+	"reflect"
+)
+
+// unionMembers contains the members of each union type, used to check at runtime that only one member is set in a union
+var unionMembers = map[reflect.Type][]unionMember{`)
+		for _, unionName := range unionNames {
+			buf.WriteString(`
+	reflect.TypeOf(` + unionName + `{}): {`)
+			for _, field := range packageTypes[unionName].Fields {
+				if field.Markers.Get(genutils.UnionDiscriminatorMarker.Name) != nil {
+					continue
+				}
+				jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+				buf.WriteString(`
+		{field: "` + field.Name + `", jsonName: "` + jsonName + `"},`)
+			}
+			buf.WriteString(`
+	},`)
+		}
+		buf.WriteString(`
+}
+`)
+	})
+}
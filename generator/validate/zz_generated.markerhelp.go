@@ -16,8 +16,17 @@ func (Generator) Help() *markers.DefinitionHelp {
 		Category: "",
 		DetailedHelp: markers.DetailedHelp{
 			Summary: "validates the consistency of the API GO code. ",
-			Details: "Validity checks are related to unions, patchStrategy, and optional fields.",
+			Details: "Validity checks are related to unions, patchStrategy, and optional fields. \n It also checks that every exported type of the package is reachable from a type processed by the generators (Json schemas, overrides, CRDs or K8S runtime objects), so that new types, such as status structures, are not silently left without CRD or schema coverage. GO helper types that are intentionally not part of the API should be annotated with the `devfile:helper` marker. \n It can also generate the table of the union members of the package, which allows checking at runtime, with the `ValidateUnions` function of the package, that only one member is set in each union of a document.",
+		},
+		FieldHelp: map[string]markers.DetailedHelp{
+			"RuntimeChecks": {
+				Summary: "generates the `unionMembers` table of the union members of the package, used by the `ValidateUnions` function to report the Json path of each union whose members are set simultaneously.",
+				Details: "",
+			},
+			"HeaderFile": {
+				Summary: "specifies the header text (e.g. license) to prepend to generated files.",
+				Details: "",
+			},
 		},
-		FieldHelp: map[string]markers.DetailedHelp{},
 	}
 }
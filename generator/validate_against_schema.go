@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	textOutput = "text"
+	jsonOutput = "json"
+)
+
+// schemaViolation is a violation of the Json schema by a validated document
+type schemaViolation struct {
+	// File is the path of the document
+	File string `json:"file"`
+	// Path is the Json pointer of the invalid value in the document
+	Path string `json:"path"`
+	// Message describes the violation
+	Message string `json:"message"`
+}
+
+// newValidateAgainstSchemaCommand returns the command that validates documents against a Json schema
+func newValidateAgainstSchemaCommand() *cobra.Command {
+	outputFormat := textOutput
+	schemaPath := ""
+	cmd := &cobra.Command{
+		Use:   "validate-against-schema --schema <schema> <document>...",
+		Short: "Validates yaml or Json documents against a Json schema, such as the generated devfile schema.",
+		Long: `Validates yaml or Json documents against a Json schema, such as the generated devfile schema,
+and prints the violations found.
+
+The schema is validated with the Json schema validator embedded in the generator, which supports the drafts 4, 6 and 7
+of the Json schema specification, so that schema changes can be tested locally without installing an external validator.
+The draft is selected from the $schema keyword of the schema, and is the draft 7 when the schema has none.
+The validator reports the first violation found in each object, so fixing a violation may reveal other ones.
+
+The command fails when at least one document doesn't match the schema.`,
+		Example: `
+# Validate a devfile against the generated devfile schema
+generator validate-against-schema --schema schemas/latest/devfile.json devfile.yaml
+
+# Validate several DevWorkspaces, and print the violations as a Json array
+generator validate-against-schema --schema schemas/latest/dev-workspace.json dw1.yaml dw2.yaml --output json
+`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if outputFormat != textOutput && outputFormat != jsonOutput {
+				return fmt.Errorf("unknown output format %q, should be one of: %s, %s", outputFormat, textOutput, jsonOutput)
+			}
+			if schemaPath == "" {
+				return fmt.Errorf("the Json schema should be given with the --schema flag")
+			}
+			schema, err := compileSchema(schemaPath)
+			if err != nil {
+				return noUsageError{exitError{err, exitGenerationErrors}}
+			}
+
+			violations := []schemaViolation{}
+			for _, documentPath := range args {
+				documentViolations, err := validateAgainstSchema(schema, documentPath)
+				if err != nil {
+					return noUsageError{exitError{err, exitGenerationErrors}}
+				}
+				violations = append(violations, documentViolations...)
+			}
+
+			if outputFormat == jsonOutput {
+				encoder := json.NewEncoder(c.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				err = encoder.Encode(violations)
+			} else {
+				var text strings.Builder
+				for _, violation := range violations {
+					fmt.Fprintf(&text, "%s: %s: %s\n", violation.File, violation.Path, violation.Message)
+				}
+				_, err = fmt.Fprint(c.OutOrStdout(), text.String())
+			}
+			if err != nil {
+				return err
+			}
+
+			if len(violations) > 0 {
+				return noUsageError{exitError{fmt.Errorf("%d schema violation(s) found", len(violations)), exitGenerationErrors}}
+			}
+			return nil
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVar(&schemaPath, "schema", schemaPath, "Json schema file against which the documents are validated")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", textOutput, "output format of the violations (either 'text' or 'json')")
+	return cmd
+}
+
+// compileSchema compiles the Json schema of the given file
+func compileSchema(schemaPath string) (*jsonschema.Schema, error) {
+	content, err := ioutil.ReadFile(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+	url, err := filepath.Abs(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(url, bytes.NewReader(content)); err != nil {
+		return nil, fmt.Errorf("failed to parse the %s schema: %w", schemaPath, err)
+	}
+	schema, err := compiler.Compile(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the %s schema: %w", schemaPath, err)
+	}
+	return schema, nil
+}
+
+// validateAgainstSchema validates the yaml or Json document of the given file against the given schema,
+// and returns the most specific violations reported by the schema validator
+func validateAgainstSchema(schema *jsonschema.Schema, documentPath string) ([]schemaViolation, error) {
+	content, err := ioutil.ReadFile(documentPath)
+	if err != nil {
+		return nil, err
+	}
+	document, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", documentPath, err)
+	}
+
+	err = schema.Validate(bytes.NewReader(document))
+	if err == nil {
+		return nil, nil
+	}
+	validationErr, isValidationErr := err.(*jsonschema.ValidationError)
+	if !isValidationErr {
+		return nil, fmt.Errorf("failed to validate %s: %w", documentPath, err)
+	}
+	var violations []schemaViolation
+	for _, cause := range leafValidationErrors(validationErr) {
+		// the instance pointers are fragments such as `#/components/0/name`
+		path := strings.TrimPrefix(cause.InstancePtr, "#")
+		if path == "" {
+			path = "/"
+		}
+		violations = append(violations, schemaViolation{File: documentPath, Path: path, Message: cause.Message})
+	}
+	return violations, nil
+}
+
+// leafValidationErrors returns the most specific validation errors nested in the given validation error
+func leafValidationErrors(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsonschema.ValidationError{err}
+	}
+	var leaves []*jsonschema.ValidationError
+	for _, cause := range err.Causes {
+		leaves = append(leaves, leafValidationErrors(cause)...)
+	}
+	return leaves
+}
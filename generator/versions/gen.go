@@ -0,0 +1,183 @@
+package versions
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/devfile/api/generator/genutils"
+	"github.com/devfile/api/generator/schemas"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+// +controllertools:marker:generateHelp
+
+// Generator generates the data of the `versions` GO package: the latest devfile schema version,
+// the supported schema versions, and the features introduced in each of them.
+//
+// It is derived from the same source of truth as the Json schemas: the latest version is the highest version
+// defined by the `devfile:jsonschema:version` marker of the K8S API packages, the oldest supported version is the first version
+// of its major version, and the features are the fields annotated with the `devfile:since` marker,
+// named `<GO type>.<Json name>`, such as `Container.cpuLimit`.
+// The fields of the generated types (such as the overrides) are not listed, since they mirror the fields of the API types.
+//
+// The source file is written in the output folder, which should be the folder of the `versions` package.
+type Generator struct {
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+
+	// Package is the name of the GO package of the generated source file. It defaults to `versions`.
+	Package string `marker:"package,optional"`
+}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	if err := schemas.RegisterVersionMarker(into); err != nil {
+		return err
+	}
+	return genutils.RegisterSinceMarker(into)
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// Generate generates the artifacts
+func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	var latest *semver.Version
+	featuresSince := map[string][]string{}
+	for _, root := range ctx.Roots {
+		ctx.Checker.Check(root)
+		root.NeedTypesInfo()
+
+		version, err := schemas.PackageVersion(ctx.Collector, root)
+		if err != nil {
+			root.AddError(err)
+			return nil
+		}
+		if version != nil && (latest == nil || latest.LessThan(*version)) {
+			latest = version
+		}
+
+		if err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
+			if strings.HasPrefix(filepath.Base(root.Fset.Position(info.RawSpec.Pos()).Filename), "zz_generated.") {
+				return
+			}
+			for _, field := range info.Fields {
+				since, err := genutils.FieldSince(info.Name, field)
+				if err != nil {
+					root.AddError(err)
+					continue
+				}
+				if since == nil {
+					continue
+				}
+				jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+				featuresSince[since.String()] = append(featuresSince[since.String()], info.Name+"."+jsonName)
+			}
+		}); err != nil {
+			root.AddError(err)
+			return nil
+		}
+	}
+	if latest == nil {
+		return fmt.Errorf("the versions generator requires a K8S API package annotated with the +devfile:jsonschema:version comment marker")
+	}
+
+	latestRelease := semver.Version{Major: latest.Major, Minor: latest.Minor, Patch: latest.Patch}
+	min := semver.Version{Major: latest.Major}
+	supportedVersions := map[string]semver.Version{min.String(): min, latestRelease.String(): latestRelease}
+	for since := range featuresSince {
+		version := semver.New(since)
+		if version.LessThan(min) || latestRelease.LessThan(*version) {
+			return fmt.Errorf("the devfile:since=%s marker is outside of the supported versions, from %s to %s", since, min, latestRelease)
+		}
+		supportedVersions[since] = *version
+	}
+	var supported []semver.Version
+	for _, version := range supportedVersions {
+		supported = append(supported, version)
+	}
+	sort.Slice(supported, func(i, j int) bool {
+		return supported[i].LessThan(supported[j])
+	})
+
+	buf := new(bytes.Buffer)
+	if g.HeaderFile != "" {
+		header, err := ctx.ReadFile(g.HeaderFile)
+		if err != nil {
+			return err
+		}
+		buf.Write(bytes.TrimSpace(header))
+		buf.WriteString("\n\n")
+	}
+	packageName := g.Package
+	if packageName == "" {
+		packageName = "versions"
+	}
+	buf.WriteString(genutils.GeneratedFileBanner + `
+
+package ` + packageName + `
+
+const (
+	// Latest is the latest devfile schema version, which is the version of the Json schemas generated from the K8S API
+	Latest = ` + strconv.Quote(latest.String()) + `
+	// LatestRelease is the latest devfile schema version, without its pre-release suffix
+	LatestRelease = ` + strconv.Quote(latestRelease.String()) + `
+	// Min is the oldest supported devfile schema version
+	Min = ` + strconv.Quote(min.String()) + `
+)
+
+// supported are the supported devfile schema versions, from the oldest to the latest:
+// Min, the versions that introduced features, and LatestRelease
+var supported = []string{`)
+	for _, version := range supported {
+		buf.WriteString(strconv.Quote(version.String()) + ", ")
+	}
+	buf.WriteString(`}
+
+// featuresSince are the features introduced in each supported schema version,
+// which are the fields annotated with the devfile:since marker, named <GO type>.<Json name>
+var featuresSince = map[string][]string{`)
+	for _, version := range supported {
+		features := featuresSince[version.String()]
+		if len(features) == 0 {
+			continue
+		}
+		sort.Strings(features)
+		buf.WriteString("\n" + strconv.Quote(version.String()) + ": {")
+		for _, feature := range features {
+			buf.WriteString("\n" + strconv.Quote(feature) + ",")
+		}
+		buf.WriteString("\n},")
+	}
+	buf.WriteString(`
+}
+`)
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	writer, err := ctx.Open(nil, "zz_generated.versions.go")
+	if err != nil {
+		return err
+	}
+	defer writer.Close()
+	_, err = writer.Write(source)
+	return err
+}
@@ -0,0 +1,519 @@
+package views
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/devfile/api/generator/genutils"
+	"sigs.k8s.io/controller-tools/pkg/genall"
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+//go:generate go run sigs.k8s.io/controller-tools/cmd/helpgen generate:headerFile=../header.go.txt,year=2021 paths=.
+
+var (
+	// ViewTypeMarker is associated with a type for which a read-only view interface should be generated
+	ViewTypeMarker = markers.Must(markers.MakeDefinition("devfile:view:generate", markers.DescribesType, struct{}{}))
+)
+
+// disabledDeepCopyMarker is the marker that disables the generation of the DeepCopy() methods of a type
+const disabledDeepCopyMarker = "+k8s:deepcopy-gen=false"
+
+// +controllertools:marker:generateHelp
+
+// Generator generates read-only view interfaces, with getter methods only, for the `devfile:view:generate` annotated types
+// and the struct types of their package reachable from them, so that hot read paths, such as controller reconcile loops,
+// can hand out the objects of a shared cache without defensive DeepCopies.
+//
+// The view of a struct type `T` is the `TView` interface, returned by the generated `View()` method of `*T`,
+// which shares the content of the object without copying it.
+// Its methods are named after the fields, with the fields of the embedded types inlined, and return:
+//
+// - the views of the nested structs, or nil for unset struct pointers,
+//
+// - list and map views, such as `ComponentListView`, for the lists and the maps with string keys,
+//
+// - the value and whether it is set for the pointers to other types, such as `*bool`,
+//
+// - copies of the other values, using their `DeepCopy()` method if any.
+//
+// The `DeepCopy()` method of a view returns a mutable deep copy of the viewed object.
+type Generator struct {
+	// HeaderFile specifies the header text (e.g. license) to prepend to generated files.
+	HeaderFile string `marker:",optional"`
+}
+
+// RegisterMarkers registers the markers of the Generator
+func (Generator) RegisterMarkers(into *markers.Registry) error {
+	if err := markers.RegisterAll(into, ViewTypeMarker); err != nil {
+		return err
+	}
+	into.AddHelp(ViewTypeMarker,
+		markers.SimpleHelp("Devfile", "indicates that a read-only view interface should be generated for this type, and for the struct types of its package reachable from it"))
+	return nil
+}
+
+func (Generator) CheckFilter() loader.NodeFilter {
+	return func(node ast.Node) bool {
+		// ignore interfaces
+		_, isIface := node.(*ast.InterfaceType)
+		return !isIface
+	}
+}
+
+// Generate generates the artifacts
+func (g Generator) Generate(ctx *genall.GenerationContext) error {
+	for _, root := range ctx.Roots {
+		ctx.Checker.Check(root)
+		root.NeedTypesInfo()
+
+		writer := &viewWriter{
+			pkg:         root.Types,
+			rootImports: genutils.PackageImports(root),
+			namesByPath: map[string]string{},
+			typeInfos:   map[string]*markers.TypeInfo{},
+			views:       map[string]types.Type{},
+		}
+		var viewTypes []*markers.TypeInfo
+		if err := markers.EachType(ctx.Collector, root, func(info *markers.TypeInfo) {
+			writer.typeInfos[info.Name] = info
+			if info.Markers.Get(ViewTypeMarker.Name) != nil {
+				viewTypes = append(viewTypes, info)
+			}
+		}); err != nil {
+			root.AddError(err)
+			return nil
+		}
+		if len(viewTypes) == 0 {
+			continue
+		}
+
+		for _, info := range viewTypes {
+			typeName, isTypeName := root.Types.Scope().Lookup(info.Name).(*types.TypeName)
+			if !isTypeName {
+				continue
+			}
+			if kindOf(root.Types, typeName.Type()) != structKind {
+				root.AddError(fmt.Errorf("the devfile:view:generate marker is specified on type %s, which is not a struct", info.Name))
+				continue
+			}
+			writer.view(typeName.Type())
+		}
+		views := new(bytes.Buffer)
+		for len(writer.queue) > 0 {
+			next := writer.queue[0]
+			writer.queue = writer.queue[1:]
+			writer.writeView(views, next)
+		}
+		if len(writer.errors) > 0 {
+			for _, err := range writer.errors {
+				root.AddError(err)
+			}
+			continue
+		}
+
+		// the view types should not be processed by the other generators
+		genutils.WriteFormattedAutogeneratedSourceFile("views", g.HeaderFile, ctx, root, func(buf *bytes.Buffer) {
+			genutils.WriteImports(buf, writer.namesByPath)
+			buf.Write(views.Bytes())
+		})
+	}
+
+	return nil
+}
+
+// viewWriter writes the read-only views of the types of a package
+type viewWriter struct {
+	pkg *types.Package
+	// rootImports contains the packages imported by the Go files of the package, indexed by the name under which they are imported
+	rootImports map[string]*types.Package
+	// namesByPath contains the names of the packages imported by the generated code, indexed by import path
+	namesByPath map[string]string
+	// typeInfos contains the marker information of the types of the package, indexed by name
+	typeInfos map[string]*markers.TypeInfo
+	// views contains the types whose views are generated, indexed by the name of their view interface
+	views map[string]types.Type
+	// queue contains the types whose views remain to be written
+	queue  []types.Type
+	errors []error
+}
+
+// qualifier returns the name under which the generated code refers to the given package,
+// which is the name under which the package is imported in the Go files of the generated package, if any
+func (w *viewWriter) qualifier(pkg *types.Package) string {
+	if pkg.Path() == w.pkg.Path() {
+		return ""
+	}
+	if name, known := w.namesByPath[pkg.Path()]; known {
+		return name
+	}
+	name := pkg.Name()
+	var importNames []string
+	for importName, imported := range w.rootImports {
+		if imported.Path() == pkg.Path() {
+			importNames = append(importNames, importName)
+		}
+	}
+	if len(importNames) > 0 {
+		sort.Strings(importNames)
+		name = importNames[0]
+	}
+	base := name
+	for i := 2; w.isImportName(name); i++ {
+		name = base + strconv.Itoa(i)
+	}
+	w.namesByPath[pkg.Path()] = name
+	return name
+}
+
+// isImportName returns true if the given name is already used by a package imported by the generated code
+func (w *viewWriter) isImportName(name string) bool {
+	for _, used := range w.namesByPath {
+		if used == name {
+			return true
+		}
+	}
+	return false
+}
+
+// typeString returns the Go expression of the given type in the generated code
+func (w *viewWriter) typeString(goType types.Type) string {
+	return types.TypeString(goType, w.qualifier)
+}
+
+// valueKind is the way the values of a type are returned by views
+type valueKind int
+
+const (
+	// leafKind values are returned as copies
+	leafKind valueKind = iota
+	structKind
+	listKind
+	mapKind
+	pointerKind
+)
+
+// kindOf returns the way the values of the given type are returned by the views of the given package
+func kindOf(pkg *types.Package, goType types.Type) valueKind {
+	switch t := goType.Underlying().(type) {
+	case *types.Pointer:
+		return pointerKind
+	case *types.Struct:
+		if named, isNamed := goType.(*types.Named); isNamed && named.Obj().Pkg() == pkg {
+			return structKind
+		}
+	case *types.Slice:
+		if basic, isBasic := t.Elem().Underlying().(*types.Basic); !isBasic || basic.Kind() != types.Byte {
+			return listKind
+		}
+	case *types.Map:
+		if basic, isBasic := t.Key().(*types.Basic); isBasic && basic.Kind() == types.String {
+			return mapKind
+		}
+	}
+	return leafKind
+}
+
+// viewName returns the name of the view interface of the given struct, list or map type, such as `Component` or `EnvVarList`,
+// without the `View` suffix
+func (w *viewWriter) viewName(goType types.Type) string {
+	switch t := goType.(type) {
+	case *types.Named:
+		return t.Obj().Name()
+	case *types.Basic:
+		return strings.Title(t.Name())
+	case *types.Pointer:
+		return w.viewName(t.Elem()) + "Pointer"
+	case *types.Slice:
+		return w.viewName(t.Elem()) + "List"
+	case *types.Map:
+		return w.viewName(t.Elem()) + "Map"
+	}
+	return ""
+}
+
+// view returns the name of the view interface of the given struct, list or map type, such as `ComponentView`,
+// and queues the writing of this view if needed
+func (w *viewWriter) view(goType types.Type) string {
+	name := w.viewName(goType) + "View"
+	if known, exists := w.views[name]; exists {
+		if !types.Identical(known, goType) {
+			w.errors = append(w.errors, fmt.Errorf("types %s and %s have the same view name %s", w.typeString(known), w.typeString(goType), name))
+		}
+		return name
+	}
+	w.views[name] = goType
+	w.queue = append(w.queue, goType)
+	return name
+}
+
+// implementation returns the name of the unexported type that implements the given view interface
+func implementation(view string) string {
+	runes := []rune(view)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+// viewValue returns the type returned by views for the values of the given type, and the Go expression
+// of this returned value for the given addressable expression
+func (w *viewWriter) viewValue(goType types.Type, expr string) (string, string) {
+	switch kindOf(w.pkg, goType) {
+	case structKind:
+		view := w.view(goType)
+		return view, fmt.Sprintf("%s{&%s}", implementation(view), expr)
+	case listKind, mapKind:
+		view := w.view(goType)
+		return view, fmt.Sprintf("%s(%s)", implementation(view), expr)
+	case pointerKind:
+		w.errors = append(w.errors, fmt.Errorf("type %s is only supported by views as a struct field", w.typeString(goType)))
+		return "", ""
+	}
+	return w.typeString(goType), w.leafCopy(goType, expr)
+}
+
+// leafCopy returns the Go expression that copies the value of the given addressable expression, of the given leaf type
+func (w *viewWriter) leafCopy(goType types.Type, expr string) string {
+	if _, isBasic := goType.Underlying().(*types.Basic); isBasic {
+		return expr
+	}
+	if slice, isSlice := goType.Underlying().(*types.Slice); isSlice {
+		if basic, isBasic := slice.Elem().Underlying().(*types.Basic); isBasic && basic.Kind() == types.Byte {
+			return fmt.Sprintf("append(%s(nil), %s...)", w.typeString(goType), expr)
+		}
+	}
+	if strings.HasPrefix(expr, "*") {
+		expr = "(" + expr + ")"
+	}
+	if deepCopy, _, _ := types.LookupFieldOrMethod(types.NewPointer(goType), true, nil, "DeepCopy"); deepCopy != nil {
+		if signature, isFunc := deepCopy.Type().(*types.Signature); isFunc && signature.Params().Len() == 0 && signature.Results().Len() == 1 {
+			result := signature.Results().At(0).Type()
+			switch {
+			case types.Identical(result, goType):
+				return expr + ".DeepCopy()"
+			case types.Identical(result, types.NewPointer(goType)):
+				return "*" + expr + ".DeepCopy()"
+			}
+		}
+	}
+	// the generated DeepCopy() methods of the K8S API types are not type-checked by the generators,
+	// so the struct types are expected to follow the deepcopy-gen convention
+	if _, isStruct := goType.Underlying().(*types.Struct); isStruct {
+		return "*" + expr + ".DeepCopy()"
+	}
+	w.errors = append(w.errors, fmt.Errorf("type %s is not supported by views: it should be a basic type, a struct or have a DeepCopy() method", w.typeString(goType)))
+	return ""
+}
+
+// writeView writes the view interface of the given type, and its implementation
+func (w *viewWriter) writeView(buf *bytes.Buffer, goType types.Type) {
+	view := w.view(goType)
+	impl := implementation(view)
+	typeString := w.typeString(goType)
+	switch kindOf(w.pkg, goType) {
+	case structKind:
+		w.writeStructView(buf, goType, view, impl, typeString)
+	case listKind:
+		elemType, elemValue := w.viewValue(goType.Underlying().(*types.Slice).Elem(), "v[index]")
+		fmt.Fprintf(buf, `
+
+// %[1]s is a read-only view of a %[3]s, that shares its content without copying it
+type %[1]s interface {
+	// Len returns the number of elements of the list
+	Len() int
+	// At returns the element of the list at the given index, and panics if the index is out of range
+	At(index int) %[4]s
+}
+
+type %[2]s %[3]s
+
+func (v %[2]s) Len() int {
+	return len(v)
+}
+
+func (v %[2]s) At(index int) %[4]s {
+	return %[5]s
+}`, view, impl, typeString, elemType, elemValue)
+	case mapKind:
+		w.namesByPath["sort"] = "sort"
+		elemType, elemValue := w.viewValue(goType.Underlying().(*types.Map).Elem(), "value")
+		fmt.Fprintf(buf, `
+
+// %[1]s is a read-only view of a %[3]s, that shares its content without copying it
+type %[1]s interface {
+	// Len returns the number of entries of the map
+	Len() int
+	// Get returns the value of the entry with the given key, and whether this entry exists
+	Get(key string) (%[4]s, bool)
+	// Keys returns the keys of the map, sorted alphabetically
+	Keys() []string
+}
+
+type %[2]s %[3]s
+
+func (v %[2]s) Len() int {
+	return len(v)
+}
+
+func (v %[2]s) Get(key string) (%[4]s, bool) {
+	value, exists := v[key]
+	if !exists {
+		var zero %[4]s
+		return zero, false
+	}
+	return %[5]s, true
+}
+
+func (v %[2]s) Keys() []string {
+	keys := make([]string, 0, len(v))
+	for key := range v {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}`, view, impl, typeString, elemType, elemValue)
+	}
+}
+
+// viewField is a field of a struct, with the fields of the embedded structs inlined
+type viewField struct {
+	name string
+	// expr is the Go expression of the field, relative to the `v.in` struct pointer
+	expr   string
+	goType types.Type
+}
+
+// writeStructView writes the view interface of the given struct type, its implementation,
+// and the `View()` method of the struct type
+func (w *viewWriter) writeStructView(buf *bytes.Buffer, goType types.Type, view string, impl string, typeString string) {
+	// the DeepCopy() methods generated in the package are not type-checked by the generators
+	if info := w.typeInfos[typeString]; info != nil && hasDeepCopyDisabled(info) {
+		w.errors = append(w.errors, fmt.Errorf("type %s is not supported by views: its DeepCopy() method should be generated", typeString))
+		return
+	}
+	fields := w.viewFields(goType.Underlying().(*types.Struct), "v.in", typeString)
+
+	var methods, implementations bytes.Buffer
+	for _, field := range fields {
+		if kindOf(w.pkg, field.goType) != pointerKind {
+			returnType, value := w.viewValue(field.goType, field.expr)
+			fmt.Fprintf(&methods, `
+	// %[1]s returns the %[1]s field of the viewed %[2]s
+	%[1]s() %[3]s`, field.name, typeString, returnType)
+			fmt.Fprintf(&implementations, `
+
+func (v %[1]s) %[2]s() %[3]s {
+	return %[4]s
+}`, impl, field.name, returnType, value)
+			continue
+		}
+
+		elem := field.goType.Underlying().(*types.Pointer).Elem()
+		switch kindOf(w.pkg, elem) {
+		case structKind:
+			elemView := w.view(elem)
+			fmt.Fprintf(&methods, `
+	// %[1]s returns the view of the %[1]s field of the viewed %[2]s, or nil if it is not set
+	%[1]s() %[3]s`, field.name, typeString, elemView)
+			fmt.Fprintf(&implementations, `
+
+func (v %[1]s) %[2]s() %[3]s {
+	if %[4]s == nil {
+		return nil
+	}
+	return %[5]s{%[4]s}
+}`, impl, field.name, elemView, field.expr, implementation(elemView))
+		case leafKind:
+			elemString := w.typeString(elem)
+			fmt.Fprintf(&methods, `
+	// %[1]s returns the value of the %[1]s field of the viewed %[2]s, and whether it is set
+	%[1]s() (%[3]s, bool)`, field.name, typeString, elemString)
+			fmt.Fprintf(&implementations, `
+
+func (v %[1]s) %[2]s() (%[3]s, bool) {
+	if %[4]s == nil {
+		var zero %[3]s
+		return zero, false
+	}
+	return %[5]s, true
+}`, impl, field.name, elemString, field.expr, w.leafCopy(elem, "*"+field.expr))
+		default:
+			w.errors = append(w.errors, fmt.Errorf("field %s of type %s is not supported by views: pointers to lists, maps and pointers are not supported", field.name, typeString))
+		}
+	}
+
+	fmt.Fprintf(buf, `
+
+// %[1]s is a read-only view of a %[3]s, that shares its content without copying it:
+// its methods return views of the nested structs, lists and maps, and copies of the other values.
+type %[1]s interface {%[4]s
+	// DeepCopy returns a mutable deep copy of the viewed %[3]s
+	DeepCopy() *%[3]s
+}
+
+// View returns a read-only view of the %[3]s, that shares its content without copying it,
+// or nil if the %[3]s is nil
+func (in *%[3]s) View() %[1]s {
+	if in == nil {
+		return nil
+	}
+	return %[2]s{in}
+}
+
+type %[2]s struct {
+	in *%[3]s
+}
+
+func (v %[2]s) DeepCopy() *%[3]s {
+	return v.in.DeepCopy()
+}%[5]s`, view, impl, typeString, methods.String(), implementations.String())
+}
+
+// viewFields returns the exported fields of the given struct, whose value is given by the `expr` expression,
+// with the fields of the embedded structs inlined, as they appear in the Json serialization
+func (w *viewWriter) viewFields(structType *types.Struct, expr string, typeString string) []viewField {
+	var fields []viewField
+	for i := 0; i < structType.NumFields(); i++ {
+		field := structType.Field(i)
+		if !field.Exported() {
+			continue
+		}
+		jsonName := strings.Split(reflect.StructTag(structType.Tag(i)).Get("json"), ",")[0]
+		if jsonName == "-" {
+			continue
+		}
+		if jsonName == "" && field.Embedded() {
+			embedded, isStruct := field.Type().Underlying().(*types.Struct)
+			if !isStruct {
+				w.errors = append(w.errors, fmt.Errorf("embedded field %s of type %s is not supported by views", field.Name(), typeString))
+				continue
+			}
+			fields = append(fields, w.viewFields(embedded, expr+"."+field.Name(), typeString)...)
+			continue
+		}
+		fields = append(fields, viewField{name: field.Name(), expr: expr + "." + field.Name(), goType: field.Type()})
+	}
+	return fields
+}
+
+// hasDeepCopyDisabled returns true if the generation of the DeepCopy() methods of the given type is disabled
+func hasDeepCopyDisabled(info *markers.TypeInfo) bool {
+	for _, doc := range []*ast.CommentGroup{info.RawDecl.Doc, info.RawSpec.Doc} {
+		if doc == nil {
+			continue
+		}
+		for _, comment := range doc.List {
+			if strings.TrimSpace(strings.TrimPrefix(comment.Text, "//")) == disabledDeepCopyMarker {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+// Package register allows registering all the versions of the `workspaces` K8S API
+// into a runtime Scheme in a single call, including the conversion functions
+// between the versions.
+package register
+
+import (
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha1"
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GroupName is the name of the API group of the `workspaces` K8S API
+const GroupName = "workspace.devfile.io"
+
+var (
+	// GroupVersions contains all the supported versions of the `workspaces` K8S API,
+	// by order of preference: the first one is the hub / storage version.
+	GroupVersions = []schema.GroupVersion{
+		v1alpha2.SchemeGroupVersion,
+		v1alpha1.SchemeGroupVersion,
+	}
+
+	// PreferredGroupVersion is the preferred (hub / storage) version of the `workspaces` K8S API
+	PreferredGroupVersion = v1alpha2.SchemeGroupVersion
+
+	// Kinds contains the kinds of all the resources defined in the `workspaces` K8S API
+	Kinds = []string{
+		v1alpha2.DevWorkspaceKind,
+		v1alpha2.DevWorkspaceListKind,
+		v1alpha2.DevWorkspaceTemplateKind,
+		v1alpha2.DevWorkspaceTemplateListKind,
+	}
+
+	// SchemeBuilder registers all the versions of the `workspaces` K8S API,
+	// as well as the conversion functions between them.
+	SchemeBuilder = runtime.NewSchemeBuilder(
+		v1alpha1.AddToScheme,
+		v1alpha2.AddToScheme,
+		addConversionFuncs,
+		setVersionPriority,
+	)
+
+	// AddToScheme adds all the versions of the `workspaces` K8S API to the given scheme,
+	// as well as the conversion functions between them.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
+
+// GroupVersionKind returns the GroupVersionKind of the given kind in the given version of the `workspaces` K8S API
+func GroupVersionKind(version string, kind string) schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: GroupName, Version: version, Kind: kind}
+}
+
+// PreferredGroupVersionKind returns the GroupVersionKind of the given kind in the preferred version of the `workspaces` K8S API
+func PreferredGroupVersionKind(kind string) schema.GroupVersionKind {
+	return PreferredGroupVersion.WithKind(kind)
+}
+
+func setVersionPriority(scheme *runtime.Scheme) error {
+	return scheme.SetVersionPriority(GroupVersions...)
+}
+
+// addConversionFuncs registers, for each resource, the conversion functions
+// between the v1alpha1 spoke and the v1alpha2 hub.
+func addConversionFuncs(scheme *runtime.Scheme) error {
+	if err := scheme.AddConversionFunc((*v1alpha1.DevWorkspace)(nil), (*v1alpha2.DevWorkspace)(nil),
+		func(src, dest interface{}, _ conversion.Scope) error {
+			return src.(*v1alpha1.DevWorkspace).ConvertTo(dest.(*v1alpha2.DevWorkspace))
+		}); err != nil {
+		return err
+	}
+	if err := scheme.AddConversionFunc((*v1alpha2.DevWorkspace)(nil), (*v1alpha1.DevWorkspace)(nil),
+		func(src, dest interface{}, _ conversion.Scope) error {
+			return dest.(*v1alpha1.DevWorkspace).ConvertFrom(src.(*v1alpha2.DevWorkspace))
+		}); err != nil {
+		return err
+	}
+	if err := scheme.AddConversionFunc((*v1alpha1.DevWorkspaceTemplate)(nil), (*v1alpha2.DevWorkspaceTemplate)(nil),
+		func(src, dest interface{}, _ conversion.Scope) error {
+			return src.(*v1alpha1.DevWorkspaceTemplate).ConvertTo(dest.(*v1alpha2.DevWorkspaceTemplate))
+		}); err != nil {
+		return err
+	}
+	return scheme.AddConversionFunc((*v1alpha2.DevWorkspaceTemplate)(nil), (*v1alpha1.DevWorkspaceTemplate)(nil),
+		func(src, dest interface{}, _ conversion.Scope) error {
+			return dest.(*v1alpha1.DevWorkspaceTemplate).ConvertFrom(src.(*v1alpha2.DevWorkspaceTemplate))
+		})
+}
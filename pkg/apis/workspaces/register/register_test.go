@@ -0,0 +1,72 @@
+package register
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha1"
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestAddToScheme(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := AddToScheme(scheme)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, groupVersion := range GroupVersions {
+		for _, kind := range Kinds {
+			assert.True(t, scheme.Recognizes(groupVersion.WithKind(kind)), "kind %s should be registered in version %s", kind, groupVersion.Version)
+		}
+	}
+
+	assert.Equal(t, GroupVersions, scheme.PrioritizedVersionsForGroup(GroupName))
+	assert.Equal(t, v1alpha2.DevWorkspaceGroupVersionKind, PreferredGroupVersionKind(v1alpha2.DevWorkspaceKind))
+	assert.Equal(t, v1alpha1.SchemeGroupVersion.WithKind(v1alpha2.DevWorkspaceKind), GroupVersionKind("v1alpha1", v1alpha2.DevWorkspaceKind))
+}
+
+func TestSchemeConversion(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if !assert.NoError(t, AddToScheme(scheme)) {
+		return
+	}
+
+	original := &v1alpha1.DevWorkspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-devworkspace"},
+		Spec: v1alpha1.DevWorkspaceSpec{
+			Started:      true,
+			RoutingClass: "basic",
+		},
+		Status: v1alpha1.DevWorkspaceStatus{
+			WorkspaceId: "workspace-id",
+			IdeUrl:      "https://ide.example.com",
+		},
+	}
+
+	converted := &v1alpha2.DevWorkspace{}
+	if !assert.NoError(t, scheme.Convert(original, converted, nil)) {
+		return
+	}
+	assert.Equal(t, "test-devworkspace", converted.Name)
+	assert.Equal(t, "workspace-id", converted.Status.DevWorkspaceId)
+	assert.Equal(t, "https://ide.example.com", converted.Status.MainUrl)
+	assert.True(t, converted.Spec.Started)
+
+	roundTripped := &v1alpha1.DevWorkspace{}
+	if !assert.NoError(t, scheme.Convert(converted, roundTripped, nil)) {
+		return
+	}
+	assert.Equal(t, original.Spec.RoutingClass, roundTripped.Spec.RoutingClass)
+	assert.Equal(t, original.Status.WorkspaceId, roundTripped.Status.WorkspaceId)
+
+	template := &v1alpha2.DevWorkspaceTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-template"},
+	}
+	convertedTemplate := &v1alpha1.DevWorkspaceTemplate{}
+	if assert.NoError(t, scheme.Convert(template, convertedTemplate, nil)) {
+		assert.Equal(t, "test-template", convertedTemplate.Name)
+	}
+}
@@ -31,8 +31,8 @@ func convertComponentFrom_v1alpha2(src *v1alpha2.Component, dest *Component) err
 	if src.Plugin != nil {
 		// Need to handle plugin components separately.
 		return convertPluginComponentFrom_v1alpha2(src, dest)
-	} else if src.Image != nil {
-		// Skip converting an Image component since v1alpha1 does not have an Image component
+	} else if src.Image != nil || src.Secret != nil || src.ConfigMap != nil {
+		// Skip converting Image, Secret and ConfigMap components since v1alpha1 does not have them
 		return nil
 	}
 	name := src.Key()
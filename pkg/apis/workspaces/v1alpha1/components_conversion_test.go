@@ -39,22 +39,47 @@ func TestComponentConversion_v1alpha1(t *testing.T) {
 
 func TestComponentConversionFrom_v1alpha2(t *testing.T) {
 
-	src := &v1alpha2.Component{
-		Name: "test1",
-		ComponentUnion: v1alpha2.ComponentUnion{
-			Image: &v1alpha2.ImageComponent{
-				Image: v1alpha2.Image{
-					ImageName: "image:latest",
+	tests := []struct {
+		name  string
+		union v1alpha2.ComponentUnion
+	}{
+		{
+			name: "Image Component",
+			union: v1alpha2.ComponentUnion{
+				Image: &v1alpha2.ImageComponent{
+					Image: v1alpha2.Image{
+						ImageName: "image:latest",
+					},
 				},
 			},
 		},
+		{
+			name: "Secret Component",
+			union: v1alpha2.ComponentUnion{
+				Secret: &v1alpha2.SecretComponent{SecretName: "certs"},
+			},
+		},
+		{
+			name: "ConfigMap Component",
+			union: v1alpha2.ComponentUnion{
+				ConfigMap: &v1alpha2.ConfigMapComponent{ConfigMapName: "settings"},
+			},
+		},
 	}
-	output := &Component{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := &v1alpha2.Component{
+				Name:           "test1",
+				ComponentUnion: tt.union,
+			}
+			output := &Component{}
 
-	err := convertComponentFrom_v1alpha2(src, output)
-	if !assert.NoError(t, err, "Should not return error when converting from v1alpha2") {
-		return
-	}
+			err := convertComponentFrom_v1alpha2(src, output)
+			if !assert.NoError(t, err, "Should not return error when converting from v1alpha2") {
+				return
+			}
 
-	assert.Equal(t, &Component{}, output, "Conversion from v1alpha2 should be skipped for Image Component")
+			assert.Equal(t, &Component{}, output, "Conversion from v1alpha2 should be skipped for "+tt.name)
+		})
+	}
 }
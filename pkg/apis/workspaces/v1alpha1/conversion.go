@@ -2,12 +2,13 @@ package v1alpha1
 
 import (
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/devfile/keys"
 )
 
 const (
 	// ConvertedFromAttribute marks a devfile element as being converted from a different underlying field. For example,
 	// since v1alpha2 does not support
-	ConvertedFromAttribute = "conversion.api.devfile.io/converted-from"
+	ConvertedFromAttribute = keys.ConvertedFromAttribute
 )
 
 func convertDevWorkspaceTo_v1alpha2(src *DevWorkspace, dest *v1alpha2.DevWorkspace) error {
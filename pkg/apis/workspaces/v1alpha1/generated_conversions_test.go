@@ -0,0 +1,50 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratedEnvVarConversion_v1alpha1(t *testing.T) {
+	f := fuzz.New().NilChance(fuzzNilChance)
+	for i := 0; i < fuzzIterations; i++ {
+		original := &EnvVar{}
+		intermediate := &v1alpha2.EnvVar{}
+		output := &EnvVar{}
+		f.Fuzz(original)
+		err := Convert_v1alpha1_EnvVar_To_v1alpha2_EnvVar(original, intermediate)
+		if !assert.NoError(t, err, "Should not return error when converting to v1alpha2") {
+			return
+		}
+		err = Convert_v1alpha2_EnvVar_To_v1alpha1_EnvVar(intermediate, output)
+		if !assert.NoError(t, err, "Should not return error when converting from v1alpha2") {
+			return
+		}
+		assert.Equal(t, original, output, "EnvVar should not be changed when converting between v1alpha1 and v1alpha2")
+	}
+}
+
+func TestGeneratedImportReferenceUnionConversion_v1alpha1(t *testing.T) {
+	f := fuzz.New().NilChance(fuzzNilChance)
+	for i := 0; i < fuzzIterations; i++ {
+		original := &ImportReferenceUnion{}
+		intermediate := &v1alpha2.ImportReferenceUnion{}
+		output := &ImportReferenceUnion{}
+		f.Fuzz(original)
+		err := Convert_v1alpha1_ImportReferenceUnion_To_v1alpha2_ImportReferenceUnion(original, intermediate)
+		if !assert.NoError(t, err, "Should not return error when converting to v1alpha2") {
+			return
+		}
+		if original.Kubernetes != nil {
+			assert.NotSame(t, original.Kubernetes, intermediate.Kubernetes, "Converted pointers should not be shared with the original")
+		}
+		err = Convert_v1alpha2_ImportReferenceUnion_To_v1alpha1_ImportReferenceUnion(intermediate, output)
+		if !assert.NoError(t, err, "Should not return error when converting from v1alpha2") {
+			return
+		}
+		assert.Equal(t, original, output, "ImportReferenceUnion should not be changed when converting between v1alpha1 and v1alpha2")
+	}
+}
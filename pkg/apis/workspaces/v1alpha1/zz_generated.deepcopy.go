@@ -1,6 +1,8 @@
 //go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
+// Generated for the devfile generator
+
 // Code generated by controller-gen. DO NOT EDIT.
 
 package v1alpha1
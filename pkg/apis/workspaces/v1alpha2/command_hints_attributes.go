@@ -0,0 +1,112 @@
+package v1alpha2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	attributes "github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/api/v2/pkg/devfile/keys"
+)
+
+// CommandHintsAttribute is the key of the command attribute that contains hints for the IDEs and tools
+// that present the command to users. Its content is described by the `CommandHints` type.
+const CommandHintsAttribute = keys.CommandHintsAttribute
+
+// CommandHints is the content of the `command-hints` attribute of a command.
+// It contains hints for the IDEs and tools that present the command to users, such as in command menus.
+// +devfile:jsonschema:generate
+type CommandHints struct {
+	// +optional
+	// Hides the command from the command menus.
+	// A hidden command can still be run by composite commands and events.
+	Hidden bool `json:"hidden,omitempty"`
+
+	// +optional
+	// Icon of the command in the command menus, either as the name of an icon of the IDE,
+	// such as `debug-start`, or as the URI of an image
+	Icon string `json:"icon,omitempty"`
+
+	// +optional
+	// Behavior of the terminal in which the command runs
+	Terminal *CommandTerminalHints `json:"terminal,omitempty"`
+}
+
+// TerminalReveal describes when the terminal of a command is brought to front.
+// +kubebuilder:validation:Enum=always;silent;never
+type TerminalReveal string
+
+const (
+	// AlwaysTerminalReveal brings the terminal to front when the command starts
+	AlwaysTerminalReveal TerminalReveal = "always"
+	// SilentTerminalReveal brings the terminal to front only when the command fails
+	SilentTerminalReveal TerminalReveal = "silent"
+	// NeverTerminalReveal never brings the terminal to front
+	NeverTerminalReveal TerminalReveal = "never"
+)
+
+// TerminalPanel describes whether the terminal of a command is shared with other commands.
+// +kubebuilder:validation:Enum=shared;dedicated;new
+type TerminalPanel string
+
+const (
+	// SharedTerminalPanel runs the command in a terminal shared with the other commands
+	SharedTerminalPanel TerminalPanel = "shared"
+	// DedicatedTerminalPanel runs the command in a terminal reused by each run of this command
+	DedicatedTerminalPanel TerminalPanel = "dedicated"
+	// NewTerminalPanel runs the command in a new terminal on each run
+	NewTerminalPanel TerminalPanel = "new"
+)
+
+// CommandTerminalHints describes the behavior of the terminal in which a command runs
+type CommandTerminalHints struct {
+	// +optional
+	// When the terminal is brought to front
+	Reveal TerminalReveal `json:"reveal,omitempty"`
+
+	// +optional
+	// Whether the terminal is shared with other commands
+	Panel TerminalPanel `json:"panel,omitempty"`
+
+	// +optional
+	// Clears the terminal before the command runs
+	Clear bool `json:"clear,omitempty"`
+}
+
+// GetCommandHints decodes and validates the `command-hints` attribute of the given attributes.
+// Unlike other attributes, the `command-hints` attribute cannot contain unknown fields,
+// so that misspelled hints are reported instead of being silently ignored.
+// It returns nil if the attribute is not set.
+func GetCommandHints(attrs attributes.Attributes) (*CommandHints, error) {
+	attribute, exists := attrs[CommandHintsAttribute]
+	if !exists {
+		return nil, nil
+	}
+	hints := &CommandHints{}
+	decoder := json.NewDecoder(bytes.NewReader(attribute.Raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(hints); err != nil {
+		return nil, fmt.Errorf("attribute %q is invalid: %v", CommandHintsAttribute, err)
+	}
+
+	if terminal := hints.Terminal; terminal != nil {
+		if terminal.Reveal != "" && !terminal.Reveal.IsValid() {
+			return nil, fmt.Errorf("attribute %q is invalid: terminal.reveal should be one of: %s, %s, %s, but is %q",
+				CommandHintsAttribute, AlwaysTerminalReveal, SilentTerminalReveal, NeverTerminalReveal, terminal.Reveal)
+		}
+		if terminal.Panel != "" && !terminal.Panel.IsValid() {
+			return nil, fmt.Errorf("attribute %q is invalid: terminal.panel should be one of: %s, %s, %s, but is %q",
+				CommandHintsAttribute, SharedTerminalPanel, DedicatedTerminalPanel, NewTerminalPanel, terminal.Panel)
+		}
+	}
+	return hints, nil
+}
+
+// PutCommandHints sets the `command-hints` attribute of the given attributes, and returns the updated attributes
+func PutCommandHints(attrs attributes.Attributes, hints CommandHints) attributes.Attributes {
+	if attrs == nil {
+		attrs = attributes.Attributes{}
+	}
+	// encoding the CommandHints type cannot fail
+	return attrs.Put(CommandHintsAttribute, hints, nil)
+}
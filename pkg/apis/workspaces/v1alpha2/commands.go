@@ -55,12 +55,14 @@ type LabeledCommand struct {
 	Label string `json:"label,omitempty"`
 }
 
+// +devfile:stringer:generate
 type Command struct {
 	// Mandatory identifier that allows referencing
 	// this command in composite commands, from
 	// a parent, or in events.
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +kubebuilder:validation:MaxLength=63
+	// +devfile:stringer:field
 	Id string `json:"id"`
 	// Map of implementation-dependant free-form YAML attributes.
 	// +optional
@@ -120,10 +122,14 @@ type ExecCommand struct {
 	//  - `$PROJECTS_ROOT`: A path where projects sources are mounted as defined by container component's sourceMapping.
 	//
 	//  - `$PROJECT_SOURCE`: A path to a project source ($PROJECTS_ROOT/<project-name>). If there are multiple projects, this will point to the directory of the first one.
+	// +devfile:stringer:field
+	// +devfile:ui:order=1
+	// +devfile:ui:widget=textarea
 	CommandLine string `json:"commandLine"`
 
 	// Describes component to which given action relates
 	//
+	// +devfile:stringer:field
 	Component string `json:"component"`
 
 	// Working directory where the command should be executed
@@ -157,6 +163,7 @@ type ApplyCommand struct {
 
 	// Describes component that will be applied
 	//
+	// +devfile:stringer:field
 	Component string `json:"component"`
 }
 
@@ -165,12 +172,27 @@ type CompositeCommand struct {
 	LabeledCommand `json:",inline"`
 
 	// The commands that comprise this composite command
+	// +devfile:stringer:field
 	Commands []string `json:"commands,omitempty" patchStrategy:"replace"`
 
 	// Indicates if the sub-commands should be executed concurrently
 	// +optional
 	// +devfile:default:value=false
 	Parallel *bool `json:"parallel,omitempty"`
+
+	// Maximum number of sub-commands executed at the same time, when `parallel` is true.
+	// All the sub-commands are executed at the same time if not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrency *int `json:"maxConcurrency,omitempty"`
+
+	// The sub-commands whose failure doesn't make the composite command fail.
+	//
+	// When a sub-command which is not listed here fails, the composite command fails:
+	// the following sub-commands are not executed, and the sub-commands running in parallel are stopped.
+	// When a sub-command listed here fails, the execution of the composite command goes on.
+	// +optional
+	ContinueOnError []string `json:"continueOnError,omitempty" patchStrategy:"replace"`
 }
 
 type CustomCommand struct {
@@ -178,6 +200,7 @@ type CustomCommand struct {
 
 	// Class of command that the associated implementation component
 	// should use to process this command with the appropriate logic
+	// +devfile:stringer:field
 	CommandClass string `json:"commandClass"`
 
 	// Additional free-form configuration for this custom command
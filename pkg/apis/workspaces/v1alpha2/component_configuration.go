@@ -0,0 +1,68 @@
+package v1alpha2
+
+// +devfile:title=Secret Component
+
+// Component that allows the developer to mount an existing Kubernetes Secret into the containers of their devworkspace
+type SecretComponent struct {
+	BaseComponent `json:",inline"`
+
+	// Name of the existing Secret, in the namespace of the devworkspace
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	// +kubebuilder:validation:MaxLength=253
+	// +devfile:stringer:field
+	SecretName string `json:"secretName"`
+
+	ConfigurationMount `json:",inline"`
+}
+
+// +devfile:title=ConfigMap Component
+
+// Component that allows the developer to mount an existing Kubernetes ConfigMap into the containers of their devworkspace
+type ConfigMapComponent struct {
+	BaseComponent `json:",inline"`
+
+	// Name of the existing ConfigMap, in the namespace of the devworkspace
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	// +kubebuilder:validation:MaxLength=253
+	// +devfile:stringer:field
+	ConfigMapName string `json:"configMapName"`
+
+	ConfigurationMount `json:",inline"`
+}
+
+// Mount of an existing Secret or ConfigMap into the containers of the devworkspace.
+// Like volume components, Secret and ConfigMap components can be mounted by the container components
+// that reference them in their volume mounts.
+// +devfile:getter:generate
+type ConfigurationMount struct {
+	// Absolute path where the Secret or ConfigMap is mounted in all the container components
+	// that don't reference it in their volume mounts.
+	// If not set, it is only mounted in the containers that reference it in their volume mounts.
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// Keys of the Secret or ConfigMap that are mounted, with the relative path of the file of each key.
+	// If not set, all the keys are mounted, as files named after the keys.
+	// +optional
+	// +patchMergeKey=key
+	// +patchStrategy=merge
+	Items []KeyToPath `json:"items,omitempty" patchStrategy:"merge" patchMergeKey:"key"`
+
+	// Whether the devworkspace can start when the Secret or ConfigMap, or one of the mounted keys, doesn't exist.
+	// Defaults to false
+	// +optional
+	// +devfile:default:value=false
+	Optional *bool `json:"optional,omitempty"`
+}
+
+// Key of a Secret or ConfigMap mounted as a file
+type KeyToPath struct {
+	// Key of the Secret or ConfigMap
+	// +kubebuilder:validation:Pattern=^[-._a-zA-Z0-9]+$
+	// +kubebuilder:validation:MaxLength=253
+	Key string `json:"key"`
+
+	// Relative path of the file of the key, in the mount path.
+	// It may not contain the '..' element, nor start with '/'.
+	Path string `json:"path"`
+}
@@ -0,0 +1,78 @@
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ToVolume returns the K8S volume, with the given name, that mounts the Secret of this component
+func (in *SecretComponent) ToVolume(name string) corev1.Volume {
+	return corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: in.SecretName,
+				Items:      in.ConfigurationMount.keysToPaths(),
+				Optional:   in.ConfigurationMount.optional(),
+			},
+		},
+	}
+}
+
+// ToVolume returns the K8S volume, with the given name, that mounts the ConfigMap of this component
+func (in *ConfigMapComponent) ToVolume(name string) corev1.Volume {
+	return corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: in.ConfigMapName},
+				Items:                in.ConfigurationMount.keysToPaths(),
+				Optional:             in.ConfigurationMount.optional(),
+			},
+		},
+	}
+}
+
+// ToVolume returns the K8S volume, with the given name, that mounts the Secret or ConfigMap of a secret or configMap component.
+// It returns false if the component is neither a secret nor a configMap component.
+func (union ComponentUnion) ToVolume(name string) (corev1.Volume, bool) {
+	switch {
+	case union.Secret != nil:
+		return union.Secret.ToVolume(name), true
+	case union.ConfigMap != nil:
+		return union.ConfigMap.ToVolume(name), true
+	}
+	return corev1.Volume{}, false
+}
+
+// GetConfigurationMount returns the mount of a secret or configMap component,
+// or nil if the component is neither a secret nor a configMap component
+func (union ComponentUnion) GetConfigurationMount() *ConfigurationMount {
+	switch {
+	case union.Secret != nil:
+		return &union.Secret.ConfigurationMount
+	case union.ConfigMap != nil:
+		return &union.ConfigMap.ConfigurationMount
+	}
+	return nil
+}
+
+func (in ConfigurationMount) keysToPaths() []corev1.KeyToPath {
+	if in.Items == nil {
+		return nil
+	}
+	items := make([]corev1.KeyToPath, 0, len(in.Items))
+	for _, item := range in.Items {
+		items = append(items, corev1.KeyToPath{Key: item.Key, Path: item.Path})
+	}
+	return items
+}
+
+// optional returns the Optional field of the mount, which is only set on the K8S volume when it is true,
+// since K8S defaults it to false
+func (in ConfigurationMount) optional() *bool {
+	if !in.GetOptional() {
+		return nil
+	}
+	optional := true
+	return &optional
+}
@@ -0,0 +1,60 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestConfigurationToVolume(t *testing.T) {
+	optional := true
+	notOptional := false
+	tests := []struct {
+		name           string
+		union          ComponentUnion
+		expectedVolume corev1.Volume
+		expectedOk     bool
+	}{
+		{
+			name: "Secret with items",
+			union: ComponentUnion{Secret: &SecretComponent{
+				SecretName: "certs",
+				ConfigurationMount: ConfigurationMount{
+					MountPath: "/etc/certs",
+					Items:     []KeyToPath{{Key: "tls.crt", Path: "server/tls.crt"}},
+					Optional:  &optional,
+				},
+			}},
+			expectedVolume: corev1.Volume{Name: "tls", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName: "certs",
+				Items:      []corev1.KeyToPath{{Key: "tls.crt", Path: "server/tls.crt"}},
+				Optional:   &optional,
+			}}},
+			expectedOk: true,
+		},
+		{
+			name: "ConfigMap with all its keys",
+			union: ComponentUnion{ConfigMap: &ConfigMapComponent{
+				ConfigMapName:      "settings",
+				ConfigurationMount: ConfigurationMount{Optional: &notOptional},
+			}},
+			expectedVolume: corev1.Volume{Name: "tls", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "settings"},
+			}}},
+			expectedOk: true,
+		},
+		{
+			name:  "Volume",
+			union: ComponentUnion{Volume: &VolumeComponent{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			volume, ok := tt.union.ToVolume("tls")
+			assert.Equal(t, tt.expectedOk, ok)
+			assert.Equal(t, tt.expectedVolume, volume)
+			assert.Equal(t, tt.expectedOk, tt.union.GetConfigurationMount() != nil)
+		})
+	}
+}
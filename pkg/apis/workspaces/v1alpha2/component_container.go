@@ -1,10 +1,15 @@
 package v1alpha2
 
+// +devfile:title=Container Component
+
 // Component that allows the developer to add a configured container into their devworkspace
 type ContainerComponent struct {
 	BaseComponent `json:",inline"`
 	Container     `json:",inline"`
-	Endpoints     []Endpoint `json:"endpoints,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// +devfile:ui:order=30
+	// +devfile:ui:group=Networking
+	Endpoints []Endpoint `json:"endpoints,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 }
 
 // Annotation specifies the annotations to be added to specific resources
@@ -20,6 +25,9 @@ type Annotation struct {
 
 // +devfile:getter:generate
 type Container struct {
+	// +devfile:stringer:field
+	// +devfile:ui:order=1
+	// +devfile:ui:group=General
 	Image string `json:"image"`
 
 	// +optional
@@ -32,38 +40,71 @@ type Container struct {
 	//  - `$PROJECTS_ROOT`
 	//
 	//  - `$PROJECT_SOURCE`
+	// +devfile:ui:order=4
+	// +devfile:ui:group=General
 	Env []EnvVar `json:"env,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// +optional
 	// Annotations that should be added to specific resources for this container
+	// +devfile:ui:order=40
+	// +devfile:ui:group=Advanced
+	// +devfile:since=2.2.0
 	Annotation *Annotation `json:"annotation,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// +optional
 	// List of volumes mounts that should be mounted is this container.
+	// +devfile:ui:order=20
+	// +devfile:ui:group=Storage
 	VolumeMounts []VolumeMount `json:"volumeMounts,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// +optional
+	// +devfile:ui:order=10
+	// +devfile:ui:group=Resources
+	// +devfile:getter:quantity
 	MemoryLimit string `json:"memoryLimit,omitempty"`
 
 	// +optional
+	// +devfile:ui:order=11
+	// +devfile:ui:group=Resources
+	// +devfile:since=2.2.0
+	// +devfile:getter:quantity
 	MemoryRequest string `json:"memoryRequest,omitempty"`
 
 	// +optional
+	// +devfile:ui:order=12
+	// +devfile:ui:group=Resources
+	// +devfile:ui:label="CPU Limit"
+	// +devfile:since=2.2.0
+	// +devfile:getter:quantity
 	CpuLimit string `json:"cpuLimit,omitempty"`
 
 	// +optional
+	// +devfile:ui:order=13
+	// +devfile:ui:group=Resources
+	// +devfile:ui:label="CPU Request"
+	// +devfile:since=2.2.0
+	// +devfile:getter:quantity
 	CpuRequest string `json:"cpuRequest,omitempty"`
 
 	// The command to run in the dockerimage component instead of the default one provided in the image.
+	// It replaces the entrypoint of the image (the `ENTRYPOINT` of a Dockerfile), like the `command` of a Kubernetes container.
 	//
 	// Defaults to an empty array, meaning use whatever is defined in the image.
 	// +optional
+	// +devfile:ui:order=2
+	// +devfile:ui:group=General
 	Command []string `json:"command,omitempty" patchStrategy:"replace"`
 
 	// The arguments to supply to the command running the dockerimage component. The arguments are supplied either to the default command provided in the image or to the overridden command.
+	// They replace the default arguments of the image (the `CMD` of a Dockerfile), like the `args` of a Kubernetes container.
+	//
+	// When `mountSources` is `false`, and the component is the target of exec commands, the command or the arguments
+	// should keep the container running, unless the entrypoint of the image already does.
 	//
 	// Defaults to an empty array, meaning use whatever is defined in the image.
 	// +optional
+	// +devfile:ui:order=3
+	// +devfile:ui:group=General
 	Args []string `json:"args,omitempty" patchStrategy:"replace"`
 
 	// Toggles whether or not the project source code should
@@ -71,6 +112,8 @@ type Container struct {
 	//
 	// Defaults to true for all component types except plugins and components that set `dedicatedPod` to true.
 	// +optional
+	// +devfile:ui:order=21
+	// +devfile:ui:group=Storage
 	MountSources *bool `json:"mountSources,omitempty"`
 
 	// Optional specification of the path in the container where
@@ -78,6 +121,8 @@ type Container struct {
 	// When omitted, the default value of /projects is used.
 	// +optional
 	// +kubebuilder:default=/projects
+	// +devfile:ui:order=22
+	// +devfile:ui:group=Storage
 	SourceMapping string `json:"sourceMapping,omitempty"`
 
 	// Specify if a container should run in its own separated pod,
@@ -86,6 +131,8 @@ type Container struct {
 	// Default value is `false`
 	// +optional
 	// +devfile:default:value=false
+	// +devfile:ui:order=41
+	// +devfile:ui:group=Advanced
 	DedicatedPod *bool `json:"dedicatedPod,omitempty"`
 
 	// Specify if a container should start only components that is not referenced by apply,
@@ -93,6 +140,8 @@ type Container struct {
 	// Default value is `false`
 	// +optional
 	// +devfile:default:value=false
+	// +devfile:ui:order=42
+	// +devfile:ui:group=Advanced
 	RunOnDemand *bool `json:"runOnDemand,omitempty"`
 }
 
@@ -0,0 +1,77 @@
+package v1alpha2
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DefaultShell is the shell used to wrap the command and args of a container when EntrypointOptions.ShellWrap is set
+// without a shell
+const DefaultShell = "/bin/sh"
+
+// EntrypointOptions configures how the command and args of a container are converted to the ones of a K8S container
+// +devfile:helper
+type EntrypointOptions struct {
+	// ShellWrap runs the command and args of the container in a shell, as a single `<shell> -c <command line>` command,
+	// so that shell features (such as `&&` or redirections) can be used around them.
+	ShellWrap bool
+	// Shell is the shell used when ShellWrap is set. DefaultShell is used when it is empty.
+	Shell string
+	// ExpandVariables keeps the command and args unquoted in the shell command line, so that the shell
+	// expands their variables and interprets their operators.
+	// Otherwise each of them is single-quoted, so that the shell passes it as is.
+	// It is only used when ShellWrap is set.
+	ExpandVariables bool
+}
+
+// HasEntrypoint returns true if the container overrides the default entrypoint of its image, with a command or args
+func (in *Container) HasEntrypoint() bool {
+	return len(in.Command) > 0 || len(in.Args) > 0
+}
+
+// Entrypoint returns the command and args of the K8S container that runs this container, with the corev1 semantics:
+// the command replaces the `ENTRYPOINT` of the image, and the args replace its `CMD`,
+// an empty command or empty args keeping the ones of the image.
+//
+// When the ShellWrap option is set, the command and args are joined into a single shell command line.
+// An error is returned if the container has args but no command, since the entrypoint of the image,
+// which would receive the args, cannot be wrapped in a shell.
+func (in *Container) Entrypoint(options EntrypointOptions) (command []string, args []string, err error) {
+	if !options.ShellWrap || !in.HasEntrypoint() {
+		return in.Command, in.Args, nil
+	}
+	if len(in.Command) == 0 {
+		return nil, nil, fmt.Errorf("the args of the container cannot be wrapped in a shell without a command, since the entrypoint of the image is unknown")
+	}
+	shell := options.Shell
+	if shell == "" {
+		shell = DefaultShell
+	}
+	var words []string
+	for _, word := range append(append([]string{}, in.Command...), in.Args...) {
+		if !options.ExpandVariables {
+			word = shellQuote(word)
+		}
+		words = append(words, word)
+	}
+	return []string{shell, "-c", strings.Join(words, " ")}, nil, nil
+}
+
+// ApplyEntrypoint sets the command and args of the given K8S container from the command and args of this container,
+// as returned by Entrypoint
+func (in *Container) ApplyEntrypoint(container *corev1.Container, options EntrypointOptions) error {
+	command, args, err := in.Entrypoint(options)
+	if err != nil {
+		return err
+	}
+	container.Command = command
+	container.Args = args
+	return nil
+}
+
+// shellQuote single-quotes the given word, so that a POSIX shell passes it as is
+func shellQuote(word string) string {
+	return "'" + strings.ReplaceAll(word, "'", `'"'"'`) + "'"
+}
@@ -0,0 +1,73 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestEntrypoint(t *testing.T) {
+	tests := []struct {
+		name            string
+		container       Container
+		options         EntrypointOptions
+		expectedCommand []string
+		expectedArgs    []string
+		expectedError   string
+	}{
+		{
+			name:      "Image entrypoint",
+			container: Container{},
+			options:   EntrypointOptions{ShellWrap: true},
+		},
+		{
+			name:            "Command and args",
+			container:       Container{Command: []string{"npm"}, Args: []string{"run", "start"}},
+			expectedCommand: []string{"npm"},
+			expectedArgs:    []string{"run", "start"},
+		},
+		{
+			name:         "Args only",
+			container:    Container{Args: []string{"--verbose"}},
+			expectedArgs: []string{"--verbose"},
+		},
+		{
+			name:            "Shell-wrapped command and args",
+			container:       Container{Command: []string{"echo"}, Args: []string{"it's $HOME"}},
+			options:         EntrypointOptions{ShellWrap: true},
+			expectedCommand: []string{"/bin/sh", "-c", `'echo' 'it'"'"'s $HOME'`},
+		},
+		{
+			name:            "Shell-wrapped command with expanded variables",
+			container:       Container{Command: []string{"tail", "-f", "/dev/null"}, Args: []string{"||", "echo", "$HOME"}},
+			options:         EntrypointOptions{ShellWrap: true, Shell: "/bin/bash", ExpandVariables: true},
+			expectedCommand: []string{"/bin/bash", "-c", "tail -f /dev/null || echo $HOME"},
+		},
+		{
+			name:          "Shell-wrapped args without command",
+			container:     Container{Args: []string{"--verbose"}},
+			options:       EntrypointOptions{ShellWrap: true},
+			expectedError: "the args of the container cannot be wrapped in a shell without a command, since the entrypoint of the image is unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command, args, err := tt.container.Entrypoint(tt.options)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expectedCommand, command)
+				assert.Equal(t, tt.expectedArgs, args)
+			}
+
+			k8sContainer := corev1.Container{Command: []string{"previous"}, Args: []string{"previous"}}
+			if assert.NoError(t, tt.container.ApplyEntrypoint(&k8sContainer, tt.options)) {
+				assert.Equal(t, tt.expectedCommand, k8sContainer.Command)
+				assert.Equal(t, tt.expectedArgs, k8sContainer.Args)
+			}
+		})
+	}
+}
@@ -12,6 +12,8 @@ const (
 type BaseImage struct {
 }
 
+// +devfile:title=Image Component
+
 // Component that allows the developer to build a runtime image for outerloop
 type ImageComponent struct {
 	BaseComponent `json:",inline"`
@@ -20,6 +22,7 @@ type ImageComponent struct {
 
 type Image struct {
 	// Name of the image for the resulting outerloop build
+	// +devfile:stringer:field
 	ImageName  string `json:"imageName"`
 	ImageUnion `json:",inline"`
 }
@@ -21,6 +21,7 @@ type K8sLikeComponentLocation struct {
 
 	// Location in a file fetched from a uri.
 	// +optional
+	// +devfile:stringer:field
 	Uri string `json:"uri,omitempty"`
 
 	// Inlined manifest
@@ -43,11 +44,15 @@ type K8sLikeComponent struct {
 	Endpoints []Endpoint `json:"endpoints,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 }
 
+// +devfile:title=Kubernetes Component
+
 // Component that allows partly importing Kubernetes resources into the devworkspace POD
 type KubernetesComponent struct {
 	K8sLikeComponent `json:",inline"`
 }
 
+// +devfile:title=Openshift Component
+
 // Component that allows partly importing Openshift resources into the devworkspace POD
 type OpenshiftComponent struct {
 	K8sLikeComponent `json:",inline"`
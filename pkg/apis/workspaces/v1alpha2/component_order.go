@@ -0,0 +1,89 @@
+package v1alpha2
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	attributes "github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/api/v2/pkg/devfile/keys"
+)
+
+// ComponentOrderAttribute is the key of the component attribute that orders a component among the other components,
+// such as the containers in the pod of the devworkspace, or the plugins applied when the devfile is flattened.
+// Its value is an integer: the components are ordered by increasing value, the components without the attribute having the order 0.
+const ComponentOrderAttribute = keys.ComponentOrderAttribute
+
+// GetComponentOrder decodes the `api.devfile.io/order` attribute of the given component attributes, which should be an integer.
+// It returns 0 if the attribute is not set.
+func GetComponentOrder(attrs attributes.Attributes) (int, error) {
+	attribute, exists := attrs[ComponentOrderAttribute]
+	if !exists {
+		return 0, nil
+	}
+	var order int
+	if err := json.Unmarshal(attribute.Raw, &order); err != nil {
+		return 0, fmt.Errorf("attribute %q is invalid: it should be an integer, but is %s", ComponentOrderAttribute, string(attribute.Raw))
+	}
+	return order, nil
+}
+
+// SortComponents sorts the given components by increasing order, as set by their `api.devfile.io/order` attribute.
+// The components with the same order, such as the components without the attribute, keep their relative order,
+// so that the result is deterministic.
+// If the attribute of a component is invalid, an error is returned and the components are left unchanged.
+func SortComponents(components []Component) error {
+	orders, err := componentOrders(components)
+	if err != nil {
+		return err
+	}
+	indexes := sortedIndexes(orders)
+	sorted := make([]Component, len(components))
+	for i, index := range indexes {
+		sorted[i] = components[index]
+	}
+	copy(components, sorted)
+	return nil
+}
+
+// PluginOrder returns the indexes of the plugin components of the given components, in the order in which the plugins should be applied
+// when the devfile is flattened: by increasing `api.devfile.io/order` attribute, then in declaration order.
+// The index of a plugin is its position among the plugin components, in declaration order.
+func PluginOrder(components []Component) ([]int, error) {
+	var plugins []Component
+	for _, component := range components {
+		if component.Plugin != nil {
+			plugins = append(plugins, component)
+		}
+	}
+	orders, err := componentOrders(plugins)
+	if err != nil {
+		return nil, err
+	}
+	return sortedIndexes(orders), nil
+}
+
+// componentOrders returns the orders of the given components
+func componentOrders(components []Component) ([]int, error) {
+	orders := make([]int, len(components))
+	for i, component := range components {
+		order, err := GetComponentOrder(component.Attributes)
+		if err != nil {
+			return nil, fmt.Errorf("component %q: %w", component.Name, err)
+		}
+		orders[i] = order
+	}
+	return orders, nil
+}
+
+// sortedIndexes returns the indexes of the given orders, sorted by increasing order, then by index
+func sortedIndexes(orders []int) []int {
+	indexes := make([]int, len(orders))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return orders[indexes[i]] < orders[indexes[j]]
+	})
+	return indexes
+}
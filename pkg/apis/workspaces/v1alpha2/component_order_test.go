@@ -0,0 +1,82 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	attributes "github.com/devfile/api/v2/pkg/attributes"
+	"github.com/stretchr/testify/assert"
+)
+
+func orderedComponent(name string, order interface{}, union ComponentUnion) Component {
+	component := Component{Name: name, ComponentUnion: union}
+	if order != nil {
+		component.Attributes = attributes.Attributes{}.Put(ComponentOrderAttribute, order, nil)
+	}
+	return component
+}
+
+func TestSortComponents(t *testing.T) {
+	container := ComponentUnion{Container: &ContainerComponent{}}
+	tests := []struct {
+		name          string
+		components    []Component
+		expectedNames []string
+		expectedError string
+	}{
+		{
+			name: "No order",
+			components: []Component{
+				orderedComponent("first", nil, container),
+				orderedComponent("second", nil, container),
+			},
+			expectedNames: []string{"first", "second"},
+		},
+		{
+			name: "Orders with ties in declaration order",
+			components: []Component{
+				orderedComponent("first", nil, container),
+				orderedComponent("sidecar", 10, container),
+				orderedComponent("init", -1, container),
+				orderedComponent("second", 0, container),
+			},
+			expectedNames: []string{"init", "first", "second", "sidecar"},
+		},
+		{
+			name: "Invalid order",
+			components: []Component{
+				orderedComponent("first", nil, container),
+				orderedComponent("second", 1.5, container),
+			},
+			expectedNames: []string{"first", "second"},
+			expectedError: `component "second": attribute "api.devfile.io/order" is invalid: it should be an integer, but is 1.5`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SortComponents(tt.components)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			var names []string
+			for _, component := range tt.components {
+				names = append(names, component.Name)
+			}
+			assert.Equal(t, tt.expectedNames, names)
+		})
+	}
+}
+
+func TestPluginOrder(t *testing.T) {
+	plugin := ComponentUnion{Plugin: &PluginComponent{}}
+	order, err := PluginOrder([]Component{
+		orderedComponent("first", nil, plugin),
+		orderedComponent("tools", -5, ComponentUnion{Container: &ContainerComponent{}}),
+		orderedComponent("second", 2, plugin),
+		orderedComponent("third", -1, plugin),
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []int{2, 0, 1}, order)
+	}
+}
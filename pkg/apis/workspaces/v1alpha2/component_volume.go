@@ -1,5 +1,7 @@
 package v1alpha2
 
+// +devfile:title=Volume Component
+
 // Component that allows the developer to declare and configure a volume into their devworkspace
 type VolumeComponent struct {
 	BaseComponent `json:",inline"`
@@ -11,6 +13,8 @@ type VolumeComponent struct {
 type Volume struct {
 	// +optional
 	// Size of the volume
+	// +devfile:stringer:field
+	// +devfile:getter:quantity
 	Size string `json:"size,omitempty"`
 
 	// +optional
@@ -7,7 +7,7 @@ import (
 
 // ComponentType describes the type of component.
 // Only one of the following component type may be specified.
-// +kubebuilder:validation:Enum=Container;Kubernetes;Openshift;Volume;Image;Plugin;Custom
+// +kubebuilder:validation:Enum=Container;Kubernetes;Openshift;Volume;Image;Secret;ConfigMap;Plugin;Custom
 type ComponentType string
 
 const (
@@ -17,6 +17,8 @@ const (
 	PluginComponentType     ComponentType = "Plugin"
 	VolumeComponentType     ComponentType = "Volume"
 	ImageComponentType      ComponentType = "Image"
+	SecretComponentType     ComponentType = "Secret"
+	ConfigMapComponentType  ComponentType = "ConfigMap"
 	CustomComponentType     ComponentType = "Custom"
 )
 
@@ -25,6 +27,7 @@ const (
 type BaseComponent struct {
 }
 
+// +devfile:stringer:generate
 //+k8s:openapi-gen=true
 type Component struct {
 	// Mandatory name that allows referencing the component
@@ -32,6 +35,7 @@ type Component struct {
 	// devfile that may reference this component through a parent or a plugin.
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +kubebuilder:validation:MaxLength=63
+	// +devfile:stringer:field
 	Name string `json:"name"`
 	// Map of implementation-dependant free-form YAML attributes.
 	// +optional
@@ -75,8 +79,21 @@ type ComponentUnion struct {
 
 	// Allows specifying the definition of an image for outer loop builds
 	// +optional
+	// +devfile:since=2.2.0
 	Image *ImageComponent `json:"image,omitempty"`
 
+	// Allows mounting an existing Kubernetes Secret
+	// into the containers of the devworkspace
+	// +optional
+	// +devfile:since=2.2.0
+	Secret *SecretComponent `json:"secret,omitempty"`
+
+	// Allows mounting an existing Kubernetes ConfigMap
+	// into the containers of the devworkspace
+	// +optional
+	// +devfile:since=2.2.0
+	ConfigMap *ConfigMapComponent `json:"configMap,omitempty"`
+
 	// Allows importing a plugin.
 	//
 	// Plugins are mainly imported devfiles that contribute components, commands
@@ -98,6 +115,7 @@ type ComponentUnion struct {
 type CustomComponent struct {
 	// Class of component that the associated implementation controller
 	// should use to process this command with the appropriate logic
+	// +devfile:stringer:field
 	ComponentClass string `json:"componentClass"`
 
 	// Additional free-form configuration for this custom component
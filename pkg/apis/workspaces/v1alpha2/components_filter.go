@@ -0,0 +1,113 @@
+package v1alpha2
+
+import (
+	"encoding/json"
+	"path"
+	"reflect"
+)
+
+// ComponentPredicate is a condition on a component, used to filter components
+// +k8s:deepcopy-gen=false
+type ComponentPredicate func(component Component) bool
+
+// FilterComponents returns a deep copy of the components that match the given predicate,
+// in the order of the devworkspace template components.
+func (container DevWorkspaceTemplateSpecContent) FilterComponents(predicate ComponentPredicate) []Component {
+	var filtered []Component
+	for _, component := range container.Components {
+		if predicate(component) {
+			filtered = append(filtered, *component.DeepCopy())
+		}
+	}
+	return filtered
+}
+
+// ByType returns a predicate that matches the components of the given type.
+// The type of a component is deduced from the union member that is set, when the component type is not set.
+func ByType(componentType ComponentType) ComponentPredicate {
+	return func(component Component) bool {
+		union := component.ComponentUnion.DeepCopy()
+		if err := union.Normalize(); err != nil {
+			return false
+		}
+		return union.ComponentType == componentType
+	}
+}
+
+// ByName returns a predicate that matches the components whose name matches the given glob pattern,
+// with the syntax of `path.Match`, such as `tools-*`. An invalid pattern matches no component.
+func ByName(pattern string) ComponentPredicate {
+	return func(component Component) bool {
+		matched, err := path.Match(pattern, component.Name)
+		return err == nil && matched
+	}
+}
+
+// ByAttribute returns a predicate that matches the components that have the given attribute, whatever its value.
+func ByAttribute(key string) ComponentPredicate {
+	return func(component Component) bool {
+		return component.Attributes.Exists(key)
+	}
+}
+
+// ByAttributeValue returns a predicate that matches the components that have the given attribute,
+// with a value equal to the given value once serialized to Json.
+func ByAttributeValue(key string, value interface{}) ComponentPredicate {
+	expected, err := toJSONValue(value)
+	if err != nil {
+		return func(Component) bool { return false }
+	}
+	return func(component Component) bool {
+		if !component.Attributes.Exists(key) {
+			return false
+		}
+		var getErr error
+		actual := component.Attributes.Get(key, &getErr)
+		return getErr == nil && reflect.DeepEqual(expected, actual)
+	}
+}
+
+// AllOf returns a predicate that matches the components that match all the given predicates
+func AllOf(predicates ...ComponentPredicate) ComponentPredicate {
+	return func(component Component) bool {
+		for _, predicate := range predicates {
+			if !predicate(component) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// AnyOf returns a predicate that matches the components that match at least one of the given predicates
+func AnyOf(predicates ...ComponentPredicate) ComponentPredicate {
+	return func(component Component) bool {
+		for _, predicate := range predicates {
+			if predicate(component) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not returns a predicate that matches the components that don't match the given predicate
+func Not(predicate ComponentPredicate) ComponentPredicate {
+	return func(component Component) bool {
+		return !predicate(component)
+	}
+}
+
+// toJSONValue returns the given value as decoded from its Json serialization,
+// so that it can be compared with attribute values
+func toJSONValue(value interface{}) (interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
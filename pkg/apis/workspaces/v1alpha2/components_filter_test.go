@@ -0,0 +1,109 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	attributes "github.com/devfile/api/v2/pkg/attributes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterComponents(t *testing.T) {
+	content := DevWorkspaceTemplateSpecContent{
+		Components: []Component{
+			{
+				Name:       "runtime",
+				Attributes: attributes.Attributes{}.PutString("origin", "main"),
+				ComponentUnion: ComponentUnion{
+					Container: &ContainerComponent{},
+				},
+			},
+			{
+				Name:       "tools-java",
+				Attributes: attributes.Attributes{}.PutString("origin", "plugin").PutBoolean("debug", true),
+				ComponentUnion: ComponentUnion{
+					Container: &ContainerComponent{},
+				},
+			},
+			{
+				Name:       "tools-storage",
+				Attributes: attributes.Attributes{}.PutString("origin", "plugin"),
+				ComponentUnion: ComponentUnion{
+					ComponentType: VolumeComponentType,
+					Volume:        &VolumeComponent{},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		predicate ComponentPredicate
+		want      []string
+	}{
+		{
+			name:      "By type, with or without discriminator",
+			predicate: ByType(ContainerComponentType),
+			want:      []string{"runtime", "tools-java"},
+		},
+		{
+			name:      "By name glob",
+			predicate: ByName("tools-*"),
+			want:      []string{"tools-java", "tools-storage"},
+		},
+		{
+			name:      "Invalid name glob",
+			predicate: ByName("tools-["),
+		},
+		{
+			name:      "By attribute",
+			predicate: ByAttribute("debug"),
+			want:      []string{"tools-java"},
+		},
+		{
+			name:      "By attribute value",
+			predicate: ByAttributeValue("origin", "plugin"),
+			want:      []string{"tools-java", "tools-storage"},
+		},
+		{
+			name:      "Combined predicates",
+			predicate: AllOf(ByType(ContainerComponentType), Not(ByAttributeValue("origin", "main"))),
+			want:      []string{"tools-java"},
+		},
+		{
+			name:      "Any of predicates",
+			predicate: AnyOf(ByName("runtime"), ByType(VolumeComponentType)),
+			want:      []string{"runtime", "tools-storage"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var names []string
+			for _, component := range content.FilterComponents(tt.predicate) {
+				names = append(names, component.Name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestFilterComponentsReturnsCopies(t *testing.T) {
+	content := DevWorkspaceTemplateSpecContent{
+		Components: []Component{
+			{
+				Name: "runtime",
+				ComponentUnion: ComponentUnion{
+					Container: &ContainerComponent{
+						Container: Container{Image: "node"},
+					},
+				},
+			},
+		},
+	}
+
+	filtered := content.FilterComponents(ByName("runtime"))
+	filtered[0].Container.Image = "changed"
+
+	assert.Equal(t, "node", content.Components[0].Container.Image, "Filtering should not return the original components")
+	content.FilterComponents(ByType(ContainerComponentType))
+	assert.Empty(t, content.Components[0].ComponentType, "Filtering by type should not normalize the original components")
+}
@@ -6,9 +6,20 @@ import (
 
 // Devfile describes the structure of a cloud-native devworkspace and development environment.
 // +k8s:deepcopy-gen=false
-// +devfile:jsonschema:generate:omitCustomUnionMembers=true,omitPluginUnionMembers=true,shortenEndpointNameLength=true
+// +devfile:jsonschema:generate:omitCustomUnionMembers=true,omitPluginUnionMembers=true,shortenEndpointNameLength=true,pluginFlavor=true
+// +devfile:pointer:generate
 type Devfile struct {
 	devfile.DevfileHeader `json:",inline"`
 
 	DevWorkspaceTemplateSpec `json:",inline"`
 }
+
+// DevfileMetadata describes the structure of the `metadata` section of a devfile.
+// Its Json schema allows tools that only consume the devfile metadata, such as registry index builders,
+// to validate it without the full devfile schema.
+// +k8s:deepcopy-gen=false
+// +kubebuilder:pruning:PreserveUnknownFields
+// +devfile:jsonschema:generate
+type DevfileMetadata struct {
+	devfile.DevfileMetadata `json:",inline"`
+}
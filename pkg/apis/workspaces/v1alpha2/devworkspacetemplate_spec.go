@@ -4,6 +4,9 @@ import attributes "github.com/devfile/api/v2/pkg/attributes"
 
 // Structure of the devworkspace. This is also the specification of a devworkspace template.
 // +devfile:jsonschema:generate
+// +devfile:stringer:generate
+// +devfile:pointer:generate
+// +devfile:view:generate
 type DevWorkspaceTemplateSpec struct {
 	// Parent devworkspace template
 	// +optional
@@ -27,6 +30,7 @@ type DevWorkspaceTemplateSpecContent struct {
 	// +optional
 	// +patchStrategy=merge
 	// +devfile:overrides:include:omitInPlugin=true,description=Overrides of variables encapsulated in a parent devfile.
+	// +devfile:since=2.1.0
 	Variables map[string]string `json:"variables,omitempty" patchStrategy:"merge"`
 
 	// Map of implementation-dependant free-form YAML attributes.
@@ -36,8 +40,30 @@ type DevWorkspaceTemplateSpecContent struct {
 	// +kubebuilder:validation:Type=object
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Schemaless
+	// +devfile:since=2.1.0
 	Attributes attributes.Attributes `json:"attributes,omitempty" patchStrategy:"merge"`
 
+	// List of environment variables that are set in all the container components of the devworkspace.
+	// When a container defines an environment variable with the same name, its own value takes precedence.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +devfile:overrides:include:omitInPlugin=true,description=Overrides of env encapsulated in a parent devfile.
+	// +devfile:since=2.2.0
+	Env []EnvVar `json:"env,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// Storage strategy of the devworkspace, that selects how the volume components and the projects are persisted.
+	// Defaults to per-workspace. The default value isn't set by the defaulting webhook, so that the storage strategy
+	// can still be inherited from the parent, or selected by the legacy `controller.devfile.io/storage-type` attribute.
+	// +optional
+	// +devfile:overrides:include:omitInPlugin=true,description=Overrides of storageStrategy encapsulated in a parent devfile.
+	// +devfile:jsonschema:omitInPlugin
+	// +devfile:getter:generate
+	// +devfile:default:value=per-workspace
+	// +devfile:default:skip
+	// +devfile:since=2.2.0
+	StorageStrategy *StorageStrategy `json:"storageStrategy,omitempty"`
+
 	// List of the devworkspace components, such as editor and plugins,
 	// user-provided containers, or other types of components
 	// +optional
@@ -45,6 +71,7 @@ type DevWorkspaceTemplateSpecContent struct {
 	// +patchStrategy=merge
 	// +devfile:overrides:include:description=Overrides of components encapsulated in a parent devfile or a plugin.
 	// +devfile:toplevellist
+	// +devfile:stringer:field
 	Components []Component `json:"components,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// Projects worked on in the devworkspace, containing names and sources locations
@@ -52,7 +79,9 @@ type DevWorkspaceTemplateSpecContent struct {
 	// +patchMergeKey=name
 	// +patchStrategy=merge
 	// +devfile:overrides:include:omitInPlugin=true,description=Overrides of projects encapsulated in a parent devfile.
+	// +devfile:jsonschema:omitInPlugin
 	// +devfile:toplevellist
+	// +devfile:stringer:field
 	Projects []Project `json:"projects,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// StarterProjects is a project that can be used as a starting point when bootstrapping new projects
@@ -60,7 +89,9 @@ type DevWorkspaceTemplateSpecContent struct {
 	// +patchMergeKey=name
 	// +patchStrategy=merge
 	// +devfile:overrides:include:omitInPlugin=true,description=Overrides of starterProjects encapsulated in a parent devfile.
+	// +devfile:jsonschema:omitInPlugin
 	// +devfile:toplevellist
+	// +devfile:stringer:field
 	StarterProjects []StarterProject `json:"starterProjects,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// Predefined, ready-to-use, devworkspace-related commands
@@ -69,6 +100,7 @@ type DevWorkspaceTemplateSpecContent struct {
 	// +patchStrategy=merge
 	// +devfile:overrides:include:description=Overrides of commands encapsulated in a parent devfile or a plugin.
 	// +devfile:toplevellist
+	// +devfile:stringer:field
 	Commands []Command `json:"commands,omitempty" patchStrategy:"merge" patchMergeKey:"id"`
 
 	// Bindings of commands to events.
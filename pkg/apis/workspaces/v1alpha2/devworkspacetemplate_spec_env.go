@@ -0,0 +1,32 @@
+package v1alpha2
+
+// ContainerEnv returns the env of the given container, merged with the top-level env of the devworkspace.
+// The top-level env variables come first, so that the env of the container can reference them,
+// and the env variables of the container take precedence over the top-level ones with the same name.
+func (in *DevWorkspaceTemplateSpecContent) ContainerEnv(container *Container) []EnvVar {
+	if len(in.Env) == 0 {
+		return container.Env
+	}
+	containerEnvNames := map[string]bool{}
+	for _, env := range container.Env {
+		containerEnvNames[env.Name] = true
+	}
+	var merged []EnvVar
+	for _, env := range in.Env {
+		if !containerEnvNames[env.Name] {
+			merged = append(merged, env)
+		}
+	}
+	return append(merged, container.Env...)
+}
+
+// ApplyEnv sets the top-level env in the env of all the container components, as returned by ContainerEnv,
+// then clears the top-level env, which has no effect anymore.
+func (in *DevWorkspaceTemplateSpecContent) ApplyEnv() {
+	for i := range in.Components {
+		if container := in.Components[i].Container; container != nil {
+			container.Env = in.ContainerEnv(&container.Container)
+		}
+	}
+	in.Env = nil
+}
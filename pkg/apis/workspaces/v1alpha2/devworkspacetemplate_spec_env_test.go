@@ -0,0 +1,57 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyEnv(t *testing.T) {
+	tests := []struct {
+		name        string
+		env         []EnvVar
+		components  []Component
+		expectedEnv map[string][]EnvVar
+	}{
+		{
+			name: "No top-level env",
+			components: []Component{
+				{Name: "container", ComponentUnion: ComponentUnion{Container: &ContainerComponent{Container: Container{Env: []EnvVar{{Name: "A", Value: "a"}}}}}},
+			},
+			expectedEnv: map[string][]EnvVar{"container": {{Name: "A", Value: "a"}}},
+		},
+		{
+			name: "Top-level env applied to all containers",
+			env:  []EnvVar{{Name: "A", Value: "top-level"}, {Name: "B", Value: "top-level"}},
+			components: []Component{
+				{Name: "container1", ComponentUnion: ComponentUnion{Container: &ContainerComponent{}}},
+				{Name: "container2", ComponentUnion: ComponentUnion{Container: &ContainerComponent{Container: Container{Env: []EnvVar{{Name: "C", Value: "c"}}}}}},
+				{Name: "volume", ComponentUnion: ComponentUnion{Volume: &VolumeComponent{}}},
+			},
+			expectedEnv: map[string][]EnvVar{
+				"container1": {{Name: "A", Value: "top-level"}, {Name: "B", Value: "top-level"}},
+				"container2": {{Name: "A", Value: "top-level"}, {Name: "B", Value: "top-level"}, {Name: "C", Value: "c"}},
+			},
+		},
+		{
+			name: "Container env takes precedence",
+			env:  []EnvVar{{Name: "A", Value: "top-level"}, {Name: "B", Value: "top-level"}},
+			components: []Component{
+				{Name: "container", ComponentUnion: ComponentUnion{Container: &ContainerComponent{Container: Container{Env: []EnvVar{{Name: "B", Value: "container"}}}}}},
+			},
+			expectedEnv: map[string][]EnvVar{"container": {{Name: "A", Value: "top-level"}, {Name: "B", Value: "container"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := DevWorkspaceTemplateSpecContent{Env: tt.env, Components: tt.components}
+			content.ApplyEnv()
+			assert.Empty(t, content.Env)
+			for _, component := range content.Components {
+				if component.Container != nil {
+					assert.Equal(t, tt.expectedEnv[component.Name], component.Container.Env, component.Name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package v1alpha2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/devfile/api/v2/pkg/devfile/keys"
+)
+
+// StorageTypeAttribute is the key of the devworkspace attribute that selected the storage strategy
+// before the `storageStrategy` field was introduced. It is still honored when the field is not set.
+const StorageTypeAttribute = keys.StorageTypeAttribute
+
+// StorageStrategy describes how the volume components and the projects of a devworkspace are persisted.
+// +kubebuilder:validation:Enum=per-workspace;common;ephemeral
+type StorageStrategy string
+
+const (
+	// PerWorkspaceStorageStrategy persists the devworkspace in a persistent volume claim dedicated to the devworkspace
+	PerWorkspaceStorageStrategy StorageStrategy = "per-workspace"
+	// CommonStorageStrategy persists the devworkspace in a persistent volume claim shared by all the devworkspaces of the namespace
+	CommonStorageStrategy StorageStrategy = "common"
+	// EphemeralStorageStrategy doesn't persist the devworkspace: its volumes are lost when the devworkspace is stopped
+	EphemeralStorageStrategy StorageStrategy = "ephemeral"
+)
+
+// legacyStorageTypes maps the values of the legacy `controller.devfile.io/storage-type` attribute
+// that were renamed to their storage strategy
+var legacyStorageTypes = map[StorageStrategy]StorageStrategy{
+	"per-user": CommonStorageStrategy,
+}
+
+// ResolveStorageStrategy returns the storage strategy of the devworkspace.
+// The `storageStrategy` field takes precedence over the legacy `controller.devfile.io/storage-type` attribute,
+// and the default strategy is returned if neither is set. The legacy `per-user` value of the attribute is resolved
+// to the `common` strategy.
+// If the attribute is set to an unknown value, the default strategy is returned along with an error
+// that describes the value, so that callers can report it as a warning.
+func (in *DevWorkspaceTemplateSpecContent) ResolveStorageStrategy() (StorageStrategy, error) {
+	if in.StorageStrategy != nil {
+		return *in.StorageStrategy, nil
+	}
+	attribute, exists := in.Attributes[StorageTypeAttribute]
+	if !exists {
+		return in.GetStorageStrategy(), nil
+	}
+	var strategy StorageStrategy
+	if err := json.Unmarshal(attribute.Raw, &strategy); err != nil {
+		return in.GetStorageStrategy(), fmt.Errorf("attribute %q is invalid: %v", StorageTypeAttribute, err)
+	}
+	if renamed, isLegacy := legacyStorageTypes[strategy]; isLegacy {
+		return renamed, nil
+	}
+	if !strategy.IsValid() {
+		return in.GetStorageStrategy(), fmt.Errorf("attribute %q is invalid: it should be one of: %s, %s, %s, but is %q",
+			StorageTypeAttribute, PerWorkspaceStorageStrategy, CommonStorageStrategy, EphemeralStorageStrategy, strategy)
+	}
+	return strategy, nil
+}
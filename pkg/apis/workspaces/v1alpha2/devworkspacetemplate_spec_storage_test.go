@@ -0,0 +1,77 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	attributes "github.com/devfile/api/v2/pkg/attributes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveStorageStrategy(t *testing.T) {
+	common := CommonStorageStrategy
+	tests := []struct {
+		name            string
+		storageStrategy *StorageStrategy
+		attributes      attributes.Attributes
+		expected        StorageStrategy
+		expectedError   string
+	}{
+		{
+			name:     "Default strategy",
+			expected: PerWorkspaceStorageStrategy,
+		},
+		{
+			name:            "Field set",
+			storageStrategy: &common,
+			expected:        CommonStorageStrategy,
+		},
+		{
+			name:       "Legacy attribute set",
+			attributes: attributes.Attributes{}.PutString(StorageTypeAttribute, "ephemeral"),
+			expected:   EphemeralStorageStrategy,
+		},
+		{
+			name:            "Field takes precedence over the legacy attribute",
+			storageStrategy: &common,
+			attributes:      attributes.Attributes{}.PutString(StorageTypeAttribute, "ephemeral"),
+			expected:        CommonStorageStrategy,
+		},
+		{
+			name:       "Legacy per-user value of the attribute",
+			attributes: attributes.Attributes{}.PutString(StorageTypeAttribute, "per-user"),
+			expected:   CommonStorageStrategy,
+		},
+		{
+			name:          "Unknown strategy in the legacy attribute",
+			attributes:    attributes.Attributes{}.PutString(StorageTypeAttribute, "async"),
+			expected:      PerWorkspaceStorageStrategy,
+			expectedError: `attribute "controller.devfile.io/storage-type" is invalid: it should be one of: per-workspace, common, ephemeral, but is "async"`,
+		},
+		{
+			name:          "Legacy attribute is not a string",
+			attributes:    attributes.Attributes{}.PutBoolean(StorageTypeAttribute, true),
+			expected:      PerWorkspaceStorageStrategy,
+			expectedError: `attribute "controller.devfile.io/storage-type" is invalid: json: cannot unmarshal bool into Go value of type v1alpha2.StorageStrategy`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := DevWorkspaceTemplateSpecContent{StorageStrategy: tt.storageStrategy, Attributes: tt.attributes}
+			strategy, err := content.ResolveStorageStrategy()
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, strategy)
+		})
+	}
+}
+
+func TestGetStorageStrategy(t *testing.T) {
+	content := DevWorkspaceTemplateSpecContent{}
+	assert.Equal(t, PerWorkspaceStorageStrategy, content.GetStorageStrategy())
+	ephemeral := EphemeralStorageStrategy
+	content.StorageStrategy = &ephemeral
+	assert.Equal(t, EphemeralStorageStrategy, content.GetStorageStrategy())
+}
@@ -0,0 +1,130 @@
+package v1alpha2
+
+import (
+	attributes "github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/api/v2/pkg/devfile/keys"
+)
+
+// ImportedByAttribute is the key of the attribute that tells whether an imported element
+// comes from the parent or from a plugin component. Its value is an `Origin`.
+const ImportedByAttribute = keys.ImportedByAttribute
+
+// DiscoverableAttribute is the key of the endpoint attribute that makes an endpoint reachable by its name
+// from the other components
+const DiscoverableAttribute = keys.DiscoverableAttribute
+
+// Origin is the origin of an element of a flattened devworkspace template
+// +k8s:deepcopy-gen=false
+// +devfile:helper
+type Origin string
+
+const (
+	// MainOrigin is the origin of the elements of the main devfile or devworkspace template
+	MainOrigin Origin = "main"
+	// ParentOrigin is the origin of the elements imported from the parent
+	ParentOrigin Origin = "parent"
+	// PluginOrigin is the origin of the elements imported from a plugin component
+	PluginOrigin Origin = "plugin"
+)
+
+// GetOrigin returns the origin of an element from its attributes: the value of the `api.devfile.io/imported-by` attribute,
+// set when flattening a devfile, or else the origin deduced from the parent and plugin override attributes.
+// Elements without any of these attributes come from the main devfile.
+func GetOrigin(attrs attributes.Attributes) Origin {
+	var err error
+	switch importedBy := Origin(attrs.GetString(ImportedByAttribute, &err)); {
+	case err == nil && (importedBy == ParentOrigin || importedBy == PluginOrigin):
+		return importedBy
+	case attrs.Exists(keys.PluginOverrideAttribute):
+		return PluginOrigin
+	case attrs.Exists(keys.ParentOverrideAttribute):
+		return ParentOrigin
+	default:
+		return MainOrigin
+	}
+}
+
+// ComponentEndpoint is an endpoint, along with the component that exposes it and the origin of this component
+// +k8s:deepcopy-gen=false
+// +devfile:helper
+type ComponentEndpoint struct {
+	Endpoint
+	// Component is the name of the component that exposes the endpoint
+	Component string
+	// Origin is the origin of the component that exposes the endpoint
+	Origin Origin
+}
+
+// EndpointPredicate is a condition on an endpoint, used to filter endpoints
+// +k8s:deepcopy-gen=false
+type EndpointPredicate func(endpoint ComponentEndpoint) bool
+
+// GetAllEndpoints returns a deep copy of the endpoints of the container, kubernetes and openshift components
+// that match all the given predicates, in the order of the components and of their endpoints.
+// The origin of the endpoints is only known for flattened devworkspace templates.
+func (container DevWorkspaceTemplateSpecContent) GetAllEndpoints(predicates ...EndpointPredicate) []ComponentEndpoint {
+	var all []ComponentEndpoint
+	for _, component := range container.Components {
+		var endpoints []Endpoint
+		switch {
+		case component.Container != nil:
+			endpoints = component.Container.Endpoints
+		case component.Kubernetes != nil:
+			endpoints = component.Kubernetes.Endpoints
+		case component.Openshift != nil:
+			endpoints = component.Openshift.Endpoints
+		}
+		origin := GetOrigin(component.Attributes)
+	endpoints:
+		for _, endpoint := range endpoints {
+			componentEndpoint := ComponentEndpoint{
+				Endpoint:  *endpoint.DeepCopy(),
+				Component: component.Name,
+				Origin:    origin,
+			}
+			for _, predicate := range predicates {
+				if !predicate(componentEndpoint) {
+					continue endpoints
+				}
+			}
+			all = append(all, componentEndpoint)
+		}
+	}
+	return all
+}
+
+// DiscoverableEndpoints returns a predicate that matches the endpoints whose `discoverable` attribute is true
+func DiscoverableEndpoints() EndpointPredicate {
+	return func(endpoint ComponentEndpoint) bool {
+		return endpoint.Attributes.GetBoolean(DiscoverableAttribute, nil)
+	}
+}
+
+// EndpointsByExposure returns a predicate that matches the endpoints with one of the given exposures.
+// The exposure of the endpoints without exposure is `public`.
+func EndpointsByExposure(exposures ...EndpointExposure) EndpointPredicate {
+	return func(endpoint ComponentEndpoint) bool {
+		exposure := endpoint.Exposure
+		if exposure == "" {
+			exposure = PublicEndpointExposure
+		}
+		for _, e := range exposures {
+			if e == exposure {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// EndpointsByOrigin returns a predicate that matches the endpoints of the components with one of the given origins
+func EndpointsByOrigin(origins ...Origin) EndpointPredicate {
+	return func(endpoint ComponentEndpoint) bool {
+		for _, origin := range origins {
+			if origin == endpoint.Origin {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,92 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	attributes "github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/api/v2/pkg/devfile/keys"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllEndpoints(t *testing.T) {
+	content := DevWorkspaceTemplateSpecContent{
+		Components: []Component{
+			{
+				Name: "runtime",
+				ComponentUnion: ComponentUnion{
+					Container: &ContainerComponent{
+						Endpoints: []Endpoint{
+							{Name: "http", TargetPort: 8080, Attributes: attributes.Attributes{}.PutBoolean(DiscoverableAttribute, true)},
+							{Name: "debug", TargetPort: 5858, Exposure: NoneEndpointExposure},
+						},
+					},
+				},
+			},
+			{
+				Name:       "db",
+				Attributes: attributes.Attributes{}.PutString(ImportedByAttribute, string(ParentOrigin)),
+				ComponentUnion: ComponentUnion{
+					Kubernetes: &KubernetesComponent{
+						K8sLikeComponent: K8sLikeComponent{
+							Endpoints: []Endpoint{
+								{Name: "postgres", TargetPort: 5432, Exposure: InternalEndpointExposure, Attributes: attributes.Attributes{}.PutString(DiscoverableAttribute, "true")},
+							},
+						},
+					},
+				},
+			},
+			{
+				Name:       "tools",
+				Attributes: attributes.Attributes{}.PutString(keys.PluginOverrideAttribute, "main devfile"),
+				ComponentUnion: ComponentUnion{
+					Container: &ContainerComponent{
+						Endpoints: []Endpoint{
+							{Name: "tools-http", TargetPort: 3000, Exposure: PublicEndpointExposure},
+						},
+					},
+				},
+			},
+			{
+				Name: "data",
+				ComponentUnion: ComponentUnion{
+					Volume: &VolumeComponent{},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		predicates []EndpointPredicate
+		want       []string
+	}{
+		{
+			name: "All endpoints",
+			want: []string{"runtime/http (main)", "runtime/debug (main)", "db/postgres (parent)", "tools/tools-http (plugin)"},
+		},
+		{
+			name:       "Discoverable endpoints",
+			predicates: []EndpointPredicate{DiscoverableEndpoints()},
+			want:       []string{"runtime/http (main)", "db/postgres (parent)"},
+		},
+		{
+			name:       "Public endpoints, with default exposure",
+			predicates: []EndpointPredicate{EndpointsByExposure(PublicEndpointExposure)},
+			want:       []string{"runtime/http (main)", "tools/tools-http (plugin)"},
+		},
+		{
+			name:       "Several predicates",
+			predicates: []EndpointPredicate{EndpointsByOrigin(MainOrigin, PluginOrigin), EndpointsByExposure(PublicEndpointExposure, NoneEndpointExposure)},
+			want:       []string{"runtime/http (main)", "runtime/debug (main)", "tools/tools-http (plugin)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, endpoint := range content.GetAllEndpoints(tt.predicates...) {
+				got = append(got, endpoint.Component+"/"+endpoint.Name+" ("+string(endpoint.Origin)+")")
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package v1alpha2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnums(t *testing.T) {
+	assert.Equal(t, []CommandGroupKind{BuildCommandGroupKind, RunCommandGroupKind, TestCommandGroupKind, DebugCommandGroupKind, DeployCommandGroupKind},
+		CommandGroupKind("").Values(), "Values should be listed in marker order")
+	assert.Equal(t, []ImageType{DockerfileImageType}, ImageType("").Values())
+
+	for _, kind := range BuildCommandGroupKind.Values() {
+		assert.True(t, kind.IsValid(), "%q should be valid", kind)
+	}
+	assert.False(t, CommandGroupKind("Build").IsValid(), "Enum values should be case-sensitive")
+	assert.False(t, EndpointExposure("").IsValid(), "The empty value should not be valid")
+
+	var group CommandGroup
+	if assert.NoError(t, json.Unmarshal([]byte(`{"kind": "deploy"}`), &group)) {
+		assert.True(t, group.Kind.IsValid())
+		assert.Equal(t, DeployCommandGroupKind, group.Kind)
+	}
+}
@@ -0,0 +1,101 @@
+package v1alpha2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	attributes "github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/api/v2/pkg/devfile/keys"
+)
+
+// EventsMergeAttribute is the key of the devfile attribute that selects, per event type, how the events of the parent and plugins
+// are merged with the events of the devfile. Its content is described by the `EventsMerge` type.
+const EventsMergeAttribute = keys.EventsMergeAttribute
+
+// EventsMergeStrategy describes how the commands bound to an event type by the parent and plugins
+// are merged with the commands bound to the same event type by the devfile.
+// +kubebuilder:validation:Enum=append;replace
+type EventsMergeStrategy string
+
+const (
+	// AppendEventsMergeStrategy runs the commands of the parent first, then those of the plugins, in the order of the plugin components,
+	// then those of the devfile. A command bound several times only runs at its first position.
+	AppendEventsMergeStrategy EventsMergeStrategy = "append"
+	// ReplaceEventsMergeStrategy only runs the commands of the devfile, if it binds commands to the event type.
+	// Otherwise the commands of the parent and plugins are appended, as with the `append` strategy.
+	ReplaceEventsMergeStrategy EventsMergeStrategy = "replace"
+)
+
+// EventsMerge is the content of the `api.devfile.io/events-merge` attribute of a devfile.
+// It selects the merge strategy of each event type, `append` being the default one.
+// +devfile:jsonschema:generate
+type EventsMerge struct {
+	// +optional
+	// Merge strategy of the preStart events
+	PreStart EventsMergeStrategy `json:"preStart,omitempty"`
+
+	// +optional
+	// Merge strategy of the postStart events
+	PostStart EventsMergeStrategy `json:"postStart,omitempty"`
+
+	// +optional
+	// Merge strategy of the preStop events
+	PreStop EventsMergeStrategy `json:"preStop,omitempty"`
+
+	// +optional
+	// Merge strategy of the postStop events
+	PostStop EventsMergeStrategy `json:"postStop,omitempty"`
+}
+
+// GetEventsMerge decodes and validates the `api.devfile.io/events-merge` attribute of the given attributes.
+// Like the `command-hints` attribute, it cannot contain unknown fields, so that misspelled event types are reported.
+// It returns an empty EventsMerge, with the default strategies, if the attribute is not set.
+func GetEventsMerge(attrs attributes.Attributes) (EventsMerge, error) {
+	merge := EventsMerge{}
+	attribute, exists := attrs[EventsMergeAttribute]
+	if !exists {
+		return merge, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(attribute.Raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&merge); err != nil {
+		return EventsMerge{}, fmt.Errorf("attribute %q is invalid: %v", EventsMergeAttribute, err)
+	}
+
+	for _, strategy := range []struct {
+		eventType string
+		value     EventsMergeStrategy
+	}{
+		{"preStart", merge.PreStart},
+		{"postStart", merge.PostStart},
+		{"preStop", merge.PreStop},
+		{"postStop", merge.PostStop},
+	} {
+		if strategy.value != "" && !strategy.value.IsValid() {
+			return EventsMerge{}, fmt.Errorf("attribute %q is invalid: %s should be one of: %s, %s, but is %q",
+				EventsMergeAttribute, strategy.eventType, AppendEventsMergeStrategy, ReplaceEventsMergeStrategy, strategy.value)
+		}
+	}
+	return merge, nil
+}
+
+// MergeEvents merges the commands bound to an event type by the parent and plugins, in this order, with those bound by the devfile,
+// according to the given strategy. The returned list has no duplicate command.
+func MergeEvents(strategy EventsMergeStrategy, imported [][]string, main []string) []string {
+	lists := append(append([][]string{}, imported...), main)
+	if strategy == ReplaceEventsMergeStrategy && len(main) > 0 {
+		lists = [][]string{main}
+	}
+	var merged []string
+	added := map[string]bool{}
+	for _, list := range lists {
+		for _, command := range list {
+			if !added[command] {
+				added[command] = true
+				merged = append(merged, command)
+			}
+		}
+	}
+	return merged
+}
@@ -0,0 +1,81 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	attributes "github.com/devfile/api/v2/pkg/attributes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEventsMerge(t *testing.T) {
+	tests := []struct {
+		name          string
+		attributes    attributes.Attributes
+		expected      EventsMerge
+		expectedError string
+	}{
+		{
+			name:       "No attribute",
+			attributes: attributes.Attributes{},
+		},
+		{
+			name:       "Strategies per event type",
+			attributes: attributes.Attributes{}.FromMap(map[string]interface{}{EventsMergeAttribute: map[string]interface{}{"preStart": "replace", "postStop": "append"}}, nil),
+			expected:   EventsMerge{PreStart: ReplaceEventsMergeStrategy, PostStop: AppendEventsMergeStrategy},
+		},
+		{
+			name:          "Unknown event type",
+			attributes:    attributes.Attributes{}.FromMap(map[string]interface{}{EventsMergeAttribute: map[string]interface{}{"preStrat": "replace"}}, nil),
+			expectedError: `attribute "api.devfile.io/events-merge" is invalid: json: unknown field "preStrat"`,
+		},
+		{
+			name:          "Unknown strategy",
+			attributes:    attributes.Attributes{}.FromMap(map[string]interface{}{EventsMergeAttribute: map[string]interface{}{"postStart": "prepend"}}, nil),
+			expectedError: `attribute "api.devfile.io/events-merge" is invalid: postStart should be one of: append, replace, but is "prepend"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merge, err := GetEventsMerge(tt.attributes)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expected, merge)
+			}
+		})
+	}
+}
+
+func TestMergeEvents(t *testing.T) {
+	imported := [][]string{{"parent1", "shared"}, {"plugin1", "shared"}}
+	tests := []struct {
+		name     string
+		strategy EventsMergeStrategy
+		main     []string
+		expected []string
+	}{
+		{
+			name:     "Default strategy appends",
+			main:     []string{"main1", "parent1"},
+			expected: []string{"parent1", "shared", "plugin1", "main1"},
+		},
+		{
+			name:     "Replace",
+			strategy: ReplaceEventsMergeStrategy,
+			main:     []string{"main1", "parent1"},
+			expected: []string{"main1", "parent1"},
+		},
+		{
+			name:     "Replace without main events appends",
+			strategy: ReplaceEventsMergeStrategy,
+			expected: []string{"parent1", "shared", "plugin1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MergeEvents(tt.strategy, imported, tt.main))
+		})
+	}
+}
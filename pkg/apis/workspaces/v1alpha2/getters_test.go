@@ -0,0 +1,62 @@
+package v1alpha2
+
+import (
+	"errors"
+	"testing"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestQuantityGetters(t *testing.T) {
+	tests := []struct {
+		name     string
+		getter   func() (resource.Quantity, error)
+		want     resource.Quantity
+		wantErr  string
+		wantZero bool
+	}{
+		{
+			name:   "Memory limit",
+			getter: (&Container{MemoryLimit: "512Mi"}).GetMemoryLimitQuantity,
+			want:   resource.MustParse("512Mi"),
+		},
+		{
+			name:   "CPU request",
+			getter: (&Container{CpuRequest: "250m"}).GetCpuRequestQuantity,
+			want:   resource.MustParse("250m"),
+		},
+		{
+			name:     "Unset volume size",
+			getter:   (&Volume{}).GetSizeQuantity,
+			wantZero: true,
+		},
+		{
+			name:    "Invalid CPU limit",
+			getter:  (&Container{CpuLimit: "two"}).GetCpuLimitQuantity,
+			wantErr: `cpuLimit: invalid quantity "two": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the second call returns the cached quantity
+			for i := 0; i < 2; i++ {
+				quantity, err := tt.getter()
+				if tt.wantErr != "" {
+					assert.EqualError(t, err, tt.wantErr)
+					assert.True(t, errors.Is(err, devfileerrors.ErrInvalidQuantity), "error should wrap ErrInvalidQuantity")
+					var quantityErr *devfileerrors.QuantityError
+					assert.True(t, errors.As(err, &quantityErr), "error should be a QuantityError")
+					continue
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, tt.wantZero, quantity.IsZero())
+					assert.True(t, tt.want.Equal(quantity), "quantity should be %s, but is %s", tt.want.String(), quantity.String())
+				}
+				// modifying the returned quantity doesn't modify the cached one
+				quantity.Add(resource.MustParse("1"))
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ type Keyed interface {
 // The devfile top-level list (such as Commands, Components, Projects, ...)
 // are examples of such lists of Keyed objects
 // +k8s:deepcopy-gen=false
+// +devfile:helper
 type KeyedList []Keyed
 
 // GetKeys converts a KeyedList into a slice of string by calling Key() on each
@@ -34,6 +35,7 @@ func (l KeyedList) GetKeys() []string {
 // Each key of this map is the name of the field that contains the given top-level list:
 // `Commands`, `Components`, etc...
 // +k8s:deepcopy-gen=false
+// +devfile:helper
 type TopLevelLists map[string]KeyedList
 
 // TopLevelListContainer is an interface that allows retrieving the devfile top-level lists
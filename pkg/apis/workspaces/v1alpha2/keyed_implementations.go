@@ -3,8 +3,18 @@ package v1alpha2
 import (
 	"fmt"
 	"reflect"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
 )
 
+// ExtractKeys returns the Keyed elements of the given list.
+//
+// It is meant to be used by the code generated for top-level list containers defined outside of this package,
+// such as in downstream API packages that embed devfile types.
+func ExtractKeys(keyedList interface{}) []Keyed {
+	return extractKeys(keyedList)
+}
+
 func extractKeys(keyedList interface{}) []Keyed {
 	value := reflect.ValueOf(keyedList)
 	keys := make([]Keyed, 0, value.Len())
@@ -31,7 +41,7 @@ func CheckDuplicateKeys(keyedList interface{}) error {
 			if keyed, ok := i.(Keyed); ok {
 				key := keyed.Key()
 				if seen[key] {
-					return fmt.Errorf("duplicate key: %s", key)
+					return fmt.Errorf("%w: %s", devfileerrors.ErrDuplicateKey, key)
 				}
 				seen[key] = true
 			}
@@ -1,6 +1,7 @@
 package v1alpha2
 
 // +kubebuilder:validation:Enum=replace;delete
+// +devfile:helper
 type OverridingPatchDirective string
 
 const (
@@ -12,6 +13,9 @@ const (
 	DeleteFromPrimitiveListOverridingPatchDirective OverridingPatchDirective = "replace"
 )
 
+// OverrideDirective is a strategic merge patch directive applied on an element of a devworkspace template.
+// It is not referenced by the API types yet.
+// +devfile:helper
 type OverrideDirective struct {
 	// Path of the element the directive should be applied on
 	//
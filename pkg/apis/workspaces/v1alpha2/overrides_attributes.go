@@ -0,0 +1,168 @@
+package v1alpha2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	attributes "github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/api/v2/pkg/devfile/keys"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// PodOverridesAttribute is the key of the attribute that overrides the pod spec generated for a devworkspace,
+	// or for a container component. Its content is described by the `PodOverrides` type.
+	PodOverridesAttribute = keys.PodOverridesAttribute
+
+	// ContainerOverridesAttribute is the key of the attribute that overrides the container generated for a container component.
+	// Its content is described by the `ContainerOverrides` type.
+	ContainerOverridesAttribute = keys.ContainerOverridesAttribute
+)
+
+var (
+	// restrictedPodOverridesSpecFields are the pod spec fields that are managed by the devfile, and cannot be overridden
+	restrictedPodOverridesSpecFields = []string{"containers", "initContainers", "volumes"}
+	// restrictedContainerOverridesFields are the container fields that are managed by the devfile, and cannot be overridden
+	restrictedContainerOverridesFields = []string{"name", "image", "command", "args", "ports", "volumeMounts", "env"}
+)
+
+// PodOverrides is the content of the `pod-overrides` attribute.
+// It is applied as a strategic merge patch on top of the pod generated for the devworkspace or the container component.
+// +devfile:jsonschema:generate
+type PodOverrides struct {
+	// +optional
+	// Metadata overrides of the pod
+	Metadata *PodOverridesMetadata `json:"metadata,omitempty"`
+
+	// +optional
+	// Spec overrides of the pod
+	Spec *PodSpecOverrides `json:"spec,omitempty"`
+}
+
+// PodOverridesMetadata contains the metadata overrides of a pod
+type PodOverridesMetadata struct {
+	// +optional
+	// Labels to be added to the pod
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +optional
+	// Annotations to be added to the pod
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// PodSpecOverrides contains the well-known pod spec overrides.
+// Other pod spec fields are accepted, apart from `containers`, `initContainers` and `volumes`,
+// which are managed by the devfile.
+// +kubebuilder:pruning:PreserveUnknownFields
+type PodSpecOverrides struct {
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// +optional
+	SecurityContext *corev1.PodSecurityContext `json:"securityContext,omitempty"`
+
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+}
+
+// ContainerOverrides is the content of the `container-overrides` attribute.
+// It is applied as a strategic merge patch on top of the container generated for the container component.
+//
+// Other container fields are accepted, apart from `name`, `image`, `command`, `args`, `ports`, `volumeMounts` and `env`,
+// which are managed by the devfile.
+// +devfile:jsonschema:generate
+// +kubebuilder:pruning:PreserveUnknownFields
+type ContainerOverrides struct {
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// +optional
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// +optional
+	WorkingDir string `json:"workingDir,omitempty"`
+}
+
+// GetPodOverrides decodes and validates the `pod-overrides` attribute of the given attributes.
+// It returns nil if the attribute is not set.
+func GetPodOverrides(attrs attributes.Attributes) (*PodOverrides, error) {
+	if !attrs.Exists(PodOverridesAttribute) {
+		return nil, nil
+	}
+	podOverrides := &PodOverrides{}
+	if err := decodeOverridesAttribute(attrs, PodOverridesAttribute, podOverrides); err != nil {
+		return nil, err
+	}
+
+	rawPodOverrides := struct {
+		Spec map[string]json.RawMessage `json:"spec,omitempty"`
+	}{}
+	if err := attrs.GetInto(PodOverridesAttribute, &rawPodOverrides); err != nil {
+		return nil, fmt.Errorf("attribute %q is invalid: %v", PodOverridesAttribute, err)
+	}
+	if err := checkRestrictedFields(PodOverridesAttribute, "spec.", rawPodOverrides.Spec, restrictedPodOverridesSpecFields); err != nil {
+		return nil, err
+	}
+	return podOverrides, nil
+}
+
+// GetContainerOverrides decodes and validates the `container-overrides` attribute of the given attributes.
+// It returns nil if the attribute is not set.
+func GetContainerOverrides(attrs attributes.Attributes) (*ContainerOverrides, error) {
+	if !attrs.Exists(ContainerOverridesAttribute) {
+		return nil, nil
+	}
+	containerOverrides := &ContainerOverrides{}
+	if err := decodeOverridesAttribute(attrs, ContainerOverridesAttribute, containerOverrides); err != nil {
+		return nil, err
+	}
+
+	rawContainerOverrides := map[string]json.RawMessage{}
+	if err := attrs.GetInto(ContainerOverridesAttribute, &rawContainerOverrides); err != nil {
+		return nil, fmt.Errorf("attribute %q is invalid: %v", ContainerOverridesAttribute, err)
+	}
+	if err := checkRestrictedFields(ContainerOverridesAttribute, "", rawContainerOverrides, restrictedContainerOverridesFields); err != nil {
+		return nil, err
+	}
+	return containerOverrides, nil
+}
+
+func decodeOverridesAttribute(attrs attributes.Attributes, key string, into interface{}) error {
+	if err := attrs.GetInto(key, into); err != nil {
+		return fmt.Errorf("attribute %q is invalid: %v", key, err)
+	}
+	return nil
+}
+
+// checkRestrictedFields returns an error if any of the restricted fields is set in the given raw overrides
+func checkRestrictedFields(key string, pathPrefix string, rawOverrides map[string]json.RawMessage, restrictedFields []string) error {
+	var found []string
+	for _, field := range restrictedFields {
+		if _, isSet := rawOverrides[field]; isSet {
+			found = append(found, pathPrefix+field)
+		}
+	}
+	if len(found) > 0 {
+		return fmt.Errorf("attribute %q is invalid: the following fields are managed by the devfile and cannot be overridden: %s", key, strings.Join(found, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package v1alpha2
+
+import (
+	"errors"
+	"testing"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func devfilePointersJSON(raw string) apiext.JSON {
+	return apiext.JSON{Raw: []byte(raw)}
+}
+
+func pointersTestDevfile() *Devfile {
+	devfile := &Devfile{}
+	devfile.SchemaVersion = "2.2.0"
+	devfile.Variables = map[string]string{"version": "1.0"}
+	devfile.Components = []Component{
+		{
+			Name: "runtime",
+			ComponentUnion: ComponentUnion{
+				Container: &ContainerComponent{
+					Container: Container{
+						Image: "quay.io/devfile/universal-developer-image",
+						Env:   []EnvVar{{Name: "a/b", Value: "1"}},
+					},
+					Endpoints: []Endpoint{{Name: "http", TargetPort: 8080}},
+				},
+			},
+		},
+	}
+	return devfile
+}
+
+func TestGetByPointer(t *testing.T) {
+	tests := []struct {
+		pointer       string
+		expectedValue interface{}
+		expectedError string
+	}{
+		{pointer: "/schemaVersion", expectedValue: "2.2.0"},
+		{pointer: "/components/0/name", expectedValue: "runtime"},
+		{pointer: "/components/0/container/image", expectedValue: "quay.io/devfile/universal-developer-image"},
+		{pointer: "/components/0/container/endpoints/0/targetPort", expectedValue: 8080},
+		{pointer: "/components/0/container/endpoints/0/exposure", expectedValue: EndpointExposure("")},
+		{pointer: "/components/0/container/env", expectedValue: []EnvVar{{Name: "a/b", Value: "1"}}},
+		{pointer: "/components/0/container/mountSources", expectedValue: (*bool)(nil)},
+		{pointer: "/variables/version", expectedValue: "1.0"},
+		{pointer: "/components/0/kubernetes", expectedValue: (*KubernetesComponent)(nil)},
+		{pointer: "/components/1/name", expectedError: `JSON pointer "/components/1/name": not found: no list element "1"`},
+		{pointer: "/components/01/name", expectedError: `JSON pointer "/components/01/name": not found: no list element "01"`},
+		{pointer: "/components/0/kubernetes/uri", expectedError: `JSON pointer "/components/0/kubernetes/uri": not found: no field "uri"`},
+		{pointer: "/components/0/container/unknown", expectedError: `JSON pointer "/components/0/container/unknown": not found: no field "unknown"`},
+		{pointer: "/schemaVersion/major", expectedError: `JSON pointer "/schemaVersion/major": not found: no field "major"`},
+		{pointer: "/variables/missing", expectedError: `JSON pointer "/variables/missing": not found: no entry "missing"`},
+		{pointer: "components", expectedError: `invalid JSON pointer "components": it should start with a slash`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pointer, func(t *testing.T) {
+			value, err := pointersTestDevfile().GetByPointer(tt.pointer)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expectedValue, value)
+			}
+		})
+	}
+}
+
+func TestGetByPointerNotFound(t *testing.T) {
+	_, err := pointersTestDevfile().GetByPointer("/components/3/container/image")
+	assert.True(t, errors.Is(err, devfileerrors.ErrNotFound))
+
+	value, err := pointersTestDevfile().GetByPointer("")
+	if assert.NoError(t, err) {
+		assert.Equal(t, *pointersTestDevfile(), value)
+	}
+}
+
+func TestSetByPointer(t *testing.T) {
+	mountSources := false
+	tests := []struct {
+		name          string
+		pointer       string
+		value         interface{}
+		check         func(t *testing.T, devfile *Devfile)
+		expectedError string
+	}{
+		{
+			name:    "Set a string field",
+			pointer: "/components/0/container/image",
+			value:   "golang:1.18",
+			check: func(t *testing.T, devfile *Devfile) {
+				assert.Equal(t, "golang:1.18", devfile.Components[0].Container.Image)
+			},
+		},
+		{
+			name:    "Set an enum field from its underlying type",
+			pointer: "/components/0/container/endpoints/0/exposure",
+			value:   "internal",
+			check: func(t *testing.T, devfile *Devfile) {
+				assert.Equal(t, InternalEndpointExposure, devfile.Components[0].Container.Endpoints[0].Exposure)
+			},
+		},
+		{
+			name:    "Set a pointer field from the pointed-to type",
+			pointer: "/components/0/container/mountSources",
+			value:   true,
+			check: func(t *testing.T, devfile *Devfile) {
+				if assert.NotNil(t, devfile.Components[0].Container.MountSources) {
+					assert.True(t, *devfile.Components[0].Container.MountSources)
+				}
+			},
+		},
+		{
+			name:    "Set a pointer field from a pointer",
+			pointer: "/components/0/container/mountSources",
+			value:   &mountSources,
+			check: func(t *testing.T, devfile *Devfile) {
+				assert.Same(t, &mountSources, devfile.Components[0].Container.MountSources)
+			},
+		},
+		{
+			name:    "Append a list element",
+			pointer: "/components/0/container/env/-",
+			value:   EnvVar{Name: "b", Value: "2"},
+			check: func(t *testing.T, devfile *Devfile) {
+				assert.Equal(t, []EnvVar{{Name: "a/b", Value: "1"}, {Name: "b", Value: "2"}}, devfile.Components[0].Container.Env)
+			},
+		},
+		{
+			name:    "Set an escaped map entry",
+			pointer: "/attributes/app.kubernetes.io~1name",
+			value:   devfilePointersJSON(`"my-app"`),
+			check: func(t *testing.T, devfile *Devfile) {
+				assert.Equal(t, devfilePointersJSON(`"my-app"`), devfile.Attributes["app.kubernetes.io/name"])
+			},
+		},
+		{
+			name:    "Set a field of an embedded type from another package",
+			pointer: "/metadata/name",
+			value:   "nodejs",
+			check: func(t *testing.T, devfile *Devfile) {
+				assert.Equal(t, "nodejs", devfile.Metadata.Name)
+			},
+		},
+		{
+			name:    "Allocate an unset union member along the path",
+			pointer: "/components/0/kubernetes/uri",
+			value:   "deploy.yaml",
+			check: func(t *testing.T, devfile *Devfile) {
+				if assert.NotNil(t, devfile.Components[0].Kubernetes) {
+					assert.Equal(t, "deploy.yaml", devfile.Components[0].Kubernetes.Uri)
+				}
+			},
+		},
+		{
+			name:          "Set a value of the wrong type",
+			pointer:       "/components/0/container/image",
+			value:         42,
+			expectedError: `JSON pointer "/components/0/container/image": cannot set a int value where a string value is expected`,
+		},
+		{
+			name:          "Set an element that doesn't exist",
+			pointer:       "/components/3/container/image",
+			value:         "golang:1.18",
+			expectedError: `JSON pointer "/components/3/container/image": not found: no list element "3"`,
+		},
+		{
+			name:          "Leave the value unchanged on error",
+			pointer:       "/components/0/openshift/unknown",
+			value:         "value",
+			expectedError: `JSON pointer "/components/0/openshift/unknown": not found: no field "unknown"`,
+			check: func(t *testing.T, devfile *Devfile) {
+				assert.Nil(t, devfile.Components[0].Openshift)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfile := pointersTestDevfile()
+			err := devfile.SetByPointer(tt.pointer, tt.value)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.check != nil {
+				tt.check(t, devfile)
+			}
+		})
+	}
+}
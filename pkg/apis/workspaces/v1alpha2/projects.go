@@ -53,13 +53,14 @@ type StarterProject struct {
 // Only one of the following project sources may be specified.
 // If none of the following policies is specified, the default one
 // is AllowConcurrent.
-// +kubebuilder:validation:Enum=Git;Zip;Custom
+// +kubebuilder:validation:Enum=Git;Zip;Archive;Custom
 type ProjectSourceType string
 
 const (
-	GitProjectSourceType    ProjectSourceType = "Git"
-	ZipProjectSourceType    ProjectSourceType = "Zip"
-	CustomProjectSourceType ProjectSourceType = "Custom"
+	GitProjectSourceType     ProjectSourceType = "Git"
+	ZipProjectSourceType     ProjectSourceType = "Zip"
+	ArchiveProjectSourceType ProjectSourceType = "Archive"
+	CustomProjectSourceType  ProjectSourceType = "Custom"
 )
 
 // +union
@@ -78,6 +79,11 @@ type ProjectSource struct {
 	// +optional
 	Zip *ZipProjectSource `json:"zip,omitempty"`
 
+	// Project's Archive source, a pre-built tarball or zip archive verified with its checksum
+	// +optional
+	// +devfile:since=2.2.0
+	Archive *ArchiveProjectSource `json:"archive,omitempty"`
+
 	// Project's Custom source
 	// +optional
 	// +devfile:overrides:include:omit=true
@@ -100,6 +106,52 @@ type ZipProjectSource struct {
 	// Zip project's source location address. Should be file path of the archive, e.g. file://$FILE_PATH
 	// +required
 	Location string `json:"location,omitempty"`
+
+	// SHA-256 checksum of the archive, as a hexadecimal string,
+	// that tools should verify after downloading the archive
+	// +optional
+	// +kubebuilder:validation:Pattern=^[a-fA-F0-9]+$
+	// +kubebuilder:validation:MinLength=64
+	// +kubebuilder:validation:MaxLength=64
+	Sha256 string `json:"sha256,omitempty"`
+}
+
+// ArchiveFormat describes the format of a project archive.
+// +kubebuilder:validation:Enum=tar;tar.gz;tar.bz2;tar.xz;zip
+type ArchiveFormat string
+
+const (
+	TarArchiveFormat    ArchiveFormat = "tar"
+	TarGzArchiveFormat  ArchiveFormat = "tar.gz"
+	TarBz2ArchiveFormat ArchiveFormat = "tar.bz2"
+	TarXzArchiveFormat  ArchiveFormat = "tar.xz"
+	ZipArchiveFormat    ArchiveFormat = "zip"
+)
+
+type ArchiveProjectSource struct {
+	CommonProjectSource `json:",inline"`
+
+	// Archive project's source location address: an http, https or file URL, or a path relative to the devfile,
+	// e.g. the URL of the archive in an artifact store
+	Location string `json:"location"`
+
+	// SHA-256 checksum of the archive, as a hexadecimal string,
+	// that tools must verify after downloading the archive, and before extracting it
+	// +kubebuilder:validation:Pattern=^[a-fA-F0-9]+$
+	// +kubebuilder:validation:MinLength=64
+	// +kubebuilder:validation:MaxLength=64
+	Sha256 string `json:"sha256"`
+
+	// Format of the archive. If not specified, it is inferred from the extension of the location,
+	// e.g. `.tgz` or `.tar.gz` for a gzipped tarball.
+	// +optional
+	Format ArchiveFormat `json:"format,omitempty"`
+
+	// Number of leading path components stripped from the file names of the archive when extracting it,
+	// as with the `--strip-components` option of `tar`, e.g. 1 to extract the content of the single top-level folder of the archive
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	StripComponents int `json:"stripComponents,omitempty"`
 }
 
 type GitLikeProjectSource struct {
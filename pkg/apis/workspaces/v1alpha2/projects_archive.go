@@ -0,0 +1,57 @@
+package v1alpha2
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+)
+
+// archiveExtensions are the file extensions of the archive formats, the longest extensions first
+var archiveExtensions = []struct {
+	extension string
+	format    ArchiveFormat
+}{
+	{".tar.gz", TarGzArchiveFormat},
+	{".tar.bz2", TarBz2ArchiveFormat},
+	{".tar.xz", TarXzArchiveFormat},
+	{".tgz", TarGzArchiveFormat},
+	{".tbz2", TarBz2ArchiveFormat},
+	{".txz", TarXzArchiveFormat},
+	{".tar", TarArchiveFormat},
+	{".zip", ZipArchiveFormat},
+}
+
+// EffectiveFormat returns the format of the archive: its `format` field if it is set,
+// or else the format inferred from the file extension of its location.
+// An error is returned if the format cannot be inferred.
+func (in *ArchiveProjectSource) EffectiveFormat() (ArchiveFormat, error) {
+	if in.Format != "" {
+		return in.Format, nil
+	}
+	path := in.Location
+	if location, err := url.Parse(in.Location); err == nil {
+		path = location.Path
+	}
+	path = strings.ToLower(path)
+	for _, archiveExtension := range archiveExtensions {
+		if strings.HasSuffix(path, archiveExtension.extension) {
+			return archiveExtension.format, nil
+		}
+	}
+	return "", fmt.Errorf("the format of the archive %q cannot be inferred from its extension, and should be set", in.Location)
+}
+
+// Verify checks that the SHA-256 checksum of the given archive content is the checksum of the archive source.
+// The returned error wraps ErrChecksumMismatch.
+func (in *ArchiveProjectSource) Verify(content []byte) error {
+	checksum := sha256.Sum256(content)
+	actual := hex.EncodeToString(checksum[:])
+	if !strings.EqualFold(actual, in.Sha256) {
+		return fmt.Errorf("%w: expected sha256 %s, got %s", devfileerrors.ErrChecksumMismatch, in.Sha256, actual)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package v1alpha2
+
+import (
+	"errors"
+	"testing"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchiveEffectiveFormat(t *testing.T) {
+	tests := []struct {
+		name           string
+		source         ArchiveProjectSource
+		expectedFormat ArchiveFormat
+		expectedError  string
+	}{
+		{
+			name:           "Explicit format",
+			source:         ArchiveProjectSource{Location: "https://example.com/artifacts/project", Format: TarXzArchiveFormat},
+			expectedFormat: TarXzArchiveFormat,
+		},
+		{
+			name:           "Gzipped tarball",
+			source:         ArchiveProjectSource{Location: "https://example.com/artifacts/project.TGZ?token=abc"},
+			expectedFormat: TarGzArchiveFormat,
+		},
+		{
+			name:           "Zip file",
+			source:         ArchiveProjectSource{Location: "file:///tmp/project.zip"},
+			expectedFormat: ZipArchiveFormat,
+		},
+		{
+			name:           "Relative path",
+			source:         ArchiveProjectSource{Location: "archives/project.tar.bz2"},
+			expectedFormat: TarBz2ArchiveFormat,
+		},
+		{
+			name:          "Unknown extension",
+			source:        ArchiveProjectSource{Location: "https://example.com/artifacts/project"},
+			expectedError: `the format of the archive "https://example.com/artifacts/project" cannot be inferred from its extension, and should be set`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := tt.source.EffectiveFormat()
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expectedFormat, format)
+			}
+		})
+	}
+}
+
+func TestArchiveVerify(t *testing.T) {
+	// SHA-256 checksum of "content"
+	source := ArchiveProjectSource{Sha256: "ED7002B439E9AC845F22357D822BAC1444730FBDB6016D3EC9432297B9EC9F73"}
+	assert.NoError(t, source.Verify([]byte("content")))
+
+	err := source.Verify([]byte("other content"))
+	assert.True(t, errors.Is(err, devfileerrors.ErrChecksumMismatch), "unexpected error: %v", err)
+}
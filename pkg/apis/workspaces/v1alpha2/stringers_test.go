@@ -0,0 +1,115 @@
+package v1alpha2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringers(t *testing.T) {
+	component := Component{
+		Name: "tools",
+		ComponentUnion: ComponentUnion{
+			Container: &ContainerComponent{
+				Container: Container{
+					Image: "quay.io/devfile/universal-developer-image",
+				},
+			},
+		},
+	}
+	command := Command{
+		Id: "build",
+		CommandUnion: CommandUnion{
+			Exec: &ExecCommand{
+				CommandLine: "make",
+				Component:   "tools",
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		value       interface{ Summary() string }
+		wantSummary string
+		wantString  string
+	}{
+		{
+			name:        "Container component",
+			value:       component,
+			wantSummary: "Component{name: tools, componentType: container}",
+			wantString:  "Component{name: tools, componentType: container, container: {image: quay.io/devfile/universal-developer-image}}",
+		},
+		{
+			name: "Kubernetes component with uri",
+			value: Component{
+				Name: "deploy",
+				ComponentUnion: ComponentUnion{
+					Kubernetes: &KubernetesComponent{
+						K8sLikeComponent: K8sLikeComponent{
+							K8sLikeComponentLocation: K8sLikeComponentLocation{
+								Uri: "deploy.yaml",
+							},
+						},
+					},
+				},
+			},
+			wantSummary: "Component{name: deploy, componentType: kubernetes}",
+			wantString:  "Component{name: deploy, componentType: kubernetes, kubernetes: {locationType: uri, uri: deploy.yaml}}",
+		},
+		{
+			name: "Component with only the union discriminator",
+			value: Component{
+				Name: "volume",
+				ComponentUnion: ComponentUnion{
+					ComponentType: VolumeComponentType,
+				},
+			},
+			wantSummary: "Component{name: volume, componentType: Volume}",
+			wantString:  "Component{name: volume, componentType: Volume}",
+		},
+		{
+			name:        "Exec command",
+			value:       command,
+			wantSummary: "Command{id: build, commandType: exec}",
+			wantString:  "Command{id: build, commandType: exec, exec: {commandLine: make, component: tools}}",
+		},
+		{
+			name: "Composite command",
+			value: Command{
+				Id: "all",
+				CommandUnion: CommandUnion{
+					Composite: &CompositeCommand{
+						Commands: []string{"build", "run"},
+					},
+				},
+			},
+			wantSummary: "Command{id: all, commandType: composite}",
+			wantString:  "Command{id: all, commandType: composite, composite: {commands: [build, run]}}",
+		},
+		{
+			name: "Template spec",
+			value: DevWorkspaceTemplateSpec{
+				DevWorkspaceTemplateSpecContent: DevWorkspaceTemplateSpecContent{
+					Components: []Component{component},
+					Commands:   []Command{command},
+					Projects:   []Project{{Name: "project"}},
+				},
+			},
+			wantSummary: "DevWorkspaceTemplateSpec{components: 1, projects: 1, commands: 1}",
+			wantString:  "DevWorkspaceTemplateSpec{components: [Component{name: tools, componentType: container}], projects: 1, commands: [Command{id: build, commandType: exec}]}",
+		},
+		{
+			name:        "Empty template spec",
+			value:       DevWorkspaceTemplateSpec{},
+			wantSummary: "DevWorkspaceTemplateSpec{}",
+			wantString:  "DevWorkspaceTemplateSpec{}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantSummary, tt.value.Summary(), "Summary should match")
+			assert.Equal(t, tt.wantString, fmt.Sprintf("%v", tt.value), "String should match")
+		})
+	}
+}
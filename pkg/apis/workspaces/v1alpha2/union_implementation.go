@@ -1,8 +1,10 @@
 package v1alpha2
 
 import (
-	"errors"
+	"fmt"
 	"reflect"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
 )
 
 func visitUnion(union interface{}, visitor interface{}) (err error) {
@@ -15,7 +17,7 @@ func visitUnion(union interface{}, visitor interface{}) (err error) {
 		unionMember := unionValue.FieldByName(unionMemberToRead)
 		if !unionMember.IsZero() {
 			if oneMemberPresent {
-				err = errors.New("Only one element should be set in union: " + unionValue.Type().Name())
+				err = invalidUnionError("Only one element should be set in union", unionValue)
 				return
 			}
 			oneMemberPresent = true
@@ -33,32 +35,62 @@ func visitUnion(union interface{}, visitor interface{}) (err error) {
 	return
 }
 
+// VisitUnion calls the visitor function that matches the member set in the given union.
+//
+// It is meant to be used by the code generated for unions defined outside of this package,
+// such as in downstream API packages that embed devfile types.
+func VisitUnion(union interface{}, visitor interface{}) error {
+	return visitUnion(union, visitor)
+}
+
+// NormalizeUnion normalizes the union the given pointer points to, according to the same rules as `Union.Normalize()`,
+// using the given pointer to the union discriminator.
+//
+// It is meant to be used by the code generated for unions defined outside of this package,
+// which cannot implement the `Union` interface.
+func NormalizeUnion(union interface{}, discriminator *string, visitorType reflect.Type) error {
+	return normalizeUnionWithDiscriminator(union, discriminator, visitorType)
+}
+
+// SimplifyUnion simplifies the union the given pointer points to, according to the same rules as `Union.Simplify()`,
+// using the given pointer to the union discriminator.
+//
+// It is meant to be used by the code generated for unions defined outside of this package,
+// which cannot implement the `Union` interface.
+func SimplifyUnion(union interface{}, discriminator *string, visitorType reflect.Type) {
+	normalizeUnionWithDiscriminator(union, discriminator, visitorType)
+	*discriminator = ""
+}
+
 func simplifyUnion(union Union, visitorType reflect.Type) {
-	normalizeUnion(union, visitorType)
-	*union.discriminator() = ""
+	SimplifyUnion(union, union.discriminator(), visitorType)
 }
 
 func normalizeUnion(union Union, visitorType reflect.Type) error {
-	err := updateDiscriminator(union, visitorType)
+	return normalizeUnionWithDiscriminator(union, union.discriminator(), visitorType)
+}
+
+func normalizeUnionWithDiscriminator(union interface{}, discriminator *string, visitorType reflect.Type) error {
+	err := updateDiscriminator(union, discriminator, visitorType)
 	if err != nil {
 		return err
 	}
 
-	err = cleanupValues(union, visitorType)
+	err = cleanupValues(union, discriminator, visitorType)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func updateDiscriminator(union Union, visitorType reflect.Type) error {
+func updateDiscriminator(union interface{}, discriminator *string, visitorType reflect.Type) error {
 	unionValue := reflect.ValueOf(union)
 
-	if union.discriminator() == nil {
-		return errors.New("Discriminator should not be 'nil' in union: " + unionValue.Type().Name())
+	if discriminator == nil {
+		return invalidUnionError("Discriminator should not be 'nil' in union", unionValue)
 	}
 
-	if *union.discriminator() != "" {
+	if *discriminator != "" {
 		// Nothing to do
 		return nil
 	}
@@ -69,35 +101,40 @@ func updateDiscriminator(union Union, visitorType reflect.Type) error {
 		unionMember := unionValue.Elem().FieldByName(unionMemberToRead)
 		if !unionMember.IsZero() {
 			if oneMemberPresent {
-				return errors.New("Discriminator cannot be deduced from 2 values in union: " + unionValue.Type().Name())
+				return invalidUnionError("Discriminator cannot be deduced from 2 values in union", unionValue)
 			}
 			oneMemberPresent = true
-			*(union.discriminator()) = unionMemberToRead
+			*discriminator = unionMemberToRead
 		}
 	}
 	return nil
 }
 
-func cleanupValues(union Union, visitorType reflect.Type) error {
+func cleanupValues(union interface{}, discriminator *string, visitorType reflect.Type) error {
 	unionValue := reflect.ValueOf(union)
 
-	if union.discriminator() == nil {
-		return errors.New("Discriminator should not be 'nil' in union: " + unionValue.Type().Name())
+	if discriminator == nil {
+		return invalidUnionError("Discriminator should not be 'nil' in union", unionValue)
 	}
 
-	if *union.discriminator() == "" {
+	if *discriminator == "" {
 		// Nothing to do
-		return errors.New("Values cannot be cleaned up without a discriminator in union: " + unionValue.Type().Name())
+		return invalidUnionError("Values cannot be cleaned up without a discriminator in union", unionValue)
 	}
 
 	for i := 0; i < visitorType.NumField(); i++ {
 		unionMemberToRead := visitorType.Field(i).Name
 		unionMember := unionValue.Elem().FieldByName(unionMemberToRead)
 		if !unionMember.IsZero() {
-			if unionMemberToRead != *union.discriminator() {
+			if unionMemberToRead != *discriminator {
 				unionMember.Set(reflect.Zero(unionMember.Type()))
 			}
 		}
 	}
 	return nil
 }
+
+// invalidUnionError returns an error with the given message about the given union, which wraps `ErrInvalidUnion`
+func invalidUnionError(message string, unionValue reflect.Value) error {
+	return fmt.Errorf("%w: %s: %s", devfileerrors.ErrInvalidUnion, message, unionValue.Type().Name())
+}
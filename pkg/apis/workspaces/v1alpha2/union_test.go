@@ -1,6 +1,7 @@
 package v1alpha2
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -60,3 +61,48 @@ func TestSimplifyingUnion(t *testing.T) {
 		original,
 		"The two values should be the same.")
 }
+
+// externalUnion mimics a union defined outside of this package, which cannot implement the Union interface
+type externalUnion struct {
+	UnionType string
+	Git       *GitProjectSource
+	Zip       *ZipProjectSource
+}
+
+type externalUnionVisitor struct {
+	Git func(*GitProjectSource) error
+	Zip func(*ZipProjectSource) error
+}
+
+func TestNormalizingExternalUnion(t *testing.T) {
+	original := externalUnion{
+		Git:       &GitProjectSource{},
+		Zip:       &ZipProjectSource{},
+		UnionType: "Zip",
+	}
+	expected := externalUnion{
+		Zip:       &ZipProjectSource{},
+		UnionType: "Zip",
+	}
+
+	err := NormalizeUnion(&original, &original.UnionType, reflect.TypeOf(externalUnionVisitor{}))
+	assert.NoError(t, err)
+
+	assert.Equal(t,
+		expected,
+		original,
+		"The two values should be the same.")
+
+	SimplifyUnion(&original, &original.UnionType, reflect.TypeOf(externalUnionVisitor{}))
+	assert.Equal(t, "", original.UnionType, "The discriminator should be removed")
+
+	visited := ""
+	err = VisitUnion(original, externalUnionVisitor{
+		Zip: func(*ZipProjectSource) error {
+			visited = "Zip"
+			return nil
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Zip", visited, "The visitor function of the set member should be called")
+}
@@ -0,0 +1,135 @@
+package v1alpha2
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// unionMember describes a member of a union type, in the union members table generated by the `validate` generator
+type unionMember struct {
+	// field is the name of the GO field of the member
+	field string
+	// jsonName is the Json name of the member
+	jsonName string
+}
+
+// +k8s:deepcopy-gen=false
+// +devfile:helper
+
+// UnionViolation is the error returned when several members of a union are set simultaneously
+type UnionViolation struct {
+	// Path is the Json path of the union in the validated object, such as `$.components[0]`
+	Path string
+	// Union is the name of the union type
+	Union string
+	// Members contains the Json names of the union members that are set simultaneously
+	Members []string
+}
+
+func (v *UnionViolation) Error() string {
+	return fmt.Sprintf("%s: only one member of union %s should be set, but found: %s", v.Path, v.Union, strings.Join(v.Members, ", "))
+}
+
+// +k8s:deepcopy-gen=false
+// +devfile:helper
+
+// UnionViolations is the error returned by ValidateUnions in aggregate mode, listing all the union violations of the validated object
+type UnionViolations []*UnionViolation
+
+func (violations UnionViolations) Error() string {
+	messages := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		messages = append(messages, violation.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+// ValidateUnions checks that at most one member is set in each union of the given object,
+// such as a `DevWorkspaceTemplateSpec` or a `Devfile`, wherever the union is located in the object tree.
+//
+// By default, it returns a `*UnionViolation` error for the first union in which several members are set.
+// In aggregate mode, it goes through the whole object and returns all the violations as a `UnionViolations` error.
+func ValidateUnions(obj interface{}, aggregate bool) error {
+	validator := unionValidator{aggregate: aggregate}
+	validator.validate(reflect.ValueOf(obj), "$")
+	switch {
+	case len(validator.violations) == 0:
+		return nil
+	case !aggregate:
+		return validator.violations[0]
+	default:
+		return validator.violations
+	}
+}
+
+type unionValidator struct {
+	aggregate  bool
+	violations UnionViolations
+}
+
+// validate checks the unions of the given value, located at the given Json path,
+// and returns false if the validation should stop
+func (v *unionValidator) validate(value reflect.Value, path string) bool {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return true
+		}
+		return v.validate(value.Elem(), path)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if !v.validate(value.Index(i), fmt.Sprintf("%s[%d]", path, i)) {
+				return false
+			}
+		}
+	case reflect.Map:
+		keys := value.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, key := range keys {
+			if !v.validate(value.MapIndex(key), fmt.Sprintf("%s[%q]", path, fmt.Sprint(key.Interface()))) {
+				return false
+			}
+		}
+	case reflect.Struct:
+		if members, isUnion := unionMembers[value.Type()]; isUnion {
+			var setMembers []string
+			for _, member := range members {
+				if !value.FieldByName(member.field).IsZero() {
+					setMembers = append(setMembers, member.jsonName)
+				}
+			}
+			if len(setMembers) > 1 {
+				v.violations = append(v.violations, &UnionViolation{Path: path, Union: value.Type().Name(), Members: setMembers})
+				if !v.aggregate {
+					return false
+				}
+			}
+		}
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.PkgPath != "" {
+				// unexported field
+				continue
+			}
+			jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+			if jsonName == "-" {
+				continue
+			}
+			fieldPath := path
+			if jsonName != "" || !field.Anonymous {
+				if jsonName == "" {
+					jsonName = field.Name
+				}
+				fieldPath = path + "." + jsonName
+			}
+			if !v.validate(value.Field(i), fieldPath) {
+				return false
+			}
+		}
+	}
+	return true
+}
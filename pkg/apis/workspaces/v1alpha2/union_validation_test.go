@@ -0,0 +1,89 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUnions(t *testing.T) {
+	content := DevWorkspaceTemplateSpecContent{
+		Components: []Component{
+			{
+				Name: "valid",
+				ComponentUnion: ComponentUnion{
+					Container: &ContainerComponent{},
+				},
+			},
+			{
+				Name: "container-and-volume",
+				ComponentUnion: ComponentUnion{
+					Container: &ContainerComponent{},
+					Volume:    &VolumeComponent{},
+				},
+			},
+		},
+		Projects: []Project{
+			{
+				Name: "git-and-zip",
+				ProjectSource: ProjectSource{
+					Git: &GitProjectSource{},
+					Zip: &ZipProjectSource{},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		content   DevWorkspaceTemplateSpecContent
+		aggregate bool
+		wantErr   []string
+	}{
+		{
+			name: "Valid unions",
+			content: DevWorkspaceTemplateSpecContent{
+				Components: content.Components[:1],
+			},
+		},
+		{
+			name:    "First violation",
+			content: content,
+			wantErr: []string{
+				"$.components[1]: only one member of union ComponentUnion should be set, but found: container, volume",
+			},
+		},
+		{
+			name:      "All violations",
+			content:   content,
+			aggregate: true,
+			wantErr: []string{
+				"$.components[1]: only one member of union ComponentUnion should be set, but found: container, volume",
+				"$.projects[0]: only one member of union ProjectSource should be set, but found: git, zip",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUnions(&tt.content, tt.aggregate)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			if tt.aggregate {
+				if assert.IsType(t, UnionViolations{}, err) {
+					violations := err.(UnionViolations)
+					var messages []string
+					for _, violation := range violations {
+						messages = append(messages, violation.Error())
+					}
+					assert.Equal(t, tt.wantErr, messages, "Violations should match")
+				}
+				return
+			}
+			if assert.IsType(t, &UnionViolation{}, err) {
+				assert.Equal(t, tt.wantErr[0], err.Error(), "Error message should match")
+			}
+		})
+	}
+}
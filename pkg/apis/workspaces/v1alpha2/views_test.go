@@ -0,0 +1,93 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/stretchr/testify/assert"
+)
+
+func viewsTestSpec() *DevWorkspaceTemplateSpec {
+	mountSources := false
+	return &DevWorkspaceTemplateSpec{
+		DevWorkspaceTemplateSpecContent: DevWorkspaceTemplateSpecContent{
+			Variables:  map[string]string{"version": "1.0", "arch": "amd64"},
+			Attributes: attributes.Attributes{}.PutString("owner", "team-a"),
+			Components: []Component{
+				{
+					Name: "runtime",
+					ComponentUnion: ComponentUnion{
+						Container: &ContainerComponent{
+							Container: Container{
+								Image:        "quay.io/devfile/universal-developer-image",
+								Args:         []string{"sleep", "infinity"},
+								MountSources: &mountSources,
+							},
+							Endpoints: []Endpoint{{Name: "http", TargetPort: 8080}},
+						},
+					},
+				},
+				{
+					Name: "data",
+					ComponentUnion: ComponentUnion{
+						Volume: &VolumeComponent{},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestViews(t *testing.T) {
+	spec := viewsTestSpec()
+	view := spec.View()
+
+	assert.Nil(t, view.Parent(), "unset struct pointers should have nil views")
+	assert.Equal(t, 2, view.Variables().Len())
+	assert.Equal(t, []string{"arch", "version"}, view.Variables().Keys())
+	version, exists := view.Variables().Get("version")
+	assert.True(t, exists)
+	assert.Equal(t, "1.0", version)
+	_, exists = view.Variables().Get("missing")
+	assert.False(t, exists)
+
+	owner, exists := view.Attributes().Get("owner")
+	if assert.True(t, exists) {
+		assert.Equal(t, `"team-a"`, string(owner.Raw))
+		owner.Raw[1] = 'T'
+		assert.Equal(t, "team-a", spec.Attributes.GetString("owner", nil), "modifying a returned value should not modify the viewed object")
+	}
+
+	components := view.Components()
+	if !assert.Equal(t, 2, components.Len()) {
+		return
+	}
+	runtime := components.At(0)
+	assert.Equal(t, "runtime", runtime.Name())
+	assert.Nil(t, runtime.Volume())
+	container := runtime.Container()
+	if assert.NotNil(t, container) {
+		assert.Equal(t, "quay.io/devfile/universal-developer-image", container.Image())
+		assert.Equal(t, 2, container.Args().Len())
+		assert.Equal(t, "infinity", container.Args().At(1))
+		mountSources, isSet := container.MountSources()
+		assert.True(t, isSet)
+		assert.False(t, mountSources)
+		_, isSet = container.DedicatedPod()
+		assert.False(t, isSet)
+		assert.Equal(t, 8080, container.Endpoints().At(0).TargetPort())
+	}
+	assert.NotNil(t, components.At(1).Volume())
+	assert.Panics(t, func() { components.At(2) })
+
+	// views share the content of the viewed object
+	spec.Components[0].Container.Image = "node:18"
+	assert.Equal(t, "node:18", container.Image())
+
+	copied := runtime.DeepCopy()
+	copied.Container.Image = "python:3"
+	assert.Equal(t, "node:18", spec.Components[0].Container.Image, "modifying a deep copy should not modify the viewed object")
+
+	var nilSpec *DevWorkspaceTemplateSpec
+	assert.Nil(t, nilSpec.View())
+}
@@ -1,12 +1,15 @@
 //go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
+// Generated for the devfile generator
+
 // Code generated by controller-gen. DO NOT EDIT.
 
 package v1alpha2
 
 import (
 	"github.com/devfile/api/v2/pkg/attributes"
+	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -146,6 +149,11 @@ func (in *ApplyCommand) DeepCopy() *ApplyCommand {
 func (in *ApplyCommandParentOverride) DeepCopyInto(out *ApplyCommandParentOverride) {
 	*out = *in
 	in.LabeledCommandParentOverride.DeepCopyInto(&out.LabeledCommandParentOverride)
+	if in.Component != nil {
+		in, out := &in.Component, &out.Component
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplyCommandParentOverride.
@@ -162,6 +170,11 @@ func (in *ApplyCommandParentOverride) DeepCopy() *ApplyCommandParentOverride {
 func (in *ApplyCommandPluginOverride) DeepCopyInto(out *ApplyCommandPluginOverride) {
 	*out = *in
 	in.LabeledCommandPluginOverride.DeepCopyInto(&out.LabeledCommandPluginOverride)
+	if in.Component != nil {
+		in, out := &in.Component, &out.Component
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplyCommandPluginOverride.
@@ -178,6 +191,11 @@ func (in *ApplyCommandPluginOverride) DeepCopy() *ApplyCommandPluginOverride {
 func (in *ApplyCommandPluginOverrideParentOverride) DeepCopyInto(out *ApplyCommandPluginOverrideParentOverride) {
 	*out = *in
 	in.LabeledCommandPluginOverrideParentOverride.DeepCopyInto(&out.LabeledCommandPluginOverrideParentOverride)
+	if in.Component != nil {
+		in, out := &in.Component, &out.Component
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplyCommandPluginOverrideParentOverride.
@@ -190,6 +208,53 @@ func (in *ApplyCommandPluginOverrideParentOverride) DeepCopy() *ApplyCommandPlug
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchiveProjectSource) DeepCopyInto(out *ArchiveProjectSource) {
+	*out = *in
+	out.CommonProjectSource = in.CommonProjectSource
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchiveProjectSource.
+func (in *ArchiveProjectSource) DeepCopy() *ArchiveProjectSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchiveProjectSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchiveProjectSourceParentOverride) DeepCopyInto(out *ArchiveProjectSourceParentOverride) {
+	*out = *in
+	out.CommonProjectSourceParentOverride = in.CommonProjectSourceParentOverride
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.Sha256 != nil {
+		in, out := &in.Sha256, &out.Sha256
+		*out = new(string)
+		**out = **in
+	}
+	if in.StripComponents != nil {
+		in, out := &in.StripComponents, &out.StripComponents
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchiveProjectSourceParentOverride.
+func (in *ArchiveProjectSourceParentOverride) DeepCopy() *ArchiveProjectSourceParentOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchiveProjectSourceParentOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BaseCommand) DeepCopyInto(out *BaseCommand) {
 	*out = *in
@@ -408,6 +473,16 @@ func (in *CheckoutFrom) DeepCopy() *CheckoutFrom {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CheckoutFromParentOverride) DeepCopyInto(out *CheckoutFromParentOverride) {
 	*out = *in
+	if in.Revision != nil {
+		in, out := &in.Revision, &out.Revision
+		*out = new(string)
+		**out = **in
+	}
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckoutFromParentOverride.
@@ -423,6 +498,16 @@ func (in *CheckoutFromParentOverride) DeepCopy() *CheckoutFromParentOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CheckoutFromPluginOverride) DeepCopyInto(out *CheckoutFromPluginOverride) {
 	*out = *in
+	if in.Revision != nil {
+		in, out := &in.Revision, &out.Revision
+		*out = new(string)
+		**out = **in
+	}
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckoutFromPluginOverride.
@@ -438,6 +523,16 @@ func (in *CheckoutFromPluginOverride) DeepCopy() *CheckoutFromPluginOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CheckoutFromPluginOverrideParentOverride) DeepCopyInto(out *CheckoutFromPluginOverrideParentOverride) {
 	*out = *in
+	if in.Revision != nil {
+		in, out := &in.Revision, &out.Revision
+		*out = new(string)
+		**out = **in
+	}
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckoutFromPluginOverrideParentOverride.
@@ -553,6 +648,26 @@ func (in *CommandGroupPluginOverrideParentOverride) DeepCopy() *CommandGroupPlug
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommandHints) DeepCopyInto(out *CommandHints) {
+	*out = *in
+	if in.Terminal != nil {
+		in, out := &in.Terminal, &out.Terminal
+		*out = new(CommandTerminalHints)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommandHints.
+func (in *CommandHints) DeepCopy() *CommandHints {
+	if in == nil {
+		return nil
+	}
+	out := new(CommandHints)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommandParentOverride) DeepCopyInto(out *CommandParentOverride) {
 	*out = *in
@@ -622,6 +737,21 @@ func (in *CommandPluginOverrideParentOverride) DeepCopy() *CommandPluginOverride
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommandTerminalHints) DeepCopyInto(out *CommandTerminalHints) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommandTerminalHints.
+func (in *CommandTerminalHints) DeepCopy() *CommandTerminalHints {
+	if in == nil {
+		return nil
+	}
+	out := new(CommandTerminalHints)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommandUnion) DeepCopyInto(out *CommandUnion) {
 	*out = *in
@@ -927,6 +1057,16 @@ func (in *ComponentUnion) DeepCopyInto(out *ComponentUnion) {
 		*out = new(ImageComponent)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(SecretComponent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapComponent)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Plugin != nil {
 		in, out := &in.Plugin, &out.Plugin
 		*out = new(PluginComponent)
@@ -977,6 +1117,16 @@ func (in *ComponentUnionParentOverride) DeepCopyInto(out *ComponentUnionParentOv
 		*out = new(ImageComponentParentOverride)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(SecretComponentParentOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapComponentParentOverride)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Plugin != nil {
 		in, out := &in.Plugin, &out.Plugin
 		*out = new(PluginComponentParentOverride)
@@ -1022,6 +1172,16 @@ func (in *ComponentUnionPluginOverride) DeepCopyInto(out *ComponentUnionPluginOv
 		*out = new(ImageComponentPluginOverride)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(SecretComponentPluginOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapComponentPluginOverride)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentUnionPluginOverride.
@@ -1062,6 +1222,16 @@ func (in *ComponentUnionPluginOverrideParentOverride) DeepCopyInto(out *Componen
 		*out = new(ImageComponentPluginOverrideParentOverride)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(SecretComponentPluginOverrideParentOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapComponentPluginOverrideParentOverride)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentUnionPluginOverrideParentOverride.
@@ -1088,6 +1258,16 @@ func (in *CompositeCommand) DeepCopyInto(out *CompositeCommand) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int)
+		**out = **in
+	}
+	if in.ContinueOnError != nil {
+		in, out := &in.ContinueOnError, &out.ContinueOnError
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeCommand.
@@ -1114,6 +1294,16 @@ func (in *CompositeCommandParentOverride) DeepCopyInto(out *CompositeCommandPare
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int)
+		**out = **in
+	}
+	if in.ContinueOnError != nil {
+		in, out := &in.ContinueOnError, &out.ContinueOnError
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeCommandParentOverride.
@@ -1140,6 +1330,16 @@ func (in *CompositeCommandPluginOverride) DeepCopyInto(out *CompositeCommandPlug
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int)
+		**out = **in
+	}
+	if in.ContinueOnError != nil {
+		in, out := &in.ContinueOnError, &out.ContinueOnError
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeCommandPluginOverride.
@@ -1166,6 +1366,16 @@ func (in *CompositeCommandPluginOverrideParentOverride) DeepCopyInto(out *Compos
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int)
+		**out = **in
+	}
+	if in.ContinueOnError != nil {
+		in, out := &in.ContinueOnError, &out.ContinueOnError
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeCommandPluginOverrideParentOverride.
@@ -1178,6 +1388,210 @@ func (in *CompositeCommandPluginOverrideParentOverride) DeepCopy() *CompositeCom
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapComponent) DeepCopyInto(out *ConfigMapComponent) {
+	*out = *in
+	out.BaseComponent = in.BaseComponent
+	in.ConfigurationMount.DeepCopyInto(&out.ConfigurationMount)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapComponent.
+func (in *ConfigMapComponent) DeepCopy() *ConfigMapComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapComponentParentOverride) DeepCopyInto(out *ConfigMapComponentParentOverride) {
+	*out = *in
+	out.BaseComponentParentOverride = in.BaseComponentParentOverride
+	if in.ConfigMapName != nil {
+		in, out := &in.ConfigMapName, &out.ConfigMapName
+		*out = new(string)
+		**out = **in
+	}
+	in.ConfigurationMountParentOverride.DeepCopyInto(&out.ConfigurationMountParentOverride)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapComponentParentOverride.
+func (in *ConfigMapComponentParentOverride) DeepCopy() *ConfigMapComponentParentOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapComponentParentOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapComponentPluginOverride) DeepCopyInto(out *ConfigMapComponentPluginOverride) {
+	*out = *in
+	out.BaseComponentPluginOverride = in.BaseComponentPluginOverride
+	if in.ConfigMapName != nil {
+		in, out := &in.ConfigMapName, &out.ConfigMapName
+		*out = new(string)
+		**out = **in
+	}
+	in.ConfigurationMountPluginOverride.DeepCopyInto(&out.ConfigurationMountPluginOverride)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapComponentPluginOverride.
+func (in *ConfigMapComponentPluginOverride) DeepCopy() *ConfigMapComponentPluginOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapComponentPluginOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapComponentPluginOverrideParentOverride) DeepCopyInto(out *ConfigMapComponentPluginOverrideParentOverride) {
+	*out = *in
+	out.BaseComponentPluginOverrideParentOverride = in.BaseComponentPluginOverrideParentOverride
+	if in.ConfigMapName != nil {
+		in, out := &in.ConfigMapName, &out.ConfigMapName
+		*out = new(string)
+		**out = **in
+	}
+	in.ConfigurationMountPluginOverrideParentOverride.DeepCopyInto(&out.ConfigurationMountPluginOverrideParentOverride)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapComponentPluginOverrideParentOverride.
+func (in *ConfigMapComponentPluginOverrideParentOverride) DeepCopy() *ConfigMapComponentPluginOverrideParentOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapComponentPluginOverrideParentOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationMount) DeepCopyInto(out *ConfigurationMount) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyToPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.Optional != nil {
+		in, out := &in.Optional, &out.Optional
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationMount.
+func (in *ConfigurationMount) DeepCopy() *ConfigurationMount {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationMount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationMountParentOverride) DeepCopyInto(out *ConfigurationMountParentOverride) {
+	*out = *in
+	if in.MountPath != nil {
+		in, out := &in.MountPath, &out.MountPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyToPathParentOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Optional != nil {
+		in, out := &in.Optional, &out.Optional
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationMountParentOverride.
+func (in *ConfigurationMountParentOverride) DeepCopy() *ConfigurationMountParentOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationMountParentOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationMountPluginOverride) DeepCopyInto(out *ConfigurationMountPluginOverride) {
+	*out = *in
+	if in.MountPath != nil {
+		in, out := &in.MountPath, &out.MountPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyToPathPluginOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Optional != nil {
+		in, out := &in.Optional, &out.Optional
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationMountPluginOverride.
+func (in *ConfigurationMountPluginOverride) DeepCopy() *ConfigurationMountPluginOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationMountPluginOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationMountPluginOverrideParentOverride) DeepCopyInto(out *ConfigurationMountPluginOverrideParentOverride) {
+	*out = *in
+	if in.MountPath != nil {
+		in, out := &in.MountPath, &out.MountPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyToPathPluginOverrideParentOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Optional != nil {
+		in, out := &in.Optional, &out.Optional
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationMountPluginOverrideParentOverride.
+func (in *ConfigurationMountPluginOverrideParentOverride) DeepCopy() *ConfigurationMountPluginOverrideParentOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationMountPluginOverrideParentOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Container) DeepCopyInto(out *Container) {
 	*out = *in
@@ -1329,13 +1743,45 @@ func (in *ContainerComponentPluginOverrideParentOverride) DeepCopy() *ContainerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerOverrides) DeepCopyInto(out *ContainerOverrides) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerOverrides.
+func (in *ContainerOverrides) DeepCopy() *ContainerOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerParentOverride) DeepCopyInto(out *ContainerParentOverride) {
 	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]EnvVarParentOverride, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Annotation != nil {
 		in, out := &in.Annotation, &out.Annotation
@@ -1345,7 +1791,29 @@ func (in *ContainerParentOverride) DeepCopyInto(out *ContainerParentOverride) {
 	if in.VolumeMounts != nil {
 		in, out := &in.VolumeMounts, &out.VolumeMounts
 		*out = make([]VolumeMountParentOverride, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MemoryLimit != nil {
+		in, out := &in.MemoryLimit, &out.MemoryLimit
+		*out = new(string)
+		**out = **in
+	}
+	if in.MemoryRequest != nil {
+		in, out := &in.MemoryRequest, &out.MemoryRequest
+		*out = new(string)
+		**out = **in
+	}
+	if in.CpuLimit != nil {
+		in, out := &in.CpuLimit, &out.CpuLimit
+		*out = new(string)
+		**out = **in
+	}
+	if in.CpuRequest != nil {
+		in, out := &in.CpuRequest, &out.CpuRequest
+		*out = new(string)
+		**out = **in
 	}
 	if in.Command != nil {
 		in, out := &in.Command, &out.Command
@@ -1362,6 +1830,11 @@ func (in *ContainerParentOverride) DeepCopyInto(out *ContainerParentOverride) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SourceMapping != nil {
+		in, out := &in.SourceMapping, &out.SourceMapping
+		*out = new(string)
+		**out = **in
+	}
 	if in.DedicatedPod != nil {
 		in, out := &in.DedicatedPod, &out.DedicatedPod
 		*out = new(bool)
@@ -1387,10 +1860,17 @@ func (in *ContainerParentOverride) DeepCopy() *ContainerParentOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerPluginOverride) DeepCopyInto(out *ContainerPluginOverride) {
 	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]EnvVarPluginOverride, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Annotation != nil {
 		in, out := &in.Annotation, &out.Annotation
@@ -1400,7 +1880,29 @@ func (in *ContainerPluginOverride) DeepCopyInto(out *ContainerPluginOverride) {
 	if in.VolumeMounts != nil {
 		in, out := &in.VolumeMounts, &out.VolumeMounts
 		*out = make([]VolumeMountPluginOverride, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MemoryLimit != nil {
+		in, out := &in.MemoryLimit, &out.MemoryLimit
+		*out = new(string)
+		**out = **in
+	}
+	if in.MemoryRequest != nil {
+		in, out := &in.MemoryRequest, &out.MemoryRequest
+		*out = new(string)
+		**out = **in
+	}
+	if in.CpuLimit != nil {
+		in, out := &in.CpuLimit, &out.CpuLimit
+		*out = new(string)
+		**out = **in
+	}
+	if in.CpuRequest != nil {
+		in, out := &in.CpuRequest, &out.CpuRequest
+		*out = new(string)
+		**out = **in
 	}
 	if in.Command != nil {
 		in, out := &in.Command, &out.Command
@@ -1417,6 +1919,11 @@ func (in *ContainerPluginOverride) DeepCopyInto(out *ContainerPluginOverride) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SourceMapping != nil {
+		in, out := &in.SourceMapping, &out.SourceMapping
+		*out = new(string)
+		**out = **in
+	}
 	if in.DedicatedPod != nil {
 		in, out := &in.DedicatedPod, &out.DedicatedPod
 		*out = new(bool)
@@ -1442,10 +1949,17 @@ func (in *ContainerPluginOverride) DeepCopy() *ContainerPluginOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerPluginOverrideParentOverride) DeepCopyInto(out *ContainerPluginOverrideParentOverride) {
 	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]EnvVarPluginOverrideParentOverride, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Annotation != nil {
 		in, out := &in.Annotation, &out.Annotation
@@ -1455,7 +1969,29 @@ func (in *ContainerPluginOverrideParentOverride) DeepCopyInto(out *ContainerPlug
 	if in.VolumeMounts != nil {
 		in, out := &in.VolumeMounts, &out.VolumeMounts
 		*out = make([]VolumeMountPluginOverrideParentOverride, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MemoryLimit != nil {
+		in, out := &in.MemoryLimit, &out.MemoryLimit
+		*out = new(string)
+		**out = **in
+	}
+	if in.MemoryRequest != nil {
+		in, out := &in.MemoryRequest, &out.MemoryRequest
+		*out = new(string)
+		**out = **in
+	}
+	if in.CpuLimit != nil {
+		in, out := &in.CpuLimit, &out.CpuLimit
+		*out = new(string)
+		**out = **in
+	}
+	if in.CpuRequest != nil {
+		in, out := &in.CpuRequest, &out.CpuRequest
+		*out = new(string)
+		**out = **in
 	}
 	if in.Command != nil {
 		in, out := &in.Command, &out.Command
@@ -1472,6 +2008,11 @@ func (in *ContainerPluginOverrideParentOverride) DeepCopyInto(out *ContainerPlug
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SourceMapping != nil {
+		in, out := &in.SourceMapping, &out.SourceMapping
+		*out = new(string)
+		**out = **in
+	}
 	if in.DedicatedPod != nil {
 		in, out := &in.DedicatedPod, &out.DedicatedPod
 		*out = new(bool)
@@ -1787,6 +2328,16 @@ func (in *DevWorkspaceTemplateSpecContent) DeepCopyInto(out *DevWorkspaceTemplat
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]EnvVar, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageStrategy != nil {
+		in, out := &in.StorageStrategy, &out.StorageStrategy
+		*out = new(StorageStrategy)
+		**out = **in
+	}
 	if in.Components != nil {
 		in, out := &in.Components, &out.Components
 		*out = make([]Component, len(*in))
@@ -1875,6 +2426,16 @@ func (in *DockerfileDevfileRegistrySource) DeepCopy() *DockerfileDevfileRegistry
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerfileDevfileRegistrySourceParentOverride) DeepCopyInto(out *DockerfileDevfileRegistrySourceParentOverride) {
 	*out = *in
+	if in.Id != nil {
+		in, out := &in.Id, &out.Id
+		*out = new(string)
+		**out = **in
+	}
+	if in.RegistryUrl != nil {
+		in, out := &in.RegistryUrl, &out.RegistryUrl
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerfileDevfileRegistrySourceParentOverride.
@@ -1890,6 +2451,16 @@ func (in *DockerfileDevfileRegistrySourceParentOverride) DeepCopy() *DockerfileD
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerfileDevfileRegistrySourcePluginOverride) DeepCopyInto(out *DockerfileDevfileRegistrySourcePluginOverride) {
 	*out = *in
+	if in.Id != nil {
+		in, out := &in.Id, &out.Id
+		*out = new(string)
+		**out = **in
+	}
+	if in.RegistryUrl != nil {
+		in, out := &in.RegistryUrl, &out.RegistryUrl
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerfileDevfileRegistrySourcePluginOverride.
@@ -1905,6 +2476,16 @@ func (in *DockerfileDevfileRegistrySourcePluginOverride) DeepCopy() *DockerfileD
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerfileDevfileRegistrySourcePluginOverrideParentOverride) DeepCopyInto(out *DockerfileDevfileRegistrySourcePluginOverrideParentOverride) {
 	*out = *in
+	if in.Id != nil {
+		in, out := &in.Id, &out.Id
+		*out = new(string)
+		**out = **in
+	}
+	if in.RegistryUrl != nil {
+		in, out := &in.RegistryUrl, &out.RegistryUrl
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerfileDevfileRegistrySourcePluginOverrideParentOverride.
@@ -1937,6 +2518,11 @@ func (in *DockerfileGitProjectSource) DeepCopy() *DockerfileGitProjectSource {
 func (in *DockerfileGitProjectSourceParentOverride) DeepCopyInto(out *DockerfileGitProjectSourceParentOverride) {
 	*out = *in
 	in.GitProjectSourceParentOverride.DeepCopyInto(&out.GitProjectSourceParentOverride)
+	if in.FileLocation != nil {
+		in, out := &in.FileLocation, &out.FileLocation
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerfileGitProjectSourceParentOverride.
@@ -1953,6 +2539,11 @@ func (in *DockerfileGitProjectSourceParentOverride) DeepCopy() *DockerfileGitPro
 func (in *DockerfileGitProjectSourcePluginOverride) DeepCopyInto(out *DockerfileGitProjectSourcePluginOverride) {
 	*out = *in
 	in.GitProjectSourcePluginOverride.DeepCopyInto(&out.GitProjectSourcePluginOverride)
+	if in.FileLocation != nil {
+		in, out := &in.FileLocation, &out.FileLocation
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerfileGitProjectSourcePluginOverride.
@@ -1969,6 +2560,11 @@ func (in *DockerfileGitProjectSourcePluginOverride) DeepCopy() *DockerfileGitPro
 func (in *DockerfileGitProjectSourcePluginOverrideParentOverride) DeepCopyInto(out *DockerfileGitProjectSourcePluginOverrideParentOverride) {
 	*out = *in
 	in.GitProjectSourcePluginOverrideParentOverride.DeepCopyInto(&out.GitProjectSourcePluginOverrideParentOverride)
+	if in.FileLocation != nil {
+		in, out := &in.FileLocation, &out.FileLocation
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerfileGitProjectSourcePluginOverrideParentOverride.
@@ -2056,6 +2652,11 @@ func (in *DockerfileImagePluginOverrideParentOverride) DeepCopy() *DockerfileIma
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerfileParentOverride) DeepCopyInto(out *DockerfileParentOverride) {
 	*out = *in
+	if in.BuildContext != nil {
+		in, out := &in.BuildContext, &out.BuildContext
+		*out = new(string)
+		**out = **in
+	}
 	if in.Args != nil {
 		in, out := &in.Args, &out.Args
 		*out = make([]string, len(*in))
@@ -2081,6 +2682,11 @@ func (in *DockerfileParentOverride) DeepCopy() *DockerfileParentOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerfilePluginOverride) DeepCopyInto(out *DockerfilePluginOverride) {
 	*out = *in
+	if in.BuildContext != nil {
+		in, out := &in.BuildContext, &out.BuildContext
+		*out = new(string)
+		**out = **in
+	}
 	if in.Args != nil {
 		in, out := &in.Args, &out.Args
 		*out = make([]string, len(*in))
@@ -2106,6 +2712,11 @@ func (in *DockerfilePluginOverride) DeepCopy() *DockerfilePluginOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerfilePluginOverrideParentOverride) DeepCopyInto(out *DockerfilePluginOverrideParentOverride) {
 	*out = *in
+	if in.BuildContext != nil {
+		in, out := &in.BuildContext, &out.BuildContext
+		*out = new(string)
+		**out = **in
+	}
 	if in.Args != nil {
 		in, out := &in.Args, &out.Args
 		*out = make([]string, len(*in))
@@ -2159,7 +2770,7 @@ func (in *DockerfileSrcParentOverride) DeepCopyInto(out *DockerfileSrcParentOver
 	if in.DevfileRegistry != nil {
 		in, out := &in.DevfileRegistry, &out.DevfileRegistry
 		*out = new(DockerfileDevfileRegistrySourceParentOverride)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Git != nil {
 		in, out := &in.Git, &out.Git
@@ -2184,7 +2795,7 @@ func (in *DockerfileSrcPluginOverride) DeepCopyInto(out *DockerfileSrcPluginOver
 	if in.DevfileRegistry != nil {
 		in, out := &in.DevfileRegistry, &out.DevfileRegistry
 		*out = new(DockerfileDevfileRegistrySourcePluginOverride)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Git != nil {
 		in, out := &in.Git, &out.Git
@@ -2209,7 +2820,7 @@ func (in *DockerfileSrcPluginOverrideParentOverride) DeepCopyInto(out *Dockerfil
 	if in.DevfileRegistry != nil {
 		in, out := &in.DevfileRegistry, &out.DevfileRegistry
 		*out = new(DockerfileDevfileRegistrySourcePluginOverrideParentOverride)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Git != nil {
 		in, out := &in.Git, &out.Git
@@ -2265,11 +2876,21 @@ func (in *Endpoint) DeepCopy() *Endpoint {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointParentOverride) DeepCopyInto(out *EndpointParentOverride) {
 	*out = *in
+	if in.TargetPort != nil {
+		in, out := &in.TargetPort, &out.TargetPort
+		*out = new(int)
+		**out = **in
+	}
 	if in.Secure != nil {
 		in, out := &in.Secure, &out.Secure
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
 	if in.Attributes != nil {
 		in, out := &in.Attributes, &out.Attributes
 		*out = make(attributes.Attributes, len(*in))
@@ -2299,11 +2920,21 @@ func (in *EndpointParentOverride) DeepCopy() *EndpointParentOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointPluginOverride) DeepCopyInto(out *EndpointPluginOverride) {
 	*out = *in
+	if in.TargetPort != nil {
+		in, out := &in.TargetPort, &out.TargetPort
+		*out = new(int)
+		**out = **in
+	}
 	if in.Secure != nil {
 		in, out := &in.Secure, &out.Secure
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
 	if in.Attributes != nil {
 		in, out := &in.Attributes, &out.Attributes
 		*out = make(attributes.Attributes, len(*in))
@@ -2333,11 +2964,21 @@ func (in *EndpointPluginOverride) DeepCopy() *EndpointPluginOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointPluginOverrideParentOverride) DeepCopyInto(out *EndpointPluginOverrideParentOverride) {
 	*out = *in
+	if in.TargetPort != nil {
+		in, out := &in.TargetPort, &out.TargetPort
+		*out = new(int)
+		**out = **in
+	}
 	if in.Secure != nil {
 		in, out := &in.Secure, &out.Secure
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
 	if in.Attributes != nil {
 		in, out := &in.Attributes, &out.Attributes
 		*out = make(attributes.Attributes, len(*in))
@@ -2364,6 +3005,21 @@ func (in *EndpointPluginOverrideParentOverride) DeepCopy() *EndpointPluginOverri
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EntrypointOptions) DeepCopyInto(out *EntrypointOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntrypointOptions.
+func (in *EntrypointOptions) DeepCopy() *EntrypointOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EntrypointOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvVar) DeepCopyInto(out *EnvVar) {
 	*out = *in
@@ -2382,6 +3038,11 @@ func (in *EnvVar) DeepCopy() *EnvVar {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvVarParentOverride) DeepCopyInto(out *EnvVarParentOverride) {
 	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVarParentOverride.
@@ -2397,6 +3058,11 @@ func (in *EnvVarParentOverride) DeepCopy() *EnvVarParentOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvVarPluginOverride) DeepCopyInto(out *EnvVarPluginOverride) {
 	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVarPluginOverride.
@@ -2412,6 +3078,11 @@ func (in *EnvVarPluginOverride) DeepCopy() *EnvVarPluginOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvVarPluginOverrideParentOverride) DeepCopyInto(out *EnvVarPluginOverrideParentOverride) {
 	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVarPluginOverrideParentOverride.
@@ -2440,6 +3111,21 @@ func (in *Events) DeepCopy() *Events {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventsMerge) DeepCopyInto(out *EventsMerge) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventsMerge.
+func (in *EventsMerge) DeepCopy() *EventsMerge {
+	if in == nil {
+		return nil
+	}
+	out := new(EventsMerge)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecCommand) DeepCopyInto(out *ExecCommand) {
 	*out = *in
@@ -2470,10 +3156,27 @@ func (in *ExecCommand) DeepCopy() *ExecCommand {
 func (in *ExecCommandParentOverride) DeepCopyInto(out *ExecCommandParentOverride) {
 	*out = *in
 	in.LabeledCommandParentOverride.DeepCopyInto(&out.LabeledCommandParentOverride)
+	if in.CommandLine != nil {
+		in, out := &in.CommandLine, &out.CommandLine
+		*out = new(string)
+		**out = **in
+	}
+	if in.Component != nil {
+		in, out := &in.Component, &out.Component
+		*out = new(string)
+		**out = **in
+	}
+	if in.WorkingDir != nil {
+		in, out := &in.WorkingDir, &out.WorkingDir
+		*out = new(string)
+		**out = **in
+	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]EnvVarParentOverride, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.HotReloadCapable != nil {
 		in, out := &in.HotReloadCapable, &out.HotReloadCapable
@@ -2496,10 +3199,27 @@ func (in *ExecCommandParentOverride) DeepCopy() *ExecCommandParentOverride {
 func (in *ExecCommandPluginOverride) DeepCopyInto(out *ExecCommandPluginOverride) {
 	*out = *in
 	in.LabeledCommandPluginOverride.DeepCopyInto(&out.LabeledCommandPluginOverride)
+	if in.CommandLine != nil {
+		in, out := &in.CommandLine, &out.CommandLine
+		*out = new(string)
+		**out = **in
+	}
+	if in.Component != nil {
+		in, out := &in.Component, &out.Component
+		*out = new(string)
+		**out = **in
+	}
+	if in.WorkingDir != nil {
+		in, out := &in.WorkingDir, &out.WorkingDir
+		*out = new(string)
+		**out = **in
+	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]EnvVarPluginOverride, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.HotReloadCapable != nil {
 		in, out := &in.HotReloadCapable, &out.HotReloadCapable
@@ -2522,10 +3242,27 @@ func (in *ExecCommandPluginOverride) DeepCopy() *ExecCommandPluginOverride {
 func (in *ExecCommandPluginOverrideParentOverride) DeepCopyInto(out *ExecCommandPluginOverrideParentOverride) {
 	*out = *in
 	in.LabeledCommandPluginOverrideParentOverride.DeepCopyInto(&out.LabeledCommandPluginOverrideParentOverride)
+	if in.CommandLine != nil {
+		in, out := &in.CommandLine, &out.CommandLine
+		*out = new(string)
+		**out = **in
+	}
+	if in.Component != nil {
+		in, out := &in.Component, &out.Component
+		*out = new(string)
+		**out = **in
+	}
+	if in.WorkingDir != nil {
+		in, out := &in.WorkingDir, &out.WorkingDir
+		*out = new(string)
+		**out = **in
+	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]EnvVarPluginOverrideParentOverride, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.HotReloadCapable != nil {
 		in, out := &in.HotReloadCapable, &out.HotReloadCapable
@@ -2579,7 +3316,7 @@ func (in *GitLikeProjectSourceParentOverride) DeepCopyInto(out *GitLikeProjectSo
 	if in.CheckoutFrom != nil {
 		in, out := &in.CheckoutFrom, &out.CheckoutFrom
 		*out = new(CheckoutFromParentOverride)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Remotes != nil {
 		in, out := &in.Remotes, &out.Remotes
@@ -2607,7 +3344,7 @@ func (in *GitLikeProjectSourcePluginOverride) DeepCopyInto(out *GitLikeProjectSo
 	if in.CheckoutFrom != nil {
 		in, out := &in.CheckoutFrom, &out.CheckoutFrom
 		*out = new(CheckoutFromPluginOverride)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Remotes != nil {
 		in, out := &in.Remotes, &out.Remotes
@@ -2635,7 +3372,7 @@ func (in *GitLikeProjectSourcePluginOverrideParentOverride) DeepCopyInto(out *Gi
 	if in.CheckoutFrom != nil {
 		in, out := &in.CheckoutFrom, &out.CheckoutFrom
 		*out = new(CheckoutFromPluginOverrideParentOverride)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Remotes != nil {
 		in, out := &in.Remotes, &out.Remotes
@@ -2807,6 +3544,11 @@ func (in *ImageComponentPluginOverrideParentOverride) DeepCopy() *ImageComponent
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageParentOverride) DeepCopyInto(out *ImageParentOverride) {
 	*out = *in
+	if in.ImageName != nil {
+		in, out := &in.ImageName, &out.ImageName
+		*out = new(string)
+		**out = **in
+	}
 	in.ImageUnionParentOverride.DeepCopyInto(&out.ImageUnionParentOverride)
 }
 
@@ -2823,6 +3565,11 @@ func (in *ImageParentOverride) DeepCopy() *ImageParentOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImagePluginOverride) DeepCopyInto(out *ImagePluginOverride) {
 	*out = *in
+	if in.ImageName != nil {
+		in, out := &in.ImageName, &out.ImageName
+		*out = new(string)
+		**out = **in
+	}
 	in.ImageUnionPluginOverride.DeepCopyInto(&out.ImageUnionPluginOverride)
 }
 
@@ -2839,6 +3586,11 @@ func (in *ImagePluginOverride) DeepCopy() *ImagePluginOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImagePluginOverrideParentOverride) DeepCopyInto(out *ImagePluginOverrideParentOverride) {
 	*out = *in
+	if in.ImageName != nil {
+		in, out := &in.ImageName, &out.ImageName
+		*out = new(string)
+		**out = **in
+	}
 	in.ImageUnionPluginOverrideParentOverride.DeepCopyInto(&out.ImageUnionPluginOverrideParentOverride)
 }
 
@@ -2972,6 +3724,16 @@ func (in *ImportReference) DeepCopy() *ImportReference {
 func (in *ImportReferenceParentOverride) DeepCopyInto(out *ImportReferenceParentOverride) {
 	*out = *in
 	in.ImportReferenceUnionParentOverride.DeepCopyInto(&out.ImportReferenceUnionParentOverride)
+	if in.RegistryUrl != nil {
+		in, out := &in.RegistryUrl, &out.RegistryUrl
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportReferenceParentOverride.
@@ -3010,7 +3772,7 @@ func (in *ImportReferenceUnionParentOverride) DeepCopyInto(out *ImportReferenceU
 	if in.Kubernetes != nil {
 		in, out := &in.Kubernetes, &out.Kubernetes
 		*out = new(KubernetesCustomResourceImportReferenceParentOverride)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -3200,6 +3962,81 @@ func (in *K8sLikeComponentPluginOverrideParentOverride) DeepCopy() *K8sLikeCompo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyToPath) DeepCopyInto(out *KeyToPath) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyToPath.
+func (in *KeyToPath) DeepCopy() *KeyToPath {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyToPath)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyToPathParentOverride) DeepCopyInto(out *KeyToPathParentOverride) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyToPathParentOverride.
+func (in *KeyToPathParentOverride) DeepCopy() *KeyToPathParentOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyToPathParentOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyToPathPluginOverride) DeepCopyInto(out *KeyToPathPluginOverride) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyToPathPluginOverride.
+func (in *KeyToPathPluginOverride) DeepCopy() *KeyToPathPluginOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyToPathPluginOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyToPathPluginOverrideParentOverride) DeepCopyInto(out *KeyToPathPluginOverrideParentOverride) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyToPathPluginOverrideParentOverride.
+func (in *KeyToPathPluginOverrideParentOverride) DeepCopy() *KeyToPathPluginOverrideParentOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyToPathPluginOverrideParentOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesComponent) DeepCopyInto(out *KubernetesComponent) {
 	*out = *in
@@ -3282,6 +4119,16 @@ func (in *KubernetesCustomResourceImportReference) DeepCopy() *KubernetesCustomR
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesCustomResourceImportReferenceParentOverride) DeepCopyInto(out *KubernetesCustomResourceImportReferenceParentOverride) {
 	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesCustomResourceImportReferenceParentOverride.
@@ -3314,6 +4161,11 @@ func (in *LabeledCommand) DeepCopy() *LabeledCommand {
 func (in *LabeledCommandParentOverride) DeepCopyInto(out *LabeledCommandParentOverride) {
 	*out = *in
 	in.BaseCommandParentOverride.DeepCopyInto(&out.BaseCommandParentOverride)
+	if in.Label != nil {
+		in, out := &in.Label, &out.Label
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabeledCommandParentOverride.
@@ -3330,6 +4182,11 @@ func (in *LabeledCommandParentOverride) DeepCopy() *LabeledCommandParentOverride
 func (in *LabeledCommandPluginOverride) DeepCopyInto(out *LabeledCommandPluginOverride) {
 	*out = *in
 	in.BaseCommandPluginOverride.DeepCopyInto(&out.BaseCommandPluginOverride)
+	if in.Label != nil {
+		in, out := &in.Label, &out.Label
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabeledCommandPluginOverride.
@@ -3346,6 +4203,11 @@ func (in *LabeledCommandPluginOverride) DeepCopy() *LabeledCommandPluginOverride
 func (in *LabeledCommandPluginOverrideParentOverride) DeepCopyInto(out *LabeledCommandPluginOverrideParentOverride) {
 	*out = *in
 	in.BaseCommandPluginOverrideParentOverride.DeepCopyInto(&out.BaseCommandPluginOverrideParentOverride)
+	if in.Label != nil {
+		in, out := &in.Label, &out.Label
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabeledCommandPluginOverrideParentOverride.
@@ -3512,6 +4374,18 @@ func (in *ParentOverrides) DeepCopyInto(out *ParentOverrides) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]EnvVarParentOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StorageStrategy != nil {
+		in, out := &in.StorageStrategy, &out.StorageStrategy
+		*out = new(StorageStrategyParentOverride)
+		**out = **in
+	}
 	if in.Components != nil {
 		in, out := &in.Components, &out.Components
 		*out = make([]ComponentParentOverride, len(*in))
@@ -3648,6 +4522,109 @@ func (in *PluginOverridesParentOverride) DeepCopy() *PluginOverridesParentOverri
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodOverrides) DeepCopyInto(out *PodOverrides) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PodOverridesMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(PodSpecOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodOverrides.
+func (in *PodOverrides) DeepCopy() *PodOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(PodOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodOverridesMetadata) DeepCopyInto(out *PodOverridesMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodOverridesMetadata.
+func (in *PodOverridesMetadata) DeepCopy() *PodOverridesMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(PodOverridesMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSpecOverrides) DeepCopyInto(out *PodSpecOverrides) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSpecOverrides.
+func (in *PodSpecOverrides) DeepCopy() *PodSpecOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSpecOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -3681,6 +4658,11 @@ func (in *ProjectParentOverride) DeepCopyInto(out *ProjectParentOverride) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.ClonePath != nil {
+		in, out := &in.ClonePath, &out.ClonePath
+		*out = new(string)
+		**out = **in
+	}
 	in.ProjectSourceParentOverride.DeepCopyInto(&out.ProjectSourceParentOverride)
 }
 
@@ -3707,6 +4689,11 @@ func (in *ProjectSource) DeepCopyInto(out *ProjectSource) {
 		*out = new(ZipProjectSource)
 		**out = **in
 	}
+	if in.Archive != nil {
+		in, out := &in.Archive, &out.Archive
+		*out = new(ArchiveProjectSource)
+		**out = **in
+	}
 	if in.Custom != nil {
 		in, out := &in.Custom, &out.Custom
 		*out = new(CustomProjectSource)
@@ -3735,7 +4722,12 @@ func (in *ProjectSourceParentOverride) DeepCopyInto(out *ProjectSourceParentOver
 	if in.Zip != nil {
 		in, out := &in.Zip, &out.Zip
 		*out = new(ZipProjectSourceParentOverride)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Archive != nil {
+		in, out := &in.Archive, &out.Archive
+		*out = new(ArchiveProjectSourceParentOverride)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -3749,6 +4741,89 @@ func (in *ProjectSourceParentOverride) DeepCopy() *ProjectSourceParentOverride {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretComponent) DeepCopyInto(out *SecretComponent) {
+	*out = *in
+	out.BaseComponent = in.BaseComponent
+	in.ConfigurationMount.DeepCopyInto(&out.ConfigurationMount)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretComponent.
+func (in *SecretComponent) DeepCopy() *SecretComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretComponentParentOverride) DeepCopyInto(out *SecretComponentParentOverride) {
+	*out = *in
+	out.BaseComponentParentOverride = in.BaseComponentParentOverride
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+	in.ConfigurationMountParentOverride.DeepCopyInto(&out.ConfigurationMountParentOverride)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretComponentParentOverride.
+func (in *SecretComponentParentOverride) DeepCopy() *SecretComponentParentOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretComponentParentOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretComponentPluginOverride) DeepCopyInto(out *SecretComponentPluginOverride) {
+	*out = *in
+	out.BaseComponentPluginOverride = in.BaseComponentPluginOverride
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+	in.ConfigurationMountPluginOverride.DeepCopyInto(&out.ConfigurationMountPluginOverride)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretComponentPluginOverride.
+func (in *SecretComponentPluginOverride) DeepCopy() *SecretComponentPluginOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretComponentPluginOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretComponentPluginOverrideParentOverride) DeepCopyInto(out *SecretComponentPluginOverrideParentOverride) {
+	*out = *in
+	out.BaseComponentPluginOverrideParentOverride = in.BaseComponentPluginOverrideParentOverride
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+	in.ConfigurationMountPluginOverrideParentOverride.DeepCopyInto(&out.ConfigurationMountPluginOverrideParentOverride)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretComponentPluginOverrideParentOverride.
+func (in *SecretComponentPluginOverrideParentOverride) DeepCopy() *SecretComponentPluginOverrideParentOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretComponentPluginOverrideParentOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StarterProject) DeepCopyInto(out *StarterProject) {
 	*out = *in
@@ -3782,6 +4857,16 @@ func (in *StarterProjectParentOverride) DeepCopyInto(out *StarterProjectParentOv
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubDir != nil {
+		in, out := &in.SubDir, &out.SubDir
+		*out = new(string)
+		**out = **in
+	}
 	in.ProjectSourceParentOverride.DeepCopyInto(&out.ProjectSourceParentOverride)
 }
 
@@ -3901,6 +4986,11 @@ func (in *VolumeMount) DeepCopy() *VolumeMount {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMountParentOverride) DeepCopyInto(out *VolumeMountParentOverride) {
 	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMountParentOverride.
@@ -3916,6 +5006,11 @@ func (in *VolumeMountParentOverride) DeepCopy() *VolumeMountParentOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMountPluginOverride) DeepCopyInto(out *VolumeMountPluginOverride) {
 	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMountPluginOverride.
@@ -3931,6 +5026,11 @@ func (in *VolumeMountPluginOverride) DeepCopy() *VolumeMountPluginOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMountPluginOverrideParentOverride) DeepCopyInto(out *VolumeMountPluginOverrideParentOverride) {
 	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMountPluginOverrideParentOverride.
@@ -3946,6 +5046,11 @@ func (in *VolumeMountPluginOverrideParentOverride) DeepCopy() *VolumeMountPlugin
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeParentOverride) DeepCopyInto(out *VolumeParentOverride) {
 	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(string)
+		**out = **in
+	}
 	if in.Ephemeral != nil {
 		in, out := &in.Ephemeral, &out.Ephemeral
 		*out = new(bool)
@@ -3966,6 +5071,11 @@ func (in *VolumeParentOverride) DeepCopy() *VolumeParentOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumePluginOverride) DeepCopyInto(out *VolumePluginOverride) {
 	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(string)
+		**out = **in
+	}
 	if in.Ephemeral != nil {
 		in, out := &in.Ephemeral, &out.Ephemeral
 		*out = new(bool)
@@ -3986,6 +5096,11 @@ func (in *VolumePluginOverride) DeepCopy() *VolumePluginOverride {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumePluginOverrideParentOverride) DeepCopyInto(out *VolumePluginOverrideParentOverride) {
 	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(string)
+		**out = **in
+	}
 	if in.Ephemeral != nil {
 		in, out := &in.Ephemeral, &out.Ephemeral
 		*out = new(bool)
@@ -4023,6 +5138,16 @@ func (in *ZipProjectSource) DeepCopy() *ZipProjectSource {
 func (in *ZipProjectSourceParentOverride) DeepCopyInto(out *ZipProjectSourceParentOverride) {
 	*out = *in
 	out.CommonProjectSourceParentOverride = in.CommonProjectSourceParentOverride
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.Sha256 != nil {
+		in, out := &in.Sha256, &out.Sha256
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZipProjectSourceParentOverride.
@@ -0,0 +1,88 @@
+// Package versions exposes the devfile schema versions supported by the API, and the features introduced in each of them.
+//
+// Its data is generated by the versions generator from the same source of truth as the Json schemas:
+// the devfile:jsonschema:version marker of the K8S API, and the devfile:since markers of its fields.
+package versions
+
+import (
+	"fmt"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// Supported returns the supported devfile schema versions, from the oldest to the latest:
+// Min, the versions that introduced features, and LatestRelease.
+// The patch versions and the pre-releases of these versions are supported too.
+func Supported() []string {
+	return append([]string(nil), supported...)
+}
+
+// IsSupported returns true if the given schema version is a semver-compatible version
+// between Min and the patch versions of LatestRelease, including its pre-releases
+func IsSupported(schemaVersion string) bool {
+	_, err := parse(schemaVersion)
+	return err == nil
+}
+
+// FeaturesSince returns the features introduced in the given supported schema version,
+// named `<GO type>.<Json name>`, such as `Container.cpuLimit`
+func FeaturesSince(schemaVersion string) []string {
+	return append([]string(nil), featuresSince[schemaVersion]...)
+}
+
+// Features returns the features that can be used in a devfile with the given schema version.
+// The returned error wraps ErrUnsupportedVersion if the schema version is not supported.
+func Features(schemaVersion string) ([]string, error) {
+	parsed, err := parse(schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	var features []string
+	for _, since := range supported {
+		if introducedBy(since, parsed) {
+			features = append(features, featuresSince[since]...)
+		}
+	}
+	return features, nil
+}
+
+// Supports returns true if the given feature can be used in a devfile with the given schema version.
+// The features that are not version-gated can be used with all the supported schema versions.
+// The returned error wraps ErrUnsupportedVersion if the schema version is not supported.
+func Supports(schemaVersion, feature string) (bool, error) {
+	parsed, err := parse(schemaVersion)
+	if err != nil {
+		return false, err
+	}
+	for _, since := range supported {
+		for _, gated := range featuresSince[since] {
+			if gated == feature {
+				return introducedBy(since, parsed), nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// introducedBy returns true if the features introduced in the since version can be used with the given schema version.
+// The pre-releases of a schema version already support its features.
+func introducedBy(since string, schemaVersion *version.Version) bool {
+	return schemaVersion.WithPreRelease("").AtLeast(version.MustParseSemantic(since))
+}
+
+// parse parses the schema version, and checks that it is supported
+func parse(schemaVersion string) (*version.Version, error) {
+	parsed, err := version.ParseSemantic(schemaVersion)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q is not a semver-compatible version", devfileerrors.ErrUnsupportedVersion, schemaVersion)
+	}
+	latest := version.MustParseSemantic(LatestRelease)
+	// the lowest version above the patch versions of the latest version, including its pre-releases
+	upperBound := latest.WithMinor(latest.Minor() + 1).WithPatch(0).WithPreRelease("0")
+	if parsed.LessThan(version.MustParseSemantic(Min)) || !parsed.LessThan(upperBound) {
+		return nil, fmt.Errorf("%w: the supported schema versions are %s to %d.%d.x, not %s",
+			devfileerrors.ErrUnsupportedVersion, Min, latest.Major(), latest.Minor(), schemaVersion)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,99 @@
+package versions
+
+import (
+	"errors"
+	"testing"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupported(t *testing.T) {
+	supported := Supported()
+	if assert.NotEmpty(t, supported) {
+		assert.Equal(t, Min, supported[0])
+		assert.Equal(t, LatestRelease, supported[len(supported)-1])
+	}
+	for _, schemaVersion := range supported {
+		assert.True(t, IsSupported(schemaVersion), schemaVersion)
+	}
+	assert.True(t, IsSupported(Latest))
+}
+
+func TestSupports(t *testing.T) {
+	tests := []struct {
+		name          string
+		schemaVersion string
+		feature       string
+		expected      bool
+		expectedError error
+	}{
+		{
+			name:          "Feature introduced before the version",
+			schemaVersion: "2.2.0",
+			feature:       "DevWorkspaceTemplateSpecContent.variables",
+			expected:      true,
+		},
+		{
+			name:          "Feature introduced after the version",
+			schemaVersion: "2.1.0",
+			feature:       "Container.cpuLimit",
+			expected:      false,
+		},
+		{
+			name:          "Feature introduced by the pre-release of the version",
+			schemaVersion: "2.2.0-alpha",
+			feature:       "Container.cpuLimit",
+			expected:      true,
+		},
+		{
+			name:          "Feature not gated",
+			schemaVersion: Min,
+			feature:       "Container.image",
+			expected:      true,
+		},
+		{
+			name:          "Version older than the supported versions",
+			schemaVersion: "1.0.0",
+			feature:       "Container.cpuLimit",
+			expectedError: devfileerrors.ErrUnsupportedVersion,
+		},
+		{
+			name:          "Version more recent than the supported versions",
+			schemaVersion: "3.0.0",
+			feature:       "Container.cpuLimit",
+			expectedError: devfileerrors.ErrUnsupportedVersion,
+		},
+		{
+			name:          "Invalid version",
+			schemaVersion: "latest",
+			feature:       "Container.cpuLimit",
+			expectedError: devfileerrors.ErrUnsupportedVersion,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			supports, err := Supports(tt.schemaVersion, tt.feature)
+			if tt.expectedError != nil {
+				assert.True(t, errors.Is(err, tt.expectedError), "unexpected error: %v", err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expected, supports)
+			}
+		})
+	}
+}
+
+func TestFeatures(t *testing.T) {
+	features, err := Features(Min)
+	assert.NoError(t, err)
+	assert.Empty(t, features)
+
+	features, err = Features(LatestRelease)
+	if assert.NoError(t, err) {
+		for _, schemaVersion := range Supported() {
+			assert.Subset(t, features, FeaturesSince(schemaVersion))
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package attributes
 
 import (
+	"bytes"
 	"encoding/json"
 	"strconv"
 
@@ -14,9 +15,40 @@ import (
 type Attributes map[string]apiext.JSON
 
 // MarshalJSON implements custom JSON marshaling
-// to support free-form attributes
+// to support free-form attributes.
+//
+// The keys of the nested objects of attribute values are sorted, like the attribute keys,
+// so that the same attributes always produce the same JSON (and YAML) content,
+// whatever the order of the keys in the content they were read from.
 func (attributes Attributes) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]apiext.JSON(attributes))
+	if attributes == nil {
+		return []byte("null"), nil
+	}
+	sorted := make(map[string]apiext.JSON, len(attributes))
+	for key, value := range attributes {
+		sorted[key] = apiext.JSON{Raw: sortObjectKeys(value.Raw)}
+	}
+	return json.Marshal(sorted)
+}
+
+// sortObjectKeys returns the given JSON value with the keys of its nested objects sorted,
+// or the value itself if it is not a valid JSON object or array, so that marshalling reports it as before
+func sortObjectKeys(raw []byte) []byte {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || (trimmed[0] != '{' && trimmed[0] != '[') || !json.Valid(trimmed) {
+		return raw
+	}
+	decoder := json.NewDecoder(bytes.NewReader(trimmed))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return raw
+	}
+	sorted, err := json.Marshal(value)
+	if err != nil {
+		return raw
+	}
+	return sorted
 }
 
 // UnmarshalJSON implements custom JSON unmarshalling
@@ -3,11 +3,14 @@ package attributes
 import (
 	//	"encoding/json"
 	"encoding/json"
+	"errors"
 	"reflect"
 	"testing"
 
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
 )
 
 type buildAttributesTestCase struct {
@@ -732,3 +735,59 @@ func TestDecodeAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyNotFoundErrorIsNotFound(t *testing.T) {
+	var err error
+	Attributes{}.GetString(invalidKey, &err)
+	assert.True(t, errors.Is(err, devfileerrors.ErrNotFound))
+
+	var keyNotFound *KeyNotFoundError
+	if assert.True(t, errors.As(err, &keyNotFound)) {
+		assert.Equal(t, invalidKey, keyNotFound.Key)
+	}
+}
+
+func TestMarshalSortedKeys(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes Attributes
+		wantJSON   string
+		wantYAML   string
+	}{
+		{
+			name: "Nested objects",
+			attributes: Attributes{
+				"b": apiext.JSON{Raw: []byte(`{"z": 1.50, "a": [{"y": true, "x": null}]}`)},
+				"a": apiext.JSON{Raw: []byte(`"value"`)},
+			},
+			wantJSON: `{"a":"value","b":{"a":[{"x":null,"y":true}],"z":1.50}}`,
+			wantYAML: `a: value
+b:
+  a:
+  - x: null
+    "y": true
+  z: 1.5
+`,
+		},
+		{
+			name:       "Nil attributes",
+			attributes: nil,
+			wantJSON:   `null`,
+			wantYAML:   "null\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				content, err := json.Marshal(tt.attributes)
+				if assert.NoError(t, err) {
+					assert.Equal(t, tt.wantJSON, string(content))
+				}
+				content, err = yaml.Marshal(tt.attributes)
+				if assert.NoError(t, err) {
+					assert.Equal(t, tt.wantYAML, string(content))
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,12 @@
 package attributes
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+)
 
 // KeyNotFoundError returns an error if no key is found for the attribute
 type KeyNotFoundError struct {
@@ -10,3 +16,65 @@ type KeyNotFoundError struct {
 func (e *KeyNotFoundError) Error() string {
 	return fmt.Sprintf("Attribute with key %q does not exist", e.Key)
 }
+
+// Unwrap returns `ErrNotFound`, so that the error can be checked with `errors.Is`
+func (e *KeyNotFoundError) Unwrap() error {
+	return devfileerrors.ErrNotFound
+}
+
+// DecodeError returns an error if the attribute with the given key cannot be decoded into the expected type
+type DecodeError struct {
+	Key string
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("Attribute with key %q cannot be decoded: %v", e.Key, e.Err)
+}
+
+// Unwrap returns the error raised when decoding the attribute
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeErrors returns all the errors raised when decoding several attributes
+type DecodeErrors []error
+
+func (e DecodeErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Sprintf("%d errors occurred when decoding attributes: %s", len(e), strings.Join(messages, "; "))
+}
+
+// Is returns true if one of the errors matches the target with `errors.Is`
+func (e DecodeErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error that matches the target with `errors.As`
+func (e DecodeErrors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// errorOrNil returns nil if there is no error, since a nil DecodeErrors is not a nil error
+func (e DecodeErrors) errorOrNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
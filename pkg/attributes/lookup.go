@@ -0,0 +1,219 @@
+package attributes
+
+// LookupString returns the attribute with the given key as a string,
+// with the same conversion rules as `GetString`.
+//
+// The returned boolean is `false` if the attribute doesn't exist, which is not an error.
+// The returned error is a `DecodeError` if the attribute exists but cannot be decoded as a string.
+func (attributes Attributes) LookupString(key string) (string, bool, error) {
+	if !attributes.Exists(key) {
+		return "", false, nil
+	}
+	var err error
+	value := attributes.GetString(key, &err)
+	if err != nil {
+		return "", true, &DecodeError{Key: key, Err: err}
+	}
+	return value, true, nil
+}
+
+// LookupNumber returns the attribute with the given key as a float64,
+// with the same conversion rules as `GetNumber`.
+//
+// The returned boolean is `false` if the attribute doesn't exist, which is not an error.
+// The returned error is a `DecodeError` if the attribute exists but cannot be decoded as a number.
+func (attributes Attributes) LookupNumber(key string) (float64, bool, error) {
+	if !attributes.Exists(key) {
+		return 0, false, nil
+	}
+	var err error
+	value := attributes.GetNumber(key, &err)
+	if err != nil {
+		return 0, true, &DecodeError{Key: key, Err: err}
+	}
+	return value, true, nil
+}
+
+// LookupBoolean returns the attribute with the given key as a bool,
+// with the same conversion rules as `GetBoolean`.
+//
+// The returned boolean is `false` if the attribute doesn't exist, which is not an error.
+// The returned error is a `DecodeError` if the attribute exists but cannot be decoded as a boolean.
+func (attributes Attributes) LookupBoolean(key string) (bool, bool, error) {
+	if !attributes.Exists(key) {
+		return false, false, nil
+	}
+	var err error
+	value := attributes.GetBoolean(key, &err)
+	if err != nil {
+		return false, true, &DecodeError{Key: key, Err: err}
+	}
+	return value, true, nil
+}
+
+// Lookup returns the attribute with the given key as an interface,
+// with the same rules as `Get`.
+//
+// The returned boolean is `false` if the attribute doesn't exist, which is not an error.
+// The returned error is a `DecodeError` if the attribute exists but its content is not valid JSON.
+func (attributes Attributes) Lookup(key string) (interface{}, bool, error) {
+	if !attributes.Exists(key) {
+		return nil, false, nil
+	}
+	var err error
+	value := attributes.Get(key, &err)
+	if err != nil {
+		return nil, true, &DecodeError{Key: key, Err: err}
+	}
+	return value, true, nil
+}
+
+// LookupInto decodes the attribute with the given key into the given interface,
+// with the same rules as `GetInto`.
+//
+// The returned boolean is `false` if the attribute doesn't exist, which is not an error:
+// the given interface is then left unchanged.
+// The returned error is a `DecodeError` if the attribute exists but cannot be decoded into the given interface.
+func (attributes Attributes) LookupInto(key string, into interface{}) (bool, error) {
+	if !attributes.Exists(key) {
+		return false, nil
+	}
+	if err := attributes.GetInto(key, into); err != nil {
+		return true, &DecodeError{Key: key, Err: err}
+	}
+	return true, nil
+}
+
+// LookupStrings returns the attributes with the given keys as strings, indexed by key,
+// with the same conversion rules as `GetString`.
+//
+// The attributes that don't exist are not part of the result.
+// The attributes that cannot be decoded are not part of the result either,
+// and the returned error is then a `DecodeErrors` that contains the errors of all these attributes.
+func (attributes Attributes) LookupStrings(keys ...string) (map[string]string, error) {
+	result := map[string]string{}
+	var errs DecodeErrors
+	for _, key := range keys {
+		value, found, err := attributes.LookupString(key)
+		if err != nil {
+			errs = append(errs, err)
+		} else if found {
+			result[key] = value
+		}
+	}
+	return result, errs.errorOrNil()
+}
+
+// LookupNumbers returns the attributes with the given keys as float64 numbers, indexed by key,
+// with the same conversion rules as `GetNumber`.
+//
+// The attributes that don't exist are not part of the result.
+// The attributes that cannot be decoded are not part of the result either,
+// and the returned error is then a `DecodeErrors` that contains the errors of all these attributes.
+func (attributes Attributes) LookupNumbers(keys ...string) (map[string]float64, error) {
+	result := map[string]float64{}
+	var errs DecodeErrors
+	for _, key := range keys {
+		value, found, err := attributes.LookupNumber(key)
+		if err != nil {
+			errs = append(errs, err)
+		} else if found {
+			result[key] = value
+		}
+	}
+	return result, errs.errorOrNil()
+}
+
+// LookupBooleans returns the attributes with the given keys as booleans, indexed by key,
+// with the same conversion rules as `GetBoolean`.
+//
+// The attributes that don't exist are not part of the result.
+// The attributes that cannot be decoded are not part of the result either,
+// and the returned error is then a `DecodeErrors` that contains the errors of all these attributes.
+func (attributes Attributes) LookupBooleans(keys ...string) (map[string]bool, error) {
+	result := map[string]bool{}
+	var errs DecodeErrors
+	for _, key := range keys {
+		value, found, err := attributes.LookupBoolean(key)
+		if err != nil {
+			errs = append(errs, err)
+		} else if found {
+			result[key] = value
+		}
+	}
+	return result, errs.errorOrNil()
+}
+
+// StrictAttributes provides accessors to attributes without error holders:
+// the errors raised by all the accessors are accumulated, and returned together by `Err`.
+//
+// In addition to the decoding errors, reading an attribute that doesn't exist is an error,
+// which wraps `ErrNotFound`.
+type StrictAttributes struct {
+	attributes Attributes
+	errs       DecodeErrors
+}
+
+// Strict returns strict accessors to the attributes, which accumulate all the errors
+// raised when reading the attributes, so that they can be checked once with `Err`:
+//
+//	strict := attributes.Strict()
+//	name := strict.GetString("name")
+//	replicas := strict.GetNumber("replicas")
+//	if err := strict.Err(); err != nil {
+//		...
+//	}
+func (attributes Attributes) Strict() *StrictAttributes {
+	return &StrictAttributes{attributes: attributes}
+}
+
+// GetString returns the attribute with the given key as a string, like `Attributes.GetString`
+func (strict *StrictAttributes) GetString(key string) string {
+	value, _, err := strict.attributes.LookupString(key)
+	strict.record(key, err)
+	return value
+}
+
+// GetNumber returns the attribute with the given key as a float64, like `Attributes.GetNumber`
+func (strict *StrictAttributes) GetNumber(key string) float64 {
+	value, _, err := strict.attributes.LookupNumber(key)
+	strict.record(key, err)
+	return value
+}
+
+// GetBoolean returns the attribute with the given key as a bool, like `Attributes.GetBoolean`
+func (strict *StrictAttributes) GetBoolean(key string) bool {
+	value, _, err := strict.attributes.LookupBoolean(key)
+	strict.record(key, err)
+	return value
+}
+
+// Get returns the attribute with the given key as an interface, like `Attributes.Get`
+func (strict *StrictAttributes) Get(key string) interface{} {
+	value, _, err := strict.attributes.Lookup(key)
+	strict.record(key, err)
+	return value
+}
+
+// GetInto decodes the attribute with the given key into the given interface, like `Attributes.GetInto`
+func (strict *StrictAttributes) GetInto(key string, into interface{}) {
+	_, err := strict.attributes.LookupInto(key, into)
+	strict.record(key, err)
+}
+
+// Err returns the errors raised by all the accessors called so far, as a `DecodeErrors`,
+// or nil if there was no error
+func (strict *StrictAttributes) Err() error {
+	return strict.errs.errorOrNil()
+}
+
+// record records the error raised when reading the attribute with the given key,
+// or a `KeyNotFoundError` if the attribute doesn't exist
+func (strict *StrictAttributes) record(key string, err error) {
+	if err == nil && !strict.attributes.Exists(key) {
+		err = &KeyNotFoundError{Key: key}
+	}
+	if err != nil {
+		strict.errs = append(strict.errs, err)
+	}
+}
@@ -0,0 +1,205 @@
+package attributes
+
+import (
+	"errors"
+	"testing"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func lookupTestAttributes() Attributes {
+	var err error
+	attributes := Attributes{}.
+		PutString("name", "nodejs").
+		PutString("enabled", "true").
+		PutInteger("replicas", 2).
+		PutBoolean("debug", false).
+		Put("ports", []int{3000, 8080}, &err)
+	if err != nil {
+		// This should never happen
+		panic(err)
+	}
+	return attributes
+}
+
+func TestLookup(t *testing.T) {
+	attributes := lookupTestAttributes()
+
+	tests := []struct {
+		name          string
+		lookup        func() (interface{}, bool, error)
+		expectedValue interface{}
+		expectedFound bool
+		expectedError string
+	}{
+		{
+			name: "String",
+			lookup: func() (interface{}, bool, error) {
+				return attributes.LookupString("name")
+			},
+			expectedValue: "nodejs",
+			expectedFound: true,
+		},
+		{
+			name: "String converted from a number",
+			lookup: func() (interface{}, bool, error) {
+				return attributes.LookupString("replicas")
+			},
+			expectedValue: "2",
+			expectedFound: true,
+		},
+		{
+			name: "Missing string",
+			lookup: func() (interface{}, bool, error) {
+				return attributes.LookupString("missing")
+			},
+			expectedValue: "",
+			expectedFound: false,
+		},
+		{
+			name: "Invalid string",
+			lookup: func() (interface{}, bool, error) {
+				return attributes.LookupString("ports")
+			},
+			expectedValue: "",
+			expectedFound: true,
+			expectedError: "Attribute with key \"ports\" cannot be decoded: json: cannot unmarshal array into Go value of type string",
+		},
+		{
+			name: "Number",
+			lookup: func() (interface{}, bool, error) {
+				return attributes.LookupNumber("replicas")
+			},
+			expectedValue: 2.0,
+			expectedFound: true,
+		},
+		{
+			name: "Invalid number",
+			lookup: func() (interface{}, bool, error) {
+				return attributes.LookupNumber("name")
+			},
+			expectedValue: 0.0,
+			expectedFound: true,
+			expectedError: "Attribute with key \"name\" cannot be decoded: json: cannot unmarshal string into Go value of type float64",
+		},
+		{
+			name: "Boolean",
+			lookup: func() (interface{}, bool, error) {
+				return attributes.LookupBoolean("debug")
+			},
+			expectedValue: false,
+			expectedFound: true,
+		},
+		{
+			name: "Boolean converted from a string",
+			lookup: func() (interface{}, bool, error) {
+				return attributes.LookupBoolean("enabled")
+			},
+			expectedValue: true,
+			expectedFound: true,
+		},
+		{
+			name: "Missing boolean",
+			lookup: func() (interface{}, bool, error) {
+				return attributes.LookupBoolean("missing")
+			},
+			expectedValue: false,
+			expectedFound: false,
+		},
+		{
+			name: "Interface",
+			lookup: func() (interface{}, bool, error) {
+				return attributes.Lookup("ports")
+			},
+			expectedValue: []interface{}{3000.0, 8080.0},
+			expectedFound: true,
+		},
+		{
+			name: "Missing interface",
+			lookup: func() (interface{}, bool, error) {
+				return attributes.Lookup("missing")
+			},
+			expectedValue: nil,
+			expectedFound: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, found, err := tt.lookup()
+			assert.Equal(t, tt.expectedValue, value)
+			assert.Equal(t, tt.expectedFound, found)
+			checkError(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestLookupInto(t *testing.T) {
+	attributes := lookupTestAttributes()
+
+	ports := []int{}
+	found, err := attributes.LookupInto("ports", &ports)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []int{3000, 8080}, ports)
+
+	name := "unchanged"
+	found, err = attributes.LookupInto("missing", &name)
+	assert.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, "unchanged", name)
+
+	found, err = attributes.LookupInto("name", &ports)
+	assert.True(t, found)
+	var decodeErr *DecodeError
+	if assert.True(t, errors.As(err, &decodeErr)) {
+		assert.Equal(t, "name", decodeErr.Key)
+	}
+}
+
+func TestLookupBatch(t *testing.T) {
+	attributes := lookupTestAttributes()
+
+	strings, err := attributes.LookupStrings("name", "replicas", "missing")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "nodejs", "replicas": "2"}, strings)
+
+	numbers, err := attributes.LookupNumbers("replicas", "name", "ports", "missing")
+	assert.Equal(t, map[string]float64{"replicas": 2}, numbers)
+	checkError(t, err, "2 errors occurred when decoding attributes: "+
+		"Attribute with key \"name\" cannot be decoded: json: cannot unmarshal string into Go value of type float64; "+
+		"Attribute with key \"ports\" cannot be decoded: json: cannot unmarshal array into Go value of type float64")
+
+	booleans, err := attributes.LookupBooleans("debug", "enabled")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"debug": false, "enabled": true}, booleans)
+}
+
+func TestStrict(t *testing.T) {
+	attributes := lookupTestAttributes()
+
+	strict := attributes.Strict()
+	assert.Equal(t, "nodejs", strict.GetString("name"))
+	assert.Equal(t, 2.0, strict.GetNumber("replicas"))
+	assert.Equal(t, true, strict.GetBoolean("enabled"))
+	assert.Equal(t, []interface{}{3000.0, 8080.0}, strict.Get("ports"))
+	ports := []int{}
+	strict.GetInto("ports", &ports)
+	assert.Equal(t, []int{3000, 8080}, ports)
+	assert.NoError(t, strict.Err())
+
+	assert.Equal(t, "", strict.GetString("missing"))
+	assert.Equal(t, 0.0, strict.GetNumber("name"))
+	assert.Equal(t, false, strict.GetBoolean("ports"))
+
+	err := strict.Err()
+	checkError(t, err, "3 errors occurred when decoding attributes: "+
+		"Attribute with key \"missing\" does not exist; "+
+		"Attribute with key \"name\" cannot be decoded: json: cannot unmarshal string into Go value of type float64; "+
+		"Attribute with key \"ports\" cannot be decoded: json: cannot unmarshal array into Go value of type bool")
+	assert.True(t, errors.Is(err, devfileerrors.ErrNotFound))
+	var decodeErr *DecodeError
+	if assert.True(t, errors.As(err, &decodeErr)) {
+		assert.Equal(t, "name", decodeErr.Key)
+	}
+}
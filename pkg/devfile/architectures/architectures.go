@@ -0,0 +1,210 @@
+// Package architectures resolves the per-architecture variants of a devfile whose components are overridden
+// on some processor architectures, so that a registry can publish a devfile per architecture from a single source.
+//
+// The overrides of a component are set in its `api.devfile.io/architecture-overrides` attribute,
+// as a strategic merge patch of the component per architecture:
+//
+//	metadata:
+//	  architectures: [amd64, arm64]
+//	components:
+//	- name: runtime
+//	  attributes:
+//	    api.devfile.io/architecture-overrides:
+//	      amd64: {}
+//	      arm64:
+//	        container:
+//	          image: quay.io/example/runtime:arm64
+//	  container:
+//	    image: quay.io/example/runtime:amd64
+//
+// A component with overrides should cover all the architectures declared in the devfile metadata,
+// with an empty patch for the architectures on which the component is used as is.
+package architectures
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/devfile"
+	"github.com/devfile/api/v2/pkg/devfile/keys"
+	"github.com/hashicorp/go-multierror"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+// OverridesAttribute is the key of the component attribute that contains the strategic merge patch
+// of the component per architecture
+const OverridesAttribute = keys.ArchitectureOverridesAttribute
+
+// Variant is the devfile resolved for a processor architecture
+type Variant struct {
+	// Architecture is the architecture of the variant
+	Architecture devfile.Architecture
+	// Devfile is the devfile with the overrides of the architecture applied to its components,
+	// and the architecture as the only architecture of its metadata
+	Devfile *v1alpha2.Devfile
+}
+
+// CoverageError is returned when the architecture overrides of a component don't match the architectures
+// declared in the devfile metadata
+type CoverageError struct {
+	// Component is the name of the component
+	Component string
+	// Missing are the declared architectures without overrides
+	Missing []devfile.Architecture
+	// Undeclared are the architectures with overrides that are not declared in the devfile metadata
+	Undeclared []devfile.Architecture
+}
+
+func (e *CoverageError) Error() string {
+	var problems []string
+	if len(e.Missing) > 0 {
+		problems = append(problems, "no overrides for the declared architectures "+joinArchitectures(e.Missing))
+	}
+	if len(e.Undeclared) > 0 {
+		problems = append(problems, "overrides for the undeclared architectures "+joinArchitectures(e.Undeclared))
+	}
+	return fmt.Sprintf("component %q has %s", e.Component, strings.Join(problems, ", and "))
+}
+
+// Overrides returns the architecture overrides of the given component, indexed by architecture,
+// or nil if the component has no overrides
+func Overrides(component v1alpha2.Component) (map[devfile.Architecture]json.RawMessage, error) {
+	if !component.Attributes.Exists(OverridesAttribute) {
+		return nil, nil
+	}
+	overrides := map[devfile.Architecture]json.RawMessage{}
+	if err := component.Attributes.GetInto(OverridesAttribute, &overrides); err != nil {
+		return nil, fmt.Errorf("component %q: attribute %q should be an object of patches indexed by architecture: %w", component.Name, OverridesAttribute, err)
+	}
+	return overrides, nil
+}
+
+// Validate checks that the architecture overrides of the components of the given devfile are valid patches,
+// for valid architectures, and that they cover exactly the architectures declared in the devfile metadata.
+// The coverage problems are returned as `*CoverageError` errors.
+func Validate(d *v1alpha2.Devfile) (returnedErr error) {
+	declared := map[devfile.Architecture]bool{}
+	for _, architecture := range d.Metadata.Architectures {
+		declared[architecture] = true
+	}
+
+	for _, component := range d.Components {
+		overrides, err := Overrides(component)
+		if err != nil {
+			returnedErr = multierror.Append(returnedErr, err)
+			continue
+		}
+		if overrides == nil {
+			continue
+		}
+		coverageErr := &CoverageError{Component: component.Name}
+		for _, architecture := range sortedArchitectures(overrides) {
+			if !architecture.IsValid() {
+				returnedErr = multierror.Append(returnedErr, fmt.Errorf("component %q: unknown architecture %q, should be one of: %s",
+					component.Name, architecture, joinArchitectures(architecture.Values())))
+				continue
+			}
+			if !declared[architecture] {
+				coverageErr.Undeclared = append(coverageErr.Undeclared, architecture)
+				continue
+			}
+			if _, err := overrideComponent(component, overrides[architecture]); err != nil {
+				returnedErr = multierror.Append(returnedErr, fmt.Errorf("component %q: invalid %s overrides: %w", component.Name, architecture, err))
+			}
+		}
+		for _, architecture := range d.Metadata.Architectures {
+			if _, isOverridden := overrides[architecture]; !isOverridden {
+				coverageErr.Missing = append(coverageErr.Missing, architecture)
+			}
+		}
+		if len(coverageErr.Missing) > 0 || len(coverageErr.Undeclared) > 0 {
+			returnedErr = multierror.Append(returnedErr, coverageErr)
+		}
+	}
+	return returnedErr
+}
+
+// Variants validates the architecture overrides of the given devfile, and returns its variant for each architecture
+// declared in its metadata, in declaration order.
+// The components of each variant have the overrides of its architecture applied, and no longer have the overrides attribute.
+func Variants(d *v1alpha2.Devfile) ([]Variant, error) {
+	if len(d.Metadata.Architectures) == 0 {
+		return nil, fmt.Errorf("the devfile declares no architectures in its metadata")
+	}
+	if err := Validate(d); err != nil {
+		return nil, err
+	}
+
+	headerJSON, err := json.Marshal(d.DevfileHeader)
+	if err != nil {
+		return nil, err
+	}
+	variants := make([]Variant, 0, len(d.Metadata.Architectures))
+	for _, architecture := range d.Metadata.Architectures {
+		variant := &v1alpha2.Devfile{DevWorkspaceTemplateSpec: *d.DevWorkspaceTemplateSpec.DeepCopy()}
+		// the header has no DeepCopy method
+		if err := json.Unmarshal(headerJSON, &variant.DevfileHeader); err != nil {
+			return nil, err
+		}
+		variant.Metadata.Architectures = []devfile.Architecture{architecture}
+		for i, component := range variant.Components {
+			overrides, _ := Overrides(component)
+			if overrides == nil {
+				continue
+			}
+			overridden, err := overrideComponent(component, overrides[architecture])
+			if err != nil {
+				return nil, fmt.Errorf("component %q: invalid %s overrides: %w", component.Name, architecture, err)
+			}
+			delete(overridden.Attributes, OverridesAttribute)
+			if len(overridden.Attributes) == 0 {
+				overridden.Attributes = nil
+			}
+			variant.Components[i] = overridden
+		}
+		variants = append(variants, Variant{Architecture: architecture, Devfile: variant})
+	}
+	return variants, nil
+}
+
+// overrideComponent returns the given component with the given strategic merge patch applied.
+// The patch cannot rename the component.
+func overrideComponent(component v1alpha2.Component, patch json.RawMessage) (v1alpha2.Component, error) {
+	originalJSON, err := json.Marshal(component)
+	if err != nil {
+		return v1alpha2.Component{}, err
+	}
+	patchedJSON, err := strategicpatch.StrategicMergePatch(originalJSON, patch, v1alpha2.Component{})
+	if err != nil {
+		return v1alpha2.Component{}, err
+	}
+	overridden := v1alpha2.Component{}
+	if err := json.Unmarshal(patchedJSON, &overridden); err != nil {
+		return v1alpha2.Component{}, err
+	}
+	if overridden.Name != component.Name {
+		return v1alpha2.Component{}, fmt.Errorf("the component name cannot be overridden")
+	}
+	return overridden, nil
+}
+
+// sortedArchitectures returns the architectures of the given overrides, sorted alphabetically
+func sortedArchitectures(overrides map[devfile.Architecture]json.RawMessage) []devfile.Architecture {
+	architectures := make([]devfile.Architecture, 0, len(overrides))
+	for architecture := range overrides {
+		architectures = append(architectures, architecture)
+	}
+	sort.Slice(architectures, func(i, j int) bool { return architectures[i] < architectures[j] })
+	return architectures
+}
+
+func joinArchitectures(architectures []devfile.Architecture) string {
+	names := make([]string, 0, len(architectures))
+	for _, architecture := range architectures {
+		names = append(names, string(architecture))
+	}
+	return strings.Join(names, ", ")
+}
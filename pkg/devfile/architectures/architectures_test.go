@@ -0,0 +1,168 @@
+package architectures
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/devfile"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func parseDevfile(t *testing.T, content string) *v1alpha2.Devfile {
+	d := &v1alpha2.Devfile{}
+	if err := yaml.Unmarshal([]byte(content), d); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestVariants(t *testing.T) {
+	d := parseDevfile(t, `
+schemaVersion: 2.2.0
+metadata:
+  name: nodejs
+  architectures: [amd64, arm64]
+components:
+- name: runtime
+  attributes:
+    api.devfile.io/architecture-overrides:
+      amd64: {}
+      arm64:
+        container:
+          image: node:18-arm64
+          env:
+          - name: NODE_OPTIONS
+            value: --max-old-space-size=512
+  container:
+    image: node:18
+    env:
+    - name: NODE_ENV
+      value: development
+- name: data
+  volume: {}
+`)
+	variants, err := Variants(d)
+	if !assert.NoError(t, err) || !assert.Len(t, variants, 2) {
+		return
+	}
+
+	amd64 := variants[0]
+	assert.Equal(t, devfile.AMD64, amd64.Architecture)
+	assert.Equal(t, []devfile.Architecture{devfile.AMD64}, amd64.Devfile.Metadata.Architectures)
+	assert.Equal(t, "node:18", amd64.Devfile.Components[0].Container.Image)
+	assert.Nil(t, amd64.Devfile.Components[0].Attributes)
+
+	arm64 := variants[1]
+	assert.Equal(t, devfile.ARM64, arm64.Architecture)
+	assert.Equal(t, []devfile.Architecture{devfile.ARM64}, arm64.Devfile.Metadata.Architectures)
+	assert.Equal(t, "node:18-arm64", arm64.Devfile.Components[0].Container.Image)
+	assert.ElementsMatch(t, []v1alpha2.EnvVar{
+		{Name: "NODE_ENV", Value: "development"},
+		{Name: "NODE_OPTIONS", Value: "--max-old-space-size=512"},
+	}, arm64.Devfile.Components[0].Container.Env)
+	assert.Equal(t, d.Components[1], arm64.Devfile.Components[1])
+
+	assert.Equal(t, []devfile.Architecture{devfile.AMD64, devfile.ARM64}, d.Metadata.Architectures, "the original devfile should be left unchanged")
+	assert.Equal(t, "node:18", d.Components[0].Container.Image, "the original devfile should be left unchanged")
+}
+
+func TestVariantsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		devfile string
+		wantErr []string
+	}{
+		{
+			name: "No declared architectures",
+			devfile: `
+schemaVersion: 2.2.0
+components:
+- name: runtime
+  container:
+    image: node:18
+`,
+			wantErr: []string{"the devfile declares no architectures in its metadata"},
+		},
+		{
+			name: "Incomplete coverage",
+			devfile: `
+schemaVersion: 2.2.0
+metadata:
+  architectures: [amd64, arm64, s390x]
+components:
+- name: runtime
+  attributes:
+    api.devfile.io/architecture-overrides:
+      arm64:
+        container:
+          image: node:18-arm64
+      ppc64le:
+        container:
+          image: node:18-ppc64le
+  container:
+    image: node:18
+`,
+			wantErr: []string{`component "runtime" has no overrides for the declared architectures amd64, s390x, and overrides for the undeclared architectures ppc64le`},
+		},
+		{
+			name: "Unknown architecture",
+			devfile: `
+schemaVersion: 2.2.0
+metadata:
+  architectures: [amd64]
+components:
+- name: runtime
+  attributes:
+    api.devfile.io/architecture-overrides:
+      amd64: {}
+      riscv64: {}
+  container:
+    image: node:18
+`,
+			wantErr: []string{`component "runtime": unknown architecture "riscv64", should be one of: amd64, arm64, ppc64le, s390x`},
+		},
+		{
+			name: "Renaming overrides",
+			devfile: `
+schemaVersion: 2.2.0
+metadata:
+  architectures: [amd64]
+components:
+- name: runtime
+  attributes:
+    api.devfile.io/architecture-overrides:
+      amd64:
+        name: other
+  container:
+    image: node:18
+`,
+			wantErr: []string{`component "runtime": invalid amd64 overrides: the component name cannot be overridden`},
+		},
+		{
+			name: "Invalid overrides attribute",
+			devfile: `
+schemaVersion: 2.2.0
+metadata:
+  architectures: [amd64]
+components:
+- name: runtime
+  attributes:
+    api.devfile.io/architecture-overrides: [amd64]
+  container:
+    image: node:18
+`,
+			wantErr: []string{`component "runtime": attribute "api.devfile.io/architecture-overrides" should be an object of patches indexed by architecture`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Variants(parseDevfile(t, tt.devfile))
+			if assert.Error(t, err) {
+				for _, wantErr := range tt.wantErr {
+					assert.Contains(t, err.Error(), wantErr)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,327 @@
+// Package compose renders the container components of flattened devfiles into Docker Compose projects,
+// for local development without a Kubernetes cluster.
+//
+// Each container component becomes a service, each volume component a named volume (or a tmpfs mount when it is ephemeral),
+// and the endpoints become published or exposed ports according to their exposure.
+// The features that only make sense on Kubernetes, such as Kubernetes components or pod overrides, are not rendered:
+// they are returned as warnings, so that the callers can report them.
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/devfile/flatten"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultProjectDir is the host folder mounted as the project sources when Options.ProjectDir is empty,
+// relative to the folder of the compose file
+const DefaultProjectDir = "."
+
+// defaultSourceMapping is the path where the project sources are mounted when a container doesn't set `sourceMapping`
+const defaultSourceMapping = "/projects"
+
+// Options configures how a devfile is rendered into a Docker Compose project
+type Options struct {
+	// ProjectDir is the host folder bind-mounted as the project sources into the containers that mount sources,
+	// relative to the folder of the compose file. DefaultProjectDir is used when it is empty.
+	ProjectDir string
+}
+
+// Project is a Docker Compose project, as described by https://docs.docker.com/compose/compose-file/
+type Project struct {
+	Name     string             `json:"name,omitempty"`
+	Services map[string]Service `json:"services"`
+	Volumes  map[string]Volume  `json:"volumes,omitempty"`
+}
+
+// Service is a service of a Docker Compose project, which runs a container
+type Service struct {
+	Image string `json:"image,omitempty"`
+	// Build describes how to build the image of the service
+	Build *Build `json:"build,omitempty"`
+	// Entrypoint overrides the `ENTRYPOINT` of the image
+	Entrypoint []string `json:"entrypoint,omitempty"`
+	// Command overrides the `CMD` of the image
+	Command     []string          `json:"command,omitempty"`
+	Environment map[string]string `json:"environment,omitempty"`
+	// Ports are the ports published on the host, as `<host port>:<container port>[/<protocol>]`
+	Ports []string `json:"ports,omitempty"`
+	// Expose are the ports reachable by the other services only
+	Expose []string `json:"expose,omitempty"`
+	// Volumes are the volumes and bind mounts of the service, as `<source>:<target>`
+	Volumes []string `json:"volumes,omitempty"`
+	// Tmpfs are the paths of the temporary file systems mounted in the service
+	Tmpfs []string `json:"tmpfs,omitempty"`
+	// MemLimit is the memory limit of the service, in bytes
+	MemLimit string `json:"mem_limit,omitempty"`
+	// MemReservation is the memory reservation of the service, in bytes
+	MemReservation string `json:"mem_reservation,omitempty"`
+	// Cpus is the number of CPUs available to the service, possibly fractional
+	Cpus string `json:"cpus,omitempty"`
+}
+
+// Build describes how to build the image of a service
+type Build struct {
+	// Context is the folder of the build context, relative to the folder of the compose file
+	Context string `json:"context"`
+	// Dockerfile is the path of the Dockerfile, relative to the build context
+	Dockerfile string `json:"dockerfile,omitempty"`
+	// Args are the build arguments, as `<name>=<value>`
+	Args []string `json:"args,omitempty"`
+}
+
+// Volume is a named volume of a Docker Compose project
+type Volume struct{}
+
+// Warning is a feature of the devfile that is not rendered in the Docker Compose project
+type Warning struct {
+	// Path is the path of the devfile field that is not rendered, such as `components[runtime].container.dedicatedPod`
+	Path string
+	// Message tells why the field is not rendered
+	Message string
+}
+
+func (w Warning) String() string {
+	return w.Path + ": " + w.Message
+}
+
+// Export renders the given flattened devfile into a Docker Compose project.
+// It returns warnings, sorted by path, for the devfile features that have no Docker Compose equivalent,
+// and an error if the devfile has no container component.
+func Export(flattened *flatten.FlattenedDevfile, opts Options) (*Project, []Warning, error) {
+	content := &flattened.DevWorkspaceTemplateSpecContent
+	e := &exporter{content: content, opts: opts, images: map[string]v1alpha2.Component{}, usedImages: map[string]bool{}}
+	if e.opts.ProjectDir == "" {
+		e.opts.ProjectDir = DefaultProjectDir
+	}
+
+	project := &Project{Name: flattened.Metadata.Name, Services: map[string]Service{}}
+	for _, component := range content.Components {
+		if component.Image != nil && component.Image.Dockerfile != nil {
+			e.images[component.Image.ImageName] = component
+		}
+		if component.Volume != nil && !component.Volume.GetEphemeral() {
+			if project.Volumes == nil {
+				project.Volumes = map[string]Volume{}
+			}
+			project.Volumes[component.Name] = Volume{}
+		}
+	}
+
+	containers := 0
+	for _, component := range content.Components {
+		path := fmt.Sprintf("components[%s]", component.Name)
+		switch {
+		case component.Container != nil:
+			containers++
+			project.Services[component.Name] = e.service(component)
+		case component.Kubernetes != nil, component.Openshift != nil:
+			e.warn(path, "Kubernetes and OpenShift components are not rendered")
+		case component.Secret != nil, component.ConfigMap != nil:
+			e.warn(path, "Kubernetes Secrets and ConfigMaps are not mounted in the services")
+		case component.Volume != nil:
+			if component.Volume.Size != "" {
+				e.warn(path+".volume.size", "Docker Compose volumes have no size")
+			}
+		}
+	}
+	if containers == 0 {
+		return nil, nil, errors.New("the devfile has no container component to render as a Docker Compose service")
+	}
+	if containers > 1 {
+		e.warn("components", "the containers run in separate services, which reach each other by service name rather than on localhost")
+	}
+
+	for _, component := range content.Components {
+		if component.Image != nil && !e.usedImages[component.Image.ImageName] {
+			e.warn(fmt.Sprintf("components[%s]", component.Name), "only the images of container components are built")
+		}
+	}
+	if _, exists := content.Attributes[v1alpha2.PodOverridesAttribute]; exists {
+		e.warn("attributes."+v1alpha2.PodOverridesAttribute, "pod overrides only apply to Kubernetes")
+	}
+	if content.Events != nil && len(content.Events.PreStart) > 0 {
+		e.warn("events.preStart", "the preStart commands run as init containers on Kubernetes, and are not rendered")
+	}
+
+	sort.SliceStable(e.warnings, func(i, j int) bool {
+		return e.warnings[i].Path < e.warnings[j].Path
+	})
+	return project, e.warnings, nil
+}
+
+// Marshal returns the docker-compose.yaml content of the given project
+func Marshal(project *Project) ([]byte, error) {
+	return yaml.Marshal(project)
+}
+
+type exporter struct {
+	content *v1alpha2.DevWorkspaceTemplateSpecContent
+	opts    Options
+	// images are the image components built from a Dockerfile, indexed by image name
+	images map[string]v1alpha2.Component
+	// usedImages are the names of the images built for container components
+	usedImages map[string]bool
+	warnings   []Warning
+}
+
+func (e *exporter) warn(path string, format string, args ...interface{}) {
+	e.warnings = append(e.warnings, Warning{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// service returns the service of the given container component
+func (e *exporter) service(component v1alpha2.Component) Service {
+	container := component.Container
+	path := fmt.Sprintf("components[%s].container", component.Name)
+
+	service := Service{Image: container.Image}
+	if image, exists := e.images[container.Image]; exists {
+		e.usedImages[container.Image] = true
+		service.Build = e.build(image)
+	}
+	// like the command and args of a K8S container, the entrypoint and command of a service replace the ones of the image
+	service.Entrypoint, service.Command = container.Command, container.Args
+
+	for _, env := range container.Env {
+		if service.Environment == nil {
+			service.Environment = map[string]string{}
+		}
+		service.Environment[env.Name] = env.Value
+	}
+
+	for _, endpoint := range container.Endpoints {
+		port := strconv.Itoa(endpoint.TargetPort)
+		if endpoint.Protocol == v1alpha2.UDPEndpointProtocol {
+			port += "/udp"
+		}
+		switch endpoint.Exposure {
+		case v1alpha2.NoneEndpointExposure:
+		case v1alpha2.InternalEndpointExposure:
+			service.Expose = append(service.Expose, port)
+		default:
+			service.Ports = append(service.Ports, strconv.Itoa(endpoint.TargetPort)+":"+port)
+			if endpoint.GetSecure() || endpoint.Path != "" {
+				e.warn(fmt.Sprintf("%s.endpoints[%s]", path, endpoint.Name), "the port is published without a route, so the secure flag and the path of the endpoint are not applied")
+			}
+		}
+	}
+
+	for _, volumeMount := range container.VolumeMounts {
+		mountPath := volumeMount.Path
+		if mountPath == "" {
+			mountPath = "/" + volumeMount.Name
+		}
+		if e.isConfiguration(volumeMount.Name) {
+			continue
+		}
+		if e.isEphemeral(volumeMount.Name) {
+			service.Tmpfs = append(service.Tmpfs, mountPath)
+			continue
+		}
+		service.Volumes = append(service.Volumes, volumeMount.Name+":"+mountPath)
+	}
+	if container.GetMountSources() {
+		sourceMapping := container.SourceMapping
+		if sourceMapping == "" {
+			sourceMapping = defaultSourceMapping
+		}
+		service.Volumes = append(service.Volumes, e.opts.ProjectDir+":"+sourceMapping)
+		for _, name := range []string{"PROJECTS_ROOT", "PROJECT_SOURCE"} {
+			if _, exists := service.Environment[name]; !exists {
+				if service.Environment == nil {
+					service.Environment = map[string]string{}
+				}
+				service.Environment[name] = sourceMapping
+			}
+		}
+	}
+
+	service.MemLimit = e.bytes(container.MemoryLimit, path+".memoryLimit")
+	service.MemReservation = e.bytes(container.MemoryRequest, path+".memoryRequest")
+	if container.CpuLimit != "" {
+		if quantity, err := resource.ParseQuantity(container.CpuLimit); err != nil {
+			e.warn(path+".cpuLimit", "invalid quantity: %s", err)
+		} else {
+			service.Cpus = strconv.FormatFloat(float64(quantity.MilliValue())/1000, 'f', -1, 64)
+		}
+	}
+	if container.CpuRequest != "" {
+		e.warn(path+".cpuRequest", "Docker Compose services have no CPU reservation")
+	}
+
+	if container.GetDedicatedPod() {
+		e.warn(path+".dedicatedPod", "every Docker Compose service runs in its own container")
+	}
+	if container.Annotation != nil {
+		e.warn(path+".annotation", "annotations only apply to Kubernetes resources")
+	}
+	for _, key := range []string{v1alpha2.PodOverridesAttribute, v1alpha2.ContainerOverridesAttribute} {
+		if _, exists := component.Attributes[key]; exists {
+			e.warn(fmt.Sprintf("components[%s].attributes.%s", component.Name, key), "overrides only apply to Kubernetes")
+		}
+	}
+	return service
+}
+
+// build returns the build of the image of the given image component, or nil if its Dockerfile is not a local file
+func (e *exporter) build(image v1alpha2.Component) *Build {
+	path := fmt.Sprintf("components[%s]", image.Name)
+	dockerfile := image.Image.Dockerfile
+	if dockerfile.Uri == "" || strings.Contains(dockerfile.Uri, "://") {
+		e.warn(path+".image.dockerfile", "only the Dockerfiles of the devfile folder can be built, the image is pulled instead")
+		return nil
+	}
+	build := &Build{Context: dockerfile.BuildContext, Args: dockerfile.Args}
+	if build.Context == "" {
+		build.Context = "."
+	}
+	build.Dockerfile = dockerfile.Uri
+	if relative, err := filepath.Rel(filepath.FromSlash(build.Context), filepath.FromSlash(dockerfile.Uri)); err == nil {
+		build.Dockerfile = filepath.ToSlash(relative)
+	}
+	if dockerfile.RootRequired != nil && *dockerfile.RootRequired {
+		e.warn(path+".image.dockerfile.rootRequired", "the privileges of the build depend on the local Docker engine")
+	}
+	return build
+}
+
+// isEphemeral returns true if the volume component with the given name is ephemeral
+func (e *exporter) isEphemeral(volume string) bool {
+	for _, component := range e.content.Components {
+		if component.Name == volume && component.Volume != nil {
+			return component.Volume.GetEphemeral()
+		}
+	}
+	return false
+}
+
+// isConfiguration returns true if the component with the given name is a secret or configMap component
+func (e *exporter) isConfiguration(name string) bool {
+	for _, component := range e.content.Components {
+		if component.Name == name {
+			return component.GetConfigurationMount() != nil
+		}
+	}
+	return false
+}
+
+// bytes returns the given memory quantity in bytes, or an empty string if it is not set or invalid
+func (e *exporter) bytes(quantity string, path string) string {
+	if quantity == "" {
+		return ""
+	}
+	parsed, err := resource.ParseQuantity(quantity)
+	if err != nil {
+		e.warn(path, "invalid quantity: %s", err)
+		return ""
+	}
+	return strconv.FormatInt(parsed.Value(), 10)
+}
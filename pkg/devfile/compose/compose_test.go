@@ -0,0 +1,181 @@
+package compose
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/devfile/flatten"
+	"github.com/stretchr/testify/assert"
+)
+
+const nodeDevfile = `
+schemaVersion: 2.2.0
+metadata:
+  name: nodejs
+components:
+- name: runtime
+  attributes:
+    container-overrides: {securityContext: {runAsUser: 1000}}
+  container:
+    image: nodejs-image
+    command: [npm]
+    args: [start]
+    memoryLimit: 1Gi
+    cpuLimit: 500m
+    sourceMapping: /workspace
+    env:
+    - name: NODE_ENV
+      value: development
+    endpoints:
+    - name: http
+      targetPort: 3000
+      secure: true
+    - name: debug
+      targetPort: 9229
+      exposure: internal
+    - name: metrics
+      targetPort: 9090
+      exposure: none
+    volumeMounts:
+    - name: node-modules
+      path: /workspace/node_modules
+    - name: tmp
+    - name: npmrc
+- name: db
+  container:
+    image: mongo
+    mountSources: false
+    dedicatedPod: true
+    endpoints:
+    - name: dns
+      targetPort: 53
+      protocol: udp
+- name: nodejs-image
+  image:
+    imageName: nodejs-image
+    dockerfile:
+      uri: docker/Dockerfile
+      buildContext: docker
+      args: [NODE_VERSION=18]
+- name: node-modules
+  volume:
+    size: 1Gi
+- name: tmp
+  volume:
+    ephemeral: true
+- name: npmrc
+  configMap:
+    configMapName: npm-settings
+- name: route
+  kubernetes:
+    inlined: "kind: Route"
+`
+
+func TestExport(t *testing.T) {
+	flattened, _, err := flatten.ValidateAndFlatten([]byte(nodeDevfile), flatten.ResolveOptions{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	project, warnings, err := Export(&flattened, Options{ProjectDir: ".."})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, &Project{
+		Name: "nodejs",
+		Services: map[string]Service{
+			"runtime": {
+				Image:      "nodejs-image",
+				Build:      &Build{Context: "docker", Dockerfile: "Dockerfile", Args: []string{"NODE_VERSION=18"}},
+				Entrypoint: []string{"npm"},
+				Command:    []string{"start"},
+				Environment: map[string]string{
+					"NODE_ENV":       "development",
+					"PROJECTS_ROOT":  "/workspace",
+					"PROJECT_SOURCE": "/workspace",
+				},
+				Ports:    []string{"3000:3000"},
+				Expose:   []string{"9229"},
+				Volumes:  []string{"node-modules:/workspace/node_modules", "..:/workspace"},
+				Tmpfs:    []string{"/tmp"},
+				MemLimit: "1073741824",
+				Cpus:     "0.5",
+			},
+			"db": {
+				Image: "mongo",
+				Ports: []string{"53:53/udp"},
+			},
+		},
+		Volumes: map[string]Volume{"node-modules": {}},
+	}, project)
+
+	assert.Equal(t, []Warning{
+		{Path: "components", Message: "the containers run in separate services, which reach each other by service name rather than on localhost"},
+		{Path: "components[db].container.dedicatedPod", Message: "every Docker Compose service runs in its own container"},
+		{Path: "components[node-modules].volume.size", Message: "Docker Compose volumes have no size"},
+		{Path: "components[npmrc]", Message: "Kubernetes Secrets and ConfigMaps are not mounted in the services"},
+		{Path: "components[route]", Message: "Kubernetes and OpenShift components are not rendered"},
+		{Path: "components[runtime].attributes.container-overrides", Message: "overrides only apply to Kubernetes"},
+		{Path: "components[runtime].container.endpoints[http]", Message: "the port is published without a route, so the secure flag and the path of the endpoint are not applied"},
+	}, warnings)
+}
+
+func TestExportRemoteDockerfile(t *testing.T) {
+	flattened, _, err := flatten.ValidateAndFlatten([]byte(`
+schemaVersion: 2.2.0
+components:
+- name: runtime
+  container:
+    image: runtime-image
+    mountSources: false
+- name: runtime-image
+  image:
+    imageName: runtime-image
+    dockerfile:
+      uri: https://example.com/Dockerfile
+- name: unused-image
+  image:
+    imageName: unused-image
+    dockerfile:
+      uri: Dockerfile
+`), flatten.ResolveOptions{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	project, warnings, err := Export(&flattened, Options{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, map[string]Service{"runtime": {Image: "runtime-image"}}, project.Services)
+	assert.Equal(t, []Warning{
+		{Path: "components[runtime-image].image.dockerfile", Message: "only the Dockerfiles of the devfile folder can be built, the image is pulled instead"},
+		{Path: "components[unused-image]", Message: "only the images of container components are built"},
+	}, warnings)
+}
+
+func TestExportWithoutContainer(t *testing.T) {
+	_, _, err := Export(&flatten.FlattenedDevfile{}, Options{})
+	assert.EqualError(t, err, "the devfile has no container component to render as a Docker Compose service")
+}
+
+func TestMarshal(t *testing.T) {
+	content, err := Marshal(&Project{
+		Name: "nodejs",
+		Services: map[string]Service{
+			"runtime": {Image: "node:18", Ports: []string{"3000:3000"}, Volumes: []string{"cache:/cache"}},
+		},
+		Volumes: map[string]Volume{"cache": {}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `name: nodejs
+services:
+  runtime:
+    image: node:18
+    ports:
+    - 3000:3000
+    volumes:
+    - cache:/cache
+volumes:
+  cache: {}
+`, string(content))
+	}
+}
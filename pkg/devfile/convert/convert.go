@@ -0,0 +1,176 @@
+// Package convert converts devfiles between the yaml and Json formats, and can normalize them on the way,
+// so that semantically identical devfiles are converted into byte-equal documents that can be diffed or signed.
+//
+// The converted devfiles keep their schema version: they are not upgraded to the latest schema version,
+// and the normalization doesn't add default values for the fields that their schema version doesn't support.
+package convert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/devfile/unknown"
+	"github.com/devfile/api/v2/pkg/utils/canonical"
+	"github.com/devfile/api/v2/pkg/validation"
+	"github.com/hashicorp/go-multierror"
+	"sigs.k8s.io/yaml"
+)
+
+// Format is the serialization format of a devfile
+type Format string
+
+const (
+	// YAML is the yaml format, with the fields of each object sorted by name
+	YAML Format = "yaml"
+	// JSON is the Json format, indented with 2 spaces, with the fields of each object sorted by name
+	JSON Format = "json"
+)
+
+// Options contains the options of the conversion of a devfile
+type Options struct {
+	// To is the format of the converted devfile
+	To Format
+
+	// Normalize canonicalizes the devfile before converting it, as done by `canonical.Canonicalize`:
+	// its unions are normalized, its unset fields are set to their default values, its keyed lists are sorted by key,
+	// and its empty lists and maps are removed.
+	// A devfile that contains fields unknown to the API types of this library cannot be normalized, since they would be lost.
+	Normalize bool
+}
+
+// Convert converts the given devfile content (yaml or json) into the format of the given options.
+// Without normalization, the content of the devfile is kept as-is, including its unknown fields:
+// only its format and the order of the fields of its objects change.
+func Convert(data []byte, opts Options) ([]byte, error) {
+	if opts.To != YAML && opts.To != JSON {
+		return nil, fmt.Errorf("unknown format %q, should be one of: %s, %s", opts.To, YAML, JSON)
+	}
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Normalize {
+		if jsonData, err = normalize(jsonData); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.To == YAML {
+		return yaml.JSONToYAML(jsonData)
+	}
+	// round-trip through a generic value to sort the fields of the objects, as in yaml
+	var value interface{}
+	if err := unmarshalJSON(jsonData, &value); err != nil {
+		return nil, err
+	}
+	converted, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(converted, '\n'), nil
+}
+
+// normalize returns the canonical form of the given Json devfile, without the default values
+// of the fields that its schema version doesn't support
+func normalize(jsonData []byte) ([]byte, error) {
+	devfile, fields, err := unknown.ParseDevfile(jsonData)
+	if err != nil {
+		return nil, err
+	}
+	if fields.Len() > 0 {
+		return nil, fmt.Errorf("cannot normalize the devfile, since the following fields are unknown and would be lost: %s", strings.Join(fields.Paths(), ", "))
+	}
+
+	unsupportedBefore := map[string]bool{}
+	for _, path := range unsupportedFieldPaths(validation.ValidateFeatures(devfile)) {
+		unsupportedBefore[path] = true
+	}
+	if err := canonical.Canonicalize(devfile); err != nil {
+		return nil, err
+	}
+	normalized, err := json.Marshal(devfile)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if err := unmarshalJSON(normalized, &value); err != nil {
+		return nil, err
+	}
+	for _, path := range unsupportedFieldPaths(validation.ValidateFeatures(devfile)) {
+		if !unsupportedBefore[path] {
+			removePath(value, path)
+		}
+	}
+	return json.Marshal(value)
+}
+
+// unsupportedFieldPaths returns the paths of the features unsupported by the schema version of the devfile,
+// as reported by the given error of `validation.ValidateFeatures`
+func unsupportedFieldPaths(err error) []string {
+	errs := []error{err}
+	if merr, isMultiError := err.(*multierror.Error); isMultiError {
+		errs = merr.WrappedErrors()
+	}
+	var paths []string
+	for _, e := range errs {
+		var unsupported *validation.UnsupportedFeatureError
+		if errors.As(e, &unsupported) {
+			paths = append(paths, validation.ErrorPath(unsupported))
+		}
+	}
+	return paths
+}
+
+// removePath removes the field with the given path, such as `components[runtime].kubernetes.deployByDefault`,
+// from the given generic Json value. The elements of lists are designated by their name, by their id, or else by their index.
+func removePath(value interface{}, path string) {
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		field, key := segment, ""
+		if open := strings.Index(segment, "["); open >= 0 && strings.HasSuffix(segment, "]") {
+			field, key = segment[:open], segment[open+1:len(segment)-1]
+		}
+		object, isObject := value.(map[string]interface{})
+		if !isObject {
+			return
+		}
+		if i == len(segments)-1 && key == "" {
+			delete(object, field)
+			return
+		}
+		value = object[field]
+		if key != "" {
+			value = listElement(value, key)
+		}
+	}
+}
+
+// listElement returns the element of the given generic Json list with the given key, which is its name,
+// its id, or else its index, or nil if there is no such element
+func listElement(list interface{}, key string) interface{} {
+	elements, isList := list.([]interface{})
+	if !isList {
+		return nil
+	}
+	for _, element := range elements {
+		if object, isObject := element.(map[string]interface{}); isObject && (object["name"] == key || object["id"] == key) {
+			return element
+		}
+	}
+	if index, err := strconv.Atoi(key); err == nil && index >= 0 && index < len(elements) {
+		return elements[index]
+	}
+	return nil
+}
+
+// unmarshalJSON decodes the given Json data into the given generic value, keeping the numbers as-is
+func unmarshalJSON(data []byte, value *interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(value)
+}
@@ -0,0 +1,149 @@
+package convert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const yamlDevfile = `
+schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+  version: 1.0.0
+components:
+- name: runtime
+  container:
+    image: node:14
+    endpoints:
+    - name: http
+      targetPort: 3000
+- name: cache
+  volume: {}
+commands: []
+`
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		opts    Options
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "Yaml to Json keeps the content as-is",
+			input: yamlDevfile,
+			opts:  Options{To: JSON},
+			want: `{
+  "commands": [],
+  "components": [
+    {
+      "container": {
+        "endpoints": [
+          {
+            "name": "http",
+            "targetPort": 3000
+          }
+        ],
+        "image": "node:14"
+      },
+      "name": "runtime"
+    },
+    {
+      "name": "cache",
+      "volume": {}
+    }
+  ],
+  "metadata": {
+    "name": "nodejs",
+    "version": "1.0.0"
+  },
+  "schemaVersion": "2.1.0"
+}
+`,
+		},
+		{
+			name:  "Json to yaml keeps the unknown fields",
+			input: `{"schemaVersion": "2.2.0", "metadata": {"name": "nodejs"}, "unknownField": 1.50}`,
+			opts:  Options{To: YAML},
+			want: `metadata:
+  name: nodejs
+schemaVersion: 2.2.0
+unknownField: 1.5
+`,
+		},
+		{
+			name:  "Normalized devfile keeps its schema version",
+			input: yamlDevfile,
+			opts:  Options{To: YAML, Normalize: true},
+			want: `components:
+- componentType: Volume
+  name: cache
+  volume:
+    ephemeral: false
+- componentType: Container
+  container:
+    dedicatedPod: false
+    endpoints:
+    - exposure: public
+      name: http
+      protocol: http
+      secure: false
+      targetPort: 3000
+    image: node:14
+    runOnDemand: false
+    sourceMapping: /projects
+  name: runtime
+metadata:
+  name: nodejs
+  version: 1.0.0
+schemaVersion: 2.1.0
+`,
+		},
+		{
+			name:    "Unknown fields cannot be normalized",
+			input:   `{"schemaVersion": "2.2.0", "metadata": {"name": "nodejs"}, "unknownField": 1}`,
+			opts:    Options{To: JSON, Normalize: true},
+			wantErr: "cannot normalize the devfile, since the following fields are unknown and would be lost: unknownField",
+		},
+		{
+			name:    "Unknown format",
+			input:   yamlDevfile,
+			opts:    Options{To: "toml"},
+			wantErr: `unknown format "toml", should be one of: yaml, json`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert([]byte(tt.input), tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, string(got))
+			}
+		})
+	}
+}
+
+func TestNormalizedConversionIsStable(t *testing.T) {
+	normalizedYaml, err := Convert([]byte(yamlDevfile), Options{To: YAML, Normalize: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+	normalizedJson, err := Convert([]byte(yamlDevfile), Options{To: JSON, Normalize: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	renormalized, err := Convert(normalizedJson, Options{To: YAML, Normalize: true})
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(normalizedYaml), string(renormalized), "normalizing a normalized devfile should not change it")
+	}
+	fromYaml, err := Convert(normalizedYaml, Options{To: JSON})
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(normalizedJson), string(fromYaml), "the normalized yaml and Json devfiles should be equivalent")
+	}
+}
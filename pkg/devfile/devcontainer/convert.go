@@ -0,0 +1,540 @@
+package devcontainer
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/devfile"
+	"github.com/devfile/api/v2/pkg/devfile/flatten"
+	"github.com/devfile/api/v2/pkg/devfile/plan"
+)
+
+// ConvertedSchemaVersion is the schema version of the devfiles converted from devcontainer.json configurations
+const ConvertedSchemaVersion = "2.2.0"
+
+// defaultSourceMapping is the path where the project sources are mounted when a container doesn't set `sourceMapping`
+const defaultSourceMapping = "/projects"
+
+// UnmappedFeature is a feature of the converted configuration that has no equivalent in the result of the conversion,
+// and is dropped
+type UnmappedFeature struct {
+	// Path is the path of the feature in the converted configuration, such as `components[runtime].container.memoryLimit`
+	// for a devfile, or `features` for a devcontainer.json configuration
+	Path string
+	// Reason tells why the feature is not mapped
+	Reason string
+}
+
+func (f UnmappedFeature) String() string {
+	return f.Path + ": " + f.Reason
+}
+
+// FromDevfile converts the given flattened devfile to a devcontainer.json configuration.
+//
+// The development container is the container component of the default run command, or the first container component.
+// Its image, environment, exposed endpoints and volume mounts are mapped, and the exec commands bound to the `postStart` event
+// are mapped to the `postCreateCommand`. The other features of the devfile, such as the other components, are returned
+// as unmapped features.
+// An error is returned if the devfile has no container component, or if its `postStart` event cannot be resolved.
+func FromDevfile(flattened *flatten.FlattenedDevfile) (*DevContainer, []UnmappedFeature, error) {
+	main := mainContainer(&flattened.DevWorkspaceTemplateSpecContent)
+	if main == nil {
+		return nil, nil, errors.New("the devfile has no container component to convert to a development container")
+	}
+	c := &fromDevfileConverter{content: &flattened.DevWorkspaceTemplateSpecContent, main: main, mappedCommands: map[string]bool{}}
+
+	config := &DevContainer{Name: flattened.Metadata.DisplayName, Image: main.Container.Image}
+	if config.Name == "" {
+		config.Name = flattened.Metadata.Name
+	}
+	c.mapContainer(config)
+	if err := c.mapPostStart(config); err != nil {
+		return nil, nil, err
+	}
+	c.reportOthers()
+	return config, c.unmapped, nil
+}
+
+// mainContainer returns the component of the development container of the given devfile content,
+// or nil if it has no container component
+func mainContainer(content *v1alpha2.DevWorkspaceTemplateSpecContent) *v1alpha2.Component {
+	var first *v1alpha2.Component
+	for i := range content.Components {
+		if content.Components[i].Container != nil {
+			first = &content.Components[i]
+			break
+		}
+	}
+	for _, command := range content.Commands {
+		exec := command.Exec
+		if exec == nil || exec.Group == nil || exec.Group.Kind != v1alpha2.RunCommandGroupKind || !exec.Group.GetIsDefault() {
+			continue
+		}
+		for i := range content.Components {
+			if content.Components[i].Name == exec.Component && content.Components[i].Container != nil {
+				return &content.Components[i]
+			}
+		}
+	}
+	return first
+}
+
+type fromDevfileConverter struct {
+	content *v1alpha2.DevWorkspaceTemplateSpecContent
+	// main is the component of the development container
+	main *v1alpha2.Component
+	// mappedCommands are the ids of the commands mapped to the `postCreateCommand`, in lower case
+	mappedCommands map[string]bool
+	unmapped       []UnmappedFeature
+}
+
+func (c *fromDevfileConverter) report(path string, format string, args ...interface{}) {
+	c.unmapped = append(c.unmapped, UnmappedFeature{Path: path, Reason: fmt.Sprintf(format, args...)})
+}
+
+// mapContainer maps the container component of the development container
+func (c *fromDevfileConverter) mapContainer(config *DevContainer) {
+	container := c.main.Container
+	path := fmt.Sprintf("components[%s].container", c.main.Name)
+
+	for _, env := range container.Env {
+		if config.ContainerEnv == nil {
+			config.ContainerEnv = map[string]string{}
+		}
+		config.ContainerEnv[env.Name] = env.Value
+	}
+
+	for _, endpoint := range container.Endpoints {
+		if endpoint.Exposure == v1alpha2.NoneEndpointExposure {
+			c.report(fmt.Sprintf("%s.endpoints[%s]", path, endpoint.Name), "endpoints with the %q exposure are not forwarded", v1alpha2.NoneEndpointExposure)
+			continue
+		}
+		config.ForwardPorts = append(config.ForwardPorts, Port{Port: endpoint.TargetPort})
+		attributes := PortAttributes{Label: endpoint.Name}
+		switch endpoint.Protocol {
+		case "", v1alpha2.HTTPEndpointProtocol, v1alpha2.HTTPSEndpointProtocol:
+			attributes.Protocol = string(v1alpha2.HTTPEndpointProtocol)
+			if endpoint.Protocol == v1alpha2.HTTPSEndpointProtocol || endpoint.GetSecure() {
+				attributes.Protocol = string(v1alpha2.HTTPSEndpointProtocol)
+			}
+		default:
+			c.report(fmt.Sprintf("%s.endpoints[%s].protocol", path, endpoint.Name), "the %q protocol has no equivalent in forwarded port attributes", endpoint.Protocol)
+		}
+		if endpoint.Path != "" {
+			c.report(fmt.Sprintf("%s.endpoints[%s].path", path, endpoint.Name), "the paths of endpoints are not mapped")
+		}
+		if config.PortsAttributes == nil {
+			config.PortsAttributes = map[string]PortAttributes{}
+		}
+		config.PortsAttributes[strconv.Itoa(endpoint.TargetPort)] = attributes
+	}
+
+	for _, volumeMount := range container.VolumeMounts {
+		path := volumeMount.Path
+		if path == "" {
+			path = "/" + volumeMount.Name
+		}
+		config.Mounts = append(config.Mounts, Mount{Source: volumeMount.Name, Target: path, Type: "volume"})
+	}
+
+	if container.GetMountSources() {
+		config.WorkspaceFolder = container.SourceMapping
+		if config.WorkspaceFolder == "" {
+			config.WorkspaceFolder = defaultSourceMapping
+		}
+	}
+
+	if len(container.Command) > 0 || len(container.Args) > 0 {
+		c.report(path+".command", "the entrypoint of the development container is not mapped")
+	}
+	for field, value := range map[string]string{
+		"memoryLimit":   container.MemoryLimit,
+		"memoryRequest": container.MemoryRequest,
+		"cpuLimit":      container.CpuLimit,
+		"cpuRequest":    container.CpuRequest,
+	} {
+		if value != "" {
+			c.report(path+"."+field, "resource requirements are not mapped")
+		}
+	}
+}
+
+// mapPostStart maps the commands bound to the `postStart` event to the `postCreateCommand`
+func (c *fromDevfileConverter) mapPostStart(config *DevContainer) error {
+	steps, err := plan.ForEvent(c.content.Events, c.content.Commands, plan.PostStartEvent)
+	if err != nil {
+		return err
+	}
+	var mapped []LifecycleCommand
+	for _, step := range steps {
+		if command, ok := c.lifecycleCommand(step); ok {
+			mapped = append(mapped, command)
+		}
+	}
+	switch len(mapped) {
+	case 0:
+	case 1:
+		config.PostCreateCommand = &mapped[0]
+	default:
+		config.PostCreateCommand = &LifecycleCommand{CommandLine: sequence(mapped)}
+	}
+	return nil
+}
+
+// lifecycleCommand returns the lifecycle command equivalent to the given step, and false if the step cannot be mapped
+func (c *fromDevfileConverter) lifecycleCommand(step *plan.Step) (LifecycleCommand, bool) {
+	var command LifecycleCommand
+	if len(step.Steps) == 0 {
+		commandLine, ok := c.commandLine(step.Command)
+		if !ok {
+			return LifecycleCommand{}, false
+		}
+		command = LifecycleCommand{CommandLine: commandLine}
+	} else {
+		c.mappedCommands[strings.ToLower(step.Command)] = true
+		var subCommands []LifecycleCommand
+		for _, subStep := range step.Steps {
+			subCommand, ok := c.lifecycleCommand(subStep)
+			if !ok {
+				continue
+			}
+			if step.Parallel {
+				if command.Parallel == nil {
+					command.Parallel = map[string]LifecycleCommand{}
+				}
+				command.Parallel[subStep.Command] = subCommand
+			}
+			subCommands = append(subCommands, subCommand)
+		}
+		if len(subCommands) == 0 {
+			return LifecycleCommand{}, false
+		}
+		if step.Parallel && step.MaxConcurrency > 0 {
+			c.report(fmt.Sprintf("commands[%s].composite.maxConcurrency", step.Command), "the maximum concurrency of parallel commands is not mapped")
+		}
+		if !step.Parallel {
+			command.CommandLine = sequence(subCommands)
+		}
+	}
+	if step.ContinueOnError {
+		command = LifecycleCommand{CommandLine: "(" + command.Shell() + ") || true"}
+	}
+	return command, true
+}
+
+// commandLine returns the command line of the exec command with the given id, run in its working directory,
+// and false if the command is not an exec command of the development container
+func (c *fromDevfileConverter) commandLine(id string) (string, bool) {
+	for _, command := range c.content.Commands {
+		if !strings.EqualFold(command.Id, id) {
+			continue
+		}
+		path := fmt.Sprintf("commands[%s]", command.Id)
+		exec := command.Exec
+		if exec == nil {
+			c.report(path, "only exec commands can be mapped to the postCreateCommand")
+			return "", false
+		}
+		if exec.Component != c.main.Name {
+			c.report(path+".exec.component", "only the commands of the %q development container can be mapped to the postCreateCommand", c.main.Name)
+			return "", false
+		}
+		if len(exec.Env) > 0 {
+			c.report(path+".exec.env", "the environment of commands is not mapped")
+		}
+		c.mappedCommands[strings.ToLower(command.Id)] = true
+		if exec.WorkingDir == "" {
+			return exec.CommandLine, true
+		}
+		// the working directory is double-quoted so that the variables it references, such as $PROJECT_SOURCE, are expanded
+		return `cd "` + strings.ReplaceAll(exec.WorkingDir, `"`, `\"`) + `" && ` + exec.CommandLine, true
+	}
+	return "", false
+}
+
+// reportOthers reports the components, commands, events and projects that are not mapped
+func (c *fromDevfileConverter) reportOthers() {
+	for _, component := range c.content.Components {
+		if component.Name == c.main.Name {
+			continue
+		}
+		if component.Volume != nil && c.isMounted(component.Name) {
+			if component.Volume.Size != "" {
+				c.report(fmt.Sprintf("components[%s].volume.size", component.Name), "the size of volumes is not mapped")
+			}
+			continue
+		}
+		c.report(fmt.Sprintf("components[%s]", component.Name), "only the %q development container component is mapped", c.main.Name)
+	}
+	for _, command := range c.content.Commands {
+		if !c.mappedCommands[strings.ToLower(command.Id)] {
+			c.report(fmt.Sprintf("commands[%s]", command.Id), "only the commands bound to the postStart event are mapped")
+		}
+	}
+	if events := c.content.Events; events != nil {
+		for name, ids := range map[string][]string{
+			"preStart": events.PreStart,
+			"preStop":  events.PreStop,
+			"postStop": events.PostStop,
+		} {
+			if len(ids) > 0 {
+				c.report("events."+name, "only the postStart event is mapped")
+			}
+		}
+	}
+	for _, project := range c.content.Projects {
+		c.report(fmt.Sprintf("projects[%s]", project.Name), "projects are not mapped: a devcontainer.json configuration applies to an existing workspace folder")
+	}
+	for _, project := range c.content.StarterProjects {
+		c.report(fmt.Sprintf("starterProjects[%s]", project.Name), "starter projects are not mapped")
+	}
+	sort.SliceStable(c.unmapped, func(i, j int) bool {
+		return c.unmapped[i].Path < c.unmapped[j].Path
+	})
+}
+
+// isMounted returns true if the development container mounts the volume with the given name
+func (c *fromDevfileConverter) isMounted(volume string) bool {
+	for _, volumeMount := range c.main.Container.VolumeMounts {
+		if volumeMount.Name == volume {
+			return true
+		}
+	}
+	return false
+}
+
+// sequence returns the command line that runs the given commands sequentially, stopping at the first failure.
+// The command lines made of several commands are run in subshells, so that their operators and their changes
+// of directory don't affect the other commands.
+func sequence(commands []LifecycleCommand) string {
+	commandLines := make([]string, 0, len(commands))
+	for _, command := range commands {
+		commandLine := command.Shell()
+		if len(commands) > 1 && strings.ContainsAny(commandLine, "&|;") {
+			commandLine = "(" + commandLine + ")"
+		}
+		commandLines = append(commandLines, commandLine)
+	}
+	return strings.Join(commandLines, " && ")
+}
+
+// ToDevfile converts the given devcontainer.json configuration to a devfile, best-effort.
+//
+// The development container is converted to a container component named `devcontainer`, whose image is built by
+// an image component when the configuration has a `build` instead of an `image`. The forwarded ports are converted
+// to endpoints, the volume mounts to volume components, and the `postCreateCommand` to exec commands bound to the
+// `postStart` event. The other fields of the configuration, such as `features`, are returned as unmapped features.
+// An error is returned if the configuration has neither an image nor a Dockerfile.
+func ToDevfile(config *DevContainer) (*v1alpha2.Devfile, []UnmappedFeature, error) {
+	c := &toDevfileConverter{}
+	container, err := c.container(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := &v1alpha2.Devfile{DevfileHeader: devfile.DevfileHeader{SchemaVersion: ConvertedSchemaVersion}}
+	result.Metadata.Name = resourceName(config.Name, "devcontainer")
+	result.Metadata.DisplayName = config.Name
+	result.Components = append([]v1alpha2.Component{container}, c.components...)
+	if config.PostCreateCommand != nil {
+		c.postCreateCommand(*config.PostCreateCommand, container.Name, config.WorkspaceFolder != "")
+		result.Events = &v1alpha2.Events{DevWorkspaceEvents: v1alpha2.DevWorkspaceEvents{PostStart: []string{postCreateCommandId}}}
+	}
+	result.Commands = c.commands
+
+	if len(config.RemoteEnv) > 0 {
+		c.report("remoteEnv", "the environment of the processes that connect to the development container is not mapped")
+	}
+	others := make([]string, 0, len(config.Other))
+	for name := range config.Other {
+		others = append(others, name)
+	}
+	sort.Strings(others)
+	for _, name := range others {
+		c.report(name, "the %q field has no devfile equivalent", name)
+	}
+	return result, c.unmapped, nil
+}
+
+const (
+	// devContainerComponentName is the name of the container component converted from the development container
+	devContainerComponentName = "devcontainer"
+	// devContainerImageComponentName is the name of the image component that builds the image of the development container
+	devContainerImageComponentName = "devcontainer-image"
+	// postCreateCommandId is the id of the command converted from the `postCreateCommand`
+	postCreateCommandId = "postcreate"
+)
+
+type toDevfileConverter struct {
+	// components are the components converted from the configuration, other than the development container
+	components []v1alpha2.Component
+	commands   []v1alpha2.Command
+	unmapped   []UnmappedFeature
+}
+
+func (c *toDevfileConverter) report(path string, format string, args ...interface{}) {
+	c.unmapped = append(c.unmapped, UnmappedFeature{Path: path, Reason: fmt.Sprintf(format, args...)})
+}
+
+// container returns the container component of the development container, and adds the components it depends on
+func (c *toDevfileConverter) container(config *DevContainer) (v1alpha2.Component, error) {
+	container := &v1alpha2.ContainerComponent{Container: v1alpha2.Container{Image: config.Image}}
+	if config.Image == "" {
+		if config.Build == nil || config.Build.Dockerfile == "" {
+			return v1alpha2.Component{}, errors.New("the devcontainer.json configuration has neither an image nor a Dockerfile to build the development container")
+		}
+		container.Image = devContainerComponentName
+		c.components = append(c.components, dockerfileComponent(config.Build))
+	} else if config.Build != nil {
+		c.report("build", "the build of the image is ignored since an image is set")
+	}
+
+	names := make([]string, 0, len(config.ContainerEnv))
+	for name := range config.ContainerEnv {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		container.Env = append(container.Env, v1alpha2.EnvVar{Name: name, Value: config.ContainerEnv[name]})
+	}
+
+	endpointNames := map[string]bool{}
+	for i, port := range config.ForwardPorts {
+		if port.Host != "" {
+			c.report(fmt.Sprintf("forwardPorts[%d]", i), "only the ports of the development container are mapped, not the ports of %q", port.Host)
+			continue
+		}
+		attributes := config.PortsAttributes[strconv.Itoa(port.Port)]
+		endpoint := v1alpha2.Endpoint{
+			Name:       uniqueName(resourceName(attributes.Label, "port-"+strconv.Itoa(port.Port)), endpointNames),
+			TargetPort: port.Port,
+		}
+		switch attributes.Protocol {
+		case "":
+		case string(v1alpha2.HTTPEndpointProtocol), string(v1alpha2.HTTPSEndpointProtocol):
+			endpoint.Protocol = v1alpha2.EndpointProtocol(attributes.Protocol)
+		default:
+			c.report(fmt.Sprintf("portsAttributes[%d].protocol", port.Port), "the %q protocol is not a devfile endpoint protocol", attributes.Protocol)
+		}
+		container.Endpoints = append(container.Endpoints, endpoint)
+	}
+
+	volumeNames := map[string]bool{}
+	for i, mount := range config.Mounts {
+		if mount.Type != "" && mount.Type != "volume" {
+			c.report(fmt.Sprintf("mounts[%d]", i), "only volume mounts are mapped, not %s mounts", mount.Type)
+			continue
+		}
+		if mount.Source == "" {
+			c.report(fmt.Sprintf("mounts[%d]", i), "anonymous volume mounts are not mapped")
+			continue
+		}
+		name := uniqueName(resourceName(mount.Source, "volume"), volumeNames)
+		container.VolumeMounts = append(container.VolumeMounts, v1alpha2.VolumeMount{Name: name, Path: mount.Target})
+		c.components = append(c.components, v1alpha2.Component{
+			Name:           name,
+			ComponentUnion: v1alpha2.ComponentUnion{Volume: &v1alpha2.VolumeComponent{}},
+		})
+	}
+
+	container.SourceMapping = config.WorkspaceFolder
+	return v1alpha2.Component{
+		Name:           devContainerComponentName,
+		ComponentUnion: v1alpha2.ComponentUnion{Container: container},
+	}, nil
+}
+
+// dockerfileComponent returns the image component that builds the image of the development container
+func dockerfileComponent(build *Build) v1alpha2.Component {
+	dockerfile := &v1alpha2.DockerfileImage{
+		DockerfileSrc: v1alpha2.DockerfileSrc{Uri: build.Dockerfile},
+		Dockerfile:    v1alpha2.Dockerfile{BuildContext: build.Context},
+	}
+	names := make([]string, 0, len(build.Args))
+	for name := range build.Args {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		dockerfile.Args = append(dockerfile.Args, name+"="+build.Args[name])
+	}
+	return v1alpha2.Component{
+		Name: devContainerImageComponentName,
+		ComponentUnion: v1alpha2.ComponentUnion{
+			Image: &v1alpha2.ImageComponent{
+				Image: v1alpha2.Image{
+					ImageName:  devContainerComponentName,
+					ImageUnion: v1alpha2.ImageUnion{Dockerfile: dockerfile},
+				},
+			},
+		},
+	}
+}
+
+// postCreateCommand adds the commands converted from the given `postCreateCommand`, run in the given component.
+// The commands run in parallel are converted to exec commands run by a parallel composite command.
+func (c *toDevfileConverter) postCreateCommand(command LifecycleCommand, component string, inSources bool) {
+	execCommand := func(id string, commandLine string) v1alpha2.Command {
+		exec := &v1alpha2.ExecCommand{CommandLine: commandLine, Component: component}
+		if inSources {
+			exec.WorkingDir = "${PROJECT_SOURCE}"
+		}
+		return v1alpha2.Command{Id: id, CommandUnion: v1alpha2.CommandUnion{Exec: exec}}
+	}
+	if len(command.Parallel) == 0 {
+		c.commands = append(c.commands, execCommand(postCreateCommandId, command.Shell()))
+		return
+	}
+
+	names := make([]string, 0, len(command.Parallel))
+	for name := range command.Parallel {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parallel := true
+	composite := &v1alpha2.CompositeCommand{Parallel: &parallel}
+	ids := map[string]bool{postCreateCommandId: true}
+	for _, name := range names {
+		id := uniqueName(resourceName(postCreateCommandId+"-"+name, postCreateCommandId), ids)
+		c.commands = append(c.commands, execCommand(id, command.Parallel[name].Shell()))
+		composite.Commands = append(composite.Commands, id)
+	}
+	c.commands = append(c.commands, v1alpha2.Command{Id: postCreateCommandId, CommandUnion: v1alpha2.CommandUnion{Composite: composite}})
+}
+
+// resourceName returns the given name as a valid devfile name: lower case alphanumeric characters or '-',
+// starting and ending with an alphanumeric character, with at most 63 characters.
+// It returns the given default name if there is no alphanumeric character in the given name.
+func resourceName(name string, defaultName string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			builder.WriteRune(r)
+		} else if builder.Len() > 0 && !strings.HasSuffix(builder.String(), "-") {
+			builder.WriteRune('-')
+		}
+	}
+	result := builder.String()
+	if len(result) > 63 {
+		result = result[:63]
+	}
+	result = strings.TrimRight(result, "-")
+	if result == "" {
+		return defaultName
+	}
+	return result
+}
+
+// uniqueName returns the given name, suffixed with a number if it is already in the given used names, and adds it to them
+func uniqueName(name string, used map[string]bool) string {
+	unique := name
+	for i := 2; used[unique]; i++ {
+		unique = name + "-" + strconv.Itoa(i)
+	}
+	used[unique] = true
+	return unique
+}
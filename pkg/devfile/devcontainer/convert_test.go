@@ -0,0 +1,269 @@
+package devcontainer
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/devfile/flatten"
+	"github.com/stretchr/testify/assert"
+)
+
+const goDevfile = `
+schemaVersion: 2.2.0
+metadata:
+  name: go
+  displayName: Go Runtime
+components:
+- name: tools
+  container:
+    image: registry.access.redhat.com/ubi8/go-toolset
+    memoryLimit: 1Gi
+    env:
+    - name: GOCACHE
+      value: /cache/go
+    endpoints:
+    - name: http
+      targetPort: 8080
+    - name: debug
+      targetPort: 5858
+      exposure: none
+    volumeMounts:
+    - name: cache
+      path: /cache
+- name: cache
+  volume: {}
+- name: db
+  container:
+    image: postgres
+    mountSources: false
+commands:
+- id: download
+  exec:
+    component: tools
+    commandLine: go mod download
+    workingDir: ${PROJECT_SOURCE}
+- id: init-db
+  exec:
+    component: db
+    commandLine: createdb app
+- id: setup
+  composite:
+    commands: [download, init-db]
+- id: run
+  exec:
+    component: tools
+    commandLine: go run .
+    group:
+      kind: run
+      isDefault: true
+events:
+  postStart: [setup]
+`
+
+func TestFromDevfile(t *testing.T) {
+	flattened, _, err := flatten.ValidateAndFlatten([]byte(goDevfile), flatten.ResolveOptions{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	config, unmapped, err := FromDevfile(&flattened)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, &DevContainer{
+		Name:              "Go Runtime",
+		Image:             "registry.access.redhat.com/ubi8/go-toolset",
+		ContainerEnv:      map[string]string{"GOCACHE": "/cache/go"},
+		ForwardPorts:      []Port{{Port: 8080}},
+		PortsAttributes:   map[string]PortAttributes{"8080": {Label: "http", Protocol: "http"}},
+		Mounts:            []Mount{{Source: "cache", Target: "/cache", Type: "volume"}},
+		WorkspaceFolder:   "/projects",
+		PostCreateCommand: &LifecycleCommand{CommandLine: `cd "${PROJECT_SOURCE}" && go mod download`},
+	}, config)
+	assert.Equal(t, []UnmappedFeature{
+		{Path: "commands[init-db]", Reason: "only the commands bound to the postStart event are mapped"},
+		{Path: "commands[init-db].exec.component", Reason: `only the commands of the "tools" development container can be mapped to the postCreateCommand`},
+		{Path: "commands[run]", Reason: "only the commands bound to the postStart event are mapped"},
+		{Path: "components[db]", Reason: `only the "tools" development container component is mapped`},
+		{Path: "components[tools].container.endpoints[debug]", Reason: `endpoints with the "none" exposure are not forwarded`},
+		{Path: "components[tools].container.memoryLimit", Reason: "resource requirements are not mapped"},
+	}, unmapped)
+}
+
+func TestFromDevfilePostStart(t *testing.T) {
+	parallel := true
+	execCommand := func(id string, commandLine string) v1alpha2.Command {
+		return v1alpha2.Command{Id: id, CommandUnion: v1alpha2.CommandUnion{
+			Exec: &v1alpha2.ExecCommand{CommandLine: commandLine, Component: "tools"},
+		}}
+	}
+	compositeCommand := func(id string, composite v1alpha2.CompositeCommand) v1alpha2.Command {
+		return v1alpha2.Command{Id: id, CommandUnion: v1alpha2.CommandUnion{Composite: &composite}}
+	}
+
+	tests := []struct {
+		name      string
+		commands  []v1alpha2.Command
+		postStart []string
+		want      *LifecycleCommand
+		wantErr   string
+	}{
+		{
+			name:      "No postStart command",
+			commands:  []v1alpha2.Command{execCommand("build", "make")},
+			postStart: nil,
+			want:      nil,
+		},
+		{
+			name:      "Several postStart commands",
+			commands:  []v1alpha2.Command{execCommand("deps", "make deps"), execCommand("build", "make")},
+			postStart: []string{"deps", "build"},
+			want:      &LifecycleCommand{CommandLine: "make deps && make"},
+		},
+		{
+			name: "Parallel composite command",
+			commands: []v1alpha2.Command{
+				execCommand("deps", "make deps"),
+				execCommand("build", "make"),
+				compositeCommand("setup", v1alpha2.CompositeCommand{Commands: []string{"deps", "build"}, Parallel: &parallel}),
+			},
+			postStart: []string{"setup"},
+			want: &LifecycleCommand{Parallel: map[string]LifecycleCommand{
+				"deps":  {CommandLine: "make deps"},
+				"build": {CommandLine: "make"},
+			}},
+		},
+		{
+			name: "Parallel composite command followed by another command",
+			commands: []v1alpha2.Command{
+				execCommand("deps", "make deps"),
+				execCommand("build", "make"),
+				execCommand("test", "make test"),
+				compositeCommand("setup", v1alpha2.CompositeCommand{Commands: []string{"deps", "build"}, Parallel: &parallel}),
+			},
+			postStart: []string{"setup", "test"},
+			want:      &LifecycleCommand{CommandLine: "((make) & (make deps) & wait) && make test"},
+		},
+		{
+			name: "Sub-command that can fail",
+			commands: []v1alpha2.Command{
+				execCommand("lint", "make lint"),
+				execCommand("build", "make"),
+				compositeCommand("setup", v1alpha2.CompositeCommand{Commands: []string{"lint", "build"}, ContinueOnError: []string{"lint"}}),
+			},
+			postStart: []string{"setup"},
+			want:      &LifecycleCommand{CommandLine: "((make lint) || true) && make"},
+		},
+		{
+			name:      "Undefined postStart command",
+			postStart: []string{"setup"},
+			wantErr:   `failed to plan the postStart event: the command "setup" does not exist in the devfile`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flattened := &flatten.FlattenedDevfile{}
+			flattened.Components = []v1alpha2.Component{{
+				Name:           "tools",
+				ComponentUnion: v1alpha2.ComponentUnion{Container: &v1alpha2.ContainerComponent{Container: v1alpha2.Container{Image: "golang"}}},
+			}}
+			flattened.Commands = tt.commands
+			flattened.Events = &v1alpha2.Events{DevWorkspaceEvents: v1alpha2.DevWorkspaceEvents{PostStart: tt.postStart}}
+
+			config, _, err := FromDevfile(flattened)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, config.PostCreateCommand)
+			}
+		})
+	}
+}
+
+func TestFromDevfileWithoutContainer(t *testing.T) {
+	_, _, err := FromDevfile(&flatten.FlattenedDevfile{})
+	assert.EqualError(t, err, "the devfile has no container component to convert to a development container")
+}
+
+func TestToDevfile(t *testing.T) {
+	config, err := Parse([]byte(`{
+	"name": "Node.js & Mongo DB",
+	"build": {"dockerfile": "Dockerfile", "context": "..", "args": {"VARIANT": "18"}},
+	"containerEnv": {"NODE_ENV": "development"},
+	"forwardPorts": [3000, 3000, "db:27017"],
+	"portsAttributes": {"3000": {"label": "Web App", "protocol": "https"}},
+	"mounts": ["source=node_modules,target=/workspace/node_modules,type=volume", "source=${localEnv:HOME},target=/home,type=bind"],
+	"workspaceFolder": "/workspace",
+	"postCreateCommand": {"install": "npm install", "seed": ["npm", "run", "seed"]},
+	"features": {"ghcr.io/devcontainers/features/go:1": {}}
+}`))
+	if !assert.NoError(t, err) {
+		return
+	}
+	devfile, unmapped, err := ToDevfile(config)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	parallel := true
+	expected := &v1alpha2.Devfile{}
+	expected.SchemaVersion = "2.2.0"
+	expected.Metadata.Name = "node-js-mongo-db"
+	expected.Metadata.DisplayName = "Node.js & Mongo DB"
+	expected.Components = []v1alpha2.Component{
+		{
+			Name: "devcontainer",
+			ComponentUnion: v1alpha2.ComponentUnion{Container: &v1alpha2.ContainerComponent{
+				Container: v1alpha2.Container{
+					Image:         "devcontainer",
+					Env:           []v1alpha2.EnvVar{{Name: "NODE_ENV", Value: "development"}},
+					VolumeMounts:  []v1alpha2.VolumeMount{{Name: "node-modules", Path: "/workspace/node_modules"}},
+					SourceMapping: "/workspace",
+				},
+				Endpoints: []v1alpha2.Endpoint{
+					{Name: "web-app", TargetPort: 3000, Protocol: v1alpha2.HTTPSEndpointProtocol},
+					{Name: "web-app-2", TargetPort: 3000, Protocol: v1alpha2.HTTPSEndpointProtocol},
+				},
+			}},
+		},
+		{
+			Name: "devcontainer-image",
+			ComponentUnion: v1alpha2.ComponentUnion{Image: &v1alpha2.ImageComponent{Image: v1alpha2.Image{
+				ImageName: "devcontainer",
+				ImageUnion: v1alpha2.ImageUnion{Dockerfile: &v1alpha2.DockerfileImage{
+					DockerfileSrc: v1alpha2.DockerfileSrc{Uri: "Dockerfile"},
+					Dockerfile:    v1alpha2.Dockerfile{BuildContext: "..", Args: []string{"VARIANT=18"}},
+				}},
+			}}},
+		},
+		{
+			Name:           "node-modules",
+			ComponentUnion: v1alpha2.ComponentUnion{Volume: &v1alpha2.VolumeComponent{}},
+		},
+	}
+	expected.Commands = []v1alpha2.Command{
+		{Id: "postcreate-install", CommandUnion: v1alpha2.CommandUnion{Exec: &v1alpha2.ExecCommand{
+			CommandLine: "npm install", Component: "devcontainer", WorkingDir: "${PROJECT_SOURCE}",
+		}}},
+		{Id: "postcreate-seed", CommandUnion: v1alpha2.CommandUnion{Exec: &v1alpha2.ExecCommand{
+			CommandLine: "npm run seed", Component: "devcontainer", WorkingDir: "${PROJECT_SOURCE}",
+		}}},
+		{Id: "postcreate", CommandUnion: v1alpha2.CommandUnion{Composite: &v1alpha2.CompositeCommand{
+			Commands: []string{"postcreate-install", "postcreate-seed"}, Parallel: &parallel,
+		}}},
+	}
+	expected.Events = &v1alpha2.Events{DevWorkspaceEvents: v1alpha2.DevWorkspaceEvents{PostStart: []string{"postcreate"}}}
+	assert.Equal(t, expected, devfile)
+
+	assert.Equal(t, []UnmappedFeature{
+		{Path: "forwardPorts[2]", Reason: `only the ports of the development container are mapped, not the ports of "db"`},
+		{Path: "mounts[1]", Reason: "only volume mounts are mapped, not bind mounts"},
+		{Path: "features", Reason: `the "features" field has no devfile equivalent`},
+	}, unmapped)
+}
+
+func TestToDevfileWithoutImage(t *testing.T) {
+	_, _, err := ToDevfile(&DevContainer{Name: "empty"})
+	assert.EqualError(t, err, "the devcontainer.json configuration has neither an image nor a Dockerfile to build the development container")
+}
@@ -0,0 +1,337 @@
+// Package devcontainer converts flattened devfiles to devcontainer.json configurations, and devcontainer.json configurations
+// back to devfiles, to interoperate with the tools of the devcontainer ecosystem (https://containers.dev).
+//
+// Only a subset of both formats can be mapped: the image, environment, ports, volume mounts and post-creation commands
+// of a single development container. The conversions are best-effort, and return the features that could not be mapped,
+// so that the callers can report them instead of silently dropping them.
+package devcontainer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DevContainer is the subset of a devcontainer.json configuration that can be mapped to a devfile,
+// as described by https://containers.dev/implementors/json_reference/
+type DevContainer struct {
+	Name string `json:"name,omitempty"`
+	// Image is the image of the development container
+	Image string `json:"image,omitempty"`
+	// Build describes how to build the image of the development container, when no image is set
+	Build *Build `json:"build,omitempty"`
+	// ContainerEnv is the environment of the development container
+	ContainerEnv map[string]string `json:"containerEnv,omitempty"`
+	// RemoteEnv is the environment of the tools and processes that connect to the development container
+	RemoteEnv map[string]string `json:"remoteEnv,omitempty"`
+	// ForwardPorts are the ports of the development container that are made available locally
+	ForwardPorts []Port `json:"forwardPorts,omitempty"`
+	// PortsAttributes are the attributes of the forwarded ports, indexed by port number
+	PortsAttributes map[string]PortAttributes `json:"portsAttributes,omitempty"`
+	// Mounts are the mounts of the development container
+	Mounts []Mount `json:"mounts,omitempty"`
+	// WorkspaceFolder is the path of the opened folder in the development container
+	WorkspaceFolder string `json:"workspaceFolder,omitempty"`
+	// PostCreateCommand is the command run once the development container has been created
+	PostCreateCommand *LifecycleCommand `json:"postCreateCommand,omitempty"`
+
+	// Other contains the fields of the configuration that are not mapped, such as `features` or `customizations`, indexed by name
+	Other map[string]json.RawMessage `json:"-"`
+}
+
+// Build describes how to build the image of the development container
+type Build struct {
+	// Dockerfile is the path of the Dockerfile, relative to the devcontainer.json file
+	Dockerfile string `json:"dockerfile,omitempty"`
+	// Context is the path of the build context, relative to the devcontainer.json file
+	Context string `json:"context,omitempty"`
+	// Args are the build arguments
+	Args map[string]string `json:"args,omitempty"`
+}
+
+// Port is a forwarded port: a port of the development container, or a port of another host, such as `db:5432`
+type Port struct {
+	// Host is the host of the port, if it is not a port of the development container
+	Host string
+	Port int
+}
+
+// MarshalJSON writes the port as a number, or as a `host:port` string for a port of another host
+func (p Port) MarshalJSON() ([]byte, error) {
+	if p.Host == "" {
+		return json.Marshal(p.Port)
+	}
+	return json.Marshal(p.Host + ":" + strconv.Itoa(p.Port))
+}
+
+// UnmarshalJSON reads a port from a number or a `host:port` string
+func (p *Port) UnmarshalJSON(data []byte) error {
+	var port int
+	if err := json.Unmarshal(data, &port); err == nil {
+		*p = Port{Port: port}
+		return nil
+	}
+	var hostPort string
+	if err := json.Unmarshal(data, &hostPort); err != nil {
+		return fmt.Errorf("invalid forwarded port %s: it should be a number or a host:port string", string(data))
+	}
+	separator := strings.LastIndex(hostPort, ":")
+	if separator < 0 {
+		return fmt.Errorf("invalid forwarded port %q: it should be a number or a host:port string", hostPort)
+	}
+	port, err := strconv.Atoi(hostPort[separator+1:])
+	if err != nil {
+		return fmt.Errorf("invalid forwarded port %q: it should be a number or a host:port string", hostPort)
+	}
+	*p = Port{Host: hostPort[:separator], Port: port}
+	return nil
+}
+
+// PortAttributes are the attributes of a forwarded port
+type PortAttributes struct {
+	// Label is the name of the port shown to users
+	Label string `json:"label,omitempty"`
+	// Protocol is the protocol of the port, `http` or `https`
+	Protocol string `json:"protocol,omitempty"`
+}
+
+// Mount is a mount of the development container
+type Mount struct {
+	// Source is the name of the volume, or the path on the host for a bind mount
+	Source string `json:"source,omitempty"`
+	// Target is the path of the mount in the development container
+	Target string `json:"target"`
+	// Type is the type of the mount, such as `volume` or `bind`
+	Type string `json:"type,omitempty"`
+}
+
+// UnmarshalJSON reads a mount from an object, or from a Docker `--mount` flag value such as `source=cache,target=/cache,type=volume`
+func (m *Mount) UnmarshalJSON(data []byte) error {
+	var flag string
+	if err := json.Unmarshal(data, &flag); err != nil {
+		type mount Mount
+		return json.Unmarshal(data, (*mount)(m))
+	}
+	*m = Mount{}
+	for _, option := range strings.Split(flag, ",") {
+		key, value := option, ""
+		if separator := strings.Index(option, "="); separator >= 0 {
+			key, value = option[:separator], option[separator+1:]
+		}
+		switch key {
+		case "source", "src":
+			m.Source = value
+		case "target", "destination", "dst":
+			m.Target = value
+		case "type":
+			m.Type = value
+		}
+	}
+	return nil
+}
+
+// LifecycleCommand is a devcontainer.json lifecycle command: a command line run in a shell, the arguments of a command run without shell,
+// or named commands run in parallel
+type LifecycleCommand struct {
+	CommandLine string
+	Args        []string
+	// Parallel contains the commands run in parallel, indexed by name
+	Parallel map[string]LifecycleCommand
+}
+
+// MarshalJSON writes the command as a string, an array of arguments, or an object of named commands
+func (c LifecycleCommand) MarshalJSON() ([]byte, error) {
+	switch {
+	case len(c.Parallel) > 0:
+		return json.Marshal(c.Parallel)
+	case len(c.Args) > 0:
+		return json.Marshal(c.Args)
+	default:
+		return json.Marshal(c.CommandLine)
+	}
+}
+
+// UnmarshalJSON reads a command from a string, an array of arguments, or an object of named commands
+func (c *LifecycleCommand) UnmarshalJSON(data []byte) error {
+	*c = LifecycleCommand{}
+	if err := json.Unmarshal(data, &c.CommandLine); err == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, &c.Args); err == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, &c.Parallel); err != nil {
+		return fmt.Errorf("invalid lifecycle command %s: it should be a string, an array of strings, or an object of commands", string(data))
+	}
+	return nil
+}
+
+// Shell returns the command as a shell command line, quoting the arguments of a command run without shell.
+// The commands run in parallel are sorted by name, and run in the background.
+func (c LifecycleCommand) Shell() string {
+	switch {
+	case len(c.Parallel) > 0:
+		names := make([]string, 0, len(c.Parallel))
+		for name := range c.Parallel {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		var commands []string
+		for _, name := range names {
+			commands = append(commands, "("+c.Parallel[name].Shell()+") &")
+		}
+		return strings.Join(append(commands, "wait"), " ")
+	case len(c.Args) > 0:
+		quoted := make([]string, 0, len(c.Args))
+		for _, arg := range c.Args {
+			quoted = append(quoted, shellQuote(arg))
+		}
+		return strings.Join(quoted, " ")
+	default:
+		return c.CommandLine
+	}
+}
+
+// knownFields are the fields of the devcontainer.json configuration that are mapped by DevContainer
+var knownFields = map[string]bool{
+	"name":              true,
+	"image":             true,
+	"build":             true,
+	"containerEnv":      true,
+	"remoteEnv":         true,
+	"forwardPorts":      true,
+	"portsAttributes":   true,
+	"mounts":            true,
+	"workspaceFolder":   true,
+	"postCreateCommand": true,
+}
+
+// Parse parses the given devcontainer.json content, which can contain comments and trailing commas.
+// The fields that are not mapped are kept in the `Other` field of the result.
+func Parse(data []byte) (*DevContainer, error) {
+	data = stripJSONC(data)
+	config := &DevContainer{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("invalid devcontainer.json content: %w", err)
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("invalid devcontainer.json content: %w", err)
+	}
+	for name, value := range fields {
+		if !knownFields[name] {
+			if config.Other == nil {
+				config.Other = map[string]json.RawMessage{}
+			}
+			config.Other[name] = value
+		}
+	}
+	return config, nil
+}
+
+// Marshal returns the devcontainer.json content of the given configuration, indented, with the fields that are not mapped
+func Marshal(config *DevContainer) ([]byte, error) {
+	content, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.Other) > 0 {
+		fields := map[string]json.RawMessage{}
+		if err := json.Unmarshal(content, &fields); err != nil {
+			return nil, err
+		}
+		for name, value := range config.Other {
+			if !knownFields[name] {
+				fields[name] = value
+			}
+		}
+		if content, err = json.Marshal(fields); err != nil {
+			return nil, err
+		}
+	}
+	indented := &bytes.Buffer{}
+	if err := json.Indent(indented, content, "", "  "); err != nil {
+		return nil, err
+	}
+	indented.WriteString("\n")
+	return indented.Bytes(), nil
+}
+
+// stripJSONC removes the comments and the trailing commas of the given JSON with comments content,
+// keeping the line breaks of the comments so that the positions of the syntax errors are preserved
+func stripJSONC(data []byte) []byte {
+	stripped := make([]byte, 0, len(data))
+	inString := false
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case inString:
+			stripped = append(stripped, c)
+			if c == '\\' && i+1 < len(data) {
+				i++
+				stripped = append(stripped, data[i])
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+			stripped = append(stripped, c)
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			if i < len(data) {
+				stripped = append(stripped, '\n')
+			}
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			i += 2
+			for i < len(data) && !(data[i] == '*' && i+1 < len(data) && data[i+1] == '/') {
+				if data[i] == '\n' {
+					stripped = append(stripped, '\n')
+				}
+				i++
+			}
+			i++
+		case c == ',' && closesAfterBlanks(data[i+1:]):
+			// trailing comma
+		default:
+			stripped = append(stripped, c)
+		}
+	}
+	return stripped
+}
+
+// closesAfterBlanks returns true if the given content starts with a closing bracket or brace, after blanks and comments
+func closesAfterBlanks(data []byte) bool {
+	for i := 0; i < len(data); i++ {
+		switch {
+		case data[i] == ' ' || data[i] == '\t' || data[i] == '\r' || data[i] == '\n':
+		case data[i] == '/' && i+1 < len(data) && data[i+1] == '/':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+		case data[i] == '/' && i+1 < len(data) && data[i+1] == '*':
+			i += 2
+			for i < len(data) && !(data[i] == '*' && i+1 < len(data) && data[i+1] == '/') {
+				i++
+			}
+			i++
+		default:
+			return data[i] == '}' || data[i] == ']'
+		}
+	}
+	return false
+}
+
+// shellQuote quotes the given argument for a POSIX shell, if needed
+func shellQuote(arg string) string {
+	if arg != "" && strings.IndexFunc(arg, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./=:@%+,", r))
+	}) < 0 {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
@@ -0,0 +1,153 @@
+package devcontainer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    *DevContainer
+		wantErr string
+	}{
+		{
+			name: "Configuration with comments and trailing commas",
+			content: `{
+	// The development container
+	"name": "Go // tools",
+	"image": "mcr.microsoft.com/devcontainers/go:1", /* the base image */
+	"containerEnv": {"GOFLAGS": "-mod=vendor",},
+}`,
+			want: &DevContainer{
+				Name:         "Go // tools",
+				Image:        "mcr.microsoft.com/devcontainers/go:1",
+				ContainerEnv: map[string]string{"GOFLAGS": "-mod=vendor"},
+			},
+		},
+		{
+			name: "Ports, mounts and commands in all their forms",
+			content: `{
+	"image": "node:18",
+	"forwardPorts": [3000, "db:5432"],
+	"mounts": ["source=node_modules,target=/workspace/node_modules,type=volume", {"source": "/tmp", "target": "/host-tmp", "type": "bind"}],
+	"postCreateCommand": {"install": "npm install", "db": ["createdb", "my app"]}
+}`,
+			want: &DevContainer{
+				Image:        "node:18",
+				ForwardPorts: []Port{{Port: 3000}, {Host: "db", Port: 5432}},
+				Mounts: []Mount{
+					{Source: "node_modules", Target: "/workspace/node_modules", Type: "volume"},
+					{Source: "/tmp", Target: "/host-tmp", Type: "bind"},
+				},
+				PostCreateCommand: &LifecycleCommand{Parallel: map[string]LifecycleCommand{
+					"install": {CommandLine: "npm install"},
+					"db":      {Args: []string{"createdb", "my app"}},
+				}},
+			},
+		},
+		{
+			name:    "Fields that are not mapped",
+			content: `{"image": "node:18", "features": {"ghcr.io/devcontainers/features/go:1": {}}, "runArgs": ["--privileged"]}`,
+			want: &DevContainer{
+				Image: "node:18",
+				Other: map[string]json.RawMessage{
+					"features": json.RawMessage(`{"ghcr.io/devcontainers/features/go:1": {}}`),
+					"runArgs":  json.RawMessage(`["--privileged"]`),
+				},
+			},
+		},
+		{
+			name:    "Invalid port",
+			content: `{"forwardPorts": ["http"]}`,
+			wantErr: `invalid devcontainer.json content: invalid forwarded port "http": it should be a number or a host:port string`,
+		},
+		{
+			name:    "Invalid JSON",
+			content: `{"image": }`,
+			wantErr: "invalid devcontainer.json content: invalid character '}' looking for beginning of value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse([]byte(tt.content))
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	config := &DevContainer{
+		Image:             "node:18",
+		ForwardPorts:      []Port{{Port: 3000}, {Host: "db", Port: 5432}},
+		PostCreateCommand: &LifecycleCommand{Args: []string{"npm", "install"}},
+		Other:             map[string]json.RawMessage{"runArgs": json.RawMessage(`["--privileged"]`)},
+	}
+	content, err := Marshal(config)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, `{
+  "forwardPorts": [
+    3000,
+    "db:5432"
+  ],
+  "image": "node:18",
+  "postCreateCommand": [
+    "npm",
+    "install"
+  ],
+  "runArgs": [
+    "--privileged"
+  ]
+}
+`, string(content))
+
+	parsed, err := Parse(content)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `["--privileged"]`, string(parsed.Other["runArgs"]))
+		parsed.Other = config.Other
+		assert.Equal(t, config, parsed)
+	}
+}
+
+func TestLifecycleCommandShell(t *testing.T) {
+	tests := []struct {
+		name    string
+		command LifecycleCommand
+		want    string
+	}{
+		{
+			name:    "Command line",
+			command: LifecycleCommand{CommandLine: "npm install && npm run build"},
+			want:    "npm install && npm run build",
+		},
+		{
+			name:    "Arguments",
+			command: LifecycleCommand{Args: []string{"echo", "it's", "--flag=value"}},
+			want:    `echo 'it'\''s' --flag=value`,
+		},
+		{
+			name: "Parallel commands",
+			command: LifecycleCommand{Parallel: map[string]LifecycleCommand{
+				"test":  {CommandLine: "make test"},
+				"build": {CommandLine: "make build"},
+			}},
+			want: "(make build) & (make test) & wait",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.command.Shell())
+		})
+	}
+}
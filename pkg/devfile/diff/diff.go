@@ -0,0 +1,254 @@
+// Package diff computes structured differences between devfiles, at the level of the devfile fields
+// rather than at the level of the yaml lines.
+//
+// Lists of objects that are identified by a `name` or an `id` field (such as components, commands, projects,
+// endpoints or environment variables) are compared element by element according to this key,
+// so that reordering them, or inserting an element in the middle of them, doesn't produce spurious differences.
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/utils/canonical"
+	"sigs.k8s.io/yaml"
+)
+
+// ChangeType is the type of a change between two devfiles
+type ChangeType string
+
+const (
+	// Added is the type of a change that adds a field or a list element
+	Added ChangeType = "added"
+	// Removed is the type of a change that removes a field or a list element
+	Removed ChangeType = "removed"
+	// Modified is the type of a change that modifies the value of a field
+	Modified ChangeType = "modified"
+)
+
+// keyFields are the fields that identify the elements of a keyed list, by order of preference
+var keyFields = []string{"name", "id"}
+
+// Change is a difference between two devfiles
+type Change struct {
+	// Path is the path of the changed field, such as `components[runtime].container.image`.
+	// The elements of keyed lists are designated by their key, and the other list elements by their index.
+	Path string `json:"path"`
+	// Type is the type of the change
+	Type ChangeType `json:"type"`
+	// Old is the original value, unset for added fields
+	Old interface{} `json:"old,omitempty"`
+	// New is the updated value, unset for removed fields
+	New interface{} `json:"new,omitempty"`
+}
+
+func (c Change) String() string {
+	switch c.Type {
+	case Added:
+		return fmt.Sprintf("+ %s: %s", c.Path, formatValue(c.New))
+	case Removed:
+		return fmt.Sprintf("- %s: %s", c.Path, formatValue(c.Old))
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", c.Path, formatValue(c.Old), formatValue(c.New))
+	}
+}
+
+// Devfiles returns the differences between the given devfile contents (yaml or json).
+// Both devfiles are canonicalized before being compared, so that semantically identical devfiles have no difference.
+func Devfiles(original, updated []byte) ([]Change, error) {
+	originalDevfile, err := parseCanonicalDevfile(original)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the original devfile: %w", err)
+	}
+	updatedDevfile, err := parseCanonicalDevfile(updated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the updated devfile: %w", err)
+	}
+	return Diff(originalDevfile, updatedDevfile)
+}
+
+func parseCanonicalDevfile(data []byte) (*v1alpha2.Devfile, error) {
+	devfile := &v1alpha2.Devfile{}
+	if err := yaml.Unmarshal(data, devfile); err != nil {
+		return nil, err
+	}
+	if err := canonical.Canonicalize(devfile); err != nil {
+		return nil, err
+	}
+	return devfile, nil
+}
+
+// Diff returns the differences between the Json serializations of the given objects
+func Diff(original, updated interface{}) ([]Change, error) {
+	originalValue, err := toJSONValue(original)
+	if err != nil {
+		return nil, err
+	}
+	updatedValue, err := toJSONValue(updated)
+	if err != nil {
+		return nil, err
+	}
+	var changes []Change
+	compare("", originalValue, updatedValue, &changes)
+	return changes, nil
+}
+
+// Format returns the human-readable representation of the given changes, one change per line
+func Format(changes []Change) string {
+	var lines strings.Builder
+	for _, change := range changes {
+		lines.WriteString(change.String() + "\n")
+	}
+	return lines.String()
+}
+
+func toJSONValue(obj interface{}) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func compare(path string, original, updated interface{}, changes *[]Change) {
+	switch originalValue := original.(type) {
+	case map[string]interface{}:
+		if updatedValue, isMap := updated.(map[string]interface{}); isMap {
+			compareMaps(path, originalValue, updatedValue, changes)
+			return
+		}
+	case []interface{}:
+		if updatedValue, isList := updated.([]interface{}); isList {
+			compareLists(path, originalValue, updatedValue, changes)
+			return
+		}
+	}
+	if !reflect.DeepEqual(original, updated) {
+		*changes = append(*changes, Change{Path: path, Type: Modified, Old: original, New: updated})
+	}
+}
+
+func compareMaps(path string, original, updated map[string]interface{}, changes *[]Change) {
+	var fields []string
+	for field := range original {
+		fields = append(fields, field)
+	}
+	for field := range updated {
+		if _, exists := original[field]; !exists {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		fieldPath := joinPath(path, field)
+		originalValue, inOriginal := original[field]
+		updatedValue, inUpdated := updated[field]
+		switch {
+		case !inUpdated:
+			*changes = append(*changes, Change{Path: fieldPath, Type: Removed, Old: originalValue})
+		case !inOriginal:
+			*changes = append(*changes, Change{Path: fieldPath, Type: Added, New: updatedValue})
+		default:
+			compare(fieldPath, originalValue, updatedValue, changes)
+		}
+	}
+}
+
+func compareLists(path string, original, updated []interface{}, changes *[]Change) {
+	keyField := listKeyField(original, updated)
+	if keyField == "" {
+		for i := 0; i < len(original) || i < len(updated); i++ {
+			elementPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(updated):
+				*changes = append(*changes, Change{Path: elementPath, Type: Removed, Old: original[i]})
+			case i >= len(original):
+				*changes = append(*changes, Change{Path: elementPath, Type: Added, New: updated[i]})
+			default:
+				compare(elementPath, original[i], updated[i], changes)
+			}
+		}
+		return
+	}
+
+	updatedByKey := map[string]interface{}{}
+	for _, element := range updated {
+		updatedByKey[elementKey(element, keyField)] = element
+	}
+	originalByKey := map[string]interface{}{}
+	for _, element := range original {
+		key := elementKey(element, keyField)
+		originalByKey[key] = element
+		elementPath := fmt.Sprintf("%s[%s]", path, key)
+		if updatedElement, exists := updatedByKey[key]; exists {
+			compare(elementPath, element, updatedElement, changes)
+		} else {
+			*changes = append(*changes, Change{Path: elementPath, Type: Removed, Old: element})
+		}
+	}
+	for _, element := range updated {
+		key := elementKey(element, keyField)
+		if _, exists := originalByKey[key]; !exists {
+			*changes = append(*changes, Change{Path: fmt.Sprintf("%s[%s]", path, key), Type: Added, New: element})
+		}
+	}
+}
+
+// listKeyField returns the field that identifies the elements of the given lists,
+// or an empty string if the lists are not keyed lists
+func listKeyField(lists ...[]interface{}) string {
+	for _, keyField := range keyFields {
+		isKeyed := true
+		elementCount := 0
+		for _, list := range lists {
+			keys := map[string]bool{}
+			for _, element := range list {
+				elementCount++
+				key := elementKey(element, keyField)
+				if key == "" || keys[key] {
+					isKeyed = false
+					break
+				}
+				keys[key] = true
+			}
+		}
+		if isKeyed && elementCount > 0 {
+			return keyField
+		}
+	}
+	return ""
+}
+
+// elementKey returns the value of the given key field of a list element, or an empty string if it isn't set
+func elementKey(element interface{}, keyField string) string {
+	object, isObject := element.(map[string]interface{})
+	if !isObject {
+		return ""
+	}
+	key, _ := object[keyField].(string)
+	return key
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func formatValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
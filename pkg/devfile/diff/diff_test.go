@@ -0,0 +1,136 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const originalDevfile = `
+schemaVersion: 2.2.0
+metadata:
+  name: nodejs
+  version: 1.0.0
+components:
+- name: runtime
+  container:
+    image: node:14
+    endpoints:
+    - name: http
+      targetPort: 3000
+- name: cache
+  volume: {}
+commands:
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+`
+
+func TestDevfiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		updated string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "Reordered and defaulted devfile has no difference",
+			updated: `
+schemaVersion: 2.2.0
+metadata:
+  version: 1.0.0
+  name: nodejs
+commands:
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+    hotReloadCapable: false
+components:
+- name: cache
+  volume: {}
+- name: runtime
+  container:
+    image: node:14
+    endpoints:
+    - name: http
+      targetPort: 3000
+      exposure: public
+`,
+		},
+		{
+			name: "Changes in keyed lists",
+			updated: `
+schemaVersion: 2.2.0
+metadata:
+  name: nodejs
+  version: 1.1.0
+components:
+- name: runtime
+  container:
+    image: node:16
+    endpoints:
+    - name: debug
+      targetPort: 5858
+    - name: http
+      targetPort: 3000
+commands:
+- id: debug
+  exec:
+    component: runtime
+    commandLine: npm run debug
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+`,
+			want: []string{
+				`+ commands[debug]: {"commandType":"Exec","exec":{"commandLine":"npm run debug","component":"runtime","hotReloadCapable":false},"id":"debug"}`,
+				`- components[cache]: {"componentType":"Volume","name":"cache","volume":{"ephemeral":false}}`,
+				`+ components[runtime].container.endpoints[debug]: {"exposure":"public","name":"debug","protocol":"http","secure":false,"targetPort":5858}`,
+				`~ components[runtime].container.image: "node:14" -> "node:16"`,
+				`~ metadata.version: "1.0.0" -> "1.1.0"`,
+			},
+		},
+		{
+			name:    "Invalid updated devfile",
+			updated: "components: [",
+			wantErr: "failed to parse the updated devfile",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := Devfiles([]byte(originalDevfile), []byte(tt.updated))
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr, "Error message should match")
+				}
+				return
+			}
+			assert.NoError(t, err)
+
+			var actual []string
+			for _, change := range changes {
+				actual = append(actual, change.String())
+			}
+			assert.Equal(t, tt.want, actual, "Changes should match")
+		})
+	}
+}
+
+func TestDiffNonKeyedLists(t *testing.T) {
+	original := map[string]interface{}{
+		"commands": []string{"build", "run"},
+	}
+	updated := map[string]interface{}{
+		"commands": []string{"build", "test", "run"},
+	}
+
+	changes, err := Diff(original, updated)
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Path: "commands[1]", Type: Modified, Old: "run", New: "test"},
+		{Path: "commands[2]", Type: Added, New: "run"},
+	}, changes)
+}
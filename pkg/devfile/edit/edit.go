@@ -0,0 +1,262 @@
+// Package edit applies typed mutations, such as adding a component or setting the image of a container,
+// to the content of an existing devfile, while preserving its comments, the order of its fields, and its formatting,
+// so that tools can programmatically update user-authored devfiles.
+//
+// The devfile is edited as a YAML node tree: only the nodes touched by a mutation are rewritten,
+// and the new elements are encoded from the devfile API types with the block style.
+// The indentation of the document is preserved, but the formatting inside a line (such as extra spaces)
+// and the indentation of the lists, relatively to their parent field, are normalized.
+package edit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultIndent is the indentation used when it cannot be detected in the document
+const defaultIndent = 2
+
+// Document is a devfile document being edited
+type Document struct {
+	document *yaml.Node
+	indent   int
+}
+
+// Parse parses the given devfile content (yaml or json) into a document that can be edited
+func Parse(data []byte) (*Document, error) {
+	document := &yaml.Node{}
+	if err := yaml.Unmarshal(data, document); err != nil {
+		return nil, err
+	}
+	if document.Kind == 0 {
+		// empty content
+		document = &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	if len(document.Content) != 1 || document.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("the devfile content should be a YAML mapping")
+	}
+	return &Document{document: document, indent: detectIndent(document.Content[0])}, nil
+}
+
+// Bytes returns the yaml content of the edited document
+func (d *Document) Bytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	encoder := yaml.NewEncoder(buf)
+	encoder.SetIndent(d.indent)
+	if err := encoder.Encode(d.document); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// AddComponent appends the given component to the components of the devfile.
+// The returned error wraps ErrDuplicateKey if the devfile already has a component with the same name.
+func (d *Document) AddComponent(component v1alpha2.Component) error {
+	return d.addElement("components", "name", component.Name, component)
+}
+
+// RemoveComponent removes the component with the given name from the devfile.
+// The returned error wraps ErrNotFound if the devfile has no such component.
+func (d *Document) RemoveComponent(name string) error {
+	return d.removeElement("components", "name", name)
+}
+
+// SetContainerImage sets the image of the container component with the given name.
+// The returned error wraps ErrNotFound if the devfile has no such container component.
+func (d *Document) SetContainerImage(componentName, image string) error {
+	component, err := d.element("components", "name", componentName)
+	if err != nil {
+		return err
+	}
+	container := mappingValue(component, "container")
+	if container == nil || container.Kind != yaml.MappingNode {
+		return fmt.Errorf("%w: component %q is not a container component", devfileerrors.ErrNotFound, componentName)
+	}
+	setScalar(container, "image", image)
+	return nil
+}
+
+// AddCommand appends the given command to the commands of the devfile.
+// The returned error wraps ErrDuplicateKey if the devfile already has a command with the same id.
+func (d *Document) AddCommand(command v1alpha2.Command) error {
+	return d.addElement("commands", "id", command.Id, command)
+}
+
+// RemoveCommand removes the command with the given id from the devfile.
+// The returned error wraps ErrNotFound if the devfile has no such command.
+func (d *Document) RemoveCommand(id string) error {
+	return d.removeElement("commands", "id", id)
+}
+
+// SetVariable sets the value of the given global variable of the devfile
+func (d *Document) SetVariable(name, value string) error {
+	variables := mappingValue(d.root(), "variables")
+	if variables == nil {
+		variables = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		setValue(d.root(), "variables", variables)
+	}
+	if variables.Kind != yaml.MappingNode {
+		return fmt.Errorf("the variables of the devfile should be a YAML mapping")
+	}
+	setScalar(variables, name, value)
+	return nil
+}
+
+// root returns the root mapping of the document
+func (d *Document) root() *yaml.Node {
+	return d.document.Content[0]
+}
+
+// list returns the top-level list with the given field name, or nil if the devfile has no such list
+func (d *Document) list(field string) (*yaml.Node, error) {
+	list := mappingValue(d.root(), field)
+	if list != nil && list.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("the %s of the devfile should be a YAML sequence", field)
+	}
+	return list, nil
+}
+
+// element returns the element of the given top-level list whose key field has the given value
+func (d *Document) element(field, keyField, key string) (*yaml.Node, error) {
+	list, err := d.list(field)
+	if err != nil {
+		return nil, err
+	}
+	if index := elementIndex(list, keyField, key); index >= 0 {
+		return list.Content[index], nil
+	}
+	return nil, fmt.Errorf("%w: the devfile has no element with %s %q in its %s", devfileerrors.ErrNotFound, keyField, key, field)
+}
+
+// addElement appends the given object to the given top-level list, which is created if needed
+func (d *Document) addElement(field, keyField, key string, obj interface{}) error {
+	list, err := d.list(field)
+	if err != nil {
+		return err
+	}
+	if elementIndex(list, keyField, key) >= 0 {
+		return fmt.Errorf("%w: the devfile already has an element with %s %q in its %s", devfileerrors.ErrDuplicateKey, keyField, key, field)
+	}
+	element, err := toNode(obj)
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		list = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		setValue(d.root(), field, list)
+	}
+	list.Style = 0
+	list.Content = append(list.Content, element)
+	return nil
+}
+
+// removeElement removes the element of the given top-level list whose key field has the given value
+func (d *Document) removeElement(field, keyField, key string) error {
+	list, err := d.list(field)
+	if err != nil {
+		return err
+	}
+	index := elementIndex(list, keyField, key)
+	if index < 0 {
+		return fmt.Errorf("%w: the devfile has no element with %s %q in its %s", devfileerrors.ErrNotFound, keyField, key, field)
+	}
+	list.Content = append(list.Content[:index], list.Content[index+1:]...)
+	return nil
+}
+
+// elementIndex returns the index of the element of the list whose key field has the given value, or -1
+func elementIndex(list *yaml.Node, keyField, key string) int {
+	if list == nil {
+		return -1
+	}
+	for i, element := range list.Content {
+		if value := mappingValue(element, keyField); value != nil && value.Kind == yaml.ScalarNode && value.Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// mappingValue returns the value of the given key in the mapping, or nil if the node is not a mapping or has no such key
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setValue sets the value of the given key in the mapping, keeping the comments of an existing key,
+// or appending the key at the end of the mapping
+func setValue(mapping *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			previous := mapping.Content[i+1]
+			value.LineComment, value.FootComment = previous.LineComment, previous.FootComment
+			mapping.Content[i+1] = value
+			return
+		}
+	}
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+}
+
+// setScalar sets the string value of the given key in the mapping, keeping the quoting style of an existing scalar value
+func setScalar(mapping *yaml.Node, key string, value string) {
+	if previous := mappingValue(mapping, key); previous != nil && previous.Kind == yaml.ScalarNode {
+		previous.Tag = "!!str"
+		previous.Value = value
+		return
+	}
+	setValue(mapping, key, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value})
+}
+
+// toNode encodes the given object of the devfile API types as a YAML node with the block style,
+// using its json encoding, so that the fields keep their json names and order
+func toNode(obj interface{}) (*yaml.Node, error) {
+	jsonData, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	document := &yaml.Node{}
+	if err := yaml.Unmarshal(jsonData, document); err != nil {
+		return nil, err
+	}
+	node := document.Content[0]
+	resetStyle(node)
+	return node, nil
+}
+
+// resetStyle clears the style of the node and its children, so that the flow style and the quotes of the json encoding
+// are replaced by the block style, and by quotes only where they are needed
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+// detectIndent returns the indentation of the document, as the column offset of the first nested mapping
+// of its root mapping, or defaultIndent if the document has no nested mapping
+func detectIndent(root *yaml.Node) int {
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		if value.Kind == yaml.MappingNode && value.Style&yaml.FlowStyle == 0 && len(value.Content) > 0 {
+			if indent := value.Content[0].Column - key.Column; indent > 0 {
+				return indent
+			}
+		}
+	}
+	return defaultIndent
+}
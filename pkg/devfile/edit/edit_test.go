@@ -0,0 +1,256 @@
+package edit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const userDevfile = `# Devfile of the nodejs sample
+schemaVersion: 2.2.0
+metadata:
+    name: nodejs # the project name
+variables:
+    version: "18"
+components:
+    # the runtime container
+    - name: runtime
+      container:
+        image: 'node:16' # pinned
+        memoryLimit: 1Gi
+    - name: cache
+      volume:
+        size: 1Gi
+commands:
+    - id: run
+      exec:
+        component: runtime
+        commandLine: npm start
+`
+
+func TestEdit(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		edit          func(d *Document) error
+		expected      string
+		expectedError error
+	}{
+		{
+			name:    "Set container image",
+			content: userDevfile,
+			edit: func(d *Document) error {
+				return d.SetContainerImage("runtime", "node:18")
+			},
+			expected: `# Devfile of the nodejs sample
+schemaVersion: 2.2.0
+metadata:
+    name: nodejs # the project name
+variables:
+    version: "18"
+components:
+    # the runtime container
+    - name: runtime
+      container:
+        image: 'node:18' # pinned
+        memoryLimit: 1Gi
+    - name: cache
+      volume:
+        size: 1Gi
+commands:
+    - id: run
+      exec:
+        component: runtime
+        commandLine: npm start
+`,
+		},
+		{
+			name:    "Add component",
+			content: userDevfile,
+			edit: func(d *Document) error {
+				return d.AddComponent(v1alpha2.Component{
+					Name: "tools",
+					ComponentUnion: v1alpha2.ComponentUnion{
+						Container: &v1alpha2.ContainerComponent{
+							Container: v1alpha2.Container{Image: "quay.io/devfile/tools:latest", MountSources: boolPtr(true)},
+						},
+					},
+				})
+			},
+			expected: `# Devfile of the nodejs sample
+schemaVersion: 2.2.0
+metadata:
+    name: nodejs # the project name
+variables:
+    version: "18"
+components:
+    # the runtime container
+    - name: runtime
+      container:
+        image: 'node:16' # pinned
+        memoryLimit: 1Gi
+    - name: cache
+      volume:
+        size: 1Gi
+    - name: tools
+      container:
+        image: quay.io/devfile/tools:latest
+        mountSources: true
+commands:
+    - id: run
+      exec:
+        component: runtime
+        commandLine: npm start
+`,
+		},
+		{
+			name:    "Remove component and set variable",
+			content: userDevfile,
+			edit: func(d *Document) error {
+				if err := d.RemoveComponent("cache"); err != nil {
+					return err
+				}
+				return d.SetVariable("port", "3000")
+			},
+			expected: `# Devfile of the nodejs sample
+schemaVersion: 2.2.0
+metadata:
+    name: nodejs # the project name
+variables:
+    version: "18"
+    port: "3000"
+components:
+    # the runtime container
+    - name: runtime
+      container:
+        image: 'node:16' # pinned
+        memoryLimit: 1Gi
+commands:
+    - id: run
+      exec:
+        component: runtime
+        commandLine: npm start
+`,
+		},
+		{
+			name:    "Add and remove commands",
+			content: userDevfile,
+			edit: func(d *Document) error {
+				if err := d.RemoveCommand("run"); err != nil {
+					return err
+				}
+				return d.AddCommand(v1alpha2.Command{
+					Id: "build",
+					CommandUnion: v1alpha2.CommandUnion{
+						Exec: &v1alpha2.ExecCommand{Component: "runtime", CommandLine: "npm install"},
+					},
+				})
+			},
+			expected: `# Devfile of the nodejs sample
+schemaVersion: 2.2.0
+metadata:
+    name: nodejs # the project name
+variables:
+    version: "18"
+components:
+    # the runtime container
+    - name: runtime
+      container:
+        image: 'node:16' # pinned
+        memoryLimit: 1Gi
+    - name: cache
+      volume:
+        size: 1Gi
+commands:
+    - id: build
+      exec:
+        commandLine: npm install
+        component: runtime
+`,
+		},
+		{
+			name:    "Create missing lists in an empty devfile",
+			content: "",
+			edit: func(d *Document) error {
+				if err := d.SetVariable("version", "18"); err != nil {
+					return err
+				}
+				return d.AddComponent(v1alpha2.Component{
+					Name:           "cache",
+					ComponentUnion: v1alpha2.ComponentUnion{Volume: &v1alpha2.VolumeComponent{Volume: v1alpha2.Volume{Size: "1Gi"}}},
+				})
+			},
+			expected: `variables:
+  version: "18"
+components:
+  - name: cache
+    volume:
+      size: 1Gi
+`,
+		},
+		{
+			name:    "Duplicate component",
+			content: userDevfile,
+			edit: func(d *Document) error {
+				return d.AddComponent(v1alpha2.Component{Name: "runtime"})
+			},
+			expectedError: devfileerrors.ErrDuplicateKey,
+		},
+		{
+			name:    "Image of a missing component",
+			content: userDevfile,
+			edit: func(d *Document) error {
+				return d.SetContainerImage("missing", "node:18")
+			},
+			expectedError: devfileerrors.ErrNotFound,
+		},
+		{
+			name:    "Image of a volume component",
+			content: userDevfile,
+			edit: func(d *Document) error {
+				return d.SetContainerImage("cache", "node:18")
+			},
+			expectedError: devfileerrors.ErrNotFound,
+		},
+		{
+			name:    "Remove missing command",
+			content: userDevfile,
+			edit: func(d *Document) error {
+				return d.RemoveCommand("missing")
+			},
+			expectedError: devfileerrors.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document, err := Parse([]byte(tt.content))
+			if !assert.NoError(t, err) {
+				return
+			}
+			err = tt.edit(document)
+			if tt.expectedError != nil {
+				assert.True(t, errors.Is(err, tt.expectedError), "unexpected error: %v", err)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			content, err := document.Bytes()
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expected, string(content))
+			}
+		})
+	}
+}
+
+func TestParseInvalidDevfile(t *testing.T) {
+	_, err := Parse([]byte("- not a mapping"))
+	assert.EqualError(t, err, "the devfile content should be a YAML mapping")
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
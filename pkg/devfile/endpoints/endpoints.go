@@ -0,0 +1,152 @@
+// Package endpoints computes the URLs at which the endpoints of devfile components are reachable,
+// according to their exposure, protocol and secure flag, so that the controllers and the CLIs
+// that expose endpoints all derive the same URLs.
+package endpoints
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+)
+
+// RoutingStrategy is the way public endpoints are routed from the base domain
+type RoutingStrategy string
+
+const (
+	// SubdomainRouting exposes each public endpoint on its own host, as a subdomain of the base domain:
+	// `<prefix>-<endpoint>.<base domain>`, or `<endpoint>.<base domain>` without prefix
+	SubdomainRouting RoutingStrategy = "subdomain"
+	// PathRouting exposes all the public endpoints on the base domain, on distinct paths:
+	// `<base domain>/<prefix>/<endpoint>/`, or `<base domain>/<endpoint>/` without prefix
+	PathRouting RoutingStrategy = "path"
+)
+
+// Options are the options that describe how the endpoints are exposed
+type Options struct {
+	// BaseDomain is the domain on which the public endpoints are exposed, such as `apps.example.com`.
+	// It is required for public endpoints.
+	BaseDomain string
+	// Routing is the way public endpoints are routed from the base domain.
+	// The default is SubdomainRouting.
+	Routing RoutingStrategy
+	// Prefix distinguishes the public endpoints of several workspaces exposed on the same base domain,
+	// typically the workspace id. It is optional.
+	Prefix string
+	// TLS is true if the public endpoints are served over TLS, such as by an ingress with a certificate,
+	// which promotes the `http` and `ws` protocols of public endpoints to `https` and `wss`.
+	TLS bool
+	// InternalHost is the host of the internal endpoints, typically the name of the K8S service.
+	// The default is the name of the component.
+	InternalHost string
+}
+
+// URL returns the URL at which the given endpoint of the given component is reachable:
+//
+// - public endpoints are reachable from the base domain of the options, with the given routing strategy;
+//
+// - internal endpoints are reachable on the internal host of the options, on the target port;
+//
+// - endpoints that are not exposed are reachable on `localhost`, on the target port.
+//
+// The `http` and `ws` protocols are promoted to `https` and `wss` when the endpoint is secure,
+// or, for public endpoints, when the options enable TLS.
+func URL(component string, endpoint v1alpha2.Endpoint, options Options) (*url.URL, error) {
+	exposure := endpoint.Exposure
+	if exposure == "" {
+		exposure = v1alpha2.PublicEndpointExposure
+	}
+	secure := endpoint.GetSecure() || (exposure == v1alpha2.PublicEndpointExposure && options.TLS)
+
+	result := &url.URL{
+		Scheme: Scheme(endpoint.Protocol, secure),
+	}
+	switch exposure {
+	case v1alpha2.PublicEndpointExposure:
+		if options.BaseDomain == "" {
+			return nil, fmt.Errorf("endpoint %q of component %q is public, but no base domain is given", endpoint.Name, component)
+		}
+		routing := options.Routing
+		if routing == "" {
+			routing = SubdomainRouting
+		}
+		switch routing {
+		case SubdomainRouting:
+			host := endpoint.Name
+			if options.Prefix != "" {
+				host = options.Prefix + "-" + host
+			}
+			result.Host = host + "." + options.BaseDomain
+			result.Path = "/"
+		case PathRouting:
+			result.Host = options.BaseDomain
+			result.Path = "/" + endpoint.Name + "/"
+			if options.Prefix != "" {
+				result.Path = "/" + options.Prefix + result.Path
+			}
+		default:
+			return nil, fmt.Errorf("unknown routing strategy %q, should be one of: %s, %s", routing, SubdomainRouting, PathRouting)
+		}
+	case v1alpha2.InternalEndpointExposure:
+		host := options.InternalHost
+		if host == "" {
+			host = component
+		}
+		result.Host = net.JoinHostPort(host, strconv.Itoa(endpoint.TargetPort))
+	case v1alpha2.NoneEndpointExposure:
+		result.Host = net.JoinHostPort("localhost", strconv.Itoa(endpoint.TargetPort))
+	default:
+		return nil, fmt.Errorf("endpoint %q of component %q has an unknown exposure %q", endpoint.Name, component, exposure)
+	}
+
+	if endpoint.Path != "" {
+		result.Path = strings.TrimSuffix(result.Path, "/") + "/" + strings.TrimPrefix(endpoint.Path, "/")
+	}
+	return result, nil
+}
+
+// Scheme returns the URL scheme of the given endpoint protocol, `http` by default.
+// The `http` and `ws` protocols are promoted to `https` and `wss` when secure is true.
+func Scheme(protocol v1alpha2.EndpointProtocol, secure bool) string {
+	if protocol == "" {
+		protocol = v1alpha2.HTTPEndpointProtocol
+	}
+	if secure {
+		switch protocol {
+		case v1alpha2.HTTPEndpointProtocol:
+			protocol = v1alpha2.HTTPSEndpointProtocol
+		case v1alpha2.WSEndpointProtocol:
+			protocol = v1alpha2.WSSEndpointProtocol
+		}
+	}
+	return string(protocol)
+}
+
+// ComponentURLs returns the URLs of the endpoints of the given container, kubernetes or openshift component,
+// by endpoint name. It returns nil for the components that have no endpoints.
+func ComponentURLs(component v1alpha2.Component, options Options) (map[string]*url.URL, error) {
+	var endpoints []v1alpha2.Endpoint
+	switch {
+	case component.Container != nil:
+		endpoints = component.Container.Endpoints
+	case component.Kubernetes != nil:
+		endpoints = component.Kubernetes.Endpoints
+	case component.Openshift != nil:
+		endpoints = component.Openshift.Endpoints
+	}
+	if len(endpoints) == 0 {
+		return nil, nil
+	}
+	urls := make(map[string]*url.URL, len(endpoints))
+	for _, endpoint := range endpoints {
+		endpointURL, err := URL(component.Name, endpoint, options)
+		if err != nil {
+			return nil, err
+		}
+		urls[endpoint.Name] = endpointURL
+	}
+	return urls, nil
+}
@@ -0,0 +1,117 @@
+package endpoints
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURL(t *testing.T) {
+	secure := true
+
+	tests := []struct {
+		name     string
+		endpoint v1alpha2.Endpoint
+		options  Options
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "Public endpoint with default exposure and protocol",
+			endpoint: v1alpha2.Endpoint{Name: "http", TargetPort: 8080},
+			options:  Options{BaseDomain: "apps.example.com"},
+			want:     "http://http.apps.example.com/",
+		},
+		{
+			name:     "Public endpoint with subdomain routing, prefix and path",
+			endpoint: v1alpha2.Endpoint{Name: "http", TargetPort: 8080, Path: "/api"},
+			options:  Options{BaseDomain: "apps.example.com", Prefix: "workspace1"},
+			want:     "http://workspace1-http.apps.example.com/api",
+		},
+		{
+			name:     "Public endpoint with path routing",
+			endpoint: v1alpha2.Endpoint{Name: "http", TargetPort: 8080, Path: "api/"},
+			options:  Options{BaseDomain: "apps.example.com", Routing: PathRouting, Prefix: "workspace1"},
+			want:     "http://apps.example.com/workspace1/http/api/",
+		},
+		{
+			name:     "Secure endpoint is promoted to https",
+			endpoint: v1alpha2.Endpoint{Name: "http", TargetPort: 8080, Secure: &secure},
+			options:  Options{BaseDomain: "apps.example.com"},
+			want:     "https://http.apps.example.com/",
+		},
+		{
+			name:     "Public websocket endpoint is promoted to wss with TLS",
+			endpoint: v1alpha2.Endpoint{Name: "terminal", TargetPort: 4444, Protocol: v1alpha2.WSEndpointProtocol},
+			options:  Options{BaseDomain: "apps.example.com", TLS: true},
+			want:     "wss://terminal.apps.example.com/",
+		},
+		{
+			name:     "Internal endpoint on the component host",
+			endpoint: v1alpha2.Endpoint{Name: "db", TargetPort: 5432, Exposure: v1alpha2.InternalEndpointExposure, Protocol: v1alpha2.TCPEndpointProtocol},
+			options:  Options{TLS: true},
+			want:     "tcp://runtime:5432",
+		},
+		{
+			name:     "Internal endpoint on the service host",
+			endpoint: v1alpha2.Endpoint{Name: "http", TargetPort: 8080, Exposure: v1alpha2.InternalEndpointExposure},
+			options:  Options{InternalHost: "workspace1-service"},
+			want:     "http://workspace1-service:8080",
+		},
+		{
+			name:     "Endpoint not exposed",
+			endpoint: v1alpha2.Endpoint{Name: "debug", TargetPort: 5858, Exposure: v1alpha2.NoneEndpointExposure, Path: "/json"},
+			want:     "http://localhost:5858/json",
+		},
+		{
+			name:     "Public endpoint without base domain",
+			endpoint: v1alpha2.Endpoint{Name: "http", TargetPort: 8080},
+			wantErr:  `endpoint "http" of component "runtime" is public, but no base domain is given`,
+		},
+		{
+			name:     "Unknown routing strategy",
+			endpoint: v1alpha2.Endpoint{Name: "http", TargetPort: 8080},
+			options:  Options{BaseDomain: "apps.example.com", Routing: "header"},
+			wantErr:  `unknown routing strategy "header", should be one of: subdomain, path`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := URL("runtime", tt.endpoint, tt.options)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got.String())
+			}
+		})
+	}
+}
+
+func TestComponentURLs(t *testing.T) {
+	component := v1alpha2.Component{
+		Name: "runtime",
+		ComponentUnion: v1alpha2.ComponentUnion{
+			Kubernetes: &v1alpha2.KubernetesComponent{
+				K8sLikeComponent: v1alpha2.K8sLikeComponent{
+					Endpoints: []v1alpha2.Endpoint{
+						{Name: "http", TargetPort: 8080},
+						{Name: "debug", TargetPort: 5858, Exposure: v1alpha2.NoneEndpointExposure},
+					},
+				},
+			},
+		},
+	}
+
+	urls, err := ComponentURLs(component, Options{BaseDomain: "apps.example.com"})
+	if assert.NoError(t, err) && assert.Len(t, urls, 2) {
+		assert.Equal(t, "http://http.apps.example.com/", urls["http"].String())
+		assert.Equal(t, "http://localhost:5858", urls["debug"].String())
+	}
+
+	urls, err = ComponentURLs(v1alpha2.Component{Name: "data", ComponentUnion: v1alpha2.ComponentUnion{Volume: &v1alpha2.VolumeComponent{}}}, Options{})
+	assert.NoError(t, err)
+	assert.Nil(t, urls)
+}
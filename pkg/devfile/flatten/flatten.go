@@ -0,0 +1,364 @@
+// Package flatten provides the end-to-end flow that turns the content of a devfile into a flattened,
+// validated devfile: parsing, inclusion of the local fragments, resolution of the parent and plugins,
+// overriding and merging, then validation.
+//
+// It lives beside the `github.com/devfile/api/v2/pkg/devfile` package, since that package is imported
+// by the K8S API types and cannot depend on them.
+package flatten
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/api/v2/pkg/devfile"
+	"github.com/devfile/api/v2/pkg/devfile/lint"
+	"github.com/devfile/api/v2/pkg/devfile/rules"
+	"github.com/devfile/api/v2/pkg/devfile/unknown"
+	"github.com/devfile/api/v2/pkg/utils/overriding"
+	"github.com/devfile/api/v2/pkg/validation"
+	"github.com/devfile/api/v2/pkg/validation/variables"
+	"github.com/hashicorp/go-multierror"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Resolver returns the content (yaml or json) of the devfile referenced by a parent or a plugin component.
+// It should stop and return an error when the given context is done.
+type Resolver func(ctx context.Context, ref v1alpha2.ImportReference) ([]byte, error)
+
+// ResolveOptions contains the options used to resolve the parent and plugins of a devfile
+type ResolveOptions struct {
+	// Resolver returns the content of the devfiles referenced by parents and plugin components.
+	// It is only required when the devfile, or one of the devfiles it references, has a parent or a plugin component.
+	Resolver Resolver
+
+	// IncludeResolver returns the content of the local fragments included by the `includes` field of the devfile.
+	// It is only required when the devfile includes fragments.
+	IncludeResolver IncludeResolver
+
+	// FetchTimeout is the maximum duration of each call to the resolver.
+	// There is no timeout when it is zero, apart from the deadline of the context passed to `ValidateAndFlattenContext`.
+	FetchTimeout time.Duration
+
+	// ValidationRules configures the severity of the validation rules, as loaded from a `.devfile-validation.yaml` file.
+	// Validation errors of disabled rules are ignored, and those with the `info` or `warning` severity are returned as warnings.
+	// The default severities are used when it is nil.
+	ValidationRules *rules.Config
+
+	// Strict rejects the devfile if it contains fields that are unknown to the API types of this library,
+	// such as misspelled fields, and reports their paths, instead of ignoring them.
+	// It doesn't apply to the devfiles referenced by parents and plugin components.
+	Strict bool
+
+	// MaxAttributesSize is the limit of the serialized size of the attributes of each object of the flattened devfile, in bytes,
+	// above which a warning is returned. `validation.DefaultMaxAttributesSize` is used when it is zero.
+	MaxAttributesSize int
+
+	// ReservedPorts are the target ports that the endpoints of the flattened devfile cannot use, indexed by port
+	// with a description of their use, such as the ports of the containers that the consumer adds to the workspace pod
+	ReservedPorts map[int]string
+}
+
+// FlattenedDevfile is a devfile whose parent and plugin components have been resolved and merged into its content.
+// It has no parent, no plugin component, no includes, and no top-level env, since the top-level env is applied to its container components.
+// The components and commands imported from the parent or from plugins have the `api.devfile.io/imported-from`
+// and `api.devfile.io/imported-by` attributes, which tell their import source and their origin.
+type FlattenedDevfile struct {
+	devfile.DevfileHeader `json:",inline"`
+
+	v1alpha2.DevWorkspaceTemplateSpecContent `json:",inline"`
+}
+
+// Warning is a problem found in a devfile that doesn't prevent from using it
+type Warning struct {
+	// Field is the top-level devfile field the warning relates to, such as `commands`
+	Field string
+	// Message describes the problem
+	Message string
+}
+
+func (w Warning) String() string {
+	return w.Field + ": " + w.Message
+}
+
+// ValidateAndFlatten parses the given devfile content (yaml or json), includes the fragments of its `includes` field,
+// resolves its parent and plugin components recursively
+// with the resolver of the given options, applies their overrides, merges them into the devfile content,
+// and finally validates the flattened devfile. Global variables are replaced in the flattened devfile.
+//
+// Returns:
+// 1. the flattened devfile, which is also returned when only the validation fails, so that callers can report on it
+// 2. the warnings for validation problems that should not prevent from using the devfile (such as a missing default command,
+// or a reference to an undefined variable)
+// 3. a non-nil error if the devfile cannot be parsed or resolved, or if it is invalid
+func ValidateAndFlatten(data []byte, opts ResolveOptions) (FlattenedDevfile, []Warning, error) {
+	return ValidateAndFlattenContext(context.Background(), data, opts)
+}
+
+// ValidateAndFlattenContext is the same as ValidateAndFlatten, but stops and returns the context error
+// as soon as the given context is done, including during the calls to the resolver.
+func ValidateAndFlattenContext(ctx context.Context, data []byte, opts ResolveOptions) (FlattenedDevfile, []Warning, error) {
+	flattened, _, warnings, err := ValidateAndFlattenWithReport(ctx, data, opts)
+	return flattened, warnings, err
+}
+
+// ValidateAndFlattenWithReport is the same as ValidateAndFlattenContext, but also returns a report of what each parent
+// and plugin contributed to the flattened devfile, and of the overrides applied to them.
+// The report is returned whenever the devfile could be resolved, even if the validation fails.
+func ValidateAndFlattenWithReport(ctx context.Context, data []byte, opts ResolveOptions) (FlattenedDevfile, Report, []Warning, error) {
+	parsed, err := parseDevfile(data, opts.Strict)
+	if err != nil {
+		return FlattenedDevfile{}, Report{}, nil, err
+	}
+
+	f := flattener{opts: opts}
+	if err := f.include(ctx, parsed); err != nil {
+		return FlattenedDevfile{}, Report{}, nil, err
+	}
+	content, err := f.flatten(ctx, &parsed.DevWorkspaceTemplateSpec, nil, nil)
+	if err != nil {
+		return FlattenedDevfile{}, Report{}, nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return FlattenedDevfile{}, Report{}, nil, err
+	}
+
+	flattened := FlattenedDevfile{
+		DevfileHeader:                   parsed.DevfileHeader,
+		DevWorkspaceTemplateSpecContent: *content,
+	}
+	flattened.Includes = nil
+	warnings, err := validate(parsed, &flattened, opts)
+	return flattened, f.report, warnings, err
+}
+
+func parseDevfile(data []byte, strict bool) (*v1alpha2.Devfile, error) {
+	if strict {
+		parsed := &v1alpha2.Devfile{}
+		if err := unknown.UnmarshalStrict(data, parsed); err != nil {
+			return nil, err
+		}
+		return parsed, nil
+	}
+	jsonData, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	parsed := &v1alpha2.Devfile{}
+	if err := json.Unmarshal(jsonData, parsed); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+type flattener struct {
+	opts   ResolveOptions
+	report Report
+}
+
+// flatten returns the content of the given spec, with its parent and plugins resolved, overridden and merged.
+// The `visited` argument contains the keys of the import references that are being resolved, to detect cycles,
+// and the `importedBy` argument the chain of imports that led to the spec, for the report.
+func (f *flattener) flatten(ctx context.Context, spec *v1alpha2.DevWorkspaceTemplateSpec, visited []string, importedBy []string) (*v1alpha2.DevWorkspaceTemplateSpecContent, error) {
+	var parentContent *v1alpha2.DevWorkspaceTemplateSpecContent
+	if spec.Parent != nil {
+		resolved, err := f.resolve(ctx, spec.Parent.ImportReference, visited,
+			append(importedBy[:len(importedBy):len(importedBy)], "parent"), parentOverridePaths(&spec.Parent.ParentOverrides))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve the parent: %w", err)
+		}
+		parentContent, err = overriding.OverrideDevWorkspaceTemplateSpec(resolved, &spec.Parent.ParentOverrides)
+		if err != nil {
+			return nil, fmt.Errorf("failed to override the parent: %w", err)
+		}
+		markImported(parentContent, v1alpha2.ParentOrigin, spec.Parent.ImportReference)
+	}
+
+	var pluginContents []*v1alpha2.DevWorkspaceTemplateSpecContent
+	for _, component := range spec.Components {
+		if component.Plugin == nil {
+			continue
+		}
+		resolved, err := f.resolve(ctx, component.Plugin.ImportReference, visited,
+			append(importedBy[:len(importedBy):len(importedBy)], fmt.Sprintf("plugin %q", component.Name)), pluginOverridePaths(&component.Plugin.PluginOverrides))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve plugin component %q: %w", component.Name, err)
+		}
+		if err := validation.ValidatePluginOverrides(component, resolved); err != nil {
+			return nil, err
+		}
+		pluginContent, err := overriding.OverrideDevWorkspaceTemplateSpec(resolved, &component.Plugin.PluginOverrides)
+		if err != nil {
+			return nil, fmt.Errorf("failed to override plugin component %q: %w", component.Name, err)
+		}
+		markImported(pluginContent, v1alpha2.PluginOrigin, component.Plugin.ImportReference)
+		pluginContents = append(pluginContents, pluginContent)
+	}
+
+	if parentContent == nil && len(pluginContents) == 0 {
+		return spec.DevWorkspaceTemplateSpecContent.DeepCopy(), nil
+	}
+	return overriding.MergeDevWorkspaceTemplateSpec(&spec.DevWorkspaceTemplateSpecContent, parentContent, pluginContents...)
+}
+
+// markImported sets the attributes that tell the origin of the components and commands imported with the given reference:
+// the import source, unless it is already set by a nested import, and the origin, from the point of view of the importing devfile
+func markImported(content *v1alpha2.DevWorkspaceTemplateSpecContent, origin v1alpha2.Origin, ref v1alpha2.ImportReference) {
+	mark := func(attrs attributes.Attributes) attributes.Attributes {
+		if attrs == nil {
+			attrs = attributes.Attributes{}
+		}
+		if !attrs.Exists(validation.ImportSourceAttribute) {
+			attrs.PutString(validation.ImportSourceAttribute, importReferenceKey(ref))
+		}
+		return attrs.PutString(v1alpha2.ImportedByAttribute, string(origin))
+	}
+	for i := range content.Components {
+		content.Components[i].Attributes = mark(content.Components[i].Attributes)
+	}
+	for i := range content.Commands {
+		content.Commands[i].Attributes = mark(content.Commands[i].Attributes)
+	}
+}
+
+// resolve returns the flattened content of the devfile referenced by the given import reference,
+// and adds it to the report with the given import chain and override paths
+func (f *flattener) resolve(ctx context.Context, ref v1alpha2.ImportReference, visited []string, importedBy []string, overrides []string) (*v1alpha2.DevWorkspaceTemplateSpecContent, error) {
+	key := importReferenceKey(ref)
+	for _, visitedKey := range visited {
+		if visitedKey == key {
+			return nil, fmt.Errorf("import cycle detected: %s", strings.Join(append(visited, key), " -> "))
+		}
+	}
+	if f.opts.Resolver == nil {
+		return nil, fmt.Errorf("cannot resolve %s: no resolver was provided", key)
+	}
+
+	data, err := f.fetch(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := parseDevfile(data, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", key, err)
+	}
+	if len(resolved.Includes) > 0 {
+		return nil, fmt.Errorf("cannot resolve %s: includes are only supported in the main devfile", key)
+	}
+	content, err := f.flatten(ctx, &resolved.DevWorkspaceTemplateSpec, append(visited[:len(visited):len(visited)], key), importedBy)
+	if err != nil {
+		return nil, err
+	}
+	imported := newImport(ref, importedBy, &resolved.DevWorkspaceTemplateSpec)
+	imported.Overrides = overrides
+	f.report.Imports = append(f.report.Imports, imported)
+	return content, nil
+}
+
+// fetch calls the resolver with the fetch timeout, if any
+func (f *flattener) fetch(ctx context.Context, ref v1alpha2.ImportReference) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if f.opts.FetchTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, f.opts.FetchTimeout)
+		defer cancel()
+	}
+	return f.opts.Resolver(ctx, ref)
+}
+
+// importReferenceKey returns a key that identifies the devfile referenced by the given import reference
+func importReferenceKey(ref v1alpha2.ImportReference) string {
+	switch {
+	case ref.Uri != "":
+		return "uri " + ref.Uri
+	case ref.Id != "":
+		key := "id " + ref.Id
+		if ref.RegistryUrl != "" {
+			key = "id " + strings.TrimSuffix(ref.RegistryUrl, "/") + "/" + ref.Id
+		}
+		if ref.Version != "" {
+			key += ":" + ref.Version
+		}
+		return key
+	case ref.Kubernetes != nil:
+		return "kubernetes " + ref.Kubernetes.Namespace + "/" + ref.Kubernetes.Name
+	default:
+		return "empty import reference"
+	}
+}
+
+// validate runs the semantic validation rules against the flattened devfile, applies its top-level env
+// to its container components, and replaces its global variables.
+// The schema version and the features it supports are validated against the main devfile,
+// since its parent and plugins can have other schema versions.
+func validate(main *v1alpha2.Devfile, flattened *FlattenedDevfile, opts ResolveOptions) ([]Warning, error) {
+	var warnings []Warning
+	var returnedErr error
+
+	addErrors := func(field string, err error) {
+		for _, e := range validation.FlattenErrors(err) {
+			isWarning := validation.IsWarning(e)
+			if severity, isConfigured := opts.ValidationRules.ValidationSeverity(validation.RuleID(e)); isConfigured {
+				if severity == lint.Off {
+					continue
+				}
+				isWarning = severity != lint.Error
+			}
+			if isWarning {
+				warnings = append(warnings, Warning{Field: field, Message: e.Error()})
+				continue
+			}
+			returnedErr = multierror.Append(returnedErr, e)
+		}
+	}
+
+	addErrors("env", validation.ValidateEnv(flattened.Env))
+	flattened.ApplyEnv()
+
+	spec := &v1alpha2.DevWorkspaceTemplateSpec{
+		DevWorkspaceTemplateSpecContent: flattened.DevWorkspaceTemplateSpecContent,
+	}
+	variableWarning := variables.ValidateAndReplaceGlobalVariable(spec)
+	flattened.DevWorkspaceTemplateSpecContent = spec.DevWorkspaceTemplateSpecContent
+	warnings = append(warnings, variableWarnings("components", "component", variableWarning.Components)...)
+	warnings = append(warnings, variableWarnings("commands", "command", variableWarning.Commands)...)
+	warnings = append(warnings, variableWarnings("projects", "project", variableWarning.Projects)...)
+	warnings = append(warnings, variableWarnings("starterProjects", "starter project", variableWarning.StarterProjects)...)
+
+	addErrors("schemaVersion", validation.ValidateSchemaVersion(main.SchemaVersion))
+	addErrors("schemaVersion", validation.ValidateFeatures(main))
+	addErrors("metadata", validation.ValidateMetadata(flattened.Metadata))
+	for _, fieldErrors := range validation.ValidateContent(&flattened.DevWorkspaceTemplateSpecContent, validation.ContentOptions{
+		MaxAttributesSize: opts.MaxAttributesSize,
+		ReservedPorts:     opts.ReservedPorts,
+	}) {
+		addErrors(fieldErrors.Field, fieldErrors.Err)
+	}
+
+	return warnings, returnedErr
+}
+
+// variableWarnings returns a warning for each element that references undefined global variables, sorted by element name
+func variableWarnings(field string, elementKind string, invalidKeys map[string][]string) []Warning {
+	names := make([]string, 0, len(invalidKeys))
+	for name := range invalidKeys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var warnings []Warning
+	for _, name := range names {
+		warnings = append(warnings, Warning{
+			Field:   field,
+			Message: fmt.Sprintf("%s %q references undefined variables: %s", elementKind, name, strings.Join(invalidKeys[name], ", ")),
+		})
+	}
+	return warnings
+}
@@ -0,0 +1,578 @@
+package flatten
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/devfile/rules"
+	"github.com/devfile/api/v2/pkg/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+const parentDevfile = `
+schemaVersion: 2.2.0
+components:
+- name: runtime
+  container:
+    image: parent-image
+commands:
+- id: build
+  exec:
+    component: runtime
+    commandLine: make
+    group:
+      kind: build
+      isDefault: true
+`
+
+const pluginDevfile = `
+schemaVersion: 2.2.0
+components:
+- name: tools
+  container:
+    image: tools-image
+`
+
+const cyclicDevfile = `
+schemaVersion: 2.2.0
+parent:
+  uri: cyclic.yaml
+`
+
+func testResolver(devfiles map[string]string) Resolver {
+	return func(_ context.Context, ref v1alpha2.ImportReference) ([]byte, error) {
+		content, found := devfiles[ref.Uri]
+		if !found {
+			return nil, fmt.Errorf("devfile %q not found", ref.Uri)
+		}
+		return []byte(content), nil
+	}
+}
+
+func TestValidateAndFlatten(t *testing.T) {
+	resolver := testResolver(map[string]string{
+		"parent.yaml": parentDevfile,
+		"plugin.yaml": pluginDevfile,
+		"cyclic.yaml": cyclicDevfile,
+	})
+
+	tests := []struct {
+		name               string
+		devfile            string
+		resolver           Resolver
+		validationRules    string
+		strict             bool
+		wantComponents     map[string]string
+		wantCommands       []string
+		wantWarnings       []string
+		wantErr            string
+		wantFlattenedOnErr bool
+	}{
+		{
+			name: "Devfile without parent or plugin",
+			devfile: `
+schemaVersion: 2.2.0
+metadata:
+  name: nodejs
+components:
+- name: runtime
+  container:
+    image: node
+`,
+			wantComponents: map[string]string{"runtime": "node"},
+		},
+		{
+			name: "Parent with overrides",
+			devfile: `
+schemaVersion: 2.2.0
+parent:
+  uri: parent.yaml
+  components:
+  - name: runtime
+    container:
+      image: overridden-image
+`,
+			resolver:       resolver,
+			wantComponents: map[string]string{"runtime": "overridden-image"},
+			wantCommands:   []string{"build"},
+		},
+		{
+			name: "Parent and plugin",
+			devfile: `
+schemaVersion: 2.2.0
+parent:
+  uri: parent.yaml
+components:
+- name: my-plugin
+  plugin:
+    uri: plugin.yaml
+`,
+			resolver:       resolver,
+			wantComponents: map[string]string{"runtime": "parent-image", "tools": "tools-image"},
+			wantCommands:   []string{"build"},
+		},
+		{
+			name: "Missing default command and undefined variable are warnings",
+			devfile: `
+schemaVersion: 2.2.0
+components:
+- name: runtime
+  container:
+    image: "{{ image }}"
+commands:
+- id: build
+  exec:
+    component: runtime
+    commandLine: make
+    group:
+      kind: build
+- id: build-debug
+  exec:
+    component: runtime
+    commandLine: make debug
+    group:
+      kind: build
+`,
+			wantComponents: map[string]string{"runtime": "{{ image }}"},
+			wantCommands:   []string{"build", "build-debug"},
+			wantWarnings: []string{
+				`components: component "runtime" references undefined variables: image`,
+				"commands: command group build warning - there should be exactly one default command, currently there is no default command",
+			},
+		},
+		{
+			name: "Volume mounted at different paths is a warning",
+			devfile: `
+schemaVersion: 2.2.0
+components:
+- name: runtime
+  container:
+    image: node
+    volumeMounts:
+    - name: cache
+      path: /data/cache
+- name: tools
+  container:
+    image: tools
+    volumeMounts:
+    - name: cache
+- name: cache
+  volume: {}
+`,
+			wantComponents: map[string]string{"runtime": "node", "tools": "tools"},
+			wantWarnings: []string{
+				"components: the volume cache is mounted at different paths by the container components: /data/cache in runtime, /cache in tools",
+			},
+		},
+		{
+			name: "Features newer than the schema version",
+			devfile: `
+schemaVersion: 2.0.0
+variables:
+  image: node
+components:
+- name: runtime
+  container:
+    image: "{{ image }}"
+`,
+			wantComponents:     map[string]string{"runtime": "node"},
+			wantErr:            "variables uses the DevWorkspaceTemplateSpecContent.variables field, which requires the schema version 2.1.0, but the schema version is 2.0.0",
+			wantFlattenedOnErr: true,
+		},
+		{
+			name: "Unsupported schema version",
+			devfile: `
+schemaVersion: 3.0.0
+components:
+- name: runtime
+  container:
+    image: node
+`,
+			wantComponents:     map[string]string{"runtime": "node"},
+			wantErr:            `the schema version "3.0.0" is invalid - the supported schema versions are 2.0.0 to 2.2.x`,
+			wantFlattenedOnErr: true,
+		},
+		{
+			name: "Global variables are replaced",
+			devfile: `
+schemaVersion: 2.2.0
+variables:
+  image: node
+components:
+- name: runtime
+  container:
+    image: "{{ image }}"
+`,
+			wantComponents: map[string]string{"runtime": "node"},
+		},
+		{
+			name: "Invalid flattened devfile",
+			devfile: `
+schemaVersion: 2.2.0
+parent:
+  uri: parent.yaml
+commands:
+- id: run
+  exec:
+    component: missing
+    commandLine: run
+`,
+			resolver:           resolver,
+			wantComponents:     map[string]string{"runtime": "parent-image"},
+			wantCommands:       []string{"build", "run"},
+			wantErr:            "the command \"run\" is invalid - command does not map to a valid component",
+			wantFlattenedOnErr: true,
+		},
+		{
+			name: "Validation rules downgraded to warnings or disabled",
+			devfile: `
+schemaVersion: 2.2.0
+components:
+- name: runtime
+  container:
+    image: node
+commands:
+- id: build
+  exec:
+    component: runtime
+    commandLine: make
+    group:
+      kind: build
+- id: run
+  exec:
+    component: missing
+    commandLine: run
+`,
+			validationRules: `
+rules:
+  commands:
+    severity: warning
+  default-command:
+    enabled: false
+`,
+			wantComponents: map[string]string{"runtime": "node"},
+			wantCommands:   []string{"build", "run"},
+			wantWarnings: []string{
+				"commands: the command \"run\" is invalid - command does not map to a valid component: component \"missing\" does not exist in the devfile",
+			},
+		},
+		{
+			name: "Validation warning upgraded to an error",
+			devfile: `
+schemaVersion: 2.2.0
+components:
+- name: runtime
+  container:
+    image: node
+commands:
+- id: build
+  exec:
+    component: runtime
+    commandLine: make
+    group:
+      kind: build
+- id: build-debug
+  exec:
+    component: runtime
+    commandLine: make debug
+    group:
+      kind: build
+`,
+			validationRules: `
+rules:
+  default-command:
+    severity: error
+`,
+			wantComponents:     map[string]string{"runtime": "node"},
+			wantCommands:       []string{"build", "build-debug"},
+			wantErr:            "command group build warning - there should be exactly one default command, currently there is no default command",
+			wantFlattenedOnErr: true,
+		},
+		{
+			name: "Parent without resolver",
+			devfile: `
+schemaVersion: 2.2.0
+parent:
+  uri: parent.yaml
+`,
+			wantErr: "failed to resolve the parent: cannot resolve uri parent.yaml: no resolver was provided",
+		},
+		{
+			name: "Unresolvable plugin",
+			devfile: `
+schemaVersion: 2.2.0
+components:
+- name: my-plugin
+  plugin:
+    uri: unknown.yaml
+`,
+			resolver: resolver,
+			wantErr:  "failed to resolve plugin component \"my-plugin\": devfile \"unknown.yaml\" not found",
+		},
+		{
+			name: "Plugin overrides of an element not defined by the plugin",
+			devfile: `
+schemaVersion: 2.2.0
+components:
+- name: my-plugin
+  plugin:
+    uri: plugin.yaml
+    components:
+    - name: runtime
+      container:
+        image: other-image
+`,
+			resolver: resolver,
+			wantErr:  "the plugin component \"my-plugin\" is invalid (plugin-overrides) - overridden component \"runtime\" is not defined by the plugin",
+		},
+		{
+			name: "Import cycle",
+			devfile: `
+schemaVersion: 2.2.0
+parent:
+  uri: cyclic.yaml
+`,
+			resolver: resolver,
+			wantErr:  "failed to resolve the parent: failed to resolve the parent: import cycle detected: uri cyclic.yaml -> uri cyclic.yaml",
+		},
+		{
+			name:    "Invalid yaml",
+			devfile: "schemaVersion: [2.2.0",
+			wantErr: "yaml: line 1",
+		},
+		{
+			name: "Unknown fields are ignored",
+			devfile: `
+schemaVersion: 2.2.0
+components:
+- name: runtime
+  container:
+    image: node:14
+    imageTag: latest
+`,
+			wantComponents: map[string]string{"runtime": "node:14"},
+		},
+		{
+			name: "Unknown fields are rejected in strict mode",
+			devfile: `
+schemaVersion: 2.2.0
+components:
+- name: runtime
+  container:
+    image: node:14
+    imageTag: latest
+`,
+			strict:  true,
+			wantErr: "unknown fields: components[runtime].container.imageTag",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := ResolveOptions{Resolver: tt.resolver, Strict: tt.strict}
+			if tt.validationRules != "" {
+				validationRules, err := rules.Parse([]byte(tt.validationRules))
+				if !assert.NoError(t, err) {
+					return
+				}
+				opts.ValidationRules = validationRules
+			}
+			flattened, warnings, err := ValidateAndFlatten([]byte(tt.devfile), opts)
+
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr, "Error message should match")
+				}
+				if !tt.wantFlattenedOnErr {
+					return
+				}
+			} else {
+				assert.NoError(t, err, "Expected no error")
+			}
+
+			components := map[string]string{}
+			for _, component := range flattened.Components {
+				assert.Nil(t, component.Plugin, "Flattened devfile should not contain plugin components")
+				if component.Container != nil {
+					components[component.Name] = component.Container.Image
+				}
+			}
+			assert.Equal(t, tt.wantComponents, components, "Flattened components should match")
+
+			var commands []string
+			for _, command := range flattened.Commands {
+				commands = append(commands, command.Id)
+			}
+			assert.ElementsMatch(t, tt.wantCommands, commands, "Flattened commands should match")
+
+			var warningMessages []string
+			for _, warning := range warnings {
+				warningMessages = append(warningMessages, warning.String())
+			}
+			assert.Equal(t, tt.wantWarnings, warningMessages, "Warnings should match")
+		})
+	}
+}
+
+func TestImportedElementsOrigin(t *testing.T) {
+	resolver := testResolver(map[string]string{
+		"parent.yaml": parentDevfile,
+		"plugin.yaml": pluginDevfile,
+	})
+	devfile := `
+schemaVersion: 2.2.0
+parent:
+  uri: parent.yaml
+components:
+- name: main
+  container:
+    image: main-image
+- name: my-plugin
+  plugin:
+    uri: plugin.yaml
+`
+	flattened, _, err := ValidateAndFlatten([]byte(devfile), ResolveOptions{Resolver: resolver})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	origins := map[string]v1alpha2.Origin{}
+	importSources := map[string]string{}
+	for _, component := range flattened.Components {
+		origins[component.Name] = v1alpha2.GetOrigin(component.Attributes)
+		importSources[component.Name] = component.Attributes.GetString(validation.ImportSourceAttribute, nil)
+	}
+	assert.Equal(t, map[string]v1alpha2.Origin{
+		"main":    v1alpha2.MainOrigin,
+		"runtime": v1alpha2.ParentOrigin,
+		"tools":   v1alpha2.PluginOrigin,
+	}, origins, "Component origins should match")
+	assert.Equal(t, map[string]string{
+		"main":    "",
+		"runtime": "uri parent.yaml",
+		"tools":   "uri plugin.yaml",
+	}, importSources, "Component import sources should match")
+
+	if assert.Len(t, flattened.Commands, 1) {
+		assert.Equal(t, v1alpha2.ParentOrigin, v1alpha2.GetOrigin(flattened.Commands[0].Attributes), "Command origin should match")
+	}
+}
+
+func TestTopLevelEnv(t *testing.T) {
+	resolver := testResolver(map[string]string{
+		"parent.yaml": `
+schemaVersion: 2.2.0
+env:
+- name: PARENT_ENV
+  value: parent
+- name: OVERRIDDEN_ENV
+  value: parent
+components:
+- name: runtime
+  container:
+    image: parent-image
+`,
+	})
+
+	tests := []struct {
+		name    string
+		devfile string
+		wantEnv map[string][]v1alpha2.EnvVar
+		wantErr string
+	}{
+		{
+			name: "Top-level env applied to containers",
+			devfile: `
+schemaVersion: 2.2.0
+variables:
+  level: debug
+parent:
+  uri: parent.yaml
+  env:
+  - name: OVERRIDDEN_ENV
+    value: overridden
+env:
+- name: LOG_LEVEL
+  value: "{{level}}"
+components:
+- name: tools
+  container:
+    image: tools-image
+    env:
+    - name: LOG_LEVEL
+      value: info
+- name: cache
+  volume: {}
+`,
+			wantEnv: map[string][]v1alpha2.EnvVar{
+				"runtime": {{Name: "PARENT_ENV", Value: "parent"}, {Name: "OVERRIDDEN_ENV", Value: "overridden"}, {Name: "LOG_LEVEL", Value: "debug"}},
+				"tools":   {{Name: "PARENT_ENV", Value: "parent"}, {Name: "OVERRIDDEN_ENV", Value: "overridden"}, {Name: "LOG_LEVEL", Value: "info"}},
+			},
+		},
+		{
+			name: "Top-level env already defined in parent",
+			devfile: `
+schemaVersion: 2.2.0
+parent:
+  uri: parent.yaml
+env:
+- name: PARENT_ENV
+  value: main
+`,
+			wantErr: "Some Env are already defined in parent: PARENT_ENV. If you want to override them, you should do it in the parent scope.",
+		},
+		{
+			name: "Duplicate top-level env",
+			devfile: `
+schemaVersion: 2.2.0
+env:
+- name: LOG_LEVEL
+  value: debug
+- name: LOG_LEVEL
+  value: info
+components:
+- name: runtime
+  container:
+    image: node
+`,
+			wantErr: "env variable LOG_LEVEL is defined several times in the top-level env",
+		},
+		{
+			name: "Reserved top-level env",
+			devfile: `
+schemaVersion: 2.2.0
+env:
+- name: PROJECT_SOURCE
+  value: /projects
+components:
+- name: runtime
+  container:
+    image: node
+`,
+			wantErr: "env variable PROJECT_SOURCE is reserved and cannot be customized in component runtime",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flattened, _, err := ValidateAndFlatten([]byte(tt.devfile), ResolveOptions{Resolver: resolver})
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr)
+				}
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Empty(t, flattened.Env, "Top-level env should be applied to the containers")
+			env := map[string][]v1alpha2.EnvVar{}
+			for _, component := range flattened.Components {
+				if component.Container != nil {
+					env[component.Name] = component.Container.Env
+				}
+			}
+			assert.Equal(t, tt.wantEnv, env, "Container env should match")
+		})
+	}
+}
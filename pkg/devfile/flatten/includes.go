@@ -0,0 +1,137 @@
+package flatten
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/devfile/unknown"
+	"github.com/devfile/api/v2/pkg/utils/overriding"
+)
+
+// IncludeResolver returns the content (yaml or json) of the fragment with the given path,
+// which is a clean slash-separated path relative to the directory of the main devfile.
+// It should stop and return an error when the given context is done.
+type IncludeResolver func(ctx context.Context, fragmentPath string) ([]byte, error)
+
+// DirIncludeResolver returns an include resolver that reads the fragments from the given directory,
+// which should be the directory of the main devfile
+func DirIncludeResolver(dir string) IncludeResolver {
+	return func(ctx context.Context, fragmentPath string) ([]byte, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(fragmentPath)))
+	}
+}
+
+// Fragment is the content of a devfile fragment, such as a library of commands or components,
+// that is included by the `includes` field of a devfile or of another fragment
+type Fragment struct {
+	// Paths of the fragments included by this fragment, relative to its directory
+	Includes []string `json:"includes,omitempty"`
+	// Components of the fragment
+	Components []v1alpha2.Component `json:"components,omitempty"`
+	// Commands of the fragment
+	Commands []v1alpha2.Command `json:"commands,omitempty"`
+}
+
+// include resolves the fragments included by the given devfile recursively, and merges their components and commands
+// into its content, before its own components and commands. Each fragment is included once,
+// even if it is included by several fragments.
+func (f *flattener) include(ctx context.Context, parsed *v1alpha2.Devfile) error {
+	if len(parsed.Includes) == 0 {
+		return nil
+	}
+	var included []overriding.IncludedContent
+	if err := f.resolveIncludes(ctx, parsed.Includes, ".", nil, map[string]bool{}, &included); err != nil {
+		return err
+	}
+	merged, err := overriding.MergeIncludes(&parsed.DevWorkspaceTemplateSpecContent, included...)
+	if err != nil {
+		return fmt.Errorf("failed to merge the includes: %w", err)
+	}
+	parsed.DevWorkspaceTemplateSpecContent = *merged
+	return nil
+}
+
+// resolveIncludes appends the content of the given includes of the file of the given directory to the included contents,
+// after the content of the fragments they include themselves.
+// The `chain` argument contains the paths of the fragments that are being included, to detect cycles,
+// and the `visited` argument the paths of the fragments that have already been included.
+func (f *flattener) resolveIncludes(ctx context.Context, includes []string, dir string, chain []string, visited map[string]bool, included *[]overriding.IncludedContent) error {
+	for _, include := range includes {
+		fragmentPath, err := includePath(dir, include)
+		if err != nil {
+			return err
+		}
+		for _, including := range chain {
+			if including == fragmentPath {
+				return fmt.Errorf("include cycle detected: %s", strings.Join(append(chain, fragmentPath), " -> "))
+			}
+		}
+		if visited[fragmentPath] {
+			continue
+		}
+		visited[fragmentPath] = true
+
+		fragment, err := f.fetchFragment(ctx, fragmentPath)
+		if err != nil {
+			return err
+		}
+		if err := f.resolveIncludes(ctx, fragment.Includes, path.Dir(fragmentPath), append(chain[:len(chain):len(chain)], fragmentPath), visited, included); err != nil {
+			return err
+		}
+		*included = append(*included, overriding.IncludedContent{
+			Path: fragmentPath,
+			Content: &v1alpha2.DevWorkspaceTemplateSpecContent{
+				Components: fragment.Components,
+				Commands:   fragment.Commands,
+			},
+		})
+	}
+	return nil
+}
+
+// fetchFragment calls the include resolver with the fetch timeout, if any, and parses the returned fragment.
+// The fragment cannot contain other fields than those of the Fragment type.
+func (f *flattener) fetchFragment(ctx context.Context, fragmentPath string) (*Fragment, error) {
+	if f.opts.IncludeResolver == nil {
+		return nil, fmt.Errorf("cannot resolve include %q: no include resolver was provided", fragmentPath)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if f.opts.FetchTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, f.opts.FetchTimeout)
+		defer cancel()
+	}
+	data, err := f.opts.IncludeResolver(ctx, fragmentPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve include %q: %w", fragmentPath, err)
+	}
+	fragment := &Fragment{}
+	if err := unknown.UnmarshalStrict(data, fragment); err != nil {
+		return nil, fmt.Errorf("failed to parse include %q: %w", fragmentPath, err)
+	}
+	return fragment, nil
+}
+
+// includePath returns the path of the given include of the file of the given directory,
+// relative to the directory of the main devfile. Includes cannot be absolute paths,
+// and cannot reference files outside of the directory of the main devfile.
+func includePath(dir string, include string) (string, error) {
+	if include == "" || path.IsAbs(include) || filepath.IsAbs(include) {
+		return "", fmt.Errorf("invalid include %q: it should be a relative path", include)
+	}
+	fragmentPath := path.Join(dir, include)
+	if fragmentPath == ".." || strings.HasPrefix(fragmentPath, "../") {
+		return "", fmt.Errorf("invalid include %q: it should not reference a file outside of the devfile directory", include)
+	}
+	return fragmentPath, nil
+}
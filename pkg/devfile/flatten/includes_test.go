@@ -0,0 +1,237 @@
+package flatten
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testIncludeResolver(fragments map[string]string) IncludeResolver {
+	return func(_ context.Context, fragmentPath string) ([]byte, error) {
+		content, found := fragments[fragmentPath]
+		if !found {
+			return nil, fmt.Errorf("fragment %q not found", fragmentPath)
+		}
+		return []byte(content), nil
+	}
+}
+
+func TestIncludes(t *testing.T) {
+	includeResolver := testIncludeResolver(map[string]string{
+		"lib/components.yaml": `
+components:
+- name: runtime
+  container:
+    image: node
+`,
+		"lib/commands.yaml": `
+includes:
+- components.yaml
+commands:
+- id: build
+  exec:
+    component: runtime
+    commandLine: npm run build
+    group:
+      kind: build
+      isDefault: true
+`,
+		"lib/cycle-a.yaml": `
+includes:
+- cycle-b.yaml
+`,
+		"lib/cycle-b.yaml": `
+includes:
+- ../lib/cycle-a.yaml
+`,
+		"lib/invalid.yaml": `
+components:
+- name: runtime
+  container:
+    image: node
+projects:
+- name: app
+  git:
+    remotes:
+      origin: https://github.com/devfile/api.git
+`,
+	})
+
+	tests := []struct {
+		name            string
+		devfile         string
+		includeResolver IncludeResolver
+		resolver        Resolver
+		wantComponents  []string
+		wantCommands    []string
+		wantErr         string
+	}{
+		{
+			name: "Nested includes",
+			devfile: `
+schemaVersion: 2.2.0
+includes:
+- lib/commands.yaml
+components:
+- name: tools
+  container:
+    image: tools
+`,
+			includeResolver: includeResolver,
+			wantComponents:  []string{"runtime", "tools"},
+			wantCommands:    []string{"build"},
+		},
+		{
+			name: "Fragment included several times",
+			devfile: `
+schemaVersion: 2.2.0
+includes:
+- lib/components.yaml
+- lib/commands.yaml
+`,
+			includeResolver: includeResolver,
+			wantComponents:  []string{"runtime"},
+			wantCommands:    []string{"build"},
+		},
+		{
+			name: "Included elements are merged with the parent",
+			devfile: `
+schemaVersion: 2.2.0
+parent:
+  uri: plugin.yaml
+includes:
+- lib/commands.yaml
+`,
+			includeResolver: includeResolver,
+			resolver:        testResolver(map[string]string{"plugin.yaml": pluginDevfile}),
+			wantComponents:  []string{"tools", "runtime"},
+			wantCommands:    []string{"build"},
+		},
+		{
+			name: "Included element defined by the devfile",
+			devfile: `
+schemaVersion: 2.2.0
+includes:
+- lib/components.yaml
+components:
+- name: runtime
+  container:
+    image: other
+`,
+			includeResolver: includeResolver,
+			wantErr:         "Some Components are already defined in include 'lib/components.yaml': runtime. Included elements cannot be overridden.",
+		},
+		{
+			name: "Include cycle",
+			devfile: `
+schemaVersion: 2.2.0
+includes:
+- lib/cycle-a.yaml
+`,
+			includeResolver: includeResolver,
+			wantErr:         "include cycle detected: lib/cycle-a.yaml -> lib/cycle-b.yaml -> lib/cycle-a.yaml",
+		},
+		{
+			name: "Fragment with other fields",
+			devfile: `
+schemaVersion: 2.2.0
+includes:
+- lib/invalid.yaml
+`,
+			includeResolver: includeResolver,
+			wantErr:         `failed to parse include "lib/invalid.yaml": unknown fields: projects`,
+		},
+		{
+			name: "Include outside of the devfile directory",
+			devfile: `
+schemaVersion: 2.2.0
+includes:
+- ../commands.yaml
+`,
+			includeResolver: includeResolver,
+			wantErr:         `invalid include "../commands.yaml": it should not reference a file outside of the devfile directory`,
+		},
+		{
+			name: "Absolute include",
+			devfile: `
+schemaVersion: 2.2.0
+includes:
+- /etc/commands.yaml
+`,
+			includeResolver: includeResolver,
+			wantErr:         `invalid include "/etc/commands.yaml": it should be a relative path`,
+		},
+		{
+			name: "Includes without include resolver",
+			devfile: `
+schemaVersion: 2.2.0
+includes:
+- lib/components.yaml
+`,
+			wantErr: `cannot resolve include "lib/components.yaml": no include resolver was provided`,
+		},
+		{
+			name: "Includes of a parent",
+			devfile: `
+schemaVersion: 2.2.0
+parent:
+  uri: parent-with-includes.yaml
+`,
+			resolver: testResolver(map[string]string{"parent-with-includes.yaml": `
+schemaVersion: 2.2.0
+includes:
+- lib/components.yaml
+`}),
+			wantErr: "failed to resolve the parent: cannot resolve uri parent-with-includes.yaml: includes are only supported in the main devfile",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flattened, _, err := ValidateAndFlatten([]byte(tt.devfile), ResolveOptions{
+				Resolver:        tt.resolver,
+				IncludeResolver: tt.includeResolver,
+			})
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr)
+				}
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Empty(t, flattened.Includes)
+			var components, commands []string
+			for _, component := range flattened.Components {
+				components = append(components, component.Name)
+			}
+			for _, command := range flattened.Commands {
+				commands = append(commands, command.Id)
+			}
+			assert.Equal(t, tt.wantComponents, components)
+			assert.Equal(t, tt.wantCommands, commands)
+		})
+	}
+}
+
+func TestDirIncludeResolver(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "lib"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	fragment := "commands:\n- id: build\n  exec:\n    component: runtime\n    commandLine: make\n"
+	if err := os.WriteFile(filepath.Join(dir, "lib", "commands.yaml"), []byte(fragment), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := DirIncludeResolver(dir)(context.Background(), "lib/commands.yaml")
+	if assert.NoError(t, err) {
+		assert.Equal(t, fragment, string(content))
+	}
+	_, err = DirIncludeResolver(dir)(context.Background(), "lib/missing.yaml")
+	assert.Error(t, err)
+}
@@ -0,0 +1,139 @@
+package flatten
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+)
+
+// Report tells what the parents and plugins of a devfile contributed to its flattened content,
+// to help understanding why the flattened devfile looks the way it does
+type Report struct {
+	// Imports are the parents and plugins resolved during the flattening, in resolution order:
+	// the imports of a parent or plugin come before it
+	Imports []Import `json:"imports,omitempty"`
+}
+
+// Import is a parent or plugin resolved during the flattening
+type Import struct {
+	// Source identifies the imported devfile, such as `uri https://example.com/devfile.yaml` or `id go:1.0.0`
+	Source string `json:"source"`
+	// ImportedBy is the chain of imports from the main devfile to this one, such as `parent` then `plugin "tools"`
+	// for a plugin of the parent
+	ImportedBy []string `json:"importedBy"`
+	// Components are the names of the components the imported devfile declares itself, apart from its plugins
+	Components []string `json:"components,omitempty"`
+	// Commands are the ids of the commands the imported devfile declares itself
+	Commands []string `json:"commands,omitempty"`
+	// Projects are the names of the projects the imported devfile declares itself
+	Projects []string `json:"projects,omitempty"`
+	// StarterProjects are the names of the starter projects the imported devfile declares itself
+	StarterProjects []string `json:"starterProjects,omitempty"`
+	// Overrides are the paths of the elements overridden by the importing devfile, such as `components[runtime]`
+	// or `variables.version`
+	Overrides []string `json:"overrides,omitempty"`
+}
+
+// FormatReport returns a human-readable description of the given report, with one paragraph per import
+func FormatReport(report Report) string {
+	if len(report.Imports) == 0 {
+		return "No parent or plugin was imported.\n"
+	}
+	var text strings.Builder
+	for _, imported := range report.Imports {
+		fmt.Fprintf(&text, "%s (%s):\n", strings.Join(imported.ImportedBy, " > "), imported.Source)
+		for _, contribution := range []struct {
+			field string
+			keys  []string
+		}{
+			{"components", imported.Components},
+			{"commands", imported.Commands},
+			{"projects", imported.Projects},
+			{"starterProjects", imported.StarterProjects},
+			{"overrides", imported.Overrides},
+		} {
+			if len(contribution.keys) > 0 {
+				fmt.Fprintf(&text, "  %s: %s\n", contribution.field, strings.Join(contribution.keys, ", "))
+			}
+		}
+	}
+	return text.String()
+}
+
+// newImport returns the import of the given devfile, with the elements it declares itself
+func newImport(ref v1alpha2.ImportReference, importedBy []string, spec *v1alpha2.DevWorkspaceTemplateSpec) Import {
+	imported := Import{Source: importReferenceKey(ref), ImportedBy: importedBy}
+	for _, component := range spec.Components {
+		if component.Plugin == nil {
+			imported.Components = append(imported.Components, component.Name)
+		}
+	}
+	for _, command := range spec.Commands {
+		imported.Commands = append(imported.Commands, command.Id)
+	}
+	for _, project := range spec.Projects {
+		imported.Projects = append(imported.Projects, project.Name)
+	}
+	for _, project := range spec.StarterProjects {
+		imported.StarterProjects = append(imported.StarterProjects, project.Name)
+	}
+	return imported
+}
+
+// parentOverridePaths returns the paths of the elements overridden by the given parent overrides
+func parentOverridePaths(overrides *v1alpha2.ParentOverrides) []string {
+	var paths []string
+	for _, component := range overrides.Components {
+		paths = append(paths, fmt.Sprintf("components[%s]", component.Name))
+	}
+	for _, command := range overrides.Commands {
+		paths = append(paths, fmt.Sprintf("commands[%s]", command.Id))
+	}
+	for _, project := range overrides.Projects {
+		paths = append(paths, fmt.Sprintf("projects[%s]", project.Name))
+	}
+	for _, project := range overrides.StarterProjects {
+		paths = append(paths, fmt.Sprintf("starterProjects[%s]", project.Name))
+	}
+	for _, env := range overrides.Env {
+		paths = append(paths, fmt.Sprintf("env[%s]", env.Name))
+	}
+	paths = append(paths, sortedKeyPaths("variables", overrides.Variables)...)
+	var attributeKeys []string
+	for key := range overrides.Attributes {
+		attributeKeys = append(attributeKeys, key)
+	}
+	sort.Strings(attributeKeys)
+	for _, key := range attributeKeys {
+		paths = append(paths, "attributes."+key)
+	}
+	return paths
+}
+
+// pluginOverridePaths returns the paths of the elements overridden by the given plugin overrides
+func pluginOverridePaths(overrides *v1alpha2.PluginOverrides) []string {
+	var paths []string
+	for _, component := range overrides.Components {
+		paths = append(paths, fmt.Sprintf("components[%s]", component.Name))
+	}
+	for _, command := range overrides.Commands {
+		paths = append(paths, fmt.Sprintf("commands[%s]", command.Id))
+	}
+	return paths
+}
+
+// sortedKeyPaths returns the paths of the keys of the given map, sorted by key
+func sortedKeyPaths(field string, values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	paths := make([]string, 0, len(keys))
+	for _, key := range keys {
+		paths = append(paths, field+"."+key)
+	}
+	return paths
+}
@@ -0,0 +1,135 @@
+package flatten
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAndFlattenWithReport(t *testing.T) {
+	resolver := testResolver(map[string]string{
+		"parent.yaml": `
+schemaVersion: 2.2.0
+variables:
+  version: "1.0"
+components:
+- name: runtime
+  container:
+    image: parent-image
+- name: parent-tools
+  plugin:
+    uri: helper.yaml
+commands:
+- id: build
+  exec:
+    component: runtime
+    commandLine: make
+projects:
+- name: web
+  git:
+    remotes:
+      origin: https://github.com/example/web.git
+`,
+		"helper.yaml": `
+schemaVersion: 2.2.0
+components:
+- name: helper
+  container:
+    image: helper-image
+`,
+		"plugin.yaml": pluginDevfile,
+	})
+	devfile := `
+schemaVersion: 2.2.0
+parent:
+  uri: parent.yaml
+  variables:
+    version: "2.0"
+  components:
+  - name: runtime
+    container:
+      image: main-image
+  commands:
+  - id: build
+    exec:
+      commandLine: make all
+components:
+- name: my-plugin
+  plugin:
+    uri: plugin.yaml
+    components:
+    - name: tools
+      container:
+        image: other-tools-image
+`
+	flattened, report, _, err := ValidateAndFlattenWithReport(context.Background(), []byte(devfile), ResolveOptions{Resolver: resolver})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, flattened.Components, 3)
+
+	assert.Equal(t, Report{Imports: []Import{
+		{
+			Source:     "uri helper.yaml",
+			ImportedBy: []string{"parent", `plugin "parent-tools"`},
+			Components: []string{"helper"},
+		},
+		{
+			Source:     "uri parent.yaml",
+			ImportedBy: []string{"parent"},
+			Components: []string{"runtime"},
+			Commands:   []string{"build"},
+			Projects:   []string{"web"},
+			Overrides:  []string{"components[runtime]", "commands[build]", "variables.version"},
+		},
+		{
+			Source:     "uri plugin.yaml",
+			ImportedBy: []string{`plugin "my-plugin"`},
+			Components: []string{"tools"},
+			Overrides:  []string{"components[tools]"},
+		},
+	}}, report)
+}
+
+func TestFormatReport(t *testing.T) {
+	tests := []struct {
+		name   string
+		report Report
+		want   string
+	}{
+		{
+			name: "No import",
+			want: "No parent or plugin was imported.\n",
+		},
+		{
+			name: "Imports",
+			report: Report{Imports: []Import{
+				{
+					Source:     "uri plugin.yaml",
+					ImportedBy: []string{"parent", `plugin "tools"`},
+					Components: []string{"tools"},
+				},
+				{
+					Source:     "id go:1.0.0",
+					ImportedBy: []string{"parent"},
+					Components: []string{"runtime", "data"},
+					Commands:   []string{"build"},
+					Overrides:  []string{"components[runtime]", "env[GOPATH]"},
+				},
+			}},
+			want: `parent > plugin "tools" (uri plugin.yaml):
+  components: tools
+parent (id go:1.0.0):
+  components: runtime, data
+  commands: build
+  overrides: components[runtime], env[GOPATH]
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatReport(tt.report))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package flatten
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/internal/fetch"
+)
+
+// HTTPResolver returns a resolver that fetches the referenced devfiles with the given HTTP client,
+// or with the default HTTP client if it is nil:
+// - `uri` import references must be absolute http or https URLs,
+// - `id` import references are fetched from the devfile registry of the `registryUrl` field,
+// through the `/devfiles/<id>` endpoint of the registry REST API (or `/devfiles/<id>/<version>` when a version is set).
+//
+// Requests are bound to the context passed to the resolver, so that they are cancelled when it is done.
+// Kubernetes import references are not supported.
+func HTTPResolver(client *http.Client) Resolver {
+	return func(ctx context.Context, ref v1alpha2.ImportReference) ([]byte, error) {
+		devfileURL, err := importReferenceURL(ref)
+		if err != nil {
+			return nil, err
+		}
+		return fetch.Get(ctx, client, devfileURL, nil)
+	}
+}
+
+// importReferenceURL returns the http or https URL of the devfile referenced by the given import reference
+func importReferenceURL(ref v1alpha2.ImportReference) (string, error) {
+	switch {
+	case ref.Uri != "":
+		parsed, err := url.Parse(ref.Uri)
+		if err != nil {
+			return "", fmt.Errorf("invalid uri %q: %w", ref.Uri, err)
+		}
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			return "", fmt.Errorf("cannot fetch uri %q: only absolute http and https URLs are supported", ref.Uri)
+		}
+		return ref.Uri, nil
+	case ref.Id != "":
+		if ref.RegistryUrl == "" {
+			return "", fmt.Errorf("cannot fetch id %q: registryUrl is not set", ref.Id)
+		}
+		devfileURL := strings.TrimSuffix(ref.RegistryUrl, "/") + "/devfiles/" + url.PathEscape(ref.Id)
+		if ref.Version != "" {
+			devfileURL += "/" + url.PathEscape(ref.Version)
+		}
+		return devfileURL, nil
+	case ref.Kubernetes != nil:
+		return "", fmt.Errorf("cannot fetch kubernetes reference %s/%s: kubernetes import references are not supported", ref.Kubernetes.Namespace, ref.Kubernetes.Name)
+	default:
+		return "", fmt.Errorf("cannot fetch an empty import reference")
+	}
+}
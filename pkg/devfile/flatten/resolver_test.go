@@ -0,0 +1,139 @@
+package flatten
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPResolver(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/parent.yaml":
+			w.Write([]byte(parentDevfile))
+		case "/devfiles/java-plugin/1.0.0":
+			w.Write([]byte(pluginDevfile))
+		case "/slow.yaml":
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name           string
+		devfile        string
+		fetchTimeout   time.Duration
+		wantComponents []string
+		wantErr        string
+	}{
+		{
+			name: "Parent uri and plugin id fetched over HTTP",
+			devfile: `
+schemaVersion: 2.2.0
+parent:
+  uri: ` + server.URL + `/parent.yaml
+components:
+- name: my-plugin
+  plugin:
+    id: java-plugin
+    registryUrl: ` + server.URL + `
+    version: 1.0.0
+`,
+			wantComponents: []string{"runtime", "tools"},
+		},
+		{
+			name: "Missing devfile",
+			devfile: `
+schemaVersion: 2.2.0
+parent:
+  uri: ` + server.URL + `/missing.yaml
+`,
+			wantErr: "failed to fetch " + server.URL + "/missing.yaml: 404 Not Found",
+		},
+		{
+			name: "Relative uri",
+			devfile: `
+schemaVersion: 2.2.0
+parent:
+  uri: parent.yaml
+`,
+			wantErr: "cannot fetch uri \"parent.yaml\": only absolute http and https URLs are supported",
+		},
+		{
+			name: "Id without registry URL",
+			devfile: `
+schemaVersion: 2.2.0
+parent:
+  id: java-plugin
+`,
+			wantErr: "cannot fetch id \"java-plugin\": registryUrl is not set",
+		},
+		{
+			name: "Fetch timeout",
+			devfile: `
+schemaVersion: 2.2.0
+parent:
+  uri: ` + server.URL + `/slow.yaml
+`,
+			fetchTimeout: 50 * time.Millisecond,
+			wantErr:      "context deadline exceeded",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flattened, _, err := ValidateAndFlatten([]byte(tt.devfile), ResolveOptions{
+				Resolver:     HTTPResolver(server.Client()),
+				FetchTimeout: tt.fetchTimeout,
+			})
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr, "Error message should match")
+				}
+				return
+			}
+			assert.NoError(t, err)
+
+			var components []string
+			for _, component := range flattened.Components {
+				components = append(components, component.Name)
+			}
+			assert.ElementsMatch(t, tt.wantComponents, components, "Flattened components should match")
+		})
+	}
+}
+
+func TestValidateAndFlattenContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	resolverCalls := 0
+	resolver := func(ctx context.Context, ref v1alpha2.ImportReference) ([]byte, error) {
+		resolverCalls++
+		// the caller gives up while the parent is being fetched
+		cancel()
+		return []byte(parentDevfile), nil
+	}
+
+	_, _, err := ValidateAndFlattenContext(ctx, []byte(`
+schemaVersion: 2.2.0
+parent:
+  uri: parent.yaml
+components:
+- name: my-plugin
+  plugin:
+    uri: plugin.yaml
+`), ResolveOptions{Resolver: resolver})
+
+	if assert.Error(t, err) {
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+	assert.Equal(t, 1, resolverCalls, "The resolver should not be called once the context is cancelled")
+}
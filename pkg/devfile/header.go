@@ -15,6 +15,13 @@ type DevfileHeader struct {
 	// +optional
 	// Optional metadata
 	Metadata DevfileMetadata `json:"metadata,omitempty"`
+
+	// Paths of local devfile fragments, such as libraries of commands or components, whose components and commands
+	// are included in the devfile when it is parsed. A fragment only contains `components`, `commands` and `includes` fields,
+	// and its paths are relative to the directory of the file that includes it.
+	// +optional
+	// +devfile:since=2.2.0
+	Includes []string `json:"includes,omitempty"`
 }
 
 // Architecture describes the architecture type
@@ -51,6 +58,7 @@ type DevfileMetadata struct {
 
 	// Optional devfile description
 	// +optional
+	// +devfile:ui:widget=textarea
 	Description string `json:"description,omitempty"`
 
 	// Optional devfile tags
@@ -60,6 +68,7 @@ type DevfileMetadata struct {
 	// Optional list of processor architectures that the devfile supports, empty list suggests that the devfile can be used on any architecture
 	// +optional
 	// +kubebuilder:validation:UniqueItems=true
+	// +devfile:since=2.2.0
 	Architectures []Architecture `json:"architectures,omitempty"`
 
 	// Optional devfile icon, can be a URI or a relative path in the project
@@ -84,9 +93,11 @@ type DevfileMetadata struct {
 
 	// Optional devfile provider information
 	// +optional
+	// +devfile:since=2.2.0
 	Provider string `json:"provider,omitempty"`
 
 	// Optional link to a page that provides support information
 	// +optional
+	// +devfile:since=2.2.0
 	SupportUrl string `json:"supportUrl,omitempty"`
 }
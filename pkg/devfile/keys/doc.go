@@ -0,0 +1,8 @@
+// Package keys provides constants for the well-known attribute keys, annotation names and label names
+// used across the devfile ecosystem, so that consumers don't have to repeat raw strings.
+//
+// The constants are generated by the `keys` generator from the `keys.yaml` registry file of this package:
+// new keys should be added to the registry file, before running the `build.sh` script.
+//
+// This package has no dependency, so that it can be imported by the K8S API types.
+package keys
@@ -0,0 +1,356 @@
+// Package lint checks devfiles against style rules, that go beyond the validity of the devfile
+// (such as a missing display name, or container images that are not pinned to a specific tag),
+// so that devfile registries can enforce quality gates on the stacks and samples they publish.
+//
+// Each rule has a default severity, that can be changed, or set to `off` to disable the rule, through a Config.
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"sigs.k8s.io/yaml"
+)
+
+// Severity is the severity of the problems reported by a lint rule
+type Severity string
+
+const (
+	// Off disables a lint rule
+	Off Severity = "off"
+	// Info is the severity of the problems that are only worth mentioning
+	Info Severity = "info"
+	// Warning is the severity of the problems that should be fixed, but don't break the quality gate
+	Warning Severity = "warning"
+	// Error is the severity of the problems that break the quality gate
+	Error Severity = "error"
+)
+
+// severityLevels orders the severities, from the disabled rules to the most severe problems
+var severityLevels = map[Severity]int{
+	Off:     0,
+	Info:    1,
+	Warning: 2,
+	Error:   3,
+}
+
+// ParseSeverity returns the severity with the given name
+func ParseSeverity(name string) (Severity, error) {
+	severity := Severity(name)
+	if _, known := severityLevels[severity]; !known {
+		return "", fmt.Errorf("unknown severity %q, should be one of: %s, %s, %s, %s", name, Off, Info, Warning, Error)
+	}
+	return severity, nil
+}
+
+// AtLeast returns true if the severity is at least as high as the given one
+func (s Severity) AtLeast(other Severity) bool {
+	return severityLevels[s] >= severityLevels[other]
+}
+
+// RuleID identifies a lint rule
+type RuleID string
+
+const (
+	// MissingDisplayNameRule reports devfiles without a `metadata.displayName`
+	MissingDisplayNameRule RuleID = "missing-display-name"
+	// UnpinnedImageRule reports container images without a tag or digest, or with the `latest` tag
+	UnpinnedImageRule RuleID = "unpinned-image"
+	// UngroupedCommandRule reports commands that are not part of a command group,
+	// unless they are referenced by a composite command
+	UngroupedCommandRule RuleID = "ungrouped-command"
+	// UnpinnedParentRule reports parents referenced by a registry id without a specific version
+	UnpinnedParentRule RuleID = "unpinned-parent"
+	// TooManyComponentsRule reports devfiles with more components than the `max` parameter of the rule.
+	// It is disabled by default.
+	TooManyComponentsRule RuleID = "too-many-components"
+)
+
+// Rule is a lint rule
+type Rule struct {
+	// ID is the identifier of the rule
+	ID RuleID
+	// Description describes the problems reported by the rule
+	Description string
+	// DefaultSeverity is the severity of the rule when it isn't overridden in the Config
+	DefaultSeverity Severity
+	// Parameters describes the parameters of the rule, by name
+	Parameters map[string]string
+
+	check func(devfile *v1alpha2.Devfile, parameters Parameters, report reporter) error
+}
+
+// reporter reports a problem at the given path of the devfile
+type reporter func(path string, message string)
+
+// Parameters are the parameters of a lint rule, by name
+type Parameters map[string]interface{}
+
+// Int returns the value of the given integer parameter, or the given default value if the parameter is not set
+func (p Parameters) Int(name string, defaultValue int) (int, error) {
+	value, isSet := p[name]
+	if !isSet {
+		return defaultValue, nil
+	}
+	switch value := value.(type) {
+	case int:
+		return value, nil
+	case int64:
+		return int(value), nil
+	case float64:
+		if value == float64(int(value)) {
+			return int(value), nil
+		}
+	}
+	return 0, fmt.Errorf("the %q parameter should be an integer, but is %v", name, value)
+}
+
+// Rules returns the available lint rules, sorted by ID
+func Rules() []Rule {
+	rules := []Rule{
+		{
+			ID:              MissingDisplayNameRule,
+			Description:     "the devfile should have a display name, to be listed in devfile registries and IDEs",
+			DefaultSeverity: Warning,
+			check:           checkDisplayName,
+		},
+		{
+			ID:              UnpinnedImageRule,
+			Description:     "container images should be pinned to a specific tag or digest, rather than the implicit or explicit `latest` tag",
+			DefaultSeverity: Warning,
+			check:           checkImages,
+		},
+		{
+			ID:              UngroupedCommandRule,
+			Description:     "commands should be part of a command group (such as build or run), unless they are referenced by a composite command",
+			DefaultSeverity: Info,
+			check:           checkCommandGroups,
+		},
+		{
+			ID:              UnpinnedParentRule,
+			Description:     "parents referenced by a registry id should be pinned to a specific version",
+			DefaultSeverity: Warning,
+			check:           checkParent,
+		},
+		{
+			ID:              TooManyComponentsRule,
+			Description:     "the devfile should not have too many components, to keep development environments lightweight",
+			DefaultSeverity: Off,
+			Parameters: map[string]string{
+				"max": fmt.Sprintf("maximum number of components (%d by default)", defaultMaxComponents),
+			},
+			check: checkComponentCount,
+		},
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return rules
+}
+
+// Config configures the lint rules
+type Config struct {
+	// Severities overrides the default severity of the rules, by rule ID.
+	// The `off` severity disables a rule.
+	Severities map[RuleID]Severity
+	// Parameters sets the parameters of the rules, by rule ID
+	Parameters map[RuleID]Parameters
+}
+
+// Validate checks that the configuration only references known rules and severities
+func (c Config) Validate() error {
+	known := map[RuleID]Rule{}
+	for _, rule := range Rules() {
+		known[rule.ID] = rule
+	}
+	for id, severity := range c.Severities {
+		if _, isKnown := known[id]; !isKnown {
+			return fmt.Errorf("unknown lint rule %q", id)
+		}
+		if _, err := ParseSeverity(string(severity)); err != nil {
+			return fmt.Errorf("invalid severity of lint rule %q: %w", id, err)
+		}
+	}
+	for id, parameters := range c.Parameters {
+		rule, isKnown := known[id]
+		if !isKnown {
+			return fmt.Errorf("unknown lint rule %q", id)
+		}
+		for name := range parameters {
+			if _, isParameter := rule.Parameters[name]; !isParameter {
+				return fmt.Errorf("unknown parameter %q of lint rule %q", name, id)
+			}
+		}
+	}
+	return nil
+}
+
+// Finding is a problem reported by a lint rule
+type Finding struct {
+	// Rule is the ID of the rule that reports the problem
+	Rule RuleID `json:"rule"`
+	// Severity is the severity of the problem
+	Severity Severity `json:"severity"`
+	// Path is the path of the devfile field that has the problem, such as `components[runtime].container.image`.
+	// The elements of the lists are designated by their name or id.
+	Path string `json:"path"`
+	// Message describes the problem
+	Message string `json:"message"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s (%s)", f.Severity, f.Path, f.Message, f.Rule)
+}
+
+// Devfile lints the given devfile content (yaml or json)
+func Devfile(content []byte, config Config) ([]Finding, error) {
+	devfile := &v1alpha2.Devfile{}
+	if err := yaml.Unmarshal(content, devfile); err != nil {
+		return nil, fmt.Errorf("failed to parse the devfile: %w", err)
+	}
+	return Lint(devfile, config)
+}
+
+// Lint checks the given devfile against the lint rules, with the severities of the given configuration,
+// and returns the problems found, grouped by rule.
+func Lint(devfile *v1alpha2.Devfile, config Config) ([]Finding, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	var findings []Finding
+	for _, rule := range Rules() {
+		severity := rule.DefaultSeverity
+		if configured, isConfigured := config.Severities[rule.ID]; isConfigured {
+			severity = configured
+		}
+		if severity == Off {
+			continue
+		}
+		err := rule.check(devfile, config.Parameters[rule.ID], func(path string, message string) {
+			findings = append(findings, Finding{
+				Rule:     rule.ID,
+				Severity: severity,
+				Path:     path,
+				Message:  message,
+			})
+		})
+		if err != nil {
+			return nil, fmt.Errorf("invalid parameters of lint rule %q: %w", rule.ID, err)
+		}
+	}
+	return findings, nil
+}
+
+// MaxSeverity returns the highest severity of the given findings, or `off` if there is no finding
+func MaxSeverity(findings []Finding) Severity {
+	max := Off
+	for _, finding := range findings {
+		if !max.AtLeast(finding.Severity) {
+			max = finding.Severity
+		}
+	}
+	return max
+}
+
+// Format formats the given findings, one per line
+func Format(findings []Finding) string {
+	var buf strings.Builder
+	for _, finding := range findings {
+		buf.WriteString(finding.String() + "\n")
+	}
+	return buf.String()
+}
+
+func checkDisplayName(devfile *v1alpha2.Devfile, _ Parameters, report reporter) error {
+	if strings.TrimSpace(devfile.Metadata.DisplayName) == "" {
+		report("metadata.displayName", "the devfile has no display name")
+	}
+	return nil
+}
+
+func checkImages(devfile *v1alpha2.Devfile, _ Parameters, report reporter) error {
+	for _, component := range devfile.Components {
+		if component.Container == nil {
+			continue
+		}
+		image := component.Container.Image
+		path := fmt.Sprintf("components[%s].container.image", component.Name)
+		if strings.Contains(image, "@") {
+			// pinned to a digest
+			continue
+		}
+		// the tag is after the last colon of the last path segment, since the registry host may have a port
+		name := image[strings.LastIndex(image, "/")+1:]
+		colon := strings.LastIndex(name, ":")
+		switch {
+		case colon < 0:
+			report(path, fmt.Sprintf("the image %q has no tag, so the latest image is used", image))
+		case name[colon+1:] == "latest":
+			report(path, fmt.Sprintf("the image %q uses the latest tag", image))
+		}
+	}
+	return nil
+}
+
+func checkCommandGroups(devfile *v1alpha2.Devfile, _ Parameters, report reporter) error {
+	subCommands := map[string]bool{}
+	for _, command := range devfile.Commands {
+		if command.Composite != nil {
+			for _, subCommand := range command.Composite.Commands {
+				subCommands[strings.ToLower(subCommand)] = true
+			}
+		}
+	}
+	for _, command := range devfile.Commands {
+		if subCommands[strings.ToLower(command.Id)] {
+			continue
+		}
+		if getGroup(command) == nil {
+			report(fmt.Sprintf("commands[%s]", command.Id), fmt.Sprintf("the command %q is not part of a command group", command.Id))
+		}
+	}
+	return nil
+}
+
+func getGroup(command v1alpha2.Command) *v1alpha2.CommandGroup {
+	switch {
+	case command.Composite != nil:
+		return command.Composite.Group
+	case command.Exec != nil:
+		return command.Exec.Group
+	case command.Apply != nil:
+		return command.Apply.Group
+	case command.Custom != nil:
+		return command.Custom.Group
+	default:
+		return nil
+	}
+}
+
+func checkParent(devfile *v1alpha2.Devfile, _ Parameters, report reporter) error {
+	parent := devfile.Parent
+	if parent == nil || parent.Id == "" {
+		return nil
+	}
+	switch parent.Version {
+	case "":
+		report("parent.version", fmt.Sprintf("the parent %q has no version, so its default version is used", parent.Id))
+	case "latest":
+		report("parent.version", fmt.Sprintf("the parent %q uses the latest version", parent.Id))
+	}
+	return nil
+}
+
+// defaultMaxComponents is the default value of the `max` parameter of the too-many-components rule
+const defaultMaxComponents = 10
+
+func checkComponentCount(devfile *v1alpha2.Devfile, parameters Parameters, report reporter) error {
+	max, err := parameters.Int("max", defaultMaxComponents)
+	if err != nil {
+		return err
+	}
+	if len(devfile.Components) > max {
+		report("components", fmt.Sprintf("the devfile has %d components, more than the maximum of %d", len(devfile.Components), max))
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const lintedDevfile = `
+schemaVersion: 2.2.0
+metadata:
+  name: nodejs
+parent:
+  id: nodejs
+  registryUrl: https://registry.devfile.io
+components:
+- name: runtime
+  container:
+    image: node
+- name: tools
+  container:
+    image: quay.io/devfile/universal-developer-image:latest
+- name: pinned
+  container:
+    image: localhost:5000/devfile/node:18
+- name: digest
+  container:
+    image: quay.io/devfile/node@sha256:0123456789abcdef
+commands:
+- id: install
+  exec:
+    component: runtime
+    commandLine: npm install
+- id: start
+  exec:
+    component: runtime
+    commandLine: npm start
+- id: run
+  composite:
+    commands: [install, start]
+    group:
+      kind: run
+- id: debug
+  exec:
+    component: runtime
+    commandLine: npm run debug
+`
+
+func TestDevfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "Default severities",
+			want: []string{
+				"warning: metadata.displayName: the devfile has no display name (missing-display-name)",
+				"info: commands[debug]: the command \"debug\" is not part of a command group (ungrouped-command)",
+				"warning: components[runtime].container.image: the image \"node\" has no tag, so the latest image is used (unpinned-image)",
+				"warning: components[tools].container.image: the image \"quay.io/devfile/universal-developer-image:latest\" uses the latest tag (unpinned-image)",
+				"warning: parent.version: the parent \"nodejs\" has no version, so its default version is used (unpinned-parent)",
+			},
+		},
+		{
+			name: "Configured severities",
+			config: Config{
+				Severities: map[RuleID]Severity{
+					MissingDisplayNameRule: Off,
+					UngroupedCommandRule:   Off,
+					UnpinnedImageRule:      Error,
+				},
+			},
+			want: []string{
+				"error: components[runtime].container.image: the image \"node\" has no tag, so the latest image is used (unpinned-image)",
+				"error: components[tools].container.image: the image \"quay.io/devfile/universal-developer-image:latest\" uses the latest tag (unpinned-image)",
+				"warning: parent.version: the parent \"nodejs\" has no version, so its default version is used (unpinned-parent)",
+			},
+		},
+		{
+			name: "Enabled rule with parameters",
+			config: Config{
+				Severities: map[RuleID]Severity{
+					MissingDisplayNameRule: Off,
+					UngroupedCommandRule:   Off,
+					UnpinnedImageRule:      Off,
+					UnpinnedParentRule:     Off,
+					TooManyComponentsRule:  Error,
+				},
+				Parameters: map[RuleID]Parameters{
+					TooManyComponentsRule: {"max": float64(3)},
+				},
+			},
+			want: []string{
+				"error: components: the devfile has 4 components, more than the maximum of 3 (too-many-components)",
+			},
+		},
+		{
+			name: "Unknown parameter",
+			config: Config{
+				Parameters: map[RuleID]Parameters{
+					TooManyComponentsRule: {"min": 1},
+				},
+			},
+			wantErr: "unknown parameter \"min\" of lint rule \"too-many-components\"",
+		},
+		{
+			name: "Invalid parameter",
+			config: Config{
+				Severities: map[RuleID]Severity{TooManyComponentsRule: Warning},
+				Parameters: map[RuleID]Parameters{
+					TooManyComponentsRule: {"max": "three"},
+				},
+			},
+			wantErr: "invalid parameters of lint rule \"too-many-components\": the \"max\" parameter should be an integer, but is three",
+		},
+		{
+			name: "Unknown rule",
+			config: Config{
+				Severities: map[RuleID]Severity{"unknown": Error},
+			},
+			wantErr: "unknown lint rule \"unknown\"",
+		},
+		{
+			name: "Unknown severity",
+			config: Config{
+				Severities: map[RuleID]Severity{UnpinnedImageRule: "fatal"},
+			},
+			wantErr: "invalid severity of lint rule \"unpinned-image\": unknown severity \"fatal\", should be one of: off, info, warning, error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings, err := Devfile([]byte(lintedDevfile), tt.config)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			var got []string
+			for _, finding := range findings {
+				got = append(got, finding.String())
+			}
+			assert.Equal(t, tt.want, got, "Findings should match")
+		})
+	}
+}
+
+func TestMaxSeverity(t *testing.T) {
+	assert.Equal(t, Off, MaxSeverity(nil))
+	assert.Equal(t, Error, MaxSeverity([]Finding{{Severity: Info}, {Severity: Error}, {Severity: Warning}}))
+}
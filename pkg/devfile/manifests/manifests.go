@@ -0,0 +1,630 @@
+// Package manifests renders flattened devfiles into the Kubernetes manifests of a complete workspace:
+// a Deployment that runs the container components in a single pod, the Services of their endpoints,
+// the PersistentVolumeClaims of their volumes and project sources, unless the storage strategy is ephemeral, and the Ingresses of their public endpoints.
+//
+// It is meant for lightweight tooling that deploys devfiles without running the DevWorkspace operator.
+// The features that are not rendered, such as Kubernetes components or image builds, are returned as warnings.
+package manifests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/api/v2/pkg/devfile/endpoints"
+	"github.com/devfile/api/v2/pkg/devfile/flatten"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// NameLabel is the label that identifies the objects of a workspace, and selects its pod
+	NameLabel = "app.kubernetes.io/name"
+	// DefaultName is the name of the workspace when neither Options.Name nor the devfile metadata name is set
+	DefaultName = "workspace"
+	// DefaultVolumeSize is the size of the PersistentVolumeClaims of the volumes that don't set their size,
+	// and of the project sources
+	DefaultVolumeSize = "1Gi"
+)
+
+const (
+	// projectsVolumeName is the name of the pod volume of the project sources
+	projectsVolumeName = "projects"
+	// defaultSourceMapping is the path where the project sources are mounted when a container doesn't set `sourceMapping`
+	defaultSourceMapping = "/projects"
+	// maxPortNameLength is the maximum length of the names of container and service ports
+	maxPortNameLength = 15
+)
+
+// Options configures how a devfile is rendered into Kubernetes manifests
+type Options struct {
+	// Name is the name of the Deployment, and the prefix of the names of the other objects.
+	// The devfile metadata name is used when it is empty, and DefaultName when both are empty.
+	Name string
+	// Namespace is the namespace of the objects. They have no namespace when it is empty.
+	Namespace string
+	// StorageClass is the storage class of the PersistentVolumeClaims. The default storage class of the cluster
+	// is used when it is empty.
+	StorageClass string
+	// IngressClass is the class of the Ingresses. The default ingress class of the cluster is used when it is empty.
+	IngressClass string
+	// Endpoints configures how the public endpoints are routed by the Ingresses: their base domain, routing strategy,
+	// prefix and TLS. With the path routing strategy, the ingress controller should strip the path prefix of the requests,
+	// which is controller-specific and can be configured with endpoint annotations.
+	Endpoints endpoints.Options
+}
+
+// Manifests are the Kubernetes objects of a workspace
+type Manifests struct {
+	Deployment             *appsv1.Deployment
+	Services               []corev1.Service
+	PersistentVolumeClaims []corev1.PersistentVolumeClaim
+	Ingresses              []networkingv1.Ingress
+}
+
+// Objects returns the objects of the manifests, in the order in which they should be applied:
+// the PersistentVolumeClaims, the Deployment, the Services, then the Ingresses
+func (m *Manifests) Objects() []runtime.Object {
+	var objects []runtime.Object
+	for i := range m.PersistentVolumeClaims {
+		objects = append(objects, &m.PersistentVolumeClaims[i])
+	}
+	if m.Deployment != nil {
+		objects = append(objects, m.Deployment)
+	}
+	for i := range m.Services {
+		objects = append(objects, &m.Services[i])
+	}
+	for i := range m.Ingresses {
+		objects = append(objects, &m.Ingresses[i])
+	}
+	return objects
+}
+
+// Marshal returns the given manifests as a multi-document yaml content, in the order of `Objects`
+func Marshal(m *Manifests) ([]byte, error) {
+	var content []byte
+	for i, object := range m.Objects() {
+		document, err := yaml.Marshal(object)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			content = append(content, "---\n"...)
+		}
+		content = append(content, document...)
+	}
+	return content, nil
+}
+
+// Warning is a feature of the devfile that is not rendered in the manifests
+type Warning struct {
+	// Path is the path of the devfile field that is not rendered, such as `components[runtime].container.dedicatedPod`
+	Path string
+	// Message tells why the field is not rendered
+	Message string
+}
+
+func (w Warning) String() string {
+	return w.Path + ": " + w.Message
+}
+
+// Export renders the given flattened devfile into the Kubernetes manifests of a workspace.
+//
+// The container components run in the pod of the Deployment, and the apply commands of container components bound to
+// the `preStart` event run as its init containers. The `pod-overrides` and `container-overrides` attributes are applied
+// as strategic merge patches.
+// It returns warnings, sorted by path, for the devfile features that are not rendered, and an error if the devfile
+// has no container component, if its overrides are invalid, or if it has public endpoints but the options have no base domain.
+func Export(flattened *flatten.FlattenedDevfile, opts Options) (*Manifests, []Warning, error) {
+	content := &flattened.DevWorkspaceTemplateSpecContent
+	e := &exporter{content: content, opts: opts}
+	if e.opts.Name == "" {
+		e.opts.Name = flattened.Metadata.Name
+	}
+	if e.opts.Name == "" {
+		e.opts.Name = DefaultName
+	}
+
+	result := &Manifests{}
+	podSpec := corev1.PodSpec{}
+	var podOverrides []attributes.Attributes
+	deploymentAnnotations := map[string]string{}
+	for _, component := range content.Components {
+		path := fmt.Sprintf("components[%s]", component.Name)
+		switch {
+		case component.Container != nil:
+			container, err := e.container(component)
+			if err != nil {
+				return nil, nil, err
+			}
+			podSpec.Containers = append(podSpec.Containers, container)
+			podOverrides = append(podOverrides, component.Attributes)
+			if service := e.service(component); service != nil {
+				result.Services = append(result.Services, *service)
+			}
+			ingresses, err := e.ingresses(component)
+			if err != nil {
+				return nil, nil, err
+			}
+			result.Ingresses = append(result.Ingresses, ingresses...)
+			if component.Container.Annotation != nil {
+				for key, value := range component.Container.Annotation.Deployment {
+					deploymentAnnotations[key] = value
+				}
+			}
+			if component.Container.GetDedicatedPod() {
+				e.warn(path+".container.dedicatedPod", "all the containers run in the pod of the Deployment")
+			}
+		case component.Kubernetes != nil, component.Openshift != nil:
+			e.warn(path, "Kubernetes and OpenShift components are not rendered")
+		case component.Image != nil:
+			e.warn(path, "images are not built: the images of the containers should be available in a registry")
+		}
+	}
+	if len(podSpec.Containers) == 0 {
+		return nil, nil, errors.New("the devfile has no container component to render in a Deployment")
+	}
+	initContainers, err := e.initContainers()
+	if err != nil {
+		return nil, nil, err
+	}
+	podSpec.InitContainers = initContainers
+
+	volumes, claims := e.podVolumes()
+	podSpec.Volumes = volumes
+	result.PersistentVolumeClaims = claims
+
+	template := corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: e.labels()}, Spec: podSpec}
+	for _, attrs := range append([]attributes.Attributes{content.Attributes}, podOverrides...) {
+		if template, err = applyPodOverrides(template, attrs); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	replicas := int32(1)
+	result.Deployment = &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"},
+		ObjectMeta: e.objectMeta(e.opts.Name, deploymentAnnotations),
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: e.labels()},
+			// the volumes are usually ReadWriteOnce, and cannot be mounted by the pods of two replica sets at the same time
+			Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+			Template: template,
+		},
+	}
+
+	sort.SliceStable(e.warnings, func(i, j int) bool {
+		return e.warnings[i].Path < e.warnings[j].Path
+	})
+	return result, e.warnings, nil
+}
+
+type exporter struct {
+	content *v1alpha2.DevWorkspaceTemplateSpecContent
+	opts    Options
+	// mountsSources is true if a container mounts the project sources
+	mountsSources bool
+	warnings      []Warning
+}
+
+func (e *exporter) warn(path string, format string, args ...interface{}) {
+	e.warnings = append(e.warnings, Warning{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (e *exporter) labels() map[string]string {
+	return map[string]string{NameLabel: e.opts.Name}
+}
+
+func (e *exporter) objectMeta(name string, annotations map[string]string) metav1.ObjectMeta {
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	return metav1.ObjectMeta{Name: name, Namespace: e.opts.Namespace, Labels: e.labels(), Annotations: annotations}
+}
+
+// container returns the K8S container of the given container component, with its container overrides applied
+func (e *exporter) container(component v1alpha2.Component) (corev1.Container, error) {
+	devfileContainer := component.Container.Container
+	path := fmt.Sprintf("components[%s].container", component.Name)
+	container := corev1.Container{Name: component.Name, Image: devfileContainer.Image}
+	if err := devfileContainer.ApplyEntrypoint(&container, v1alpha2.EntrypointOptions{}); err != nil {
+		return corev1.Container{}, fmt.Errorf("component %q: %w", component.Name, err)
+	}
+
+	for _, env := range devfileContainer.Env {
+		container.Env = append(container.Env, corev1.EnvVar{Name: env.Name, Value: env.Value})
+	}
+	if devfileContainer.GetMountSources() {
+		e.mountsSources = true
+		sourceMapping := devfileContainer.SourceMapping
+		if sourceMapping == "" {
+			sourceMapping = defaultSourceMapping
+		}
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: projectsVolumeName, MountPath: sourceMapping})
+		for _, env := range []corev1.EnvVar{
+			{Name: "PROJECTS_ROOT", Value: sourceMapping},
+			{Name: "PROJECT_SOURCE", Value: e.projectSource(sourceMapping)},
+		} {
+			if !hasEnv(container.Env, env.Name) {
+				container.Env = append(container.Env, env)
+			}
+		}
+	}
+	for _, volumeMount := range devfileContainer.VolumeMounts {
+		mountPath := volumeMount.Path
+		if mountPath == "" {
+			mountPath = "/" + volumeMount.Name
+		}
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: volumeMount.Name, MountPath: mountPath})
+	}
+	// the secret and configMap components with a mount path are mounted in all the containers that don't mount them explicitly
+	for _, other := range e.content.Components {
+		mount := other.GetConfigurationMount()
+		if mount == nil || mount.MountPath == "" || hasVolumeMount(container.VolumeMounts, other.Name) {
+			continue
+		}
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: other.Name, MountPath: mount.MountPath, ReadOnly: true})
+	}
+
+	for _, endpoint := range component.Container.Endpoints {
+		if hasContainerPort(container.Ports, endpoint.TargetPort) {
+			continue
+		}
+		container.Ports = append(container.Ports, corev1.ContainerPort{
+			Name:          portName(endpoint),
+			ContainerPort: int32(endpoint.TargetPort),
+			Protocol:      portProtocol(endpoint),
+		})
+	}
+
+	for _, quantity := range []struct {
+		field     string
+		value     string
+		resources *corev1.ResourceList
+		name      corev1.ResourceName
+	}{
+		{"memoryLimit", devfileContainer.MemoryLimit, &container.Resources.Limits, corev1.ResourceMemory},
+		{"memoryRequest", devfileContainer.MemoryRequest, &container.Resources.Requests, corev1.ResourceMemory},
+		{"cpuLimit", devfileContainer.CpuLimit, &container.Resources.Limits, corev1.ResourceCPU},
+		{"cpuRequest", devfileContainer.CpuRequest, &container.Resources.Requests, corev1.ResourceCPU},
+	} {
+		if quantity.value == "" {
+			continue
+		}
+		parsed, err := resource.ParseQuantity(quantity.value)
+		if err != nil {
+			return corev1.Container{}, fmt.Errorf("%s.%s: invalid quantity: %w", path, quantity.field, err)
+		}
+		if *quantity.resources == nil {
+			*quantity.resources = corev1.ResourceList{}
+		}
+		(*quantity.resources)[quantity.name] = parsed
+	}
+
+	container, err := applyContainerOverrides(container, component.Attributes)
+	if err != nil {
+		return corev1.Container{}, fmt.Errorf("component %q: %w", component.Name, err)
+	}
+	return container, nil
+}
+
+// projectSource returns the path of the sources of the first project, where the projects are mounted at the given path
+func (e *exporter) projectSource(sourceMapping string) string {
+	if len(e.content.Projects) == 0 {
+		return sourceMapping
+	}
+	project := e.content.Projects[0]
+	if project.ClonePath != "" {
+		return path.Join(sourceMapping, project.ClonePath)
+	}
+	return path.Join(sourceMapping, project.Name)
+}
+
+// initContainers returns the init containers of the apply commands of container components bound to the `preStart` event
+func (e *exporter) initContainers() ([]corev1.Container, error) {
+	if e.content.Events == nil {
+		return nil, nil
+	}
+	var initContainers []corev1.Container
+	for i, id := range e.content.Events.PreStart {
+		path := fmt.Sprintf("events.preStart[%d]", i)
+		command := e.command(id)
+		if command == nil || command.Apply == nil {
+			e.warn(path, "only the apply commands of container components are rendered as init containers")
+			continue
+		}
+		component := e.component(command.Apply.Component)
+		if component == nil || component.Container == nil {
+			e.warn(path, "only the apply commands of container components are rendered as init containers")
+			continue
+		}
+		initContainer, err := e.container(*component)
+		if err != nil {
+			return nil, err
+		}
+		initContainer.Name = command.Id
+		initContainer.Ports = nil
+		initContainers = append(initContainers, initContainer)
+	}
+	return initContainers, nil
+}
+
+func (e *exporter) command(id string) *v1alpha2.Command {
+	for i := range e.content.Commands {
+		if e.content.Commands[i].Id == id {
+			return &e.content.Commands[i]
+		}
+	}
+	return nil
+}
+
+func (e *exporter) component(name string) *v1alpha2.Component {
+	for i := range e.content.Components {
+		if e.content.Components[i].Name == name {
+			return &e.content.Components[i]
+		}
+	}
+	return nil
+}
+
+// podVolumes returns the volumes of the pod, and the PersistentVolumeClaims of the persistent ones:
+// the project sources, if mounted, and the volume components.
+// With the ephemeral storage strategy, all of them are emptyDir volumes.
+func (e *exporter) podVolumes() ([]corev1.Volume, []corev1.PersistentVolumeClaim) {
+	strategy, err := e.content.ResolveStorageStrategy()
+	if err != nil {
+		e.warn("attributes."+v1alpha2.StorageTypeAttribute, "the storage strategy %s is used: %s", strategy, err)
+	}
+	var volumes []corev1.Volume
+	var claims []corev1.PersistentVolumeClaim
+	addVolume := func(volumeName string, size string, ephemeral bool) {
+		sizePath := fmt.Sprintf("components[%s].volume.size", volumeName)
+		if ephemeral || strategy == v1alpha2.EphemeralStorageStrategy {
+			volumes = append(volumes, e.emptyDirVolume(volumeName, size, sizePath))
+			return
+		}
+		claim := e.claim(e.opts.Name+"-"+volumeName, size, sizePath)
+		claims = append(claims, claim)
+		volumes = append(volumes, corev1.Volume{
+			Name:         volumeName,
+			VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim.Name}},
+		})
+	}
+	if e.mountsSources {
+		addVolume(projectsVolumeName, "", false)
+	}
+	for _, component := range e.content.Components {
+		if configurationVolume, isConfiguration := component.ToVolume(component.Name); isConfiguration {
+			volumes = append(volumes, configurationVolume)
+			continue
+		}
+		if component.Volume != nil {
+			addVolume(component.Name, component.Volume.Size, component.Volume.GetEphemeral())
+		}
+	}
+	return volumes, claims
+}
+
+// emptyDirVolume returns an emptyDir volume with the given name, limited to the given size if set
+func (e *exporter) emptyDirVolume(name string, size string, sizePath string) corev1.Volume {
+	emptyDir := &corev1.EmptyDirVolumeSource{}
+	if size != "" {
+		if sizeLimit, err := resource.ParseQuantity(size); err != nil {
+			e.warn(sizePath, "invalid quantity: %s", err)
+		} else {
+			emptyDir.SizeLimit = &sizeLimit
+		}
+	}
+	return corev1.Volume{Name: name, VolumeSource: corev1.VolumeSource{EmptyDir: emptyDir}}
+}
+
+// claim returns a ReadWriteOnce PersistentVolumeClaim with the given name and size
+func (e *exporter) claim(name string, size string, sizePath string) corev1.PersistentVolumeClaim {
+	storage := resource.MustParse(DefaultVolumeSize)
+	if size != "" {
+		if parsed, err := resource.ParseQuantity(size); err != nil {
+			e.warn(sizePath, "invalid quantity, the default size %s is used: %s", DefaultVolumeSize, err)
+		} else {
+			storage = parsed
+		}
+	}
+	claim := corev1.PersistentVolumeClaim{
+		TypeMeta:   metav1.TypeMeta{APIVersion: corev1.SchemeGroupVersion.String(), Kind: "PersistentVolumeClaim"},
+		ObjectMeta: e.objectMeta(name, nil),
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources:   corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceStorage: storage}},
+		},
+	}
+	if e.opts.StorageClass != "" {
+		storageClass := e.opts.StorageClass
+		claim.Spec.StorageClassName = &storageClass
+	}
+	return claim
+}
+
+// service returns the Service of the public and internal endpoints of the given container component,
+// or nil if it has none
+func (e *exporter) service(component v1alpha2.Component) *corev1.Service {
+	var ports []corev1.ServicePort
+	for _, endpoint := range component.Container.Endpoints {
+		if endpoint.Exposure == v1alpha2.NoneEndpointExposure || hasServicePort(ports, endpoint.TargetPort) {
+			continue
+		}
+		ports = append(ports, corev1.ServicePort{
+			Name:       portName(endpoint),
+			Port:       int32(endpoint.TargetPort),
+			TargetPort: intstr.FromInt(endpoint.TargetPort),
+			Protocol:   portProtocol(endpoint),
+		})
+	}
+	if len(ports) == 0 {
+		return nil
+	}
+	var annotations map[string]string
+	if component.Container.Annotation != nil {
+		annotations = component.Container.Annotation.Service
+	}
+	return &corev1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: corev1.SchemeGroupVersion.String(), Kind: "Service"},
+		ObjectMeta: e.objectMeta(e.opts.Name+"-"+component.Name, annotations),
+		Spec: corev1.ServiceSpec{
+			Selector: e.labels(),
+			Ports:    ports,
+		},
+	}
+}
+
+// ingresses returns the Ingresses of the public endpoints of the given container component
+func (e *exporter) ingresses(component v1alpha2.Component) ([]networkingv1.Ingress, error) {
+	var ingresses []networkingv1.Ingress
+	for _, endpoint := range component.Container.Endpoints {
+		if endpoint.Exposure != "" && endpoint.Exposure != v1alpha2.PublicEndpointExposure {
+			continue
+		}
+		switch endpoint.Protocol {
+		case v1alpha2.TCPEndpointProtocol, v1alpha2.UDPEndpointProtocol:
+			e.warn(fmt.Sprintf("components[%s].container.endpoints[%s]", component.Name, endpoint.Name),
+				"only http and websocket endpoints can be routed by an Ingress, the %s endpoint is only exposed by the Service", endpoint.Protocol)
+			continue
+		}
+
+		// the route of the endpoint is its URL without its path, which is part of the requests
+		route := endpoint
+		route.Path = ""
+		routeURL, err := endpoints.URL(component.Name, route, e.opts.Endpoints)
+		if err != nil {
+			return nil, err
+		}
+		pathType := networkingv1.PathTypePrefix
+		ingress := networkingv1.Ingress{
+			TypeMeta:   metav1.TypeMeta{APIVersion: networkingv1.SchemeGroupVersion.String(), Kind: "Ingress"},
+			ObjectMeta: e.objectMeta(e.opts.Name+"-"+endpoint.Name, endpoint.Annotations),
+			Spec: networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{{
+					Host: routeURL.Hostname(),
+					IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     routeURL.Path,
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+								Name: e.opts.Name + "-" + component.Name,
+								Port: networkingv1.ServiceBackendPort{Number: int32(endpoint.TargetPort)},
+							}},
+						}},
+					}},
+				}},
+			},
+		}
+		if e.opts.IngressClass != "" {
+			ingressClass := e.opts.IngressClass
+			ingress.Spec.IngressClassName = &ingressClass
+		}
+		if e.opts.Endpoints.TLS {
+			ingress.Spec.TLS = []networkingv1.IngressTLS{{Hosts: []string{routeURL.Hostname()}}}
+		}
+		ingresses = append(ingresses, ingress)
+	}
+	return ingresses, nil
+}
+
+// applyPodOverrides applies the `pod-overrides` attribute of the given attributes, if any, to the given pod template
+func applyPodOverrides(template corev1.PodTemplateSpec, attrs attributes.Attributes) (corev1.PodTemplateSpec, error) {
+	overrides, err := v1alpha2.GetPodOverrides(attrs)
+	if err != nil || overrides == nil {
+		return template, err
+	}
+	patched := corev1.PodTemplateSpec{}
+	err = strategicMerge(template, attrs[v1alpha2.PodOverridesAttribute].Raw, &patched)
+	return patched, err
+}
+
+// applyContainerOverrides applies the `container-overrides` attribute of the given attributes, if any, to the given container
+func applyContainerOverrides(container corev1.Container, attrs attributes.Attributes) (corev1.Container, error) {
+	overrides, err := v1alpha2.GetContainerOverrides(attrs)
+	if err != nil || overrides == nil {
+		return container, err
+	}
+	patched := corev1.Container{}
+	err = strategicMerge(container, attrs[v1alpha2.ContainerOverridesAttribute].Raw, &patched)
+	return patched, err
+}
+
+// strategicMerge applies the given strategic merge patch to the given original object, into the given patched object
+func strategicMerge(original interface{}, patch []byte, patched interface{}) error {
+	originalJSON, err := json.Marshal(original)
+	if err != nil {
+		return err
+	}
+	patchedJSON, err := strategicpatch.StrategicMergePatch(originalJSON, patch, patched)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(patchedJSON, patched)
+}
+
+// portName returns the name of the container and service ports of the given endpoint,
+// which is the endpoint name unless it is longer than port names can be
+func portName(endpoint v1alpha2.Endpoint) string {
+	if len(endpoint.Name) <= maxPortNameLength {
+		return endpoint.Name
+	}
+	return "port-" + strconv.Itoa(endpoint.TargetPort)
+}
+
+func portProtocol(endpoint v1alpha2.Endpoint) corev1.Protocol {
+	if endpoint.Protocol == v1alpha2.UDPEndpointProtocol {
+		return corev1.ProtocolUDP
+	}
+	return corev1.ProtocolTCP
+}
+
+func hasEnv(env []corev1.EnvVar, name string) bool {
+	for _, envVar := range env {
+		if envVar.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func hasVolumeMount(volumeMounts []corev1.VolumeMount, name string) bool {
+	for _, volumeMount := range volumeMounts {
+		if volumeMount.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func hasContainerPort(ports []corev1.ContainerPort, port int) bool {
+	for _, containerPort := range ports {
+		if containerPort.ContainerPort == int32(port) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasServicePort(ports []corev1.ServicePort, port int) bool {
+	for _, servicePort := range ports {
+		if servicePort.Port == int32(port) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,396 @@
+package manifests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/devfile/endpoints"
+	"github.com/devfile/api/v2/pkg/devfile/flatten"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const nodeDevfile = `
+schemaVersion: 2.2.0
+metadata:
+  name: nodejs
+attributes:
+  pod-overrides:
+    spec:
+      serviceAccountName: developer
+projects:
+- name: web
+  git:
+    remotes:
+      origin: https://github.com/example/web.git
+components:
+- name: runtime
+  attributes:
+    container-overrides:
+      securityContext:
+        runAsUser: 1000
+  container:
+    image: node:18
+    command: [npm]
+    args: [start]
+    memoryLimit: 1Gi
+    env:
+    - name: NODE_ENV
+      value: development
+    endpoints:
+    - name: http
+      targetPort: 3000
+      path: /api
+      annotation:
+        nginx.ingress.kubernetes.io/proxy-body-size: 10m
+    - name: debug
+      targetPort: 9229
+      exposure: internal
+    - name: metrics
+      targetPort: 9090
+      exposure: none
+    - name: stream
+      targetPort: 4000
+      protocol: tcp
+    volumeMounts:
+    - name: node-modules
+      path: /projects/web/node_modules
+    - name: tmp
+    - name: npmrc
+      path: /home/node/.npmrc.d
+- name: node-modules
+  volume:
+    size: 2Gi
+- name: tmp
+  volume:
+    ephemeral: true
+- name: registry-certs
+  secret:
+    secretName: registry-certs
+    mountPath: /etc/ssl/registry
+    optional: true
+- name: npmrc
+  configMap:
+    configMapName: npm-settings
+    items:
+    - key: npmrc
+      path: .npmrc
+- name: route
+  kubernetes:
+    inlined: "kind: Route"
+commands:
+- id: install
+  apply:
+    component: runtime
+events:
+  preStart: [install]
+`
+
+func TestExport(t *testing.T) {
+	flattened, _, err := flatten.ValidateAndFlatten([]byte(nodeDevfile), flatten.ResolveOptions{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	result, warnings, err := Export(&flattened, Options{
+		Namespace:    "dev",
+		StorageClass: "fast",
+		Endpoints:    endpoints.Options{BaseDomain: "apps.example.com", Routing: endpoints.PathRouting, Prefix: "alice", TLS: true},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []Warning{
+		{Path: "components[route]", Message: "Kubernetes and OpenShift components are not rendered"},
+		{Path: "components[runtime].container.endpoints[stream]", Message: "only http and websocket endpoints can be routed by an Ingress, the tcp endpoint is only exposed by the Service"},
+	}, warnings)
+
+	optional := true
+	deployment := result.Deployment
+	assert.Equal(t, "nodejs", deployment.Name)
+	assert.Equal(t, "dev", deployment.Namespace)
+	assert.Equal(t, map[string]string{NameLabel: "nodejs"}, deployment.Spec.Selector.MatchLabels)
+	podSpec := deployment.Spec.Template.Spec
+	assert.Equal(t, "developer", podSpec.ServiceAccountName)
+	if assert.Len(t, podSpec.Containers, 1) {
+		container := podSpec.Containers[0]
+		assert.Equal(t, "runtime", container.Name)
+		assert.Equal(t, []string{"npm"}, container.Command)
+		assert.Equal(t, []string{"start"}, container.Args)
+		assert.Equal(t, []corev1.EnvVar{
+			{Name: "NODE_ENV", Value: "development"},
+			{Name: "PROJECTS_ROOT", Value: "/projects"},
+			{Name: "PROJECT_SOURCE", Value: "/projects/web"},
+		}, container.Env)
+		assert.Equal(t, []corev1.VolumeMount{
+			{Name: "projects", MountPath: "/projects"},
+			{Name: "node-modules", MountPath: "/projects/web/node_modules"},
+			{Name: "tmp", MountPath: "/tmp"},
+			{Name: "npmrc", MountPath: "/home/node/.npmrc.d"},
+			{Name: "registry-certs", MountPath: "/etc/ssl/registry", ReadOnly: true},
+		}, container.VolumeMounts)
+		assert.Len(t, container.Ports, 4)
+		assert.True(t, resource.MustParse("1Gi").Equal(container.Resources.Limits[corev1.ResourceMemory]))
+		if assert.NotNil(t, container.SecurityContext) {
+			assert.Equal(t, int64(1000), *container.SecurityContext.RunAsUser)
+		}
+	}
+	if assert.Len(t, podSpec.InitContainers, 1) {
+		assert.Equal(t, "install", podSpec.InitContainers[0].Name)
+		assert.Equal(t, "node:18", podSpec.InitContainers[0].Image)
+		assert.Empty(t, podSpec.InitContainers[0].Ports)
+	}
+	assert.Equal(t, []corev1.Volume{
+		{Name: "projects", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "nodejs-projects"}}},
+		{Name: "node-modules", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "nodejs-node-modules"}}},
+		{Name: "tmp", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		{Name: "registry-certs", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "registry-certs", Optional: &optional}}},
+		{Name: "npmrc", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "npm-settings"},
+			Items:                []corev1.KeyToPath{{Key: "npmrc", Path: ".npmrc"}},
+		}}},
+	}, podSpec.Volumes)
+
+	if assert.Len(t, result.PersistentVolumeClaims, 2) {
+		claim := result.PersistentVolumeClaims[1]
+		assert.Equal(t, "nodejs-node-modules", claim.Name)
+		assert.Equal(t, "fast", *claim.Spec.StorageClassName)
+		assert.True(t, resource.MustParse("2Gi").Equal(claim.Spec.Resources.Requests[corev1.ResourceStorage]))
+		assert.True(t, resource.MustParse(DefaultVolumeSize).Equal(result.PersistentVolumeClaims[0].Spec.Resources.Requests[corev1.ResourceStorage]))
+	}
+
+	if assert.Len(t, result.Services, 1) {
+		service := result.Services[0]
+		assert.Equal(t, "nodejs-runtime", service.Name)
+		assert.Equal(t, []corev1.ServicePort{
+			{Name: "http", Port: 3000, TargetPort: intstr.FromInt(3000), Protocol: corev1.ProtocolTCP},
+			{Name: "debug", Port: 9229, TargetPort: intstr.FromInt(9229), Protocol: corev1.ProtocolTCP},
+			{Name: "stream", Port: 4000, TargetPort: intstr.FromInt(4000), Protocol: corev1.ProtocolTCP},
+		}, service.Spec.Ports)
+	}
+
+	if assert.Len(t, result.Ingresses, 1) {
+		ingress := result.Ingresses[0]
+		assert.Equal(t, "nodejs-http", ingress.Name)
+		assert.Equal(t, map[string]string{"nginx.ingress.kubernetes.io/proxy-body-size": "10m"}, ingress.Annotations)
+		assert.Equal(t, []networkingv1.IngressTLS{{Hosts: []string{"apps.example.com"}}}, ingress.Spec.TLS)
+		rule := ingress.Spec.Rules[0]
+		assert.Equal(t, "apps.example.com", rule.Host)
+		assert.Equal(t, "/alice/http/", rule.HTTP.Paths[0].Path)
+		assert.Equal(t, "nodejs-runtime", rule.HTTP.Paths[0].Backend.Service.Name)
+		assert.Equal(t, int32(3000), rule.HTTP.Paths[0].Backend.Service.Port.Number)
+	}
+
+	assert.Len(t, result.Objects(), 5)
+}
+
+func TestExportStorageStrategy(t *testing.T) {
+	const devfile = `
+schemaVersion: 2.2.0
+metadata:
+  name: go
+%s
+projects:
+- name: api
+  git:
+    remotes:
+      origin: https://github.com/example/api.git
+components:
+- name: runtime
+  container:
+    image: golang:1.19
+    volumeMounts:
+    - name: cache
+      path: /home/user/.cache
+- name: cache
+  volume:
+    size: 1Gi
+`
+	sizeLimit := resource.MustParse("1Gi")
+	emptyDirVolumes := []corev1.Volume{
+		{Name: "projects", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		{Name: "cache", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{SizeLimit: &sizeLimit}}},
+	}
+	claimVolumes := []corev1.Volume{
+		{Name: "projects", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "go-projects"}}},
+		{Name: "cache", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "go-cache"}}},
+	}
+	tests := []struct {
+		name         string
+		strategy     string
+		wantVolumes  []corev1.Volume
+		wantClaims   int
+		wantWarnings []Warning
+	}{
+		{
+			name:        "Default storage strategy",
+			wantVolumes: claimVolumes,
+			wantClaims:  2,
+		},
+		{
+			name:        "Ephemeral storage strategy",
+			strategy:    "storageStrategy: ephemeral",
+			wantVolumes: emptyDirVolumes,
+		},
+		{
+			name:        "Ephemeral legacy storage-type attribute",
+			strategy:    "attributes:\n  controller.devfile.io/storage-type: ephemeral",
+			wantVolumes: emptyDirVolumes,
+		},
+		{
+			name:        "Unknown legacy storage-type attribute",
+			strategy:    "attributes:\n  controller.devfile.io/storage-type: async",
+			wantVolumes: claimVolumes,
+			wantClaims:  2,
+			wantWarnings: []Warning{{
+				Path: "attributes.controller.devfile.io/storage-type",
+				Message: `the storage strategy per-workspace is used: attribute "controller.devfile.io/storage-type" is invalid: ` +
+					`it should be one of: per-workspace, common, ephemeral, but is "async"`,
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flattened, _, err := flatten.ValidateAndFlatten([]byte(fmt.Sprintf(devfile, tt.strategy)), flatten.ResolveOptions{})
+			if !assert.NoError(t, err) {
+				return
+			}
+			result, warnings, err := Export(&flattened, Options{})
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.wantWarnings, warnings)
+			assert.Equal(t, tt.wantVolumes, result.Deployment.Spec.Template.Spec.Volumes)
+			assert.Len(t, result.PersistentVolumeClaims, tt.wantClaims)
+		})
+	}
+}
+
+func TestExportErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		devfile string
+		wantErr string
+	}{
+		{
+			name: "No container component",
+			devfile: `
+schemaVersion: 2.2.0
+components:
+- name: data
+  volume: {}
+`,
+			wantErr: "the devfile has no container component to render in a Deployment",
+		},
+		{
+			name: "Public endpoint without base domain",
+			devfile: `
+schemaVersion: 2.2.0
+components:
+- name: runtime
+  container:
+    image: node:18
+    endpoints:
+    - name: http
+      targetPort: 3000
+`,
+			wantErr: `endpoint "http" of component "runtime" is public, but no base domain is given`,
+		},
+		{
+			name: "Restricted container overrides",
+			devfile: `
+schemaVersion: 2.2.0
+components:
+- name: runtime
+  attributes:
+    container-overrides: {image: other}
+  container:
+    image: node:18
+`,
+			wantErr: `component "runtime": attribute "container-overrides" is invalid: the following fields are managed by the devfile and cannot be overridden: image`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flattened, _, _ := flatten.ValidateAndFlatten([]byte(tt.devfile), flatten.ResolveOptions{})
+			_, _, err := Export(&flattened, Options{})
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	flattened, _, err := flatten.ValidateAndFlatten([]byte(`
+schemaVersion: 2.2.0
+components:
+- name: runtime
+  container:
+    image: node:18
+    mountSources: false
+    endpoints:
+    - name: debug
+      targetPort: 9229
+      exposure: internal
+`), flatten.ResolveOptions{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	result, _, err := Export(&flattened, Options{Name: "web"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	content, err := Marshal(result)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  creationTimestamp: null
+  labels:
+    app.kubernetes.io/name: web
+  name: web
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app.kubernetes.io/name: web
+  strategy:
+    type: Recreate
+  template:
+    metadata:
+      creationTimestamp: null
+      labels:
+        app.kubernetes.io/name: web
+    spec:
+      containers:
+      - image: node:18
+        name: runtime
+        ports:
+        - containerPort: 9229
+          name: debug
+          protocol: TCP
+        resources: {}
+status: {}
+---
+apiVersion: v1
+kind: Service
+metadata:
+  creationTimestamp: null
+  labels:
+    app.kubernetes.io/name: web
+  name: web-runtime
+spec:
+  ports:
+  - name: debug
+    port: 9229
+    protocol: TCP
+    targetPort: 9229
+  selector:
+    app.kubernetes.io/name: web
+status:
+  loadBalancer: {}
+`, string(content))
+	}
+}
@@ -0,0 +1,119 @@
+// Package plan resolves the commands bound to the devfile events, or run on demand, into execution plans:
+// trees of steps that tell the tools which commands to run, in which order, with which concurrency,
+// and how to react to their failures, so that all the tools give composite commands the same semantics.
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+)
+
+// EventType is the type of a devfile event
+type EventType string
+
+const (
+	PreStartEvent  EventType = "preStart"
+	PostStartEvent EventType = "postStart"
+	PreStopEvent   EventType = "preStop"
+	PostStopEvent  EventType = "postStop"
+)
+
+// Step is a step of an execution plan, which executes a command.
+//
+// The steps of a composite command are executed in declaration order, or concurrently if the step is parallel.
+// When a step fails, its enclosing step fails too, unless the failed step has `ContinueOnError` set:
+// the following steps are then not executed, and the steps running in parallel are stopped.
+type Step struct {
+	// Command is the id of the executed command
+	Command string `json:"command"`
+	// ContinueOnError is true if the failure of the step doesn't make its enclosing step fail
+	ContinueOnError bool `json:"continueOnError,omitempty"`
+	// Parallel is true if the steps of the composite command are executed concurrently
+	Parallel bool `json:"parallel,omitempty"`
+	// MaxConcurrency is the maximum number of steps of a parallel composite command executed at the same time.
+	// It is 0 if all the steps are executed at the same time.
+	MaxConcurrency int `json:"maxConcurrency,omitempty"`
+	// Steps are the steps of the sub-commands of a composite command, in declaration order
+	Steps []*Step `json:"steps,omitempty"`
+}
+
+// ForCommand returns the execution plan of the command with the given id
+func ForCommand(commands []v1alpha2.Command, id string) (*Step, error) {
+	commandMap := make(map[string]v1alpha2.Command, len(commands))
+	for _, command := range commands {
+		commandMap[strings.ToLower(command.Id)] = command
+	}
+	return buildStep(commandMap, id, map[string]bool{})
+}
+
+// ForEvent returns the execution plans of the commands bound to the given event type, in execution order.
+// The commands of an event are executed sequentially, and the event fails as soon as one of them fails.
+func ForEvent(events *v1alpha2.Events, commands []v1alpha2.Command, eventType EventType) ([]*Step, error) {
+	if events == nil {
+		return nil, nil
+	}
+	var ids []string
+	switch eventType {
+	case PreStartEvent:
+		ids = events.PreStart
+	case PostStartEvent:
+		ids = events.PostStart
+	case PreStopEvent:
+		ids = events.PreStop
+	case PostStopEvent:
+		ids = events.PostStop
+	default:
+		return nil, fmt.Errorf("unknown event type %q, should be one of: %s, %s, %s, %s", eventType, PreStartEvent, PostStartEvent, PreStopEvent, PostStopEvent)
+	}
+
+	var steps []*Step
+	for _, id := range ids {
+		step, err := ForCommand(commands, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan the %s event: %w", eventType, err)
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// buildStep builds the step of the command with the given id, where parents are the ids of the enclosing composite commands
+func buildStep(commandMap map[string]v1alpha2.Command, id string, parents map[string]bool) (*Step, error) {
+	command, exists := commandMap[strings.ToLower(id)]
+	if !exists {
+		return nil, fmt.Errorf("the command %q does not exist in the devfile", id)
+	}
+	step := &Step{Command: command.Id}
+	composite := command.Composite
+	if composite == nil {
+		return step, nil
+	}
+
+	if parents[strings.ToLower(command.Id)] {
+		return nil, fmt.Errorf("the composite command %q references itself", command.Id)
+	}
+	parents[strings.ToLower(command.Id)] = true
+	defer delete(parents, strings.ToLower(command.Id))
+
+	if composite.Parallel != nil && *composite.Parallel {
+		step.Parallel = true
+		if composite.MaxConcurrency != nil {
+			step.MaxConcurrency = *composite.MaxConcurrency
+		}
+	}
+	continueOnError := make(map[string]bool)
+	for _, subCommand := range composite.ContinueOnError {
+		continueOnError[strings.ToLower(subCommand)] = true
+	}
+	for _, subCommand := range composite.Commands {
+		subStep, err := buildStep(commandMap, subCommand, parents)
+		if err != nil {
+			return nil, err
+		}
+		subStep.ContinueOnError = continueOnError[strings.ToLower(subCommand)]
+		step.Steps = append(step.Steps, subStep)
+	}
+	return step, nil
+}
@@ -0,0 +1,125 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/stretchr/testify/assert"
+)
+
+func execCommand(id string) v1alpha2.Command {
+	return v1alpha2.Command{
+		Id: id,
+		CommandUnion: v1alpha2.CommandUnion{
+			Exec: &v1alpha2.ExecCommand{CommandLine: "echo " + id, Component: "tools"},
+		},
+	}
+}
+
+func compositeCommand(id string, composite v1alpha2.CompositeCommand) v1alpha2.Command {
+	return v1alpha2.Command{
+		Id:           id,
+		CommandUnion: v1alpha2.CommandUnion{Composite: &composite},
+	}
+}
+
+func TestForCommand(t *testing.T) {
+	parallel := true
+	maxConcurrency := 2
+
+	tests := []struct {
+		name     string
+		commands []v1alpha2.Command
+		id       string
+		want     *Step
+		wantErr  string
+	}{
+		{
+			name:     "Exec command",
+			commands: []v1alpha2.Command{execCommand("build")},
+			id:       "build",
+			want:     &Step{Command: "build"},
+		},
+		{
+			name: "Sequential composite command",
+			commands: []v1alpha2.Command{
+				execCommand("compile"),
+				execCommand("lint"),
+				compositeCommand("build", v1alpha2.CompositeCommand{Commands: []string{"compile", "lint"}, ContinueOnError: []string{"lint"}}),
+			},
+			id: "build",
+			want: &Step{Command: "build", Steps: []*Step{
+				{Command: "compile"},
+				{Command: "lint", ContinueOnError: true},
+			}},
+		},
+		{
+			name: "Nested parallel composite command",
+			commands: []v1alpha2.Command{
+				execCommand("frontend"),
+				execCommand("backend"),
+				execCommand("docs"),
+				compositeCommand("all", v1alpha2.CompositeCommand{Commands: []string{"frontend", "backend", "docs"}, Parallel: &parallel, MaxConcurrency: &maxConcurrency}),
+				compositeCommand("run", v1alpha2.CompositeCommand{Commands: []string{"all"}}),
+			},
+			id: "run",
+			want: &Step{Command: "run", Steps: []*Step{
+				{Command: "all", Parallel: true, MaxConcurrency: 2, Steps: []*Step{
+					{Command: "frontend"},
+					{Command: "backend"},
+					{Command: "docs"},
+				}},
+			}},
+		},
+		{
+			name:     "Missing command",
+			commands: []v1alpha2.Command{execCommand("build")},
+			id:       "run",
+			wantErr:  "the command \"run\" does not exist in the devfile",
+		},
+		{
+			name: "Composite command referencing itself",
+			commands: []v1alpha2.Command{
+				compositeCommand("build", v1alpha2.CompositeCommand{Commands: []string{"all"}}),
+				compositeCommand("all", v1alpha2.CompositeCommand{Commands: []string{"build"}}),
+			},
+			id:      "all",
+			wantErr: "the composite command \"all\" references itself",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, err := ForCommand(tt.commands, tt.id)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, step)
+			}
+		})
+	}
+}
+
+func TestForEvent(t *testing.T) {
+	commands := []v1alpha2.Command{execCommand("init"), execCommand("cleanup")}
+	events := &v1alpha2.Events{DevWorkspaceEvents: v1alpha2.DevWorkspaceEvents{
+		PostStart: []string{"init", "cleanup"},
+		PreStop:   []string{"missing"},
+	}}
+
+	steps, err := ForEvent(events, commands, PostStartEvent)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []*Step{{Command: "init"}, {Command: "cleanup"}}, steps)
+	}
+
+	steps, err = ForEvent(events, commands, PreStartEvent)
+	assert.NoError(t, err)
+	assert.Empty(t, steps)
+
+	_, err = ForEvent(events, commands, PreStopEvent)
+	assert.EqualError(t, err, "failed to plan the preStop event: the command \"missing\" does not exist in the devfile")
+
+	_, err = ForEvent(events, commands, "onSave")
+	assert.EqualError(t, err, "unknown event type \"onSave\", should be one of: preStart, postStart, preStop, postStop")
+}
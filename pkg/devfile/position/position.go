@@ -0,0 +1,326 @@
+// Package position locates the elements of a devfile in its yaml or json content, by line and column,
+// and attaches these positions to the parsing and validation errors, so that editor integrations can underline
+// the offending line of the devfile rather than showing a detached path.
+//
+// Elements are designated by the same paths as in the validation errors, such as `components[runtime].container.image`:
+// the elements of the lists of objects that have a `name` or an `id` field are designated by their key,
+// and the other list elements by their index.
+package position
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/validation"
+	"github.com/hashicorp/go-multierror"
+	"gopkg.in/yaml.v3"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+// keyFields are the fields that identify the elements of a keyed list, by order of preference
+var keyFields = []string{"name", "id"}
+
+// yamlErrorLine matches the line number in the messages of the yaml syntax errors
+var yamlErrorLine = regexp.MustCompile(`^yaml: line (\d+): `)
+
+// Position is a position in the devfile content. Lines and columns start at 1.
+type Position struct {
+	Line   int
+	Column int
+}
+
+func (p Position) String() string {
+	if p.Column == 0 {
+		return fmt.Sprintf("line %d", p.Line)
+	}
+	return fmt.Sprintf("line %d, column %d", p.Line, p.Column)
+}
+
+// Error is an error located in the devfile content
+type Error struct {
+	// Path is the path of the devfile element that the error is about, if known
+	Path string
+	// Position is the position of the element in the devfile content
+	Position Position
+	// Err is the located error
+	Err error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Position, e.Err)
+}
+
+// Unwrap returns the located error
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// field is a field or a list element of the devfile content
+type field struct {
+	// path is the path of the element
+	path string
+	// jsonPath is the path of the element as reported by the Json type errors: dot-separated, with the list elements designated by their index
+	jsonPath string
+	// jsonType is the Json type of the value of the element, as reported by the Json type errors
+	jsonType string
+	// indent is the column at which the element starts, including the dash of the list elements
+	indent int
+}
+
+// Positions are the positions of the elements of a devfile content
+type Positions struct {
+	positions map[string]Position
+	// fields are the elements of the content, in document order
+	fields []field
+}
+
+// Parse returns the positions of the elements of the given devfile content (yaml or json).
+// The yaml syntax errors are returned as an *Error.
+func Parse(data []byte) (*Positions, error) {
+	document := &yaml.Node{}
+	if err := yaml.Unmarshal(data, document); err != nil {
+		return nil, locateYAMLError(err)
+	}
+	positions := &Positions{positions: map[string]Position{}}
+	if len(document.Content) > 0 {
+		root := document.Content[0]
+		positions.index(root, "", "", Position{Line: root.Line, Column: root.Column}, root.Column)
+	}
+	return positions, nil
+}
+
+// ParseDevfile parses the given devfile content (yaml or json), and returns the parsed devfile along with the positions of its elements.
+// The yaml syntax errors, and the fields whose value doesn't match the type of the field, are returned as an *Error.
+func ParseDevfile(data []byte) (*v1alpha2.Devfile, *Positions, error) {
+	positions, err := Parse(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	jsonData, err := k8syaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	devfile := &v1alpha2.Devfile{}
+	if err := json.Unmarshal(jsonData, devfile); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			if located := positions.locateTypeError(typeErr); located != nil {
+				return nil, nil, located
+			}
+		}
+		return nil, nil, err
+	}
+	return devfile, positions, nil
+}
+
+// Lookup returns the position of the devfile element with the given path.
+// If the element is not in the devfile content, such as an element imported from a parent devfile,
+// the position of its closest ancestor in the content is returned.
+// false is returned if no ancestor is in the content either.
+func (p *Positions) Lookup(path string) (Position, bool) {
+	for {
+		if position, found := p.positions[path]; found {
+			return position, true
+		}
+		if path == "" {
+			return Position{}, false
+		}
+		path = parentPath(path)
+	}
+}
+
+// PathAt returns the path of the devfile element at the given position of the content,
+// which is the deepest element that starts on the line of the position, at or before its column.
+// If no element starts there, such as on an empty line where a new field is being typed,
+// the path of the enclosing element is returned: the last element before the position that is less indented than the position,
+// the dash of the list elements being part of their indentation.
+// An empty path designates the root of the devfile.
+func (p *Positions) PathAt(position Position) string {
+	path, enclosing := "", ""
+	found := false
+	for _, f := range p.fields {
+		start, hasPosition := p.positions[f.path]
+		if !hasPosition {
+			continue
+		}
+		if start.Line > position.Line || (start.Line == position.Line && start.Column > position.Column) {
+			break
+		}
+		if start.Line == position.Line {
+			path, found = f.path, true
+		} else if f.indent < position.Column {
+			enclosing = f.path
+		}
+	}
+	if found {
+		return path
+	}
+	return enclosing
+}
+
+// Locate attaches the position of the devfile element that each validation error is about, as returned by validation.ErrorPath,
+// to the given validation errors, which can be a single error or a *multierror.Error.
+// The located errors are returned as an *Error, and the errors that cannot be located are returned unchanged.
+func Locate(err error, positions *Positions) error {
+	if err == nil {
+		return nil
+	}
+	var merr *multierror.Error
+	if errors.As(err, &merr) {
+		var located error
+		for _, e := range merr.Errors {
+			located = multierror.Append(located, locate(e, positions))
+		}
+		return located
+	}
+	return locate(err, positions)
+}
+
+// locate attaches to the given validation error the position of the devfile element that it is about, if known
+func locate(err error, positions *Positions) error {
+	path := validation.ErrorPath(err)
+	if path == "" {
+		return err
+	}
+	position, found := positions.Lookup(path)
+	if !found {
+		return err
+	}
+	return &Error{Path: path, Position: position, Err: err}
+}
+
+// locateTypeError attaches to the given Json type error the position of the field whose value doesn't match its type,
+// or returns nil if the field cannot be found.
+// Older GO versions don't report the list indexes and the map keys in the field paths of the type errors:
+// in that case, the first field of the document with the same path, once list indexes are removed, and the same Json type, is used.
+func (p *Positions) locateTypeError(err *json.UnmarshalTypeError) error {
+	if err.Field == "" {
+		return nil
+	}
+	for _, f := range p.fields {
+		if f.jsonPath == err.Field {
+			return &Error{Path: f.path, Position: p.positions[f.path], Err: err}
+		}
+	}
+	jsonType := strings.Fields(err.Value)
+	for _, f := range p.fields {
+		if len(jsonType) > 0 && f.jsonType == jsonType[0] && withoutIndexes(f.jsonPath) == err.Field {
+			return &Error{Path: f.path, Position: p.positions[f.path], Err: err}
+		}
+	}
+	return nil
+}
+
+// index records the positions of the given node and its children, at the given paths.
+// The position of a field is the position of its key, which is where the element starts in the content.
+// The root of the content has no position, so that the elements that are not in the content are not located at its start.
+func (p *Positions) index(node *yaml.Node, path, jsonPath string, position Position, indent int) {
+	if path != "" {
+		p.positions[path] = position
+	}
+	if node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+	p.fields = append(p.fields, field{path: path, jsonPath: jsonPath, jsonType: jsonType(node), indent: indent})
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			p.index(value, joinPath(path, key.Value), joinPath(jsonPath, key.Value), Position{Line: key.Line, Column: key.Column}, key.Column)
+		}
+	case yaml.SequenceNode:
+		for i, element := range node.Content {
+			elementPosition := Position{Line: element.Line, Column: element.Column}
+			indexPath := fmt.Sprintf("%s[%d]", path, i)
+			elementPath := indexPath
+			if key := elementKey(element); key != "" {
+				// the element can also be designated by its index
+				p.positions[indexPath] = elementPosition
+				elementPath = fmt.Sprintf("%s[%s]", path, key)
+			}
+			p.index(element, elementPath, joinPath(jsonPath, strconv.Itoa(i)), elementPosition, node.Column)
+		}
+	}
+}
+
+// elementKey returns the key of the given list element, or an empty string if the element has no key field
+func elementKey(element *yaml.Node) string {
+	if element.Kind != yaml.MappingNode {
+		return ""
+	}
+	for _, keyField := range keyFields {
+		for i := 0; i+1 < len(element.Content); i += 2 {
+			if key, value := element.Content[i], element.Content[i+1]; key.Value == keyField && value.Kind == yaml.ScalarNode && value.Value != "" {
+				return value.Value
+			}
+		}
+	}
+	return ""
+}
+
+// jsonType returns the Json type of the given node, as reported by the Json type errors
+func jsonType(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "array"
+	}
+	switch node.ShortTag() {
+	case "!!int", "!!float":
+		return "number"
+	case "!!bool":
+		return "bool"
+	case "!!null":
+		return "null"
+	}
+	return "string"
+}
+
+// locateYAMLError returns the given yaml syntax error as an *Error, if its message contains a line number
+func locateYAMLError(err error) error {
+	match := yamlErrorLine.FindStringSubmatchIndex(err.Error())
+	if match == nil {
+		return err
+	}
+	message := err.Error()
+	line, _ := strconv.Atoi(message[match[2]:match[3]])
+	return &Error{Position: Position{Line: line}, Err: errors.New("yaml: " + message[match[1]:])}
+}
+
+// parentPath returns the path of the parent of the element with the given path
+func parentPath(path string) string {
+	if strings.HasSuffix(path, "]") {
+		if i := strings.LastIndex(path, "["); i >= 0 {
+			return path[:i]
+		}
+	}
+	if i := strings.LastIndex(path, "."); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
+
+// withoutIndexes removes the list indexes from the given Json path
+func withoutIndexes(jsonPath string) string {
+	var segments []string
+	for _, segment := range strings.Split(jsonPath, ".") {
+		if _, err := strconv.Atoi(segment); err != nil {
+			segments = append(segments, segment)
+		}
+	}
+	return strings.Join(segments, ".")
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
@@ -0,0 +1,186 @@
+package position
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/validation"
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+)
+
+const devfileContent = `schemaVersion: 2.2.0
+metadata:
+  name: nodejs
+components:
+  - name: runtime
+    container:
+      image: node:18
+      memoryLimit: 1Gi
+  - name: tools
+    container:
+      image: quay.io/devfile/tools
+      memoryLimit: 1Gigabyte
+      env:
+        - name: PROJECT_SOURCE
+          value: /src
+commands:
+  - id: run
+    exec:
+      component: missing
+      commandLine: npm start
+`
+
+func TestLookup(t *testing.T) {
+	positions, err := Parse([]byte(devfileContent))
+	if !assert.NoError(t, err) {
+		return
+	}
+	tests := []struct {
+		path             string
+		expectedPosition Position
+		expectedFound    bool
+	}{
+		{path: "schemaVersion", expectedPosition: Position{Line: 1, Column: 1}, expectedFound: true},
+		{path: "metadata.name", expectedPosition: Position{Line: 3, Column: 3}, expectedFound: true},
+		{path: "components[tools]", expectedPosition: Position{Line: 9, Column: 5}, expectedFound: true},
+		{path: "components[1]", expectedPosition: Position{Line: 9, Column: 5}, expectedFound: true},
+		{path: "components[tools].container.memoryLimit", expectedPosition: Position{Line: 12, Column: 7}, expectedFound: true},
+		{path: "components[tools].container.env[PROJECT_SOURCE]", expectedPosition: Position{Line: 14, Column: 11}, expectedFound: true},
+		{path: "components[runtime].container.endpoints[http]", expectedPosition: Position{Line: 6, Column: 5}, expectedFound: true},
+		{path: "components[imported]", expectedPosition: Position{Line: 4, Column: 1}, expectedFound: true},
+		{path: "events.preStart", expectedFound: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			position, found := positions.Lookup(tt.path)
+			assert.Equal(t, tt.expectedFound, found)
+			assert.Equal(t, tt.expectedPosition, position)
+		})
+	}
+}
+
+func TestLocate(t *testing.T) {
+	devfile, positions, err := ParseDevfile([]byte(devfileContent))
+	if !assert.NoError(t, err) {
+		return
+	}
+	var validationErr error
+	if err := validation.ValidateComponents(devfile.Components); err != nil {
+		validationErr = multierror.Append(validationErr, err)
+	}
+	if err := validation.ValidateCommands(devfile.Commands, devfile.Components); err != nil {
+		validationErr = multierror.Append(validationErr, err)
+	}
+
+	merr, isMultiError := Locate(validationErr, positions).(*multierror.Error)
+	if !assert.True(t, isMultiError) {
+		return
+	}
+	var messages []string
+	for _, located := range merr.Errors {
+		var positionErr *Error
+		if assert.True(t, errors.As(located, &positionErr), "error is not located: %v", located) {
+			assert.NotEmpty(t, validation.RuleID(located))
+		}
+		messages = append(messages, located.Error())
+	}
+	assert.ElementsMatch(t, []string{
+		"line 14, column 11: env variable PROJECT_SOURCE is reserved and cannot be customized in component tools",
+		`line 12, column 7: error parsing memoryLimit requirement for component tools: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'`,
+		`line 17, column 5: the command "run" is invalid - command does not map to a valid component: component "missing" does not exist in the devfile`,
+	}, messages)
+}
+
+func TestParseDevfileErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		expectedPath  string
+		expectedError string
+	}{
+		{
+			name: "Yaml syntax error",
+			content: `schemaVersion: 2.2.0
+metadata:
+  name: nodejs
+   displayName: Node.js
+`,
+			expectedError: "line 4: yaml: mapping values are not allowed in this context",
+		},
+		{
+			name: "Field of the wrong type",
+			content: `schemaVersion: 2.2.0
+components:
+  - name: runtime
+    container:
+      image: node:18
+  - name: tools
+    container:
+      image: quay.io/devfile/tools
+      mountSources: "yes"
+`,
+			expectedPath:  "components[tools].container.mountSources",
+			expectedError: "line 9, column 7: json: cannot unmarshal string into Go struct field",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ParseDevfile([]byte(tt.content))
+			var positionErr *Error
+			if assert.True(t, errors.As(err, &positionErr), "error is not located: %v", err) {
+				assert.Equal(t, tt.expectedPath, positionErr.Path)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestLocateTypeErrorWithoutIndexes(t *testing.T) {
+	positions, err := Parse([]byte(`components:
+  - name: runtime
+    container:
+      mountSources: true
+  - name: tools
+    container:
+      mountSources: "yes"
+`))
+	if !assert.NoError(t, err) {
+		return
+	}
+	// as reported by GO versions older than 1.20
+	err = positions.locateTypeError(&json.UnmarshalTypeError{Value: "string", Type: reflect.TypeOf(true), Field: "components.container.mountSources"})
+	var positionErr *Error
+	if assert.True(t, errors.As(err, &positionErr)) {
+		assert.Equal(t, "components[tools].container.mountSources", positionErr.Path)
+		assert.Equal(t, Position{Line: 7, Column: 7}, positionErr.Position)
+	}
+}
+
+func TestPathAt(t *testing.T) {
+	positions, err := Parse([]byte(devfileContent))
+	if !assert.NoError(t, err) {
+		return
+	}
+	tests := []struct {
+		name         string
+		position     Position
+		expectedPath string
+	}{
+		{name: "Top-level field", position: Position{Line: 1, Column: 5}, expectedPath: "schemaVersion"},
+		{name: "Value of a field", position: Position{Line: 7, Column: 15}, expectedPath: "components[runtime].container.image"},
+		{name: "Key field of a list element", position: Position{Line: 9, Column: 7}, expectedPath: "components[tools].name"},
+		{name: "Dash of a list element", position: Position{Line: 9, Column: 3}, expectedPath: "components"},
+		{name: "Empty line in an object", position: Position{Line: 35, Column: 7}, expectedPath: "commands[run].exec"},
+		{name: "Empty line in a list element", position: Position{Line: 35, Column: 5}, expectedPath: "commands[run]"},
+		{name: "Empty line in a list", position: Position{Line: 35, Column: 3}, expectedPath: "commands"},
+		{name: "Empty top-level line", position: Position{Line: 35, Column: 1}, expectedPath: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedPath, positions.PathAt(tt.position))
+		})
+	}
+}
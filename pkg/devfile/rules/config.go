@@ -0,0 +1,165 @@
+// Package rules loads the `.devfile-validation.yaml` configuration file, which enables or disables
+// the devfile validation and lint rules, sets their severity, and configures their parameters,
+// so that the same configuration is applied by the command line tools and the library entry points.
+//
+// A configuration file looks like:
+//
+//	rules:
+//	  default-command:
+//	    severity: error
+//	  missing-display-name:
+//	    enabled: false
+//	  too-many-components:
+//	    severity: warning
+//	    parameters:
+//	      max: 5
+//
+// The rules are identified by the IDs returned by `validation.RuleIDs()` and `lint.Rules()`.
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/devfile/api/v2/pkg/devfile/lint"
+	"github.com/devfile/api/v2/pkg/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// FileName is the name of the configuration file, looked up in the folder of the devfile
+const FileName = ".devfile-validation.yaml"
+
+// Config is the content of a rule configuration file
+type Config struct {
+	// Rules configures the rules, by rule ID.
+	// The rules that are not configured keep their default behavior.
+	Rules map[string]RuleConfig `json:"rules,omitempty"`
+}
+
+// RuleConfig configures a validation or lint rule
+type RuleConfig struct {
+	// Enabled enables or disables the rule.
+	// Enabling a lint rule which is off by default gives it the `warning` severity, unless a severity is set.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Severity is the severity of the problems reported by the rule (one of `off`, `info`, `warning` or `error`).
+	// Validation errors with the `info` or `warning` severity are reported as warnings,
+	// and don't make the validation fail.
+	Severity lint.Severity `json:"severity,omitempty"`
+
+	// Parameters are the parameters of the rule, by name.
+	// Only lint rules have parameters.
+	Parameters lint.Parameters `json:"parameters,omitempty"`
+}
+
+// Parse parses and validates the given configuration content (yaml or json)
+func Parse(content []byte) (*Config, error) {
+	config := &Config{}
+	if err := yaml.UnmarshalStrict(content, config); err != nil {
+		return nil, fmt.Errorf("failed to parse the rule configuration: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// LoadFile loads and validates the configuration file at the given path
+func LoadFile(path string) (*Config, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return config, nil
+}
+
+// LoadForDevfile loads the configuration file that lies in the same folder as the devfile at the given path.
+// It returns nil, without error, when there is no configuration file.
+func LoadForDevfile(devfilePath string) (*Config, error) {
+	config, err := LoadFile(filepath.Join(filepath.Dir(devfilePath), FileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return config, err
+}
+
+// Validate checks that the configuration only references known rules, severities and parameters
+func (c *Config) Validate() error {
+	validationRules := map[string]bool{}
+	for _, id := range validation.RuleIDs() {
+		validationRules[id] = true
+	}
+	lintRules := map[string]lint.Rule{}
+	for _, rule := range lint.Rules() {
+		lintRules[string(rule.ID)] = rule
+	}
+
+	for id, ruleConfig := range c.Rules {
+		lintRule, isLintRule := lintRules[id]
+		if !isLintRule && !validationRules[id] {
+			return fmt.Errorf("unknown rule %q", id)
+		}
+		if ruleConfig.Severity != "" {
+			if _, err := lint.ParseSeverity(string(ruleConfig.Severity)); err != nil {
+				return fmt.Errorf("invalid severity of rule %q: %w", id, err)
+			}
+		}
+		for name := range ruleConfig.Parameters {
+			if _, isParameter := lintRule.Parameters[name]; !isParameter {
+				return fmt.Errorf("unknown parameter %q of rule %q", name, id)
+			}
+		}
+	}
+	return nil
+}
+
+// severity returns the configured severity of the given rule, and whether the rule is configured at all
+func (c *Config) severity(id string, defaultSeverity lint.Severity) (lint.Severity, bool) {
+	if c == nil {
+		return defaultSeverity, false
+	}
+	ruleConfig, isConfigured := c.Rules[id]
+	switch {
+	case !isConfigured:
+		return defaultSeverity, false
+	case ruleConfig.Enabled != nil && !*ruleConfig.Enabled:
+		return lint.Off, true
+	case ruleConfig.Severity != "":
+		return ruleConfig.Severity, true
+	case ruleConfig.Enabled != nil && defaultSeverity == lint.Off:
+		return lint.Warning, true
+	default:
+		return defaultSeverity, false
+	}
+}
+
+// LintConfig returns the configuration of the lint rules.
+// It returns an empty configuration, with the default severities, for a nil Config.
+func (c *Config) LintConfig() lint.Config {
+	config := lint.Config{
+		Severities: map[lint.RuleID]lint.Severity{},
+		Parameters: map[lint.RuleID]lint.Parameters{},
+	}
+	for _, rule := range lint.Rules() {
+		if severity, isConfigured := c.severity(string(rule.ID), rule.DefaultSeverity); isConfigured {
+			config.Severities[rule.ID] = severity
+		}
+		if c != nil && c.Rules[string(rule.ID)].Parameters != nil {
+			config.Parameters[rule.ID] = c.Rules[string(rule.ID)].Parameters
+		}
+	}
+	return config
+}
+
+// ValidationSeverity returns the severity of the given validation rule, which is `error` by default,
+// and whether this severity comes from the configuration.
+func (c *Config) ValidationSeverity(id string) (lint.Severity, bool) {
+	return c.severity(id, lint.Error)
+}
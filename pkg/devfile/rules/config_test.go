@@ -0,0 +1,150 @@
+package rules
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/devfile/lint"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name                string
+		content             string
+		wantLintConfig      lint.Config
+		wantValidationRules map[string]lint.Severity
+		wantErr             string
+	}{
+		{
+			name:    "Empty configuration",
+			content: "",
+			wantLintConfig: lint.Config{
+				Severities: map[lint.RuleID]lint.Severity{},
+				Parameters: map[lint.RuleID]lint.Parameters{},
+			},
+		},
+		{
+			name: "Validation and lint rules",
+			content: `
+rules:
+  default-command:
+    severity: error
+  commands:
+    enabled: false
+  volumes:
+    enabled: true
+  missing-display-name:
+    enabled: false
+  unpinned-image:
+    severity: error
+  too-many-components:
+    enabled: true
+    parameters:
+      max: 5
+`,
+			wantLintConfig: lint.Config{
+				Severities: map[lint.RuleID]lint.Severity{
+					lint.MissingDisplayNameRule: lint.Off,
+					lint.UnpinnedImageRule:      lint.Error,
+					lint.TooManyComponentsRule:  lint.Warning,
+				},
+				Parameters: map[lint.RuleID]lint.Parameters{
+					lint.TooManyComponentsRule: {"max": float64(5)},
+				},
+			},
+			wantValidationRules: map[string]lint.Severity{
+				"default-command": lint.Error,
+				"commands":        lint.Off,
+			},
+		},
+		{
+			name: "Unknown rule",
+			content: `
+rules:
+  unknown: {}
+`,
+			wantErr: "unknown rule \"unknown\"",
+		},
+		{
+			name: "Unknown severity",
+			content: `
+rules:
+  commands:
+    severity: fatal
+`,
+			wantErr: "invalid severity of rule \"commands\": unknown severity \"fatal\", should be one of: off, info, warning, error",
+		},
+		{
+			name: "Unknown parameter",
+			content: `
+rules:
+  too-many-components:
+    parameters:
+      min: 1
+`,
+			wantErr: "unknown parameter \"min\" of rule \"too-many-components\"",
+		},
+		{
+			name: "Unknown field",
+			content: `
+rules:
+  commands:
+    disabled: true
+`,
+			wantErr: "failed to parse the rule configuration: error unmarshaling JSON: while decoding JSON: json: unknown field \"disabled\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := Parse([]byte(tt.content))
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.wantLintConfig, config.LintConfig(), "Lint configuration should match")
+			for _, id := range []string{"commands", "default-command", "volumes"} {
+				severity, isConfigured := config.ValidationSeverity(id)
+				wantSeverity, wantConfigured := tt.wantValidationRules[id]
+				assert.Equal(t, wantConfigured, isConfigured, "Validation rule %q should be configured or not", id)
+				if wantConfigured {
+					assert.Equal(t, wantSeverity, severity, "Severity of the %q validation rule should match", id)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadForDevfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "devfile-rules")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	devfilePath := filepath.Join(dir, "devfile.yaml")
+
+	config, err := LoadForDevfile(devfilePath)
+	assert.NoError(t, err)
+	assert.Nil(t, config, "There should be no configuration without configuration file")
+
+	err = ioutil.WriteFile(filepath.Join(dir, FileName), []byte("rules:\n  metadata:\n    severity: warning\n"), 0644)
+	if !assert.NoError(t, err) {
+		return
+	}
+	config, err = LoadForDevfile(devfilePath)
+	if assert.NoError(t, err) {
+		severity, isConfigured := config.ValidationSeverity("metadata")
+		assert.True(t, isConfigured)
+		assert.Equal(t, lint.Warning, severity)
+	}
+
+	var nilConfig *Config
+	severity, isConfigured := nilConfig.ValidationSeverity("metadata")
+	assert.False(t, isConfigured)
+	assert.Equal(t, lint.Error, severity)
+}
@@ -0,0 +1,304 @@
+package stacks
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// Constraint is a semver range, such as `>=2.0.0 <3.0.0`, `^2.1.0` or `2.x`, parsed by ParseConstraint
+type Constraint struct {
+	raw string
+	// alternatives are the sets of comparators separated by `||`: a version matches the constraint
+	// if it matches all the comparators of one of the alternatives
+	alternatives [][]comparator
+}
+
+// operators are the operators that can precede the version of a comparator,
+// the operators that are prefixes of other ones being listed after them
+var operators = []string{"!=", ">=", "<=", ">", "<", "=", "^", "~"}
+
+type comparator struct {
+	operator string
+	version  *version.Version
+}
+
+// ParseConstraint parses a semver range, made of alternatives separated by `||`,
+// each one being a list of comparators separated by spaces or commas, which should all match.
+//
+// The comparators are a version preceded by one of the `=`, `!=`, `>`, `>=`, `<`, `<=` operators
+// (`=` by default), possibly separated from the version by spaces, or by one of the range operators:
+//
+// - `^`: the versions compatible with the given version, such as `>=2.1.0 <3.0.0` for `^2.1.0`;
+//
+// - `~`: the patches of the given version, such as `>=2.1.0 <2.2.0` for `~2.1.0`.
+//
+// Versions can be partial, or have `x` or `*` wildcards, such as `2`, `2.1` or `2.x`,
+// which match all the versions with the given prefix. A `*` or empty constraint matches all the versions.
+//
+// Pre-release versions only match the comparators whose version is a pre-release of the same
+// major, minor and patch numbers, so that `>=2.0.0` doesn't match `3.0.0-rc1`.
+func ParseConstraint(constraint string) (*Constraint, error) {
+	parsed := &Constraint{raw: constraint}
+	for _, alternative := range strings.Split(constraint, "||") {
+		var comparators []comparator
+		fields := strings.FieldsFunc(alternative, func(r rune) bool { return r == ' ' || r == ',' })
+		for i := 0; i < len(fields); i++ {
+			field := fields[i]
+			if isOperator(field) {
+				// the operator is separated from its version by spaces, such as in `>= 2.0.0`
+				if i+1 == len(fields) || isOperator(fields[i+1]) {
+					return nil, fmt.Errorf("invalid version constraint %q: the %s operator is not followed by a version", constraint, field)
+				}
+				i++
+				field += fields[i]
+			}
+			fieldComparators, err := parseComparator(field)
+			if err != nil {
+				return nil, fmt.Errorf("invalid version constraint %q: %w", constraint, err)
+			}
+			comparators = append(comparators, fieldComparators...)
+		}
+		parsed.alternatives = append(parsed.alternatives, comparators)
+	}
+	return parsed, nil
+}
+
+// String returns the constraint as it was parsed
+func (c *Constraint) String() string {
+	return c.raw
+}
+
+// Check returns true if the given version matches the constraint
+func (c *Constraint) Check(v *version.Version) bool {
+	for _, comparators := range c.alternatives {
+		if matchesAll(v, comparators) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAll(v *version.Version, comparators []comparator) bool {
+	if v.PreRelease() != "" && !allowsPreRelease(v, comparators) {
+		return false
+	}
+	for _, comparator := range comparators {
+		if !comparator.matches(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// allowsPreRelease returns true if one of the comparators has a pre-release version
+// with the same major, minor and patch numbers as the given version
+func allowsPreRelease(v *version.Version, comparators []comparator) bool {
+	for _, comparator := range comparators {
+		if comparator.version.PreRelease() != "" &&
+			comparator.version.Major() == v.Major() && comparator.version.Minor() == v.Minor() && comparator.version.Patch() == v.Patch() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c comparator) matches(v *version.Version) bool {
+	comparison := 0
+	if v.LessThan(c.version) {
+		comparison = -1
+	} else if c.version.LessThan(v) {
+		comparison = 1
+	}
+	switch c.operator {
+	case "!=":
+		return comparison != 0
+	case ">":
+		return comparison > 0
+	case ">=":
+		return comparison >= 0
+	case "<":
+		return comparison < 0
+	case "<=":
+		return comparison <= 0
+	default:
+		return comparison == 0
+	}
+}
+
+func isOperator(field string) bool {
+	for _, operator := range operators {
+		if field == operator {
+			return true
+		}
+	}
+	return false
+}
+
+// parseComparator parses a single comparator, which can result in several comparators for ranges
+func parseComparator(field string) ([]comparator, error) {
+	operator := ""
+	for _, candidate := range operators {
+		if strings.HasPrefix(field, candidate) {
+			operator = candidate
+			break
+		}
+	}
+	partial, err := parsePartialVersion(strings.TrimPrefix(field, operator))
+	if err != nil {
+		return nil, err
+	}
+	if partial.precision == 0 {
+		// wildcard: all the versions
+		if operator == "" || operator == "=" || operator == ">=" || operator == "<=" || operator == "^" || operator == "~" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%q matches no version", field)
+	}
+
+	lower := partial.version()
+	switch operator {
+	case "^":
+		upper := partial.nextMajor()
+		if partial.major == 0 && partial.precision > 1 {
+			upper = partial.nextMinor()
+			if partial.minor == 0 && partial.precision > 2 {
+				upper = partial.nextPatch()
+			}
+		}
+		return between(lower, upper), nil
+	case "~":
+		if partial.precision == 1 {
+			return between(lower, partial.nextMajor()), nil
+		}
+		return between(lower, partial.nextMinor()), nil
+	}
+
+	if partial.precision == 3 {
+		if operator == "" {
+			operator = "="
+		}
+		return []comparator{{operator: operator, version: lower}}, nil
+	}
+
+	// partial version: a range of versions
+	upper := partial.nextMajor()
+	if partial.precision == 2 {
+		upper = partial.nextMinor()
+	}
+	switch operator {
+	case "", "=":
+		return between(lower, upper), nil
+	case "!=":
+		return nil, fmt.Errorf("%q: the != operator requires a complete version", field)
+	case ">":
+		return []comparator{{operator: ">=", version: upper}}, nil
+	case ">=":
+		return []comparator{{operator: ">=", version: lower}}, nil
+	case "<":
+		return []comparator{{operator: "<", version: lower}}, nil
+	default: // "<="
+		return []comparator{{operator: "<", version: upper}}, nil
+	}
+}
+
+// between returns the comparators of the versions at least equal to lower, and lower than upper
+func between(lower, upper *version.Version) []comparator {
+	return []comparator{{operator: ">=", version: lower}, {operator: "<", version: upper}}
+}
+
+// partialVersion is a version whose minor and patch numbers can be omitted
+type partialVersion struct {
+	major, minor, patch uint
+	preRelease          string
+	// precision is the number of version numbers that are set, from 0 for `*` to 3
+	precision int
+}
+
+func parsePartialVersion(str string) (partialVersion, error) {
+	partial := partialVersion{}
+	str = strings.TrimPrefix(str, "v")
+	if i := strings.Index(str, "+"); i >= 0 {
+		str = str[:i]
+	}
+	if i := strings.Index(str, "-"); i >= 0 {
+		partial.preRelease = str[i+1:]
+		str = str[:i]
+		if err := validatePreRelease(partial.preRelease); err != nil {
+			return partial, fmt.Errorf("%q is not a valid version: %v", str+"-"+partial.preRelease, err)
+		}
+	}
+	numbers := strings.Split(str, ".")
+	if len(numbers) > 3 {
+		return partial, fmt.Errorf("%q is not a valid version", str)
+	}
+	wildcard := false
+	for i, number := range numbers {
+		if number == "x" || number == "X" || number == "*" {
+			wildcard = true
+			continue
+		}
+		if wildcard {
+			return partial, fmt.Errorf("%q is not a valid version: a wildcard cannot be followed by a version number", str)
+		}
+		value, err := strconv.ParseUint(number, 10, 32)
+		if err != nil {
+			return partial, fmt.Errorf("%q is not a valid version", str)
+		}
+		switch i {
+		case 0:
+			partial.major = uint(value)
+		case 1:
+			partial.minor = uint(value)
+		case 2:
+			partial.patch = uint(value)
+		}
+		partial.precision++
+	}
+	if partial.preRelease != "" && partial.precision < 3 {
+		return partial, fmt.Errorf("%q is not a valid version: a pre-release requires a complete version", str)
+	}
+	return partial, nil
+}
+
+// validatePreRelease checks that the given pre-release is made of dot-separated identifiers, as defined by semver:
+// non-empty identifiers of alphanumerics and hyphens, the numeric identifiers having no leading zeros
+func validatePreRelease(preRelease string) error {
+	for _, identifier := range strings.Split(preRelease, ".") {
+		if identifier == "" {
+			return fmt.Errorf("the pre-release %q has an empty identifier", preRelease)
+		}
+		numeric := true
+		for _, c := range identifier {
+			switch {
+			case c >= '0' && c <= '9':
+			case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '-':
+				numeric = false
+			default:
+				return fmt.Errorf("the pre-release identifier %q should only contain alphanumerics and hyphens", identifier)
+			}
+		}
+		if numeric && len(identifier) > 1 && identifier[0] == '0' {
+			return fmt.Errorf("the numeric pre-release identifier %q should not have leading zeros", identifier)
+		}
+	}
+	return nil
+}
+
+func (p partialVersion) version() *version.Version {
+	return version.MustParseSemantic(fmt.Sprintf("%d.%d.%d", p.major, p.minor, p.patch)).WithPreRelease(p.preRelease)
+}
+
+func (p partialVersion) nextMajor() *version.Version {
+	return version.MustParseSemantic(fmt.Sprintf("%d.0.0-0", p.major+1))
+}
+
+func (p partialVersion) nextMinor() *version.Version {
+	return version.MustParseSemantic(fmt.Sprintf("%d.%d.0-0", p.major, p.minor+1))
+}
+
+func (p partialVersion) nextPatch() *version.Version {
+	return version.MustParseSemantic(fmt.Sprintf("%d.%d.%d-0", p.major, p.minor, p.patch+1))
+}
@@ -0,0 +1,167 @@
+// Package stacks models the devfile stacks of a devfile registry, which can provide several versions of their devfile,
+// and resolves the stack version to use from a version constraint, so that the registry clients
+// all select stack versions the same way.
+//
+// In the registry layout, a stack with several versions is described by a `stack.yaml` file,
+// next to one folder per version that contains the devfile of the version:
+//
+//	name: nodejs
+//	displayName: Node.js Runtime
+//	versions:
+//	- version: 1.0.0
+//	- version: 2.0.0
+//	  default: true
+//	- version: 2.1.0-rc1
+package stacks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/version"
+	"sigs.k8s.io/yaml"
+)
+
+// FileName is the name of the file that describes a stack with several versions, in the registry layout
+const FileName = "stack.yaml"
+
+// Stack is a devfile stack, which provides several versions of its devfile
+type Stack struct {
+	// Name is the name of the stack
+	Name string `json:"name"`
+	// DisplayName is the name of the stack displayed to users
+	DisplayName string `json:"displayName,omitempty"`
+	// Description is the description of the stack
+	Description string `json:"description,omitempty"`
+	// Icon is the URI of the icon of the stack
+	Icon string `json:"icon,omitempty"`
+	// Versions are the versions of the stack, in any order
+	Versions []Version `json:"versions,omitempty"`
+}
+
+// Version is a version of a devfile stack
+type Version struct {
+	// Version is the semver-compatible version of the stack
+	Version string `json:"version"`
+	// SchemaVersion is the devfile schema version of the devfile of this stack version
+	SchemaVersion string `json:"schemaVersion,omitempty"`
+	// Default is true for the version used when no version is requested
+	Default bool `json:"default,omitempty"`
+	// Description is the description of this stack version
+	Description string `json:"description,omitempty"`
+	// StarterProjects are the names of the starter projects of this stack version
+	StarterProjects []string `json:"starterProjects,omitempty"`
+	// Resources are the files of this stack version, relative to its folder
+	Resources []string `json:"resources,omitempty"`
+}
+
+// Parse parses and validates the given stack content (yaml or json), such as the content of a `stack.yaml` file
+func Parse(content []byte) (*Stack, error) {
+	stack := &Stack{}
+	if err := yaml.Unmarshal(content, stack); err != nil {
+		return nil, err
+	}
+	if err := stack.Validate(); err != nil {
+		return nil, err
+	}
+	return stack, nil
+}
+
+// Validate checks that the stack has a name and versions, that its versions are semver-compatible and unique,
+// and that at most one of them is the default version
+func (s *Stack) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("the stack has no name")
+	}
+	if len(s.Versions) == 0 {
+		return fmt.Errorf("stack %q has no version", s.Name)
+	}
+	versions := map[string]bool{}
+	var defaults []string
+	for _, v := range s.Versions {
+		parsed, err := version.ParseSemantic(v.Version)
+		if err != nil {
+			return fmt.Errorf("stack %q has an invalid version: %w", s.Name, err)
+		}
+		// versions that only differ by their build metadata are the same version
+		key := parsed.WithBuildMetadata("").String()
+		if versions[key] {
+			return fmt.Errorf("stack %q has several %s versions", s.Name, key)
+		}
+		versions[key] = true
+		if v.Default {
+			defaults = append(defaults, v.Version)
+		}
+	}
+	if len(defaults) > 1 {
+		return fmt.Errorf("stack %q has several default versions: %s", s.Name, strings.Join(defaults, ", "))
+	}
+	return nil
+}
+
+// SortedVersions returns the versions of the stack sorted from the highest to the lowest version.
+// The versions that are not semver-compatible are ignored.
+func (s *Stack) SortedVersions() []Version {
+	type parsedVersion struct {
+		Version
+		parsed *version.Version
+	}
+	var parsedVersions []parsedVersion
+	for _, v := range s.Versions {
+		if parsed, err := version.ParseSemantic(v.Version); err == nil {
+			parsedVersions = append(parsedVersions, parsedVersion{v, parsed})
+		}
+	}
+	sort.SliceStable(parsedVersions, func(i, j int) bool {
+		return parsedVersions[j].parsed.LessThan(parsedVersions[i].parsed)
+	})
+	sorted := make([]Version, 0, len(parsedVersions))
+	for _, v := range parsedVersions {
+		sorted = append(sorted, v.Version)
+	}
+	return sorted
+}
+
+// DefaultVersion returns the default version of the stack: the version marked as default,
+// or else the highest version which is not a pre-release
+func (s *Stack) DefaultVersion() (Version, error) {
+	for _, v := range s.Versions {
+		if v.Default {
+			return v, nil
+		}
+	}
+	for _, v := range s.SortedVersions() {
+		if parsed, _ := version.ParseSemantic(v.Version); parsed.PreRelease() == "" {
+			return v, nil
+		}
+	}
+	return Version{}, fmt.Errorf("stack %q has no default version", s.Name)
+}
+
+// SelectVersion returns the version of the stack to use for the given request,
+// which can be:
+//
+// - empty, or `default`, for the default version of the stack;
+//
+// - `latest`, for the highest version which is not a pre-release;
+//
+// - a version constraint, with the syntax of ParseConstraint, for the highest version that matches the constraint.
+func (s *Stack) SelectVersion(request string) (Version, error) {
+	switch request = strings.TrimSpace(request); request {
+	case "", "default":
+		return s.DefaultVersion()
+	case "latest":
+		request = "*"
+	}
+	constraint, err := ParseConstraint(request)
+	if err != nil {
+		return Version{}, err
+	}
+	for _, v := range s.SortedVersions() {
+		if parsed, _ := version.ParseSemantic(v.Version); constraint.Check(parsed) {
+			return v, nil
+		}
+	}
+	return Version{}, fmt.Errorf("stack %q has no version matching %q", s.Name, request)
+}
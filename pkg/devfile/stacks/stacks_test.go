@@ -0,0 +1,232 @@
+package stacks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+const stackContent = `
+name: nodejs
+displayName: Node.js Runtime
+versions:
+- version: 1.0.0
+- version: 2.2.0-rc1
+- version: 2.1.0
+  default: true
+- version: 2.0.1
+- version: 1.1.0
+`
+
+func TestConstraint(t *testing.T) {
+	tests := []struct {
+		constraint  string
+		matching    []string
+		notMatching []string
+		wantErr     string
+	}{
+		{
+			constraint:  ">=2.0.0 <3.0.0",
+			matching:    []string{"2.0.0", "2.9.1"},
+			notMatching: []string{"1.9.9", "3.0.0", "3.0.0-rc1", "2.5.0-rc1"},
+		},
+		{
+			constraint:  "^2.1.0",
+			matching:    []string{"2.1.0", "2.3.0"},
+			notMatching: []string{"2.0.9", "3.0.0"},
+		},
+		{
+			constraint:  "^0.2.3",
+			matching:    []string{"0.2.3", "0.2.9"},
+			notMatching: []string{"0.3.0"},
+		},
+		{
+			constraint:  "~2.1",
+			matching:    []string{"2.1.0", "2.1.9"},
+			notMatching: []string{"2.2.0"},
+		},
+		{
+			constraint:  "2.x",
+			matching:    []string{"2.0.0", "2.9.0"},
+			notMatching: []string{"1.0.0", "3.0.0"},
+		},
+		{
+			constraint:  "1.0.0 || >2",
+			matching:    []string{"1.0.0", "3.0.0"},
+			notMatching: []string{"1.0.1", "2.9.0"},
+		},
+		{
+			constraint:  ">=2.2.0-rc1, !=2.2.1",
+			matching:    []string{"2.2.0-rc1", "2.2.0-rc2", "2.2.0", "2.3.0"},
+			notMatching: []string{"2.2.1", "2.3.0-rc1"},
+		},
+		{
+			constraint:  "*",
+			matching:    []string{"0.0.1", "10.0.0"},
+			notMatching: []string{"1.0.0-alpha"},
+		},
+		{
+			constraint:  ">= 2.0.0 < 3.0.0",
+			matching:    []string{"2.0.0", "2.9.1"},
+			notMatching: []string{"1.9.9", "3.0.0"},
+		},
+		{
+			constraint:  "> 1, <= 2.1",
+			matching:    []string{"2.0.0", "2.1.9"},
+			notMatching: []string{"1.9.9", "2.2.0"},
+		},
+		{
+			constraint:  "^ 2.1.0 || = 1.0.0",
+			matching:    []string{"1.0.0", "2.1.0", "2.3.0"},
+			notMatching: []string{"1.0.1", "3.0.0"},
+		},
+		{
+			constraint: ">= 2.0.0 <",
+			wantErr:    `invalid version constraint ">= 2.0.0 <": the < operator is not followed by a version`,
+		},
+		{
+			constraint: ">= < 2.0.0",
+			wantErr:    `invalid version constraint ">= < 2.0.0": the >= operator is not followed by a version`,
+		},
+		{
+			constraint: ">=2.a",
+			wantErr:    `invalid version constraint ">=2.a": "2.a" is not a valid version`,
+		},
+		{
+			constraint: "1.2.3-a..b",
+			wantErr:    `invalid version constraint "1.2.3-a..b": "1.2.3-a..b" is not a valid version: the pre-release "a..b" has an empty identifier`,
+		},
+		{
+			constraint: "1.2.3-",
+			wantErr:    `invalid version constraint "1.2.3-": "1.2.3-" is not a valid version: the pre-release "" has an empty identifier`,
+		},
+		{
+			constraint: ">=1.2.3-rc.01",
+			wantErr:    `invalid version constraint ">=1.2.3-rc.01": "1.2.3-rc.01" is not a valid version: the numeric pre-release identifier "01" should not have leading zeros`,
+		},
+		{
+			constraint: "1.2.3-rc_1",
+			wantErr:    `invalid version constraint "1.2.3-rc_1": "1.2.3-rc_1" is not a valid version: the pre-release identifier "rc_1" should only contain alphanumerics and hyphens`,
+		},
+		{
+			constraint: "x.1",
+			wantErr:    `invalid version constraint "x.1": "x.1" is not a valid version: a wildcard cannot be followed by a version number`,
+		},
+		{
+			constraint:  "1.x.x",
+			matching:    []string{"1.0.0", "1.9.3"},
+			notMatching: []string{"0.9.0", "2.0.0"},
+		},
+		{
+			constraint:  ">=1.0.0-rc.0.a-1",
+			matching:    []string{"1.0.0-rc.1", "1.0.0"},
+			notMatching: []string{"1.0.0-beta.1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			constraint, err := ParseConstraint(tt.constraint)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			for _, v := range tt.matching {
+				assert.True(t, constraint.Check(version.MustParseSemantic(v)), "%s should match %s", v, tt.constraint)
+			}
+			for _, v := range tt.notMatching {
+				assert.False(t, constraint.Check(version.MustParseSemantic(v)), "%s should not match %s", v, tt.constraint)
+			}
+		})
+	}
+}
+
+func TestSelectVersion(t *testing.T) {
+	stack, err := Parse([]byte(stackContent))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tests := []struct {
+		request string
+		want    string
+		wantErr string
+	}{
+		{request: "", want: "2.1.0"},
+		{request: "default", want: "2.1.0"},
+		{request: "latest", want: "2.1.0"},
+		{request: "1.x", want: "1.1.0"},
+		{request: "~2.0", want: "2.0.1"},
+		{request: ">=2.2.0-rc1", want: "2.2.0-rc1"},
+		{request: "^3.0.0", wantErr: `stack "nodejs" has no version matching "^3.0.0"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.request, func(t *testing.T) {
+			got, err := stack.SelectVersion(tt.request)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got.Version)
+			}
+		})
+	}
+}
+
+func TestDefaultVersion(t *testing.T) {
+	stack := &Stack{
+		Name: "nodejs",
+		Versions: []Version{
+			{Version: "1.0.0"},
+			{Version: "2.0.0-rc1"},
+			{Version: "1.2.0"},
+		},
+	}
+	got, err := stack.DefaultVersion()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "1.2.0", got.Version, "The default version should be the highest release")
+	}
+
+	stack.Versions = []Version{{Version: "2.0.0-rc1"}}
+	_, err = stack.DefaultVersion()
+	assert.EqualError(t, err, `stack "nodejs" has no default version`)
+}
+
+func TestParseInvalidStack(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "No version",
+			content: "name: nodejs",
+			wantErr: `stack "nodejs" has no version`,
+		},
+		{
+			name:    "Invalid version",
+			content: "name: nodejs\nversions:\n- version: latest",
+			wantErr: `stack "nodejs" has an invalid version: could not parse "latest" as version`,
+		},
+		{
+			name:    "Duplicate version",
+			content: "name: nodejs\nversions:\n- version: 1.0.0\n- version: 1.0.0+build1",
+			wantErr: `stack "nodejs" has several 1.0.0 versions`,
+		},
+		{
+			name:    "Several default versions",
+			content: "name: nodejs\nversions:\n- version: 1.0.0\n  default: true\n- version: 2.0.0\n  default: true",
+			wantErr: `stack "nodejs" has several default versions: 1.0.0, 2.0.0`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.content))
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
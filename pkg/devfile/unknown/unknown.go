@@ -0,0 +1,296 @@
+// Package unknown captures the fields of a devfile that are not known by the API types of this library,
+// and restores them when the devfile is marshalled again, so that devfiles written for a newer version
+// of the devfile API survive a round-trip through tools built with an older version of this library,
+// instead of silently losing their data.
+// Conversely, `UnmarshalStrict` rejects the contents that have unknown fields, such as misspelled fields
+// of hand-written devfiles, and reports their paths. The additional fields of the objects whose schema allows them,
+// such as the devfile metadata, are not rejected.
+//
+// Unknown fields are kept in a side-band `Fields` value, indexed by the path of the object that contains them,
+// such as `components[runtime].container`. The elements of the lists of objects that have a `name` or an `id` field
+// are designated by their key, and the other list elements by their index, so that unknown fields follow
+// their element when a list is reordered. The unknown fields of a removed element are dropped on restore.
+package unknown
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/devfile"
+	"sigs.k8s.io/yaml"
+)
+
+// keyFields are the fields that identify the elements of a keyed list, by order of preference
+var keyFields = []string{"name", "id"}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// openTypes are the types whose Json schema allows additional properties, such as the devfile metadata,
+// so that their unknown fields are still captured, but are not rejected by UnmarshalStrict
+var openTypes = map[reflect.Type]bool{
+	reflect.TypeOf(devfile.DevfileMetadata{}): true,
+}
+
+// Fields are the unknown fields captured in a document, by path of the object that contains them.
+// The root object has an empty path.
+type Fields map[string]map[string]json.RawMessage
+
+// Len returns the number of unknown fields
+func (f Fields) Len() int {
+	count := 0
+	for _, fields := range f {
+		count += len(fields)
+	}
+	return count
+}
+
+// Paths returns the paths of the unknown fields, such as `components[runtime].container.gpu`, in sorted order
+func (f Fields) Paths() []string {
+	var paths []string
+	for path, fields := range f {
+		for name := range fields {
+			paths = append(paths, joinPath(path, name))
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Error is returned by UnmarshalStrict when the content has unknown fields
+type Error struct {
+	// Paths are the paths of the unknown fields, in sorted order
+	Paths []string
+}
+
+func (e *Error) Error() string {
+	return "unknown fields: " + strings.Join(e.Paths, ", ")
+}
+
+// ParseDevfile parses the given devfile content (yaml or json), and returns the parsed devfile
+// along with the fields unknown to the devfile API types of this library
+func ParseDevfile(data []byte) (*v1alpha2.Devfile, Fields, error) {
+	devfile := &v1alpha2.Devfile{}
+	fields, err := Unmarshal(data, devfile)
+	if err != nil {
+		return nil, nil, err
+	}
+	return devfile, fields, nil
+}
+
+// Unmarshal decodes the given content (yaml or json) into the given object, which should be a pointer,
+// and returns the fields of the content that don't match any field of the object type
+func Unmarshal(data []byte, obj interface{}) (Fields, error) {
+	return unmarshal(data, obj, false)
+}
+
+// UnmarshalStrict is the same as Unmarshal, but returns an *Error that lists the paths of the unknown fields,
+// if any, instead of returning them. The additional fields of the objects whose schema allows them are not reported.
+func UnmarshalStrict(data []byte, obj interface{}) error {
+	fields, err := unmarshal(data, obj, true)
+	if err != nil {
+		return err
+	}
+	if fields.Len() > 0 {
+		return &Error{Paths: fields.Paths()}
+	}
+	return nil
+}
+
+// unmarshal decodes the given content into the given object, and returns its unknown fields,
+// except the additional fields of the open types in strict mode
+func unmarshal(data []byte, obj interface{}, strict bool) (Fields, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(jsonData, obj); err != nil {
+		return nil, err
+	}
+	value, err := decode(jsonData)
+	if err != nil {
+		return nil, err
+	}
+	fields := Fields{}
+	if err := capture("", value, reflect.TypeOf(obj), strict, fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// Marshal returns the Json serialization of the given object, in which the given unknown fields
+// are restored at their path. Unknown fields never replace the fields set in the object,
+// and the unknown fields whose path doesn't exist anymore in the object are dropped.
+func Marshal(obj interface{}, fields Fields) ([]byte, error) {
+	jsonData, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return jsonData, nil
+	}
+	value, err := decode(jsonData)
+	if err != nil {
+		return nil, err
+	}
+	restore("", value, fields)
+	return json.Marshal(value)
+}
+
+// MarshalYAML is the same as Marshal, but returns the yaml serialization of the object
+func MarshalYAML(obj interface{}, fields Fields) ([]byte, error) {
+	jsonData, err := Marshal(obj, fields)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(jsonData)
+}
+
+// decode decodes the given Json content as generic maps and lists, keeping the numbers as they are written
+func decode(jsonData []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// capture records in `fields` the fields of the given Json value that are unknown to the given GO type.
+// In strict mode, the additional fields of the open types are not recorded.
+func capture(path string, value interface{}, goType reflect.Type, strict bool, fields Fields) error {
+	for goType.Kind() == reflect.Ptr {
+		goType = goType.Elem()
+	}
+	// types with a custom Json decoding, such as free-form Json values, have no unknown fields
+	if reflect.PtrTo(goType).Implements(unmarshalerType) {
+		return nil
+	}
+
+	switch goType.Kind() {
+	case reflect.Struct:
+		object, isObject := value.(map[string]interface{})
+		if !isObject {
+			return nil
+		}
+		known := map[string]reflect.Type{}
+		knownFields(goType, known)
+		for name, fieldValue := range object {
+			fieldType, isKnown := known[name]
+			if isKnown {
+				if err := capture(joinPath(path, name), fieldValue, fieldType, strict, fields); err != nil {
+					return err
+				}
+				continue
+			}
+			if strict && openTypes[goType] {
+				continue
+			}
+			raw, err := json.Marshal(fieldValue)
+			if err != nil {
+				return fmt.Errorf("cannot capture the unknown field %s: %w", joinPath(path, name), err)
+			}
+			if fields[path] == nil {
+				fields[path] = map[string]json.RawMessage{}
+			}
+			fields[path][name] = raw
+		}
+	case reflect.Map:
+		object, isObject := value.(map[string]interface{})
+		if !isObject {
+			return nil
+		}
+		for key, entryValue := range object {
+			if err := capture(joinPath(path, key), entryValue, goType.Elem(), strict, fields); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		list, isList := value.([]interface{})
+		if !isList {
+			return nil
+		}
+		for i, element := range list {
+			if err := capture(elementPath(path, i, element), element, goType.Elem(), strict, fields); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// knownFields adds to `known` the types of the fields of the given struct type, by Json name,
+// including the fields of the inlined embedded structs
+func knownFields(structType reflect.Type, known map[string]reflect.Type) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			embeddedType := field.Type
+			if embeddedType.Kind() == reflect.Ptr {
+				embeddedType = embeddedType.Elem()
+			}
+			if embeddedType.Kind() == reflect.Struct {
+				knownFields(embeddedType, known)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			// unexported field
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		known[name] = field.Type
+	}
+}
+
+// restore adds the unknown fields to the objects of the given Json value, at their path
+func restore(path string, value interface{}, fields Fields) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for name, raw := range fields[path] {
+			if _, exists := typed[name]; !exists {
+				typed[name] = raw
+			}
+		}
+		for name, fieldValue := range typed {
+			if _, isRestored := fieldValue.(json.RawMessage); !isRestored {
+				restore(joinPath(path, name), fieldValue, fields)
+			}
+		}
+	case []interface{}:
+		for i, element := range typed {
+			restore(elementPath(path, i, element), element, fields)
+		}
+	}
+}
+
+// elementPath returns the path of a list element: its key for the elements of keyed lists, or its index
+func elementPath(path string, index int, element interface{}) string {
+	if object, isObject := element.(map[string]interface{}); isObject {
+		for _, keyField := range keyFields {
+			if key, isString := object[keyField].(string); isString && key != "" {
+				return fmt.Sprintf("%s[%s]", path, key)
+			}
+		}
+	}
+	return fmt.Sprintf("%s[%d]", path, index)
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
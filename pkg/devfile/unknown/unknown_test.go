@@ -0,0 +1,123 @@
+package unknown
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/stretchr/testify/assert"
+)
+
+const newerDevfile = `
+schemaVersion: 2.9.0
+sbom: spdx
+metadata:
+  name: nodejs
+  license: Apache-2.0
+attributes:
+  not-a-field: true
+components:
+- name: runtime
+  container:
+    image: node:18
+    gpu: 1
+    endpoints:
+    - name: http
+      targetPort: 3000
+      tls: strict
+- name: cache
+  volume:
+    size: 1Gi
+    storageClass: fast
+commands:
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+    retries: 3
+`
+
+func TestParseDevfile(t *testing.T) {
+	devfile, fields, err := ParseDevfile([]byte(newerDevfile))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "node:18", devfile.Components[0].Container.Image)
+	assert.Equal(t, Fields{
+		"":                              {"sbom": json.RawMessage(`"spdx"`)},
+		"metadata":                      {"license": json.RawMessage(`"Apache-2.0"`)},
+		"components[runtime].container": {"gpu": json.RawMessage(`1`)},
+		"components[runtime].container.endpoints[http]": {"tls": json.RawMessage(`"strict"`)},
+		"components[cache].volume":                      {"storageClass": json.RawMessage(`"fast"`)},
+		"commands[run].exec":                            {"retries": json.RawMessage(`3`)},
+	}, fields)
+	assert.Equal(t, 6, fields.Len())
+}
+
+func TestMarshalYAML(t *testing.T) {
+	devfile, fields, err := ParseDevfile([]byte(newerDevfile))
+	if !assert.NoError(t, err) {
+		return
+	}
+	// reorder the components, remove the command, and update a restored field
+	devfile.Components[0], devfile.Components[1] = devfile.Components[1], devfile.Components[0]
+	devfile.Commands = nil
+	devfile.Components[0].Volume.Size = "2Gi"
+
+	content, err := MarshalYAML(devfile, fields)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `attributes:
+  not-a-field: true
+components:
+- name: cache
+  volume:
+    size: 2Gi
+    storageClass: fast
+- container:
+    endpoints:
+    - name: http
+      targetPort: 3000
+      tls: strict
+    gpu: 1
+    image: node:18
+  name: runtime
+metadata:
+  license: Apache-2.0
+  name: nodejs
+sbom: spdx
+schemaVersion: 2.9.0
+`, string(content))
+	}
+}
+
+func TestMarshalDoesNotReplaceKnownFields(t *testing.T) {
+	component := &v1alpha2.Component{Name: "runtime", ComponentUnion: v1alpha2.ComponentUnion{Volume: &v1alpha2.VolumeComponent{}}}
+	content, err := Marshal(component, Fields{"": {"name": json.RawMessage(`"other"`), "extra": json.RawMessage(`{"a":1}`)}})
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"name":"runtime","volume":{},"extra":{"a":1}}`, string(content))
+	}
+}
+
+func TestUnmarshalInvalidContent(t *testing.T) {
+	_, _, err := ParseDevfile([]byte("components: {"))
+	assert.Error(t, err)
+
+	_, _, err = ParseDevfile([]byte("components: 3"))
+	assert.Error(t, err)
+}
+
+func TestUnmarshalStrict(t *testing.T) {
+	err := UnmarshalStrict([]byte(newerDevfile), &v1alpha2.Devfile{})
+	assert.EqualError(t, err, "unknown fields: commands[run].exec.retries, components[cache].volume.storageClass, "+
+		"components[runtime].container.endpoints[http].tls, components[runtime].container.gpu, sbom")
+	if unknownErr, isUnknownErr := err.(*Error); assert.True(t, isUnknownErr) {
+		assert.Len(t, unknownErr.Paths, 5)
+	}
+
+	// the schema of the metadata allows additional properties
+	err = UnmarshalStrict([]byte("schemaVersion: 2.2.0\nmetadata:\n  name: x\n  foo: bar\n"), &v1alpha2.Devfile{})
+	assert.NoError(t, err)
+
+	err = UnmarshalStrict([]byte("schemaVersion: 2.2.0\ncomponents:\n- name: cache\n  volume: {}\n"), &v1alpha2.Devfile{})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,66 @@
+// Package errors defines the sentinel errors of the devfile API library packages,
+// and the structured errors that give the details of some of them.
+//
+// The errors returned by the library packages wrap these sentinel errors when they apply,
+// so that callers can check the kind of an error with `errors.Is`, instead of matching its message:
+//
+//	if errors.Is(err, devfileerrors.ErrNotFound) {
+//		...
+//	}
+package errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotFound is wrapped by the errors about an element that is referenced but doesn't exist,
+	// such as a missing attribute, or a command that references an unknown component
+	ErrNotFound = errors.New("not found")
+
+	// ErrDuplicateKey is wrapped by the errors about several elements defined with the same key,
+	// such as two components with the same name, or a component of the main devfile already defined in its parent
+	ErrDuplicateKey = errors.New("duplicate key")
+
+	// ErrInvalidUnion is wrapped by the errors about a union whose members and discriminator are inconsistent,
+	// such as a union with several members set
+	ErrInvalidUnion = errors.New("invalid union")
+
+	// ErrUnsupportedVersion is wrapped by the errors about a devfile schema version that is invalid or not supported,
+	// or doesn't support a feature used by the devfile
+	ErrUnsupportedVersion = errors.New("unsupported version")
+
+	// ErrChecksumMismatch is wrapped by the errors about a downloaded content whose checksum
+	// is not the expected one, such as a corrupted starter project archive
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+
+	// ErrInvalidQuantity is wrapped by the errors about a field that should contain a Kubernetes quantity,
+	// such as a memory limit or a volume size, but cannot be parsed
+	ErrInvalidQuantity = errors.New("invalid quantity")
+)
+
+// QuantityError is the error returned by the generated quantity getters, such as `GetMemoryLimitQuantity()`,
+// when the field cannot be parsed as a Kubernetes quantity. It matches ErrInvalidQuantity with `errors.Is`.
+type QuantityError struct {
+	// Field is the Json name of the field, such as `memoryLimit`
+	Field string
+	// Value is the value of the field
+	Value string
+	// Err is the parsing error
+	Err error
+}
+
+func (e *QuantityError) Error() string {
+	return fmt.Sprintf("%s: %s %q: %v", e.Field, ErrInvalidQuantity, e.Value, e.Err)
+}
+
+// Is returns true for ErrInvalidQuantity
+func (e *QuantityError) Is(target error) bool {
+	return target == ErrInvalidQuantity
+}
+
+// Unwrap returns the parsing error
+func (e *QuantityError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,85 @@
+// Package events provides helpers to emit Kubernetes events about the processing of devfiles
+// (such as their resolution or their validation), with typed reasons and consistent messages,
+// so that the controllers of the devfile ecosystem report the same events for the same problems.
+//
+// The helpers emit events through a Recorder, which is a subset of the client-go `record.EventRecorder` interface:
+// any client-go or controller-runtime event recorder can be used, without this package depending on client-go.
+package events
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/devfile/api/v2/pkg/validation"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Recorder records Kubernetes events about an object.
+// It is implemented by the client-go `record.EventRecorder`.
+type Recorder interface {
+	// Event records an event of the given type (`Normal` or `Warning`) about the given object
+	Event(object runtime.Object, eventtype, reason, message string)
+}
+
+// Reason is the reason of a Kubernetes event about the processing of a devfile.
+// It is a short, machine understandable string in UpperCamelCase.
+type Reason string
+
+const (
+	// DevfileResolvedReason is the reason of the event emitted when a devfile has been resolved,
+	// with its parent and plugins flattened into it
+	DevfileResolvedReason Reason = "DevfileResolved"
+	// DevfileResolutionFailedReason is the reason of the event emitted when a devfile cannot be resolved,
+	// such as when its parent or one of its plugins cannot be retrieved
+	DevfileResolutionFailedReason Reason = "DevfileResolutionFailed"
+	// DevfileValidationFailedReason is the reason of the events emitted when a devfile breaks a validation rule
+	DevfileValidationFailedReason Reason = "DevfileValidationFailed"
+	// DevfileValidationWarningReason is the reason of the events emitted for the validation problems
+	// that should not prevent the devfile from being used, such as a missing default command
+	DevfileValidationWarningReason Reason = "DevfileValidationWarning"
+)
+
+// maxMessageLength is the maximum length of the event messages, as enforced by the Kubernetes events API
+const maxMessageLength = 1024
+
+// Record records an event with the given type, reason and message about the given object.
+// Messages that are longer than the maximum length accepted by Kubernetes are truncated.
+func Record(recorder Recorder, object runtime.Object, eventType string, reason Reason, message string) {
+	if len(message) > maxMessageLength {
+		truncated := message[:maxMessageLength-len("...")]
+		for !utf8.ValidString(truncated) {
+			truncated = truncated[:len(truncated)-1]
+		}
+		message = truncated + "..."
+	}
+	recorder.Event(object, eventType, string(reason), message)
+}
+
+// DevfileResolved records a `Normal` event about the successful resolution of the devfile of the given object
+func DevfileResolved(recorder Recorder, object runtime.Object) {
+	Record(recorder, object, corev1.EventTypeNormal, DevfileResolvedReason, "Devfile resolved successfully")
+}
+
+// ResolutionFailed records a `Warning` event about the failed resolution of the devfile of the given object
+func ResolutionFailed(recorder Recorder, object runtime.Object, err error) {
+	Record(recorder, object, corev1.EventTypeWarning, DevfileResolutionFailedReason, fmt.Sprintf("Failed to resolve the devfile: %v", err))
+}
+
+// ValidationFailed records a `Warning` event for each validation error wrapped in the given error,
+// such as the errors returned by the `pkg/validation` package.
+// The message of each event contains the identifier of the broken validation rule, when it is known.
+// Validation warnings, such as a missing default command, are recorded with the `DevfileValidationWarning` reason.
+func ValidationFailed(recorder Recorder, object runtime.Object, err error) {
+	for _, validationErr := range validation.FlattenErrors(err) {
+		reason := DevfileValidationFailedReason
+		if validation.IsWarning(validationErr) {
+			reason = DevfileValidationWarningReason
+		}
+		message := fmt.Sprintf("Devfile validation failed: %v", validationErr)
+		if rule := validation.RuleID(validationErr); rule != "" {
+			message = fmt.Sprintf("Devfile validation rule %s failed: %v", rule, validationErr)
+		}
+		Record(recorder, object, corev1.EventTypeWarning, reason, message)
+	}
+}
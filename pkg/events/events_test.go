@@ -0,0 +1,156 @@
+package events
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/validation"
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type recordedEvent struct {
+	eventType string
+	reason    string
+	message   string
+}
+
+type fakeRecorder struct {
+	events []recordedEvent
+}
+
+func (r *fakeRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.events = append(r.events, recordedEvent{eventType: eventtype, reason: reason, message: message})
+}
+
+func TestValidationFailed(t *testing.T) {
+	components := []v1alpha2.Component{
+		{
+			Name: "plugin",
+			ComponentUnion: v1alpha2.ComponentUnion{
+				Plugin: &v1alpha2.PluginComponent{},
+			},
+		},
+	}
+	runCommand := func(id string) v1alpha2.Command {
+		return v1alpha2.Command{
+			Id: id,
+			CommandUnion: v1alpha2.CommandUnion{
+				Exec: &v1alpha2.ExecCommand{
+					Component: "missing",
+					LabeledCommand: v1alpha2.LabeledCommand{
+						BaseCommand: v1alpha2.BaseCommand{
+							Group: &v1alpha2.CommandGroup{Kind: v1alpha2.RunCommandGroupKind},
+						},
+					},
+				},
+			},
+		}
+	}
+	commands := []v1alpha2.Command{runCommand("run"), runCommand("run-debug")}
+	endpointComponents := []v1alpha2.Component{
+		{
+			Name: "runtime",
+			ComponentUnion: v1alpha2.ComponentUnion{
+				Container: &v1alpha2.ContainerComponent{
+					Endpoints: []v1alpha2.Endpoint{
+						{Name: "http", TargetPort: 80},
+						{Name: "debug", TargetPort: 5858},
+						{Name: "debug-duplicate", TargetPort: 5858},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want []recordedEvent
+	}{
+		{
+			name: "Plugin rule",
+			err:  validation.ValidateComponents(components),
+			want: []recordedEvent{
+				{
+					eventType: "Warning",
+					reason:    "DevfileValidationFailed",
+					message:   "Devfile validation rule plugin-import-reference failed: the plugin component \"plugin\" is invalid (plugin-import-reference) - one of uri, id or kubernetes must be set",
+				},
+			},
+		},
+		{
+			name: "Command rule and missing default command warning",
+			err:  validation.ValidateCommands(commands, nil),
+			want: []recordedEvent{
+				{
+					eventType: "Warning",
+					reason:    "DevfileValidationFailed",
+					message:   "Devfile validation rule commands failed: the command \"run\" is invalid - command does not map to a valid component: component \"missing\" does not exist in the devfile",
+				},
+				{
+					eventType: "Warning",
+					reason:    "DevfileValidationFailed",
+					message:   "Devfile validation rule commands failed: the command \"run-debug\" is invalid - command does not map to a valid component: component \"missing\" does not exist in the devfile",
+				},
+				{
+					eventType: "Warning",
+					reason:    "DevfileValidationWarning",
+					message:   "Devfile validation rule default-command failed: command group run warning - there should be exactly one default command, currently there is no default command",
+				},
+			},
+		},
+		{
+			name: "Endpoint port rule and privileged port warning",
+			err:  validation.ValidateEndpointPorts(endpointComponents, nil),
+			want: []recordedEvent{
+				{
+					eventType: "Warning",
+					reason:    "DevfileValidationWarning",
+					message:   "Devfile validation rule privileged-ports failed: public endpoint http of component runtime uses the privileged target port 80, which containers running as a non-root user cannot listen to",
+				},
+				{
+					eventType: "Warning",
+					reason:    "DevfileValidationFailed",
+					message:   "Devfile validation rule endpoint-ports failed: endpoints debug and debug-duplicate of component runtime use the same target port 5858",
+				},
+			},
+		},
+		{
+			name: "Unknown rule",
+			err:  multierror.Append(nil, errors.New("some error")),
+			want: []recordedEvent{
+				{
+					eventType: "Warning",
+					reason:    "DevfileValidationFailed",
+					message:   "Devfile validation failed: some error",
+				},
+			},
+		},
+		{
+			name: "No error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &fakeRecorder{}
+			ValidationFailed(recorder, &v1alpha2.DevWorkspace{}, tt.err)
+			assert.Equal(t, tt.want, recorder.events, "Recorded events should match")
+		})
+	}
+}
+
+func TestRecordTruncatesLongMessages(t *testing.T) {
+	recorder := &fakeRecorder{}
+	ResolutionFailed(recorder, &v1alpha2.DevWorkspace{}, errors.New(strings.Repeat("é", maxMessageLength)))
+	if assert.Len(t, recorder.events, 1) {
+		message := recorder.events[0].message
+		assert.LessOrEqual(t, len(message), maxMessageLength, "Message should be truncated")
+		assert.True(t, strings.HasPrefix(message, "Failed to resolve the devfile: éé"), "Message should keep its start")
+		assert.True(t, strings.HasSuffix(message, "é..."), "Message should be truncated on a character boundary")
+		assert.Equal(t, "DevfileResolutionFailed", recorder.events[0].reason)
+	}
+}
@@ -0,0 +1,75 @@
+// Package fetch contains the HTTP plumbing shared by the devfile resolver, the registry client and the OCI helpers.
+package fetch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+)
+
+// StatusError is the error returned when the server answers with another status than `200 OK`
+type StatusError struct {
+	URL        string
+	Status     string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("failed to fetch %s: %s", e.URL, e.Status)
+}
+
+// Unwrap returns ErrNotFound when the server answers with `404 Not Found`
+func (e *StatusError) Unwrap() error {
+	if e.StatusCode == http.StatusNotFound {
+		return devfileerrors.ErrNotFound
+	}
+	return nil
+}
+
+// Get fetches the content of the given URL with the given HTTP client, or with the default HTTP client if it is nil,
+// adding the given headers to the request.
+//
+// The request is bound to the given context, so that it is cancelled when the context is done.
+func Get(ctx context.Context, client *http.Client, url string, header http.Header) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		for _, value := range values {
+			request.Header.Add(name, value)
+		}
+	}
+	response, err := Do(client, request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	return io.ReadAll(response.Body)
+}
+
+// Do sends the given request with the given HTTP client, or with the default HTTP client if it is nil,
+// and returns the response if its status is one of the expected statuses (`200 OK` when none is given).
+// Otherwise, the response body is closed, and a StatusError is returned.
+func Do(client *http.Client, request *http.Request, expectedStatuses ...int) (*http.Response, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if len(expectedStatuses) == 0 {
+		expectedStatuses = []int{http.StatusOK}
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	for _, status := range expectedStatuses {
+		if response.StatusCode == status {
+			return response, nil
+		}
+	}
+	response.Body.Close()
+	return nil, &StatusError{URL: request.URL.String(), Status: response.Status, StatusCode: response.StatusCode}
+}
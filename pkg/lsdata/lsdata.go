@@ -0,0 +1,321 @@
+// Package lsdata provides the data needed by a devfile language server, derived from the GO types and markers
+// of the devfile API: the completion candidates, the hover documentation and the allowed enum values
+// at a given path of a devfile, without re-deriving them from the Json schema.
+//
+// The data is generated, by the `lsdata` generator, from the same source of truth as the Json schemas:
+// the descriptions are the documentation of the GO types and fields, the enum values come from the
+// `kubebuilder:validation:Enum` markers, the default values from the `kubebuilder:default` markers,
+// and the schema versions that introduced fields from the `devfile:since` markers.
+//
+// Paths are the same as in the validation errors, such as `components[runtime].container.image`:
+// the list elements are designated by their key or their index, and the map entries by their key.
+// The path of the element at a position of the devfile content is returned by position.Positions.PathAt.
+package lsdata
+
+import (
+	"fmt"
+	"strings"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+)
+
+// RootType is the name of the type of the devfile, from which the paths are resolved
+const RootType = "Devfile"
+
+// Kind is the kind of a type
+type Kind string
+
+const (
+	StringKind  Kind = "string"
+	IntegerKind Kind = "integer"
+	NumberKind  Kind = "number"
+	BooleanKind Kind = "boolean"
+	// ObjectKind is an object type, whose fields are described by an Object
+	ObjectKind Kind = "object"
+	// EnumKind is a string type with a fixed set of values
+	EnumKind Kind = "enum"
+	ListKind Kind = "list"
+	// MapKind is a map with string keys
+	MapKind Kind = "map"
+	// AnyKind is a free-form value, such as the attributes
+	AnyKind Kind = "any"
+)
+
+// Type is the type of a field
+type Type struct {
+	Kind Kind
+	// Name is the name of the object or enum type, for the ObjectKind and EnumKind kinds
+	Name string
+	// Elem is the type of the elements, for the ListKind and MapKind kinds
+	Elem *Type
+}
+
+// String describes the type, such as `string`, `Container`, or `list of EnvVar`
+func (t Type) String() string {
+	switch t.Kind {
+	case ObjectKind, EnumKind:
+		return t.Name
+	case ListKind, MapKind:
+		return fmt.Sprintf("%s of %s", t.Kind, t.Elem)
+	}
+	return string(t.Kind)
+}
+
+// Field is a field of an object type
+type Field struct {
+	// Name is the Json name of the field
+	Name        string
+	Description string
+	Type        Type
+	Required    bool
+	// Default is the default value of the field, if any
+	Default string
+	// Since is the devfile schema version that introduced the field, if it was introduced after the first version
+	Since string
+}
+
+// Object is an object type
+type Object struct {
+	Name        string
+	Description string
+	// Fields are the fields of the type, as they appear in the Json serialization, in declaration order
+	Fields []Field
+	// Unions are the Json names of the mutually-exclusive fields of each union of the type
+	Unions [][]string
+}
+
+// Field returns the field with the given Json name
+func (o *Object) Field(name string) (*Field, bool) {
+	for i := range o.Fields {
+		if o.Fields[i].Name == name {
+			return &o.Fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// CompletionKind is the kind of a completion candidate
+type CompletionKind string
+
+const (
+	// FieldCompletion is the name of a field
+	FieldCompletion CompletionKind = "field"
+	// ValueCompletion is a value, such as an enum value
+	ValueCompletion CompletionKind = "value"
+)
+
+// Completion is a completion candidate
+type Completion struct {
+	Label string
+	Kind  CompletionKind
+	// Detail is a short description of the candidate, such as the type of a field
+	Detail string
+	// Documentation is the description of the candidate, if any
+	Documentation string
+}
+
+// GetObject returns the object type with the given name
+func GetObject(name string) (*Object, bool) {
+	object, found := objects[name]
+	return object, found
+}
+
+// EnumValues returns the values of the enum type with the given name
+func EnumValues(name string) ([]string, bool) {
+	values, found := enums[name]
+	return append([]string{}, values...), found
+}
+
+// TypeAt returns the type of the devfile element at the given path.
+// The returned error wraps ErrNotFound if the path designates a field that doesn't exist.
+func TypeAt(path string) (Type, error) {
+	elementType, _, err := resolve(path)
+	return elementType, err
+}
+
+// FieldAt returns the field at the given path, which should designate a field of an object, such as `components[runtime].container.image`.
+// The returned error wraps ErrNotFound if the path doesn't designate a field.
+func FieldAt(path string) (*Field, error) {
+	_, field, err := resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	if field == nil {
+		return nil, fmt.Errorf("%w: %q doesn't designate a field", devfileerrors.ErrNotFound, path)
+	}
+	return field, nil
+}
+
+// Hover returns the documentation of the devfile element at the given path, in markdown:
+// the description of the field, or of the object type of a list element, along with its type, allowed values,
+// default value, and the schema version that introduced it.
+// The returned error wraps ErrNotFound if the path designates a field that doesn't exist.
+func Hover(path string) (string, error) {
+	elementType, field, err := resolve(path)
+	if err != nil {
+		return "", err
+	}
+	var sections []string
+	description := ""
+	if field != nil {
+		description = field.Description
+	} else if object, isObject := objects[elementType.Name]; isObject && elementType.Kind == ObjectKind {
+		description = object.Description
+	}
+	if description != "" {
+		sections = append(sections, description)
+	}
+	sections = append(sections, fmt.Sprintf("Type: `%s`", elementType))
+	if values := enumValues(elementType); len(values) > 0 {
+		sections = append(sections, "Allowed values: `"+strings.Join(values, "`, `")+"`")
+	}
+	if field != nil && field.Default != "" {
+		sections = append(sections, fmt.Sprintf("Default: `%s`", field.Default))
+	}
+	if field != nil && field.Since != "" {
+		sections = append(sections, fmt.Sprintf("Since schema version %s", field.Since))
+	}
+	return strings.Join(sections, "\n\n"), nil
+}
+
+// Completions returns the completion candidates for the devfile element at the given path:
+// the fields of an object, the fields of the elements of a list of objects, the values of an enum or a boolean,
+// or nothing for the other types.
+// The returned error wraps ErrNotFound if the path designates a field that doesn't exist.
+func Completions(path string) ([]Completion, error) {
+	elementType, _, err := resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	if elementType.Kind == ListKind {
+		elementType = *elementType.Elem
+	}
+	switch elementType.Kind {
+	case ObjectKind:
+		var completions []Completion
+		for _, field := range objects[elementType.Name].Fields {
+			completions = append(completions, Completion{Label: field.Name, Kind: FieldCompletion, Detail: field.Type.String(), Documentation: field.Description})
+		}
+		return completions, nil
+	case EnumKind:
+		var completions []Completion
+		for _, value := range enums[elementType.Name] {
+			completions = append(completions, Completion{Label: value, Kind: ValueCompletion, Detail: elementType.Name})
+		}
+		return completions, nil
+	case BooleanKind:
+		return []Completion{
+			{Label: "true", Kind: ValueCompletion, Detail: string(BooleanKind)},
+			{Label: "false", Kind: ValueCompletion, Detail: string(BooleanKind)},
+		}, nil
+	}
+	return nil, nil
+}
+
+// enumValues returns the values of the given enum type, or of the elements of the given list of enums
+func enumValues(t Type) []string {
+	if t.Kind == ListKind {
+		t = *t.Elem
+	}
+	if t.Kind != EnumKind {
+		return nil
+	}
+	return enums[t.Name]
+}
+
+// segment is a segment of a path: a field name, or a list element or map entry key
+type segment struct {
+	name      string
+	isElement bool
+}
+
+// resolve returns the type of the element at the given path, and the field that the path designates, if any
+func resolve(path string) (Type, *Field, error) {
+	segments, err := splitPath(path)
+	if err != nil {
+		return Type{}, nil, err
+	}
+	current := Type{Kind: ObjectKind, Name: RootType}
+	var field *Field
+	for i, s := range segments {
+		switch current.Kind {
+		case AnyKind:
+			// free-form content
+			return current, nil, nil
+		case ObjectKind:
+			if s.isElement {
+				return Type{}, nil, fmt.Errorf("invalid path %q: %s is not a list", path, joinSegments(segments[:i]))
+			}
+			object := objects[current.Name]
+			found := false
+			if field, found = object.Field(s.name); !found {
+				return Type{}, nil, fmt.Errorf("%w: type %s has no field %q", devfileerrors.ErrNotFound, object.Name, s.name)
+			}
+			current = field.Type
+		case ListKind:
+			if !s.isElement {
+				return Type{}, nil, fmt.Errorf("invalid path %q: %s is a list", path, joinSegments(segments[:i]))
+			}
+			field = nil
+			current = *current.Elem
+		case MapKind:
+			field = nil
+			current = *current.Elem
+		default:
+			return Type{}, nil, fmt.Errorf("invalid path %q: %s is a %s", path, joinSegments(segments[:i]), current)
+		}
+	}
+	return current, field, nil
+}
+
+// splitPath splits the given path, such as `components[runtime].container.env[0]`, into segments
+func splitPath(path string) ([]segment, error) {
+	var segments []segment
+	rest := path
+	for rest != "" {
+		switch {
+		case rest[0] == '[':
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: unclosed bracket", path)
+			}
+			segments = append(segments, segment{name: rest[1:end], isElement: true})
+			rest = strings.TrimPrefix(rest[end+1:], ".")
+		default:
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid path %q: empty field name", path)
+			}
+			segments = append(segments, segment{name: rest[:end]})
+			rest = rest[end:]
+			if strings.HasPrefix(rest, ".") {
+				rest = rest[1:]
+				if rest == "" {
+					return nil, fmt.Errorf("invalid path %q: empty field name", path)
+				}
+			}
+		}
+	}
+	return segments, nil
+}
+
+func joinSegments(segments []segment) string {
+	path := ""
+	for _, s := range segments {
+		switch {
+		case s.isElement:
+			path += "[" + s.name + "]"
+		case path == "":
+			path = s.name
+		default:
+			path += "." + s.name
+		}
+	}
+	if path == "" {
+		return "the devfile"
+	}
+	return path
+}
@@ -0,0 +1,144 @@
+package lsdata
+
+import (
+	"errors"
+	"testing"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeAt(t *testing.T) {
+	tests := []struct {
+		path          string
+		expectedType  string
+		expectedError string
+	}{
+		{path: "", expectedType: "Devfile"},
+		{path: "components", expectedType: "list of Component"},
+		{path: "components[runtime].container", expectedType: "ContainerComponent"},
+		{path: "components[0].container.env[1].value", expectedType: "string"},
+		{path: "components[runtime].container.endpoints[http].exposure", expectedType: "EndpointExposure"},
+		{path: "variables.version", expectedType: "string"},
+		{path: "attributes.app.kubernetes.io/name", expectedType: "any"},
+		{path: "components[runtime].container.unknown", expectedError: `not found: type ContainerComponent has no field "unknown"`},
+		{path: "components.container", expectedError: `invalid path "components.container": components is a list`},
+		{path: "schemaVersion.major", expectedError: `invalid path "schemaVersion.major": schemaVersion is a string`},
+		{path: "components[runtime", expectedError: `invalid path "components[runtime": unclosed bracket`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			elementType, err := TypeAt(tt.path)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expectedType, elementType.String())
+			}
+		})
+	}
+}
+
+func TestHover(t *testing.T) {
+	tests := []struct {
+		path          string
+		expectedHover string
+	}{
+		{
+			path:          "schemaVersion",
+			expectedHover: "Devfile schema version\n\nType: `string`",
+		},
+		{
+			path:          "env",
+			expectedHover: "List of environment variables that are set in all the container components of the devworkspace. When a container defines an environment variable with the same name, its own value takes precedence.\n\nType: `list of EnvVar`\n\nSince schema version 2.2.0",
+		},
+		{
+			path: "components[runtime].container.endpoints[http].protocol",
+			expectedHover: "Describes the application and transport protocols of the traffic that will go through this endpoint. \n " +
+				"- `http`: Endpoint will have `http` traffic, typically on a TCP connection. It will be automaticaly promoted to `https` when the `secure` field is set to `true`. \n " +
+				"- `https`: Endpoint will have `https` traffic, typically on a TCP connection. \n " +
+				"- `ws`: Endpoint will have `ws` traffic, typically on a TCP connection. It will be automaticaly promoted to `wss` when the `secure` field is set to `true`. \n " +
+				"- `wss`: Endpoint will have `wss` traffic, typically on a TCP connection. \n " +
+				"- `tcp`: Endpoint will have traffic on a TCP connection, without specifying an application protocol. \n " +
+				"- `udp`: Endpoint will have traffic on an UDP connection, without specifying an application protocol. \n " +
+				"Default value is `http`" +
+				"\n\nType: `EndpointProtocol`\n\nAllowed values: `http`, `https`, `ws`, `wss`, `tcp`, `udp`\n\nDefault: `http`",
+		},
+		{
+			path:          "events",
+			expectedHover: "Bindings of commands to events. Each command is referred-to by its name.\n\nType: `Events`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			hover, err := Hover(tt.path)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expectedHover, hover)
+			}
+		})
+	}
+}
+
+func TestCompletions(t *testing.T) {
+	tests := []struct {
+		path           string
+		expectedLabels []string
+	}{
+		{
+			path:           "",
+			expectedLabels: []string{"schemaVersion", "metadata", "includes", "parent", "variables", "attributes", "env", "storageStrategy", "components", "projects", "starterProjects", "commands", "events"},
+		},
+		{
+			path:           "components[runtime].container.env",
+			expectedLabels: []string{"name", "value"},
+		},
+		{
+			path:           "components[runtime].container.endpoints[http].exposure",
+			expectedLabels: []string{"public", "internal", "none"},
+		},
+		{
+			path:           "components[runtime].container.mountSources",
+			expectedLabels: []string{"true", "false"},
+		},
+		{
+			path: "components[runtime].container.image",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			completions, err := Completions(tt.path)
+			if !assert.NoError(t, err) {
+				return
+			}
+			var labels []string
+			for _, completion := range completions {
+				labels = append(labels, completion.Label)
+			}
+			assert.Equal(t, tt.expectedLabels, labels)
+		})
+	}
+}
+
+func TestFieldAt(t *testing.T) {
+	field, err := FieldAt("components[runtime].container.endpoints[http].exposure")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "exposure", field.Name)
+		assert.Equal(t, "public", field.Default)
+		assert.False(t, field.Required)
+	}
+
+	_, err = FieldAt("components[runtime]")
+	assert.True(t, errors.Is(err, devfileerrors.ErrNotFound))
+
+	component, found := GetObject("Component")
+	if assert.True(t, found) {
+		assert.Contains(t, component.Unions, []string{"container", "kubernetes", "openshift", "volume", "image", "secret", "configMap", "plugin", "custom"})
+		_, hasDiscriminator := component.Field("componentType")
+		assert.False(t, hasDiscriminator)
+	}
+
+	values, found := EnumValues("EndpointExposure")
+	assert.True(t, found)
+	assert.Equal(t, []string{"public", "internal", "none"}, values)
+}
@@ -0,0 +1,228 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/devfile/api/v2/pkg/internal/fetch"
+)
+
+// Client pushes and pulls devfile artifacts with the OCI distribution API of OCI registries
+type Client struct {
+	// HTTPClient is the HTTP client used to send the requests.
+	// The default HTTP client is used when it is nil.
+	HTTPClient *http.Client
+
+	// Token is sent as a bearer token in the `Authorization` header of the requests,
+	// for the registries that require authentication. No `Authorization` header is sent when it is empty.
+	Token string
+
+	// PlainHTTP sends the requests over http instead of https, for local or test registries
+	PlainHTTP bool
+}
+
+// Push pushes the given devfile, along with the given assets, as an OCI artifact with the given reference,
+// and returns the digest of the manifest of the artifact, which can be used to pull it.
+func (c *Client) Push(ctx context.Context, reference Reference, devfile []byte, assets []Asset) (string, error) {
+	layers := []Asset{{Name: DevfileName, MediaType: DevfileMediaType, Content: devfile}}
+	names := map[string]bool{DevfileName: true}
+	for _, asset := range assets {
+		if names[asset.Name] {
+			return "", fmt.Errorf("cannot push %s: several files are named %q", reference, asset.Name)
+		}
+		names[asset.Name] = true
+		if asset.MediaType == "" {
+			asset.MediaType = AssetMediaType
+		}
+		layers = append(layers, asset)
+	}
+
+	config := []byte("{}")
+	if err := c.pushBlob(ctx, reference, config); err != nil {
+		return "", err
+	}
+	manifest := Manifest{
+		SchemaVersion: 2,
+		MediaType:     ManifestMediaType,
+		Config:        Descriptor{MediaType: ConfigMediaType, Digest: Digest(config), Size: int64(len(config))},
+	}
+	for _, layer := range layers {
+		if err := c.pushBlob(ctx, reference, layer.Content); err != nil {
+			return "", err
+		}
+		manifest.Layers = append(manifest.Layers, Descriptor{
+			MediaType:   layer.MediaType,
+			Digest:      Digest(layer.Content),
+			Size:        int64(len(layer.Content)),
+			Annotations: map[string]string{TitleAnnotation: layer.Name},
+		})
+	}
+
+	manifestContent, err := json.Marshal(manifest)
+	if err != nil {
+		return "", err
+	}
+	manifestDigest := Digest(manifestContent)
+	tag := reference.Tag
+	if tag == "" {
+		tag = manifestDigest
+	}
+	request, err := c.newRequest(ctx, http.MethodPut, reference, "/manifests/"+tag, bytes.NewReader(manifestContent))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", ManifestMediaType)
+	response, err := fetch.Do(c.HTTPClient, request, http.StatusCreated)
+	if err != nil {
+		return "", fmt.Errorf("cannot push the manifest of %s: %w", reference, err)
+	}
+	response.Body.Close()
+	return manifestDigest, nil
+}
+
+// Pull pulls the devfile artifact with the given reference.
+//
+// The digests of the manifest (when the reference has a digest) and of all the layers are verified,
+// and the returned error wraps ErrChecksumMismatch if one of them doesn't match the pulled content.
+func (c *Client) Pull(ctx context.Context, reference Reference) (*Artifact, error) {
+	request, err := c.newRequest(ctx, http.MethodGet, reference, "/manifests/"+reference.manifestReference(), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", ManifestMediaType)
+	manifestContent, err := c.read(request)
+	if err != nil {
+		return nil, fmt.Errorf("cannot pull the manifest of %s: %w", reference, err)
+	}
+	if reference.Digest != "" {
+		if err := VerifyDigest(manifestContent, reference.Digest); err != nil {
+			return nil, fmt.Errorf("invalid manifest of %s: %w", reference, err)
+		}
+	}
+	manifest := Manifest{}
+	if err := json.Unmarshal(manifestContent, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest of %s: %w", reference, err)
+	}
+	if manifest.Config.MediaType != ConfigMediaType {
+		return nil, fmt.Errorf("%s is not a devfile artifact: its config media type is %q", reference, manifest.Config.MediaType)
+	}
+
+	artifact := &Artifact{Digest: Digest(manifestContent)}
+	for _, layer := range manifest.Layers {
+		content, err := c.pullBlob(ctx, reference, layer)
+		if err != nil {
+			return nil, err
+		}
+		name := layer.Annotations[TitleAnnotation]
+		if layer.MediaType == DevfileMediaType && artifact.Devfile == nil {
+			artifact.Devfile = content
+			continue
+		}
+		artifact.Assets = append(artifact.Assets, Asset{Name: name, MediaType: layer.MediaType, Content: content})
+	}
+	if artifact.Devfile == nil {
+		return nil, fmt.Errorf("%s is not a devfile artifact: it has no %s layer", reference, DevfileMediaType)
+	}
+	return artifact, nil
+}
+
+// pushBlob uploads the given content as a blob of the repository of the given reference, with a monolithic upload,
+// unless the blob already exists
+func (c *Client) pushBlob(ctx context.Context, reference Reference, content []byte) error {
+	digest := Digest(content)
+	request, err := c.newRequest(ctx, http.MethodHead, reference, "/blobs/"+digest, nil)
+	if err != nil {
+		return err
+	}
+	if response, err := fetch.Do(c.HTTPClient, request); err == nil {
+		response.Body.Close()
+		return nil
+	}
+
+	request, err = c.newRequest(ctx, http.MethodPost, reference, "/blobs/uploads/", nil)
+	if err != nil {
+		return err
+	}
+	response, err := fetch.Do(c.HTTPClient, request, http.StatusAccepted)
+	if err != nil {
+		return fmt.Errorf("cannot push blob %s to %s: %w", digest, reference, err)
+	}
+	response.Body.Close()
+	location, err := request.URL.Parse(response.Header.Get("Location"))
+	if err != nil {
+		return fmt.Errorf("cannot push blob %s to %s: invalid upload location: %w", digest, reference, err)
+	}
+	query := location.Query()
+	query.Set("digest", digest)
+	location.RawQuery = query.Encode()
+
+	request, err = http.NewRequestWithContext(ctx, http.MethodPut, location.String(), bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	c.authenticate(request)
+	request.Header.Set("Content-Type", "application/octet-stream")
+	request.Header.Set("Content-Length", strconv.Itoa(len(content)))
+	response, err = fetch.Do(c.HTTPClient, request, http.StatusCreated)
+	if err != nil {
+		return fmt.Errorf("cannot push blob %s to %s: %w", digest, reference, err)
+	}
+	response.Body.Close()
+	return nil
+}
+
+// pullBlob downloads the blob of the given layer from the repository of the given reference, and verifies its digest and size
+func (c *Client) pullBlob(ctx context.Context, reference Reference, layer Descriptor) ([]byte, error) {
+	request, err := c.newRequest(ctx, http.MethodGet, reference, "/blobs/"+layer.Digest, nil)
+	if err != nil {
+		return nil, err
+	}
+	content, err := c.read(request)
+	if err != nil {
+		return nil, fmt.Errorf("cannot pull blob %s of %s: %w", layer.Digest, reference, err)
+	}
+	if err := VerifyDigest(content, layer.Digest); err != nil {
+		return nil, fmt.Errorf("invalid blob %s of %s: %w", layer.Digest, reference, err)
+	}
+	if int64(len(content)) != layer.Size {
+		return nil, fmt.Errorf("invalid blob %s of %s: expected %d bytes, got %d", layer.Digest, reference, layer.Size, len(content))
+	}
+	return content, nil
+}
+
+// newRequest returns an authenticated request to the given endpoint of the repository of the given reference,
+// in the OCI distribution API
+func (c *Client) newRequest(ctx context.Context, method string, reference Reference, endpoint string, body io.Reader) (*http.Request, error) {
+	scheme := "https"
+	if c.PlainHTTP {
+		scheme = "http"
+	}
+	repositoryURL := url.URL{Scheme: scheme, Host: reference.Registry, Path: "/v2/" + reference.Repository}
+	request, err := http.NewRequestWithContext(ctx, method, repositoryURL.String()+endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	c.authenticate(request)
+	return request, nil
+}
+
+func (c *Client) authenticate(request *http.Request) {
+	if c.Token != "" {
+		request.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+}
+
+func (c *Client) read(request *http.Request) ([]byte, error) {
+	response, err := fetch.Do(c.HTTPClient, request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	return io.ReadAll(response.Body)
+}
@@ -0,0 +1,127 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// testRegistry is a minimal in-memory implementation of the OCI distribution API, for a single repository
+type testRegistry struct {
+	mu        sync.Mutex
+	blobs     map[string][]byte
+	manifests map[string][]byte
+}
+
+func (r *testRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if req.Header.Get("Authorization") != "Bearer secret" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	path := strings.TrimPrefix(req.URL.Path, "/v2/devfile/nodejs")
+	switch {
+	case path == "/blobs/uploads/" && req.Method == http.MethodPost:
+		w.Header().Set("Location", "/v2/devfile/nodejs/blobs/uploads/1?state=test")
+		w.WriteHeader(http.StatusAccepted)
+	case strings.HasPrefix(path, "/blobs/uploads/") && req.Method == http.MethodPut:
+		content, _ := io.ReadAll(req.Body)
+		if req.URL.Query().Get("state") != "test" || VerifyDigest(content, req.URL.Query().Get("digest")) != nil {
+			http.Error(w, "invalid upload", http.StatusBadRequest)
+			return
+		}
+		r.blobs[req.URL.Query().Get("digest")] = content
+		w.WriteHeader(http.StatusCreated)
+	case strings.HasPrefix(path, "/blobs/"):
+		content, found := r.blobs[strings.TrimPrefix(path, "/blobs/")]
+		if !found {
+			http.NotFound(w, req)
+			return
+		}
+		w.Write(content)
+	case strings.HasPrefix(path, "/manifests/") && req.Method == http.MethodPut:
+		if req.Header.Get("Content-Type") != ManifestMediaType {
+			http.Error(w, "invalid manifest", http.StatusBadRequest)
+			return
+		}
+		content, _ := io.ReadAll(req.Body)
+		r.manifests[strings.TrimPrefix(path, "/manifests/")] = content
+		r.manifests[Digest(content)] = content
+		w.WriteHeader(http.StatusCreated)
+	case strings.HasPrefix(path, "/manifests/"):
+		content, found := r.manifests[strings.TrimPrefix(path, "/manifests/")]
+		if !found {
+			http.NotFound(w, req)
+			return
+		}
+		w.Write(content)
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func TestPushAndPull(t *testing.T) {
+	registry := &testRegistry{blobs: map[string][]byte{}, manifests: map[string][]byte{}}
+	server := httptest.NewServer(registry)
+	defer server.Close()
+	registryHost := strings.TrimPrefix(server.URL, "http://")
+	client := &Client{Token: "secret", PlainHTTP: true}
+
+	devfile := []byte("schemaVersion: 2.2.0\n")
+	assets := []Asset{
+		{Name: "logo.svg", MediaType: SVGLogoMediaType, Content: []byte("<svg/>")},
+		{Name: "README.md", Content: []byte("# Node.js")},
+	}
+
+	reference, err := ParseReference(registryHost + "/devfile/nodejs:2.1.1")
+	assert.NoError(t, err)
+	digest, err := client.Push(context.Background(), reference, devfile, assets)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	expectedAssets := []Asset{
+		{Name: "logo.svg", MediaType: SVGLogoMediaType, Content: []byte("<svg/>")},
+		{Name: "README.md", MediaType: AssetMediaType, Content: []byte("# Node.js")},
+	}
+	for _, ref := range []string{
+		registryHost + "/devfile/nodejs:2.1.1",
+		registryHost + "/devfile/nodejs@" + digest,
+	} {
+		reference, err := ParseReference(ref)
+		assert.NoError(t, err)
+		artifact, err := client.Pull(context.Background(), reference)
+		if assert.NoError(t, err, ref) {
+			assert.Equal(t, digest, artifact.Digest)
+			assert.Equal(t, devfile, artifact.Devfile)
+			assert.Equal(t, expectedAssets, artifact.Assets)
+		}
+	}
+
+	// a tampered blob is rejected
+	registry.blobs[Digest([]byte("<svg/>"))] = []byte("<svg></svg>")
+	_, err = client.Pull(context.Background(), reference)
+	assert.True(t, errors.Is(err, devfileerrors.ErrChecksumMismatch), "unexpected error: %v", err)
+
+	// a missing artifact is not found
+	reference.Tag = "3.0.0"
+	_, err = client.Pull(context.Background(), reference)
+	assert.True(t, errors.Is(err, devfileerrors.ErrNotFound), "unexpected error: %v", err)
+
+	// several assets with the same name cannot be pushed
+	_, err = client.Push(context.Background(), reference, devfile, []Asset{{Name: DevfileName}})
+	assert.EqualError(t, err, "cannot push "+registryHost+"/devfile/nodejs:3.0.0: several files are named \"devfile.yaml\"")
+
+	// authentication errors are reported
+	_, err = (&Client{PlainHTTP: true}).Push(context.Background(), reference, devfile, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,175 @@
+// Package oci stores devfiles, along with the assets they reference, as OCI artifacts in OCI registries,
+// and retrieves them by tag or by digest.
+//
+// The artifacts follow the layout of the devfile registries: an OCI image manifest whose config has the
+// `application/vnd.devfileio.devfile.config.v2+json` media type, with one layer for the devfile and one layer per asset.
+// The name of the file of each layer is given by its `org.opencontainers.image.title` annotation.
+// The media types are defined in this package, so that the tools that exchange devfile artifacts converge on one layout.
+package oci
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+)
+
+const (
+	// ManifestMediaType is the media type of the OCI image manifest of a devfile artifact
+	ManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	// ConfigMediaType is the media type of the config of a devfile artifact
+	ConfigMediaType = "application/vnd.devfileio.devfile.config.v2+json"
+	// DevfileMediaType is the media type of the layer that contains the devfile
+	DevfileMediaType = "application/vnd.devfileio.devfile.layer.v1"
+	// VSXMediaType is the media type of the layers that contain a VS Code extension
+	VSXMediaType = "application/vnd.devfileio.vsx.layer.v1.tar"
+	// SVGLogoMediaType is the media type of the layers that contain an SVG logo
+	SVGLogoMediaType = "image/svg+xml"
+	// PNGLogoMediaType is the media type of the layers that contain a PNG logo
+	PNGLogoMediaType = "image/png"
+	// ArchiveMediaType is the media type of the layers that contain an archive, such as a starter project
+	ArchiveMediaType = "application/x-tar"
+	// AssetMediaType is the media type of the layers that contain any other asset
+	AssetMediaType = "application/octet-stream"
+
+	// TitleAnnotation is the annotation of a layer that contains the name of its file
+	TitleAnnotation = "org.opencontainers.image.title"
+
+	// DevfileName is the name of the file of the devfile layer
+	DevfileName = "devfile.yaml"
+)
+
+// Descriptor describes the content of a config or a layer of an OCI manifest
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Manifest is an OCI image manifest
+type Manifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType,omitempty"`
+	Config        Descriptor        `json:"config"`
+	Layers        []Descriptor      `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// Asset is a file referenced by a devfile, such as a logo or a starter project archive,
+// stored as a layer of the devfile artifact
+type Asset struct {
+	// Name is the name of the file of the asset, relative to the devfile
+	Name string
+	// MediaType is the media type of the asset layer. AssetMediaType is used when it is empty.
+	MediaType string
+	// Content is the content of the asset
+	Content []byte
+}
+
+// Artifact is a devfile artifact pulled from an OCI registry
+type Artifact struct {
+	// Digest is the digest of the manifest of the artifact
+	Digest string
+	// Devfile is the content of the devfile
+	Devfile []byte
+	// Assets are the other files of the artifact
+	Assets []Asset
+}
+
+// Reference is a reference to an artifact in an OCI registry, such as `quay.io/devfile/nodejs:2.1.1`
+// or `quay.io/devfile/nodejs@sha256:...`
+type Reference struct {
+	// Registry is the host (and port) of the registry
+	Registry string
+	// Repository is the path of the repository in the registry
+	Repository string
+	// Tag is the tag of the artifact, if any
+	Tag string
+	// Digest is the digest of the manifest of the artifact, if any
+	Digest string
+}
+
+// ParseReference parses an OCI reference made of a registry host, a repository, and a tag or a digest:
+// `<registry>/<repository>[:<tag>][@<digest>]`. The tag is `latest` when there is neither a tag nor a digest.
+func ParseReference(reference string) (Reference, error) {
+	parsed := Reference{}
+	original := reference
+	slash := strings.Index(reference, "/")
+	if slash <= 0 {
+		return parsed, fmt.Errorf("invalid OCI reference %q: the registry is missing", reference)
+	}
+	parsed.Registry, reference = reference[:slash], reference[slash+1:]
+
+	if at := strings.Index(reference, "@"); at >= 0 {
+		parsed.Digest, reference = reference[at+1:], reference[:at]
+		if err := validateDigest(parsed.Digest); err != nil {
+			return parsed, err
+		}
+	}
+	if colon := strings.LastIndex(reference, ":"); colon >= 0 && !strings.Contains(reference[colon:], "/") {
+		parsed.Tag, reference = reference[colon+1:], reference[:colon]
+		if parsed.Tag == "" {
+			return parsed, fmt.Errorf("invalid OCI reference %q: the tag is empty", original)
+		}
+	}
+	if reference == "" {
+		return parsed, fmt.Errorf("invalid OCI reference %q: the repository is missing", original)
+	}
+	parsed.Repository = reference
+	if parsed.Tag == "" && parsed.Digest == "" {
+		parsed.Tag = "latest"
+	}
+	return parsed, nil
+}
+
+// String returns the reference in the `<registry>/<repository>[:<tag>][@<digest>]` form
+func (r Reference) String() string {
+	reference := r.Registry + "/" + r.Repository
+	if r.Tag != "" {
+		reference += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		reference += "@" + r.Digest
+	}
+	return reference
+}
+
+// manifestReference returns the reference of the manifest in the registry API: the digest if any, or else the tag
+func (r Reference) manifestReference() string {
+	if r.Digest != "" {
+		return r.Digest
+	}
+	return r.Tag
+}
+
+// Digest returns the SHA-256 digest of the given content, in the `sha256:<hex>` form
+func Digest(content []byte) string {
+	checksum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(checksum[:])
+}
+
+// VerifyDigest checks that the given content has the given SHA-256 digest.
+// The returned error wraps ErrChecksumMismatch.
+func VerifyDigest(content []byte, digest string) error {
+	if err := validateDigest(digest); err != nil {
+		return err
+	}
+	if actual := Digest(content); actual != strings.ToLower(digest) {
+		return fmt.Errorf("%w: expected digest %s, got %s", devfileerrors.ErrChecksumMismatch, digest, actual)
+	}
+	return nil
+}
+
+func validateDigest(digest string) error {
+	hexDigest := strings.TrimPrefix(digest, "sha256:")
+	if hexDigest == digest {
+		return fmt.Errorf("unsupported digest %q: only sha256 digests are supported", digest)
+	}
+	if _, err := hex.DecodeString(hexDigest); err != nil || len(hexDigest) != 2*sha256.Size {
+		return fmt.Errorf("invalid digest %q", digest)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package oci
+
+import (
+	"errors"
+	"testing"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDigest = "sha256:b24382384ebde30bea9a5bf9d6000b7d060446963f5c28b063b436b0c0e6259a"
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		name          string
+		reference     string
+		expected      Reference
+		expectedError string
+	}{
+		{
+			name:      "Tag",
+			reference: "quay.io/devfile/nodejs:2.1.1",
+			expected:  Reference{Registry: "quay.io", Repository: "devfile/nodejs", Tag: "2.1.1"},
+		},
+		{
+			name:      "Default tag",
+			reference: "localhost:5000/nodejs",
+			expected:  Reference{Registry: "localhost:5000", Repository: "nodejs", Tag: "latest"},
+		},
+		{
+			name:      "Digest",
+			reference: "localhost:5000/devfile/nodejs@" + testDigest,
+			expected:  Reference{Registry: "localhost:5000", Repository: "devfile/nodejs", Digest: testDigest},
+		},
+		{
+			name:      "Tag and digest",
+			reference: "quay.io/devfile/nodejs:2.1.1@" + testDigest,
+			expected:  Reference{Registry: "quay.io", Repository: "devfile/nodejs", Tag: "2.1.1", Digest: testDigest},
+		},
+		{
+			name:          "Missing registry",
+			reference:     "nodejs:2.1.1",
+			expectedError: "invalid OCI reference \"nodejs:2.1.1\": the registry is missing",
+		},
+		{
+			name:          "Missing repository",
+			reference:     "quay.io/:2.1.1",
+			expectedError: "invalid OCI reference \"quay.io/:2.1.1\": the repository is missing",
+		},
+		{
+			name:          "Unsupported digest",
+			reference:     "quay.io/devfile/nodejs@md5:0123",
+			expectedError: "unsupported digest \"md5:0123\": only sha256 digests are supported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reference, err := ParseReference(tt.reference)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expected, reference)
+				if tt.expected.Tag != "latest" {
+					assert.Equal(t, tt.reference, reference.String())
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyDigest(t *testing.T) {
+	assert.Equal(t, testDigest, Digest([]byte("starter project archive")))
+	assert.NoError(t, VerifyDigest([]byte("starter project archive"), testDigest))
+
+	err := VerifyDigest([]byte("tampered archive"), testDigest)
+	assert.True(t, errors.Is(err, devfileerrors.ErrChecksumMismatch), "unexpected error: %v", err)
+
+	assert.EqualError(t, VerifyDigest(nil, "sha256:0123"), "invalid digest \"sha256:0123\"")
+}
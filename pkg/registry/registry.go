@@ -0,0 +1,211 @@
+// Package registry provides a client of the REST API of a devfile registry, which lists the stacks of the registry,
+// and downloads their devfiles and starter projects, so that the tools don't have to embed their own registry client.
+//
+// It is built on the same HTTP plumbing as the `HTTPResolver` of the `flatten` package:
+//
+//	client := &registry.Client{URL: "https://registry.devfile.io"}
+//	stacks, err := client.Stacks(ctx)
+//	...
+//	content, err := client.Devfile(ctx, "nodejs", "2.1.1")
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/devfile/stacks"
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/devfile/api/v2/pkg/internal/fetch"
+)
+
+// StackType is the type of the index entries of devfile stacks
+const StackType = "stack"
+
+// SampleType is the type of the index entries of samples
+const SampleType = "sample"
+
+// Client is a client of the REST API of a devfile registry
+type Client struct {
+	// URL is the base URL of the registry, such as `https://registry.devfile.io`
+	URL string
+
+	// HTTPClient is the HTTP client used to send the requests.
+	// The default HTTP client is used when it is nil.
+	HTTPClient *http.Client
+
+	// Token is sent as a bearer token in the `Authorization` header of the requests,
+	// for the registries that require authentication. No `Authorization` header is sent when it is empty.
+	Token string
+
+	// Header contains additional headers sent with each request, such as a `User-Agent`
+	Header http.Header
+}
+
+// IndexEntry is an entry of the index of a devfile registry, which describes a stack or a sample
+type IndexEntry struct {
+	// Name is the name of the stack or sample
+	Name string `json:"name"`
+	// Version is the version of the devfile of a stack with a single version
+	Version string `json:"version,omitempty"`
+	// DisplayName is the name of the stack or sample displayed to users
+	DisplayName string `json:"displayName,omitempty"`
+	// Description is the description of the stack or sample
+	Description string `json:"description,omitempty"`
+	// Type is either `stack` or `sample`
+	Type string `json:"type,omitempty"`
+	// Tags are the tags of the stack or sample
+	Tags []string `json:"tags,omitempty"`
+	// Architectures are the architectures supported by the stack or sample
+	Architectures []string `json:"architectures,omitempty"`
+	// Icon is the URI of the icon of the stack or sample
+	Icon string `json:"icon,omitempty"`
+	// ProjectType is the type of the projects of the stack or sample
+	ProjectType string `json:"projectType,omitempty"`
+	// Language is the programming language of the stack or sample
+	Language string `json:"language,omitempty"`
+	// Provider is the provider of the stack or sample
+	Provider string `json:"provider,omitempty"`
+	// Links contains the links of the stack, such as the `self` link to its devfile
+	Links map[string]string `json:"links,omitempty"`
+	// Resources are the files of a stack with a single version
+	Resources []string `json:"resources,omitempty"`
+	// StarterProjects are the names of the starter projects of a stack with a single version
+	StarterProjects []string `json:"starterProjects,omitempty"`
+	// Versions are the versions of a stack with several versions
+	Versions []stacks.Version `json:"versions,omitempty"`
+}
+
+// Stack returns the stack described by the index entry, whose version can be selected with `SelectVersion`.
+// The single version of a stack without versions is its default version.
+func (e *IndexEntry) Stack() *stacks.Stack {
+	stack := &stacks.Stack{
+		Name:        e.Name,
+		DisplayName: e.DisplayName,
+		Description: e.Description,
+		Icon:        e.Icon,
+		Versions:    e.Versions,
+	}
+	if len(stack.Versions) == 0 && e.Version != "" {
+		stack.Versions = []stacks.Version{{
+			Version:         e.Version,
+			Default:         true,
+			StarterProjects: e.StarterProjects,
+			Resources:       e.Resources,
+		}}
+	}
+	return stack
+}
+
+// Archive is a downloaded archive, such as a starter project
+type Archive struct {
+	// Content is the content of the archive
+	Content []byte
+	// Sha256 is the SHA-256 checksum of the content, as a hexadecimal string
+	Sha256 string
+}
+
+// Verify checks that the checksum of the archive is the given SHA-256 checksum, as a hexadecimal string.
+// The returned error wraps ErrChecksumMismatch.
+func (a *Archive) Verify(expectedSha256 string) error {
+	if !strings.EqualFold(a.Sha256, expectedSha256) {
+		return fmt.Errorf("%w: expected sha256 %s, got %s", devfileerrors.ErrChecksumMismatch, expectedSha256, a.Sha256)
+	}
+	return nil
+}
+
+// Index returns all the entries of the registry index, stacks and samples, as served by the `/index/all` endpoint
+func (c *Client) Index(ctx context.Context) ([]IndexEntry, error) {
+	content, err := c.get(ctx, "index", "all")
+	if err != nil {
+		return nil, err
+	}
+	var index []IndexEntry
+	if err := json.Unmarshal(content, &index); err != nil {
+		return nil, fmt.Errorf("invalid registry index: %w", err)
+	}
+	return index, nil
+}
+
+// Stacks returns the stack entries of the registry index
+func (c *Client) Stacks(ctx context.Context) ([]IndexEntry, error) {
+	index, err := c.Index(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var stackEntries []IndexEntry
+	for _, entry := range index {
+		if entry.Type == StackType {
+			stackEntries = append(stackEntries, entry)
+		}
+	}
+	return stackEntries, nil
+}
+
+// Stack returns the entry of the registry index of the stack with the given name.
+// The returned error wraps ErrNotFound if the registry has no such stack.
+func (c *Client) Stack(ctx context.Context, name string) (*IndexEntry, error) {
+	stackEntries, err := c.Stacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range stackEntries {
+		if stackEntries[i].Name == name {
+			return &stackEntries[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: stack %q in registry %s", devfileerrors.ErrNotFound, name, c.URL)
+}
+
+// Devfile returns the content of the devfile of the given stack version, or of the default version of the stack
+// if the version is empty.
+// The returned error wraps ErrNotFound if the registry has no such stack or version.
+func (c *Client) Devfile(ctx context.Context, stack, version string) ([]byte, error) {
+	return c.get(ctx, stackPath(stack, version)...)
+}
+
+// StarterProject downloads the archive of the given starter project of the given stack version,
+// or of the default version of the stack if the version is empty, and computes its checksum.
+// The returned error wraps ErrNotFound if the registry has no such stack, version or starter project.
+func (c *Client) StarterProject(ctx context.Context, stack, version, starterProject string) (*Archive, error) {
+	content, err := c.get(ctx, append(stackPath(stack, version), "starter-projects", starterProject)...)
+	if err != nil {
+		return nil, err
+	}
+	checksum := sha256.Sum256(content)
+	return &Archive{Content: content, Sha256: hex.EncodeToString(checksum[:])}, nil
+}
+
+// stackPath returns the path segments of the devfile of the given stack version in the registry REST API
+func stackPath(stack, version string) []string {
+	segments := []string{"devfiles", stack}
+	if version != "" {
+		segments = append(segments, version)
+	}
+	return segments
+}
+
+// get fetches the content of the registry REST API endpoint made of the given path segments,
+// with the authentication and headers of the client
+func (c *Client) get(ctx context.Context, segments ...string) ([]byte, error) {
+	if c.URL == "" {
+		return nil, fmt.Errorf("the registry URL is not set")
+	}
+	endpoint := strings.TrimSuffix(c.URL, "/")
+	for _, segment := range segments {
+		endpoint += "/" + url.PathEscape(segment)
+	}
+	header := c.Header.Clone()
+	if c.Token != "" {
+		if header == nil {
+			header = http.Header{}
+		}
+		header.Set("Authorization", "Bearer "+c.Token)
+	}
+	return fetch.Get(ctx, c.HTTPClient, endpoint, header)
+}
@@ -0,0 +1,168 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const testIndex = `[
+  {
+    "name": "nodejs",
+    "displayName": "Node.js Runtime",
+    "type": "stack",
+    "versions": [
+      {"version": "2.0.0", "schemaVersion": "2.1.0"},
+      {"version": "2.1.1", "schemaVersion": "2.2.0", "default": true, "starterProjects": ["nodejs-starter"]}
+    ]
+  },
+  {
+    "name": "go",
+    "type": "stack",
+    "version": "1.0.2",
+    "starterProjects": ["go-starter"]
+  },
+  {
+    "name": "nodejs-basic",
+    "type": "sample"
+  }
+]`
+
+// starterProjectSha256 is the SHA-256 checksum of "starter project archive"
+const starterProjectSha256 = "b24382384ebde30bea9a5bf9d6000b7d060446963f5c28b063b436b0c0e6259a"
+
+func newTestServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/index/all":
+			w.Write([]byte(testIndex))
+		case "/devfiles/nodejs":
+			w.Write([]byte("schemaVersion: 2.2.0\n"))
+		case "/devfiles/nodejs/2.0.0":
+			w.Write([]byte("schemaVersion: 2.1.0\n"))
+		case "/devfiles/nodejs/2.1.1/starter-projects/nodejs-starter":
+			w.Write([]byte("starter project archive"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestStacks(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	client := &Client{URL: server.URL + "/", Token: "secret"}
+
+	stackEntries, err := client.Stacks(context.Background())
+	if assert.NoError(t, err) && assert.Len(t, stackEntries, 2) {
+		assert.Equal(t, "nodejs", stackEntries[0].Name)
+		assert.Equal(t, "go", stackEntries[1].Name)
+	}
+
+	index, err := client.Index(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, index, 3)
+
+	stack, err := client.Stack(context.Background(), "go")
+	if assert.NoError(t, err) {
+		defaultVersion, err := stack.Stack().DefaultVersion()
+		assert.NoError(t, err)
+		assert.Equal(t, "1.0.2", defaultVersion.Version)
+		assert.Equal(t, []string{"go-starter"}, defaultVersion.StarterProjects)
+	}
+
+	stack, err = client.Stack(context.Background(), "nodejs")
+	if assert.NoError(t, err) {
+		selected, err := stack.Stack().SelectVersion("2.0")
+		assert.NoError(t, err)
+		assert.Equal(t, "2.0.0", selected.Version)
+	}
+
+	_, err = client.Stack(context.Background(), "nodejs-basic")
+	assert.True(t, errors.Is(err, devfileerrors.ErrNotFound), "unexpected error: %v", err)
+}
+
+func TestDevfile(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+
+	tests := []struct {
+		name            string
+		token           string
+		stack           string
+		version         string
+		expectedContent string
+		expectedError   string
+		notFound        bool
+	}{
+		{
+			name:            "Default version",
+			token:           "secret",
+			stack:           "nodejs",
+			expectedContent: "schemaVersion: 2.2.0\n",
+		},
+		{
+			name:            "Given version",
+			token:           "secret",
+			stack:           "nodejs",
+			version:         "2.0.0",
+			expectedContent: "schemaVersion: 2.1.0\n",
+		},
+		{
+			name:          "Missing version",
+			token:         "secret",
+			stack:         "nodejs",
+			version:       "3.0.0",
+			expectedError: "failed to fetch " + server.URL + "/devfiles/nodejs/3.0.0: 404 Not Found",
+			notFound:      true,
+		},
+		{
+			name:          "Unauthorized",
+			stack:         "nodejs",
+			expectedError: "failed to fetch " + server.URL + "/devfiles/nodejs: 401 Unauthorized",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{URL: server.URL, Token: tt.token}
+			content, err := client.Devfile(context.Background(), tt.stack, tt.version)
+			if tt.expectedError != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, tt.expectedError, err.Error())
+					assert.Equal(t, tt.notFound, errors.Is(err, devfileerrors.ErrNotFound))
+				}
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expectedContent, string(content))
+			}
+		})
+	}
+}
+
+func TestStarterProject(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	client := &Client{URL: server.URL, Token: "secret"}
+
+	archive, err := client.StarterProject(context.Background(), "nodejs", "2.1.1", "nodejs-starter")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "starter project archive", string(archive.Content))
+		assert.Equal(t, starterProjectSha256, archive.Sha256)
+		assert.NoError(t, archive.Verify(starterProjectSha256))
+		err = archive.Verify("0123")
+		assert.True(t, errors.Is(err, devfileerrors.ErrChecksumMismatch), "unexpected error: %v", err)
+	}
+
+	_, err = client.StarterProject(context.Background(), "nodejs", "2.1.1", "missing")
+	assert.True(t, errors.Is(err, devfileerrors.ErrNotFound), "unexpected error: %v", err)
+}
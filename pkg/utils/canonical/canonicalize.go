@@ -0,0 +1,114 @@
+// Package canonical provides the canonicalization of devfile and devworkspace template contents,
+// so that two semantically identical contents are byte-equal once marshalled.
+// This is useful to cache or diff devfiles.
+package canonical
+
+import (
+	"reflect"
+	"sort"
+
+	dw "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/utils/unions"
+	"github.com/mitchellh/reflectwalk"
+)
+
+// defaultSourceMapping is the default value of the `sourceMapping` field of container components
+const defaultSourceMapping = "/projects"
+
+// defaulter is implemented by the API types that have a generated `Default()` method
+type defaulter interface {
+	Default()
+}
+
+var keyedType = reflect.TypeOf((*dw.Keyed)(nil)).Elem()
+
+type defaultSetter struct {
+}
+
+func (d *defaultSetter) Struct(s reflect.Value) error {
+	if !s.CanAddr() {
+		return nil
+	}
+	addr := s.Addr()
+	if !addr.CanInterface() {
+		return nil
+	}
+	i := addr.Interface()
+	if d, ok := i.(defaulter); ok {
+		d.Default()
+	}
+	// Also apply the defaults that are only declared with the `kubebuilder:default` marker.
+	// Override types are not concerned, since a default value would override the parent value.
+	switch typed := i.(type) {
+	case *dw.Container:
+		if typed.SourceMapping == "" {
+			typed.SourceMapping = defaultSourceMapping
+		}
+	case *dw.Endpoint:
+		if typed.Exposure == "" {
+			typed.Exposure = dw.PublicEndpointExposure
+		}
+		if typed.Protocol == "" {
+			typed.Protocol = dw.HTTPEndpointProtocol
+		}
+	}
+	return nil
+}
+func (d *defaultSetter) StructField(reflect.StructField, reflect.Value) error {
+	return nil
+}
+
+// Canonicalize transforms the given tree (a pointer to a devfile, a devworkspace template spec, or any part of it)
+// into its canonical form:
+// - all the unions are normalized, so that their discriminator is set,
+// - the unset fields are set to their default values,
+// - the keyed lists (such as the components, commands or projects lists, and their overrides) are sorted by key,
+// - the empty maps and slices are removed.
+//
+// Other lists, such as composite command sub-commands or environment variables, are kept as-is,
+// since their order is meaningful.
+func Canonicalize(tree interface{}) error {
+	if err := unions.Normalize(tree); err != nil {
+		return err
+	}
+	if err := reflectwalk.Walk(tree, &defaultSetter{}); err != nil {
+		return err
+	}
+	canonicalizeValue(reflect.ValueOf(tree))
+	return nil
+}
+
+// canonicalizeValue sorts the keyed lists and removes the empty maps and slices found in the given value
+func canonicalizeValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			canonicalizeValue(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if field := v.Field(i); field.CanSet() {
+				canonicalizeValue(field)
+			}
+		}
+	case reflect.Map:
+		if v.Len() == 0 && !v.IsNil() && v.CanSet() {
+			v.Set(reflect.Zero(v.Type()))
+		}
+	case reflect.Slice:
+		if v.Len() == 0 {
+			if !v.IsNil() && v.CanSet() {
+				v.Set(reflect.Zero(v.Type()))
+			}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			canonicalizeValue(v.Index(i))
+		}
+		if v.Type().Elem().Implements(keyedType) {
+			sort.SliceStable(v.Interface(), func(i, j int) bool {
+				return v.Index(i).Interface().(dw.Keyed).Key() < v.Index(j).Interface().(dw.Keyed).Key()
+			})
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package canonical
+
+import (
+	"encoding/json"
+	"testing"
+
+	dw "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		other    string
+	}{
+		{
+			name: "Keyed lists are sorted",
+			original: `
+components:
+- name: tools
+  container:
+    image: tools
+- name: runtime
+  container:
+    image: node
+commands:
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+- id: build
+  exec:
+    component: runtime
+    commandLine: npm install
+`,
+			other: `
+commands:
+- id: build
+  exec:
+    component: runtime
+    commandLine: npm install
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+components:
+- name: runtime
+  container:
+    image: node
+- name: tools
+  container:
+    image: tools
+`,
+		},
+		{
+			name: "Unions are normalized",
+			original: `
+components:
+- name: runtime
+  componentType: Container
+  container:
+    image: node
+`,
+			other: `
+components:
+- name: runtime
+  container:
+    image: node
+`,
+		},
+		{
+			name: "Defaults are applied",
+			original: `
+components:
+- name: runtime
+  container:
+    image: node
+    dedicatedPod: false
+    sourceMapping: /projects
+    endpoints:
+    - name: http
+      targetPort: 8080
+      exposure: public
+      protocol: http
+`,
+			other: `
+components:
+- name: runtime
+  container:
+    image: node
+    endpoints:
+    - name: http
+      targetPort: 8080
+`,
+		},
+		{
+			name: "Empty maps and slices are removed",
+			original: `
+variables: {}
+projects: []
+components:
+- name: runtime
+  container:
+    image: node
+    env: []
+`,
+			other: `
+components:
+- name: runtime
+  container:
+    image: node
+`,
+		},
+		{
+			name: "Parent overrides are sorted",
+			original: `
+parent:
+  uri: parent.yaml
+  components:
+  - name: b
+    container:
+      image: b
+  - name: a
+    container:
+      image: a
+`,
+			other: `
+parent:
+  uri: parent.yaml
+  components:
+  - name: a
+    container:
+      image: a
+  - name: b
+    container:
+      image: b
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := &dw.DevWorkspaceTemplateSpec{}
+			other := &dw.DevWorkspaceTemplateSpec{}
+			if !assert.NoError(t, yaml.Unmarshal([]byte(tt.original), original)) ||
+				!assert.NoError(t, yaml.Unmarshal([]byte(tt.other), other)) {
+				return
+			}
+
+			assert.NoError(t, Canonicalize(original))
+			assert.NoError(t, Canonicalize(other))
+
+			originalBytes, err := json.Marshal(original)
+			assert.NoError(t, err)
+			otherBytes, err := json.Marshal(other)
+			assert.NoError(t, err)
+			assert.Equal(t, string(otherBytes), string(originalBytes), "Canonical forms should be byte-equal")
+		})
+	}
+}
+
+func TestCanonicalizeKeepsOrderedLists(t *testing.T) {
+	original := &dw.DevWorkspaceTemplateSpec{}
+	err := yaml.Unmarshal([]byte(`
+commands:
+- id: all
+  composite:
+    commands:
+    - run
+    - build
+`), original)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.NoError(t, Canonicalize(original))
+	assert.Equal(t, []string{"run", "build"}, original.Commands[0].Composite.Commands, "Composite sub-commands order should be kept")
+}
@@ -0,0 +1,17 @@
+package overriding
+
+// keysError is an error about the keys of top-level elements, which wraps a sentinel error
+// of the `pkg/errors` package, such as `ErrDuplicateKey`, without changing the error message
+type keysError struct {
+	message  string
+	sentinel error
+}
+
+func (e *keysError) Error() string {
+	return e.message
+}
+
+// Unwrap returns the sentinel error, so that the error can be checked with `errors.Is`
+func (e *keysError) Unwrap() error {
+	return e.sentinel
+}
@@ -36,14 +36,17 @@ func checkKeys(doCheck checkFn, toplevelListContainers ...dw.TopLevelListContain
 
 		var variableValue reflect.Value
 		var attributeValue reflect.Value
+		var envValue reflect.Value
 
 		// toplevelListContainers can contain either a pointer or a struct and needs to be safeguarded when using reflect
 		if value.Kind() == reflect.Ptr {
 			variableValue = value.Elem().FieldByName("Variables")
 			attributeValue = value.Elem().FieldByName("Attributes")
+			envValue = value.Elem().FieldByName("Env")
 		} else {
 			variableValue = value.FieldByName("Variables")
 			attributeValue = value.FieldByName("Attributes")
+			envValue = value.FieldByName("Env")
 		}
 
 		if variableValue.IsValid() && variableValue.Kind() == reflect.Map {
@@ -72,6 +75,18 @@ func checkKeys(doCheck checkFn, toplevelListContainers ...dw.TopLevelListContain
 			}
 			listTypeToKeys["Attributes"] = append(listTypeToKeys["Attributes"], sets.NewString(attributeKeys...))
 		}
+
+		if envValue.IsValid() && envValue.Kind() == reflect.Slice {
+			var envNames []string
+			for i := 0; i < envValue.Len(); i++ {
+				name := envValue.Index(i).FieldByName("Name")
+				if !name.IsValid() || name.Kind() != reflect.String {
+					return fmt.Errorf("unable to fetch top-level Env, top-level Env should be a list of named env variables")
+				}
+				envNames = append(envNames, name.String())
+			}
+			listTypeToKeys["Env"] = append(listTypeToKeys["Env"], sets.NewString(envNames...))
+		}
 	}
 
 	for listType, keySets := range listTypeToKeys {
@@ -7,6 +7,7 @@ import (
 
 	dw "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/api/v2/pkg/attributes"
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/yaml"
@@ -19,6 +20,15 @@ import (
 // Returns non-nil error if there are duplicate (== with same key) commands, components or projects between the
 // main content and the parent or plugins.
 //
+// The commands bound to each event type are merged according to the strategy selected by the `api.devfile.io/events-merge`
+// attribute of the main content: by default, the commands of the parent come first, then those of the plugins,
+// then those of the main content (see `EventsMergeStrategy`).
+//
+// The plugins are expected in the order of the plugin components of the main content. They are applied by increasing
+// `api.devfile.io/order` attribute of their plugin component, then in declaration order, and the components of the result
+// are sorted by increasing `api.devfile.io/order` attribute, the components with the same order keeping their merge order
+// (see `SortComponents`), which gives the order of the containers in the pod of the devworkspace.
+//
 // The result is a transformed `DevWorkspaceTemplateSpec` object, that does not contain any `plugin` component
 // (since they are expected to be provided as flattened overridden devfiles in the arguments)
 func MergeDevWorkspaceTemplateSpec(
@@ -26,15 +36,6 @@ func MergeDevWorkspaceTemplateSpec(
 	parentFlattenedContent *dw.DevWorkspaceTemplateSpecContent,
 	pluginFlattenedContents ...*dw.DevWorkspaceTemplateSpecContent) (*dw.DevWorkspaceTemplateSpecContent, error) {
 
-	allContents := []*dw.DevWorkspaceTemplateSpecContent{}
-	if parentFlattenedContent != nil {
-		allContents = append(allContents, parentFlattenedContent)
-	}
-	if len(pluginFlattenedContents) > 0 {
-		allContents = append(allContents, pluginFlattenedContents...)
-	}
-	allContents = append(allContents, mainContent)
-
 	// Check for conflicts
 	if parentFlattenedContent != nil {
 		if err := ensureNoConflictWithParent(mainContent, parentFlattenedContent); err != nil {
@@ -53,6 +54,18 @@ func MergeDevWorkspaceTemplateSpec(
 		}
 	}
 
+	orderedPluginContents, err := orderPluginContents(mainContent, pluginFlattenedContents)
+	if err != nil {
+		return nil, err
+	}
+
+	allContents := []*dw.DevWorkspaceTemplateSpecContent{}
+	if parentFlattenedContent != nil {
+		allContents = append(allContents, parentFlattenedContent)
+	}
+	allContents = append(allContents, orderedPluginContents...)
+	allContents = append(allContents, mainContent)
+
 	result := dw.DevWorkspaceTemplateSpecContent{}
 
 	// Merge top-level lists (Commands, Projects, Components, etc ...)
@@ -92,19 +105,23 @@ func MergeDevWorkspaceTemplateSpec(
 		}
 	}
 
-	preStartCommands := sets.String{}
-	postStartCommands := sets.String{}
-	preStopCommands := sets.String{}
-	postStopCommands := sets.String{}
+	if err := dw.SortComponents(result.Components); err != nil {
+		return nil, err
+	}
+
+	eventsMerge, err := dw.GetEventsMerge(mainContent.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	var importedEvents []dw.Events
 	for _, content := range allContents {
 		if content.Events != nil {
 			if result.Events == nil {
 				result.Events = &dw.Events{}
 			}
-			preStartCommands = preStartCommands.Union(sets.NewString(content.Events.PreStart...))
-			postStartCommands = postStartCommands.Union(sets.NewString(content.Events.PostStart...))
-			preStopCommands = preStopCommands.Union(sets.NewString(content.Events.PreStop...))
-			postStopCommands = postStopCommands.Union(sets.NewString(content.Events.PostStop...))
+			if content != mainContent {
+				importedEvents = append(importedEvents, *content.Events)
+			}
 		}
 
 		if len(content.Variables) > 0 {
@@ -116,7 +133,12 @@ func MergeDevWorkspaceTemplateSpec(
 			}
 		}
 
-		var err error
+		result.Env = append(result.Env, content.Env...)
+
+		if content.StorageStrategy != nil {
+			result.StorageStrategy = content.StorageStrategy
+		}
+
 		if len(content.Attributes) > 0 {
 			if len(result.Attributes) == 0 {
 				result.Attributes = attributes.Attributes{}
@@ -131,15 +153,64 @@ func MergeDevWorkspaceTemplateSpec(
 	}
 
 	if result.Events != nil {
-		result.Events.PreStart = preStartCommands.List()
-		result.Events.PostStart = postStartCommands.List()
-		result.Events.PreStop = preStopCommands.List()
-		result.Events.PostStop = postStopCommands.List()
+		mainEvents := dw.Events{}
+		if mainContent.Events != nil {
+			mainEvents = *mainContent.Events
+		}
+		mergeEvents := func(strategy dw.EventsMergeStrategy, eventType func(dw.Events) []string) []string {
+			var imported [][]string
+			for _, events := range importedEvents {
+				imported = append(imported, eventType(events))
+			}
+			return dw.MergeEvents(strategy, imported, eventType(mainEvents))
+		}
+		result.Events.PreStart = mergeEvents(eventsMerge.PreStart, func(events dw.Events) []string { return events.PreStart })
+		result.Events.PostStart = mergeEvents(eventsMerge.PostStart, func(events dw.Events) []string { return events.PostStart })
+		result.Events.PreStop = mergeEvents(eventsMerge.PreStop, func(events dw.Events) []string { return events.PreStop })
+		result.Events.PostStop = mergeEvents(eventsMerge.PostStop, func(events dw.Events) []string { return events.PostStop })
 	}
 
 	return &result, nil
 }
 
+// IncludedContent is the content of a devfile fragment included by the `includes` field of a devfile,
+// with the path of the fragment
+type IncludedContent struct {
+	Path    string
+	Content *dw.DevWorkspaceTemplateSpecContent
+}
+
+// MergeIncludes merges the components and commands of the given included fragments, in this order,
+// before the components and commands of the main content.
+//
+// Unlike the elements of a parent, the included elements cannot be overridden:
+// returns non-nil error if a component or command is defined both in the main content and in a fragment,
+// or in several fragments.
+func MergeIncludes(mainContent *dw.DevWorkspaceTemplateSpecContent, includes ...IncludedContent) (*dw.DevWorkspaceTemplateSpecContent, error) {
+	if err := ensureNoConflictsWithIncludes(mainContent, includes...); err != nil {
+		return nil, err
+	}
+
+	result := mainContent.DeepCopy()
+	var components []dw.Component
+	var commands []dw.Command
+	for _, included := range includes {
+		for _, component := range included.Content.Components {
+			components = append(components, *component.DeepCopy())
+		}
+		for _, command := range included.Content.Commands {
+			commands = append(commands, *command.DeepCopy())
+		}
+	}
+	if len(components) > 0 {
+		result.Components = append(components, result.Components...)
+	}
+	if len(commands) > 0 {
+		result.Commands = append(commands, result.Commands...)
+	}
+	return result, nil
+}
+
 // MergeDevWorkspaceTemplateSpecBytes implements the merging logic of a main devfile content with flattened, already-overridden parent devfiles or plugins.
 // On an json or yaml document that contains the core content of the devfile (which is the core part of a devfile, without the `apiVersion` and `metadata`),
 // it allows adding all the new overridden elements provided by flattened parent and plugins (also provided as json or yaml documents)
@@ -190,16 +261,37 @@ func MergeDevWorkspaceTemplateSpecBytes(originalBytes []byte, flattenedParentByt
 	return MergeDevWorkspaceTemplateSpec(&original, &flattenedParent, flattenedPlugins...)
 }
 
+// orderPluginContents returns the given flattened plugins, which are in the order of the plugin components of the main content,
+// in the order in which they should be applied, as returned by `PluginOrder`.
+// The plugins are left in their order if they don't match the plugin components of the main content.
+func orderPluginContents(mainContent *dw.DevWorkspaceTemplateSpecContent, pluginFlattenedContents []*dw.DevWorkspaceTemplateSpecContent) ([]*dw.DevWorkspaceTemplateSpecContent, error) {
+	order, err := dw.PluginOrder(mainContent.Components)
+	if err != nil {
+		return nil, err
+	}
+	if len(order) != len(pluginFlattenedContents) {
+		return pluginFlattenedContents, nil
+	}
+	ordered := make([]*dw.DevWorkspaceTemplateSpecContent, 0, len(pluginFlattenedContents))
+	for _, index := range order {
+		ordered = append(ordered, pluginFlattenedContents[index])
+	}
+	return ordered, nil
+}
+
 func ensureNoConflictWithParent(mainContent *dw.DevWorkspaceTemplateSpecContent, parentflattenedContent *dw.DevWorkspaceTemplateSpecContent) error {
 	return checkKeys(func(elementType string, keysSets []sets.String) []error {
 		mainKeys := keysSets[0]
 		parentOrPluginKeys := keysSets[1]
 		overriddenElementsInMainContent := mainKeys.Intersection(parentOrPluginKeys)
 		if overriddenElementsInMainContent.Len() > 0 {
-			return []error{fmt.Errorf("Some %s are already defined in parent: %s. "+
-				"If you want to override them, you should do it in the parent scope.",
-				elementType,
-				strings.Join(overriddenElementsInMainContent.List(), ", "))}
+			return []error{&keysError{
+				message: fmt.Sprintf("Some %s are already defined in parent: %s. "+
+					"If you want to override them, you should do it in the parent scope.",
+					elementType,
+					strings.Join(overriddenElementsInMainContent.List(), ", ")),
+				sentinel: devfileerrors.ErrDuplicateKey,
+			}}
 		}
 		return []error{}
 	},
@@ -232,11 +324,52 @@ func ensureNoConflictsWithPlugins(mainContent *dw.DevWorkspaceTemplateSpecConten
 			overriddenElementsInMainContent := mainKeys.Intersection(pluginKeys)
 
 			if overriddenElementsInMainContent.Len() > 0 {
-				errs = append(errs, fmt.Errorf("Some %s are already defined in plugin '%s': %s. "+
-					"If you want to override them, you should do it in the plugin scope.",
-					elementType,
-					getPluginKey(pluginNumber),
-					strings.Join(overriddenElementsInMainContent.List(), ", ")))
+				errs = append(errs, &keysError{
+					message: fmt.Sprintf("Some %s are already defined in plugin '%s': %s. "+
+						"If you want to override them, you should do it in the plugin scope.",
+						elementType,
+						getPluginKey(pluginNumber),
+						strings.Join(overriddenElementsInMainContent.List(), ", ")),
+					sentinel: devfileerrors.ErrDuplicateKey,
+				})
+			}
+		}
+		return errs
+	},
+		allSpecs...)
+}
+
+func ensureNoConflictsWithIncludes(mainContent *dw.DevWorkspaceTemplateSpecContent, includes ...IncludedContent) error {
+	allSpecs := []dw.TopLevelListContainer{mainContent}
+	for _, included := range includes {
+		allSpecs = append(allSpecs, included.Content)
+	}
+	return checkKeys(func(elementType string, keysSets []sets.String) []error {
+		mainKeys := keysSets[0]
+		includedKeysSets := keysSets[1:]
+		errs := []error{}
+		for includeNumber, includedKeys := range includedKeysSets {
+			if duplicates := mainKeys.Intersection(includedKeys); duplicates.Len() > 0 {
+				errs = append(errs, &keysError{
+					message: fmt.Sprintf("Some %s are already defined in include '%s': %s. "+
+						"Included elements cannot be overridden.",
+						elementType,
+						includes[includeNumber].Path,
+						strings.Join(duplicates.List(), ", ")),
+					sentinel: devfileerrors.ErrDuplicateKey,
+				})
+			}
+			for previousNumber, previousKeys := range includedKeysSets[:includeNumber] {
+				if duplicates := previousKeys.Intersection(includedKeys); duplicates.Len() > 0 {
+					errs = append(errs, &keysError{
+						message: fmt.Sprintf("Some %s are defined in both include '%s' and include '%s': %s.",
+							elementType,
+							includes[previousNumber].Path,
+							includes[includeNumber].Path,
+							strings.Join(duplicates.List(), ", ")),
+						sentinel: devfileerrors.ErrDuplicateKey,
+					})
+				}
 			}
 		}
 		return errs
@@ -1,12 +1,14 @@
 package overriding
 
 import (
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
 
 	dw "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -118,3 +120,174 @@ func TestMergingOnlyParent(t *testing.T) {
 		assert.Equal(t, &expectedDWT, gotDWT)
 	}
 }
+
+func TestMergingConflictsAreDuplicateKeys(t *testing.T) {
+	tests := []struct {
+		name       string
+		fixtureDir string
+	}{
+		{
+			name:       "Conflict with parent",
+			fixtureDir: "test-fixtures/merges/duplicate-with-parent",
+		},
+		{
+			name:       "Conflict with plugin",
+			fixtureDir: "test-fixtures/merges/duplicate-with-plugin",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mainDWT := dw.DevWorkspaceTemplateSpecContent{}
+			readFileToStruct(t, filepath.Join(tt.fixtureDir, "main.yaml"), &mainDWT)
+
+			var parentDWT *dw.DevWorkspaceTemplateSpecContent
+			var pluginDWTs []*dw.DevWorkspaceTemplateSpecContent
+			if parentFile := filepath.Join(tt.fixtureDir, "parent.yaml"); fileExists(parentFile) {
+				parentDWT = &dw.DevWorkspaceTemplateSpecContent{}
+				readFileToStruct(t, parentFile, parentDWT)
+			}
+			if pluginFile := filepath.Join(tt.fixtureDir, "plugin.yaml"); fileExists(pluginFile) {
+				pluginDWT := &dw.DevWorkspaceTemplateSpecContent{}
+				readFileToStruct(t, pluginFile, pluginDWT)
+				pluginDWTs = append(pluginDWTs, pluginDWT)
+			}
+
+			_, err := MergeDevWorkspaceTemplateSpec(&mainDWT, parentDWT, pluginDWTs...)
+			if assert.Error(t, err) {
+				assert.True(t, errors.Is(err, devfileerrors.ErrDuplicateKey))
+				assert.False(t, errors.Is(err, devfileerrors.ErrNotFound))
+			}
+		})
+	}
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func TestMergingPluginOrder(t *testing.T) {
+	main := []byte(`
+components:
+  - name: "first-plugin"
+    plugin:
+      uri: "firstLocation"
+  - name: "second-plugin"
+    attributes:
+      api.devfile.io/order: -1
+    plugin:
+      uri: "secondLocation"
+events:
+  preStart:
+    - "preStartFromMainContent"
+`)
+	firstPlugin := []byte(`
+components:
+  - name: "first-plugin-container"
+    container:
+      image: "first-image"
+events:
+  preStart:
+    - "preStartFromFirstPlugin"
+`)
+	secondPlugin := []byte(`
+components:
+  - name: "second-plugin-container"
+    container:
+      image: "second-image"
+events:
+  preStart:
+    - "preStartFromSecondPlugin"
+`)
+
+	result, err := MergeDevWorkspaceTemplateSpecBytes(main, []byte{}, firstPlugin, secondPlugin)
+	if !assert.NoError(t, err) {
+		return
+	}
+	var componentNames []string
+	for _, component := range result.Components {
+		componentNames = append(componentNames, component.Name)
+	}
+	assert.Equal(t, []string{"second-plugin-container", "first-plugin-container"}, componentNames)
+	assert.Equal(t, []string{"preStartFromSecondPlugin", "preStartFromFirstPlugin", "preStartFromMainContent"}, result.Events.PreStart)
+}
+
+func TestMergingInvalidComponentOrder(t *testing.T) {
+	main := []byte(`
+components:
+  - name: "main-container"
+    attributes:
+      api.devfile.io/order: "first"
+    container:
+      image: "main-image"
+`)
+	_, err := MergeDevWorkspaceTemplateSpecBytes(main, []byte{})
+	assert.EqualError(t, err, `component "main-container": attribute "api.devfile.io/order" is invalid: it should be an integer, but is "first"`)
+}
+
+func TestMergeIncludes(t *testing.T) {
+	component := func(name string) dw.Component {
+		return dw.Component{Name: name, ComponentUnion: dw.ComponentUnion{Volume: &dw.VolumeComponent{}}}
+	}
+	command := func(id string) dw.Command {
+		return dw.Command{Id: id, CommandUnion: dw.CommandUnion{Exec: &dw.ExecCommand{CommandLine: id}}}
+	}
+	tests := []struct {
+		name           string
+		main           dw.DevWorkspaceTemplateSpecContent
+		includes       []IncludedContent
+		wantComponents []string
+		wantCommands   []string
+		wantDuplicate  bool
+	}{
+		{
+			name: "Included elements come first",
+			main: dw.DevWorkspaceTemplateSpecContent{Components: []dw.Component{component("main")}},
+			includes: []IncludedContent{
+				{Path: "components.yaml", Content: &dw.DevWorkspaceTemplateSpecContent{Components: []dw.Component{component("a"), component("b")}}},
+				{Path: "commands.yaml", Content: &dw.DevWorkspaceTemplateSpecContent{Commands: []dw.Command{command("build")}}},
+			},
+			wantComponents: []string{"a", "b", "main"},
+			wantCommands:   []string{"build"},
+		},
+		{
+			name: "Conflict with the main content",
+			main: dw.DevWorkspaceTemplateSpecContent{Commands: []dw.Command{command("build")}},
+			includes: []IncludedContent{
+				{Path: "commands.yaml", Content: &dw.DevWorkspaceTemplateSpecContent{Commands: []dw.Command{command("build")}}},
+			},
+			wantDuplicate: true,
+		},
+		{
+			name: "Conflict between includes",
+			includes: []IncludedContent{
+				{Path: "a.yaml", Content: &dw.DevWorkspaceTemplateSpecContent{Components: []dw.Component{component("tools")}}},
+				{Path: "b.yaml", Content: &dw.DevWorkspaceTemplateSpecContent{Components: []dw.Component{component("tools")}}},
+			},
+			wantDuplicate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, err := MergeIncludes(&tt.main, tt.includes...)
+			if tt.wantDuplicate {
+				if assert.Error(t, err) {
+					assert.True(t, errors.Is(err, devfileerrors.ErrDuplicateKey))
+				}
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			var components, commands []string
+			for _, component := range merged.Components {
+				components = append(components, component.Name)
+			}
+			for _, command := range merged.Commands {
+				commands = append(commands, command.Id)
+			}
+			assert.Equal(t, tt.wantComponents, components)
+			assert.Equal(t, tt.wantCommands, commands)
+		})
+	}
+}
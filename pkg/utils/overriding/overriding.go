@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	dw "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
 	unions "github.com/devfile/api/v2/pkg/utils/unions"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -123,10 +124,13 @@ func ensureOnlyExistingElementsAreOverridden(spec *dw.DevWorkspaceTemplateSpecCo
 		overlayKeys := keysSets[1]
 		newElementsInOverlay := overlayKeys.Difference(specKeys)
 		if newElementsInOverlay.Len() > 0 {
-			return []error{fmt.Errorf("Some %s do not override any existing element: %s. "+
-				"They should be defined in the main body, as new elements, not in the overriding section",
-				elementType,
-				strings.Join(newElementsInOverlay.List(), ", "))}
+			return []error{&keysError{
+				message: fmt.Sprintf("Some %s do not override any existing element: %s. "+
+					"They should be defined in the main body, as new elements, not in the overriding section",
+					elementType,
+					strings.Join(newElementsInOverlay.List(), ", ")),
+				sentinel: devfileerrors.ErrNotFound,
+			}}
 		}
 		return []error{}
 	},
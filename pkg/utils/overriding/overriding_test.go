@@ -1,6 +1,7 @@
 package overriding
 
 import (
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -9,6 +10,7 @@ import (
 
 	dw "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	attributesPkg "github.com/devfile/api/v2/pkg/attributes"
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"sigs.k8s.io/yaml"
 )
@@ -66,7 +68,7 @@ func TestBasicToplevelOverriding(t *testing.T) {
 				Id: "command-with-type-changed",
 				CommandUnionParentOverride: dw.CommandUnionParentOverride{
 					Apply: &dw.ApplyCommandParentOverride{
-						Component: "mycomponent",
+						Component: stringPointer("mycomponent"),
 					},
 				},
 			},
@@ -77,11 +79,11 @@ func TestBasicToplevelOverriding(t *testing.T) {
 						Env: []dw.EnvVarParentOverride{
 							{
 								Name:  "envVarToReplace",
-								Value: "envVarToReplaceNewValue",
+								Value: stringPointer("envVarToReplaceNewValue"),
 							},
 							{
 								Name:  "endVarToAdd",
-								Value: "endVarToAddValue",
+								Value: stringPointer("endVarToAddValue"),
 							},
 						},
 					},
@@ -235,6 +237,61 @@ func TestPluginOverrides(t *testing.T) {
 	}
 }
 
+func TestMergeOverridesMatchesPatches(t *testing.T) {
+	tests := []struct {
+		name      string
+		isPlugin  bool
+		directory string
+	}{
+		{name: "Command and env", directory: "command-and-env"},
+		{name: "Command with attributes", directory: "command-with-attributes"},
+		{name: "Global variables and attributes", directory: "global-variables-and-attributes"},
+		{name: "Image component union", directory: "image-component-union"},
+		{name: "Kubernetes component union", directory: "kubernetes-component-union"},
+		{name: "Provision remote", directory: "provision-remote"},
+		{name: "Update remote", directory: "update-remote"},
+		{name: "Zero-value overrides", directory: "zero-value-overrides"},
+		{name: "Plugin overrides", isPlugin: true, directory: "override-just-plugin"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dirPath := filepath.Join("test-fixtures/patches", tt.directory)
+			original := dw.DevWorkspaceTemplateSpecContent{}
+			expected := dw.DevWorkspaceTemplateSpecContent{}
+			readFileToStruct(t, filepath.Join(dirPath, "original.yaml"), &original)
+			readFileToStruct(t, filepath.Join(dirPath, "result.yaml"), &expected)
+
+			var merged *dw.DevWorkspaceTemplateSpecContent
+			if tt.isPlugin {
+				patch := dw.PluginOverrides{}
+				readFileToStruct(t, filepath.Join(dirPath, "patch.yaml"), &patch)
+				merged = dw.MergePluginOverrides(&original, &patch)
+			} else {
+				patch := dw.ParentOverrides{}
+				readFileToStruct(t, filepath.Join(dirPath, "patch.yaml"), &patch)
+				merged = dw.MergeParentOverrides(&original, &patch)
+			}
+			assert.Equal(t, &expected, merged, "the generated mergers should apply the overrides as the strategic merge patch does")
+		})
+	}
+}
+
+func stringPointer(value string) *string {
+	return &value
+}
+
+func TestOverridingNewElementsIsNotFound(t *testing.T) {
+	originalDWT := dw.DevWorkspaceTemplateSpecContent{}
+	patch := dw.ParentOverrides{}
+	readFileToStruct(t, "test-fixtures/patches/add-command-and-component/original.yaml", &originalDWT)
+	readFileToStruct(t, "test-fixtures/patches/add-command-and-component/patch.yaml", &patch)
+
+	_, err := OverrideDevWorkspaceTemplateSpec(&originalDWT, patch)
+	if assert.Error(t, err) {
+		assert.True(t, errors.Is(err, devfileerrors.ErrNotFound))
+	}
+}
+
 func readFileToStruct(t *testing.T, path string, into interface{}) {
 	bytes, err := ioutil.ReadFile(path)
 	if err != nil {
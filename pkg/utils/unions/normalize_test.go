@@ -1,9 +1,11 @@
 package unions
 
 import (
+	"errors"
 	"testing"
 
 	dw "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -39,6 +41,25 @@ func TestNormalizingUnion_SetDiscriminator(t *testing.T) {
 		"The two values should be the same.")
 }
 
+func TestNormalizingUnion_AmbiguousUnion(t *testing.T) {
+	original := dw.DevWorkspaceTemplateSpecContent{
+		Projects: []dw.Project{
+			{
+				Name: "MyProject",
+				ProjectSource: dw.ProjectSource{
+					Git: &dw.GitProjectSource{},
+					Zip: &dw.ZipProjectSource{},
+				},
+			},
+		},
+	}
+
+	err := Normalize(original)
+	if assert.Error(t, err) {
+		assert.True(t, errors.Is(err, devfileerrors.ErrInvalidUnion))
+	}
+}
+
 func TestNormalizingUnion_CleanupOldValue(t *testing.T) {
 	original := dw.DevWorkspaceTemplateSpecContent{
 		Projects: []dw.Project{
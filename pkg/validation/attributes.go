@@ -0,0 +1,73 @@
+package validation
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	attributesAPI "github.com/devfile/api/v2/pkg/attributes"
+	"github.com/hashicorp/go-multierror"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+)
+
+// DefaultMaxAttributesSize is the default limit of the serialized size of the attributes of an object, in bytes.
+// It is the limit of the total size of the annotations of a K8S object, into which attributes are often propagated.
+const DefaultMaxAttributesSize = 256 * 1024
+
+// ValidateAttributes validates the attributes of the devfile content, and of its components, commands, projects and starter projects:
+// 1. makes sure the attribute keys are qualified names, with an optional DNS subdomain prefix, such as `api.devfile.io/imported-from`
+// 2. makes sure the serialized size of the attributes of each object is at most maxSize bytes, DefaultMaxAttributesSize being used if maxSize is not positive
+//
+// The problems are returned as warnings, so that they can be fixed before the API server rejects the whole DevWorkspace.
+func ValidateAttributes(content *v1alpha2.DevWorkspaceTemplateSpecContent, maxSize int) (returnedErr error) {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxAttributesSize
+	}
+	validate := func(objectType, objectName string, attributes attributesAPI.Attributes) {
+		if err := validateObjectAttributes(objectType, objectName, attributes, maxSize); err != nil {
+			returnedErr = multierror.Append(returnedErr, err)
+		}
+	}
+
+	validate("devfile", "", content.Attributes)
+	for _, component := range content.Components {
+		validate("component", component.Name, component.Attributes)
+	}
+	for _, command := range content.Commands {
+		validate("command", command.Id, command.Attributes)
+	}
+	for _, project := range content.Projects {
+		validate("project", project.Name, project.Attributes)
+	}
+	for _, starterProject := range content.StarterProjects {
+		validate("starterProject", starterProject.Name, starterProject.Attributes)
+	}
+	return returnedErr
+}
+
+// validateObjectAttributes validates the keys and the serialized size of the attributes of an object
+func validateObjectAttributes(objectType, objectName string, attributes attributesAPI.Attributes, maxSize int) (returnedErr error) {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if errs := k8svalidation.IsQualifiedName(key); len(errs) > 0 {
+			returnedErr = multierror.Append(returnedErr, &InvalidAttributeKeyWarning{
+				objectType: objectType, objectName: objectName, key: key, reason: strings.Join(errs, "; ")})
+		}
+	}
+
+	serialized, err := json.Marshal(attributes)
+	if err == nil && len(serialized) > maxSize {
+		returnedErr = multierror.Append(returnedErr, &AttributesSizeWarning{
+			objectType: objectType, objectName: objectName, size: len(serialized), maxSize: maxSize})
+	}
+	return returnedErr
+}
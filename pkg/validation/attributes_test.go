@@ -0,0 +1,81 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAttributes(t *testing.T) {
+
+	invalidTopLevelKeyErr := "the attribute key \"my attribute\" of the devfile is invalid - .*"
+	invalidComponentKeyErr := "the attribute key \"-invalid\" of component runtime is invalid - .*"
+	componentSizeErr := "the attributes of component runtime take 2011 bytes once serialized, more than the limit of 1024 bytes"
+	starterProjectSizeErr := "the attributes of starterProject nodejs take 2011 bytes once serialized, more than the limit of 1024 bytes"
+
+	largeAttributes := attributes.Attributes{}.PutString("api.devfile.io/large", strings.Repeat("a", 1984))
+
+	tests := []struct {
+		name    string
+		content v1alpha2.DevWorkspaceTemplateSpecContent
+		maxSize int
+		wantErr []string
+	}{
+		{
+			name: "Valid attributes",
+			content: v1alpha2.DevWorkspaceTemplateSpecContent{
+				Attributes: attributes.Attributes{}.PutString("controller.devfile.io/storage-type", "ephemeral").PutBoolean("debug", true),
+				Components: []v1alpha2.Component{
+					{Name: "runtime", Attributes: largeAttributes},
+				},
+			},
+		},
+		{
+			name: "Invalid attribute keys",
+			content: v1alpha2.DevWorkspaceTemplateSpecContent{
+				Attributes: attributes.Attributes{}.PutString("my attribute", "value"),
+				Components: []v1alpha2.Component{
+					{Name: "runtime", Attributes: attributes.Attributes{}.PutString("-invalid", "value").PutString("valid", "value")},
+				},
+			},
+			wantErr: []string{invalidTopLevelKeyErr, invalidComponentKeyErr},
+		},
+		{
+			name: "Attributes larger than the size limit",
+			content: v1alpha2.DevWorkspaceTemplateSpecContent{
+				Components: []v1alpha2.Component{
+					{Name: "runtime", Attributes: largeAttributes},
+				},
+				Commands: []v1alpha2.Command{
+					{Id: "build", Attributes: attributes.Attributes{}.PutString("small", "value")},
+				},
+				StarterProjects: []v1alpha2.StarterProject{
+					{Name: "nodejs", Attributes: largeAttributes},
+				},
+			},
+			maxSize: 1024,
+			wantErr: []string{componentSizeErr, starterProjectSizeErr},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAttributes(&tt.content, tt.maxSize)
+
+			if merr, ok := err.(*multierror.Error); ok && tt.wantErr != nil {
+				if assert.Equal(t, len(tt.wantErr), len(merr.Errors), "Error list length should match") {
+					for i := 0; i < len(merr.Errors); i++ {
+						assert.Regexp(t, tt.wantErr[i], merr.Errors[i].Error(), "Error message should match")
+						assert.True(t, IsWarning(merr.Errors[i]), "Error should be a warning")
+						assert.Equal(t, "attributes", RuleID(merr.Errors[i]), "Rule ID should match")
+					}
+				}
+			} else {
+				assert.Equal(t, nil, err, "Error should be nil")
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
 	"github.com/hashicorp/go-multierror"
 )
 
@@ -12,6 +13,8 @@ import (
 // 1. there are no duplicate command ids
 // 2. the command type is not invalid
 // 3. if a command is part of a command group, there is a single default command
+// 4. the `command-hints` attribute of the commands, if specified, is valid
+// 5. the container components targeted by exec commands, which don't mount the project sources, set a command or args
 func ValidateCommands(commands []v1alpha2.Command, components []v1alpha2.Component) (returnedErr error) {
 	groupKindCommandMap := make(map[v1alpha2.CommandGroupKind][]v1alpha2.Command)
 	commandMap := getCommandsMap(commands)
@@ -29,6 +32,11 @@ func ValidateCommands(commands []v1alpha2.Command, components []v1alpha2.Compone
 			returnedErr = multierror.Append(returnedErr, resolveErrorMessageWithImportAttributes(err, command.Attributes))
 		}
 
+		if _, err := v1alpha2.GetCommandHints(command.Attributes); err != nil {
+			hintsErr := &InvalidCommandError{commandId: command.Id, reason: err.Error(), cause: err}
+			returnedErr = multierror.Append(returnedErr, resolveErrorMessageWithImportAttributes(hintsErr, command.Attributes))
+		}
+
 		commandGroup := getGroup(command)
 		if commandGroup != nil {
 			groupKindCommandMap[commandGroup.Kind] = append(groupKindCommandMap[commandGroup.Kind], command)
@@ -41,9 +49,33 @@ func ValidateCommands(commands []v1alpha2.Command, components []v1alpha2.Compone
 		}
 	}
 
+	for _, err := range validateContainerCommands(commands, components) {
+		returnedErr = multierror.Append(returnedErr, err)
+	}
+
 	return returnedErr
 }
 
+// validateContainerCommands warns about the container components that are the target of exec commands, but set `mountSources`
+// to false, and neither a command nor args: such containers usually run a dedicated image, whose entrypoint may exit
+// instead of keeping the container running for the exec commands
+func validateContainerCommands(commands []v1alpha2.Command, components []v1alpha2.Component) (errList []error) {
+	execCommands := make(map[string][]string)
+	for _, command := range commands {
+		if command.Exec != nil {
+			execCommands[command.Exec.Component] = append(execCommands[command.Exec.Component], command.Id)
+		}
+	}
+	for _, component := range components {
+		container := component.Container
+		if container == nil || len(execCommands[component.Name]) == 0 || container.GetMountSources() || container.HasEntrypoint() {
+			continue
+		}
+		errList = append(errList, &MissingContainerCommandWarning{componentName: component.Name, commandIds: execCommands[component.Name]})
+	}
+	return errList
+}
+
 // validateCommand validates a given devfile command where parentCommands is a map to track all the parent commands when validating
 // the composite command's subcommands recursively and devfileCommands is a map of command id to the devfile command
 func validateCommand(command v1alpha2.Command, parentCommands map[string]string, devfileCommands map[string]v1alpha2.Command, components []v1alpha2.Component) error {
@@ -114,7 +146,9 @@ func getGroup(command v1alpha2.Command) *v1alpha2.CommandGroup {
 	}
 }
 
-// validateCommandComponent validates the given exec or apply command, the command should map to a valid container component
+// validateCommandComponent validates the given exec or apply command:
+// 1. an exec command must map to a container component
+// 2. an apply command must map to a container, kubernetes, openshift or image component
 func validateCommandComponent(command v1alpha2.Command, components []v1alpha2.Component) error {
 
 	if command.Exec == nil && command.Apply == nil {
@@ -128,20 +162,39 @@ func validateCommandComponent(command v1alpha2.Command, components []v1alpha2.Co
 		commandComponent = command.Apply.Component
 	}
 
-	// exec command must map to a container component
-	// apply command must map to a container/kubernetes/openshift/image component
+	if commandComponent == "" {
+		return &InvalidCommandError{commandId: command.Id, reason: "command does not map to a valid component: no component is specified"}
+	}
+
 	for _, component := range components {
-		if commandComponent == component.Name {
-			if component.Container != nil {
-				return nil
-			}
-			if command.Apply != nil && (component.Image != nil || component.Kubernetes != nil || component.Openshift != nil) {
-				return nil
-			}
-			break
+		if commandComponent != component.Name {
+			continue
 		}
+		kind := componentKind(component)
+		switch {
+		case component.Container != nil:
+			return nil
+		case command.Apply != nil && (component.Image != nil || component.Kubernetes != nil || component.Openshift != nil):
+			return nil
+		case command.Exec != nil:
+			return &InvalidCommandError{commandId: command.Id,
+				reason: fmt.Sprintf("command does not map to a valid component: exec commands must reference a container component, but %q is a %s component", commandComponent, kind)}
+		default:
+			return &InvalidCommandError{commandId: command.Id,
+				reason: fmt.Sprintf("command does not map to a valid component: apply commands must reference a container, kubernetes, openshift or image component, but %q is a %s component", commandComponent, kind)}
+		}
+	}
+	return &InvalidCommandError{commandId: command.Id, reason: fmt.Sprintf("command does not map to a valid component: component %q does not exist in the devfile", commandComponent),
+		cause: devfileerrors.ErrNotFound}
+}
+
+// componentKind returns the lower-case type of the given component, such as `volume`
+func componentKind(component v1alpha2.Component) string {
+	union := component.ComponentUnion.DeepCopy()
+	if err := union.Normalize(); err != nil || union.ComponentType == "" {
+		return "unknown"
 	}
-	return &InvalidCommandError{commandId: command.Id, reason: "command does not map to a valid component"}
+	return strings.ToLower(string(union.ComponentType))
 }
 
 // validateCompositeCommand checks that the specified composite command is valid. The command:
@@ -160,6 +213,10 @@ func validateCompositeCommand(command *v1alpha2.Command, parentCommands map[stri
 		return &InvalidCommandError{commandId: command.Id, reason: "should be of type composite"}
 	}
 
+	if err := validateCompositeExecution(command.Id, command.Composite); err != nil {
+		return err
+	}
+
 	// Loop over the commands and validate that each command points to a command that's in the devfile
 	for _, cmd := range command.Composite.Commands {
 		if strings.ToLower(cmd) == command.Id {
@@ -174,7 +231,8 @@ func validateCompositeCommand(command *v1alpha2.Command, parentCommands map[stri
 
 		subCommand, ok := devfileCommands[strings.ToLower(cmd)]
 		if !ok {
-			return &InvalidCommandError{commandId: command.Id, reason: fmt.Sprintf("the command %q mentioned in the composite command does not exist in the devfile", cmd)}
+			return &InvalidCommandError{commandId: command.Id, reason: fmt.Sprintf("the command %q mentioned in the composite command does not exist in the devfile", cmd),
+				cause: devfileerrors.ErrNotFound}
 		}
 
 		err := validateCommand(subCommand, parentCommands, devfileCommands, components)
@@ -185,3 +243,27 @@ func validateCompositeCommand(command *v1alpha2.Command, parentCommands map[stri
 	}
 	return nil
 }
+
+// validateCompositeExecution validates that the `maxConcurrency` of the given composite command is only set
+// for parallel composite commands, and that its `continueOnError` sub-commands are sub-commands of the composite command
+func validateCompositeExecution(id string, composite *v1alpha2.CompositeCommand) error {
+	if composite.MaxConcurrency != nil {
+		if composite.Parallel == nil || !*composite.Parallel {
+			return &InvalidCommandError{commandId: id, reason: "maxConcurrency can only be set on parallel composite commands"}
+		}
+		if *composite.MaxConcurrency < 1 {
+			return &InvalidCommandError{commandId: id, reason: fmt.Sprintf("maxConcurrency should be at least 1, but is %d", *composite.MaxConcurrency)}
+		}
+	}
+
+	subCommands := make(map[string]bool)
+	for _, cmd := range composite.Commands {
+		subCommands[strings.ToLower(cmd)] = true
+	}
+	for _, cmd := range composite.ContinueOnError {
+		if !subCommands[strings.ToLower(cmd)] {
+			return &InvalidCommandError{commandId: id, reason: fmt.Sprintf("the command %q mentioned in continueOnError is not a sub-command of the composite command", cmd)}
+		}
+	}
+	return nil
+}
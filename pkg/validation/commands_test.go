@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"fmt"
 	"github.com/devfile/api/v2/pkg/attributes"
 	"testing"
 
@@ -83,7 +84,7 @@ func TestValidateCommands(t *testing.T) {
 
 	parentOverridesFromMainDevfile := attributes.Attributes{}.PutString(ImportSourceAttribute,
 		"uri: http://127.0.0.1:8080").PutString(ParentOverrideAttribute, "main devfile")
-	invalidCmdErrWithImportAttributes := ".*command does not map to a valid component, imported from uri: http://127.0.0.1:8080, in parent overrides from main devfile"
+	invalidCmdErrWithImportAttributes := ".*command does not map to a valid component: component \"invalidComponent\" does not exist in the devfile, imported from uri: http://127.0.0.1:8080, in parent overrides from main devfile"
 
 	tests := []struct {
 		name     string
@@ -160,6 +161,36 @@ func TestValidateCommands(t *testing.T) {
 			},
 			wantErr: []string{invalidCmdErrWithImportAttributes},
 		},
+		{
+			name: "Valid command hints",
+			commands: []v1alpha2.Command{
+				generateDummyApplyCommand("command", component, nil, v1alpha2.PutCommandHints(nil, v1alpha2.CommandHints{
+					Hidden:   true,
+					Icon:     "debug-start",
+					Terminal: &v1alpha2.CommandTerminalHints{Reveal: v1alpha2.SilentTerminalReveal, Panel: v1alpha2.DedicatedTerminalPanel},
+				})),
+			},
+		},
+		{
+			name: "Invalid command hints with unknown field",
+			commands: []v1alpha2.Command{
+				generateDummyApplyCommand("command", component, nil, attributes.Attributes{}.FromStringMap(map[string]string{v1alpha2.CommandHintsAttribute: "hidden"})),
+				generateDummyApplyCommand("command2", component, nil, attributes.Attributes{}.Put(v1alpha2.CommandHintsAttribute, map[string]interface{}{"hiden": true}, nil)),
+			},
+			wantErr: []string{
+				"the command \"command\" is invalid - attribute \"command-hints\" is invalid: json: cannot unmarshal string into Go value of type v1alpha2.CommandHints",
+				"the command \"command2\" is invalid - attribute \"command-hints\" is invalid: json: unknown field \"hiden\"",
+			},
+		},
+		{
+			name: "Invalid command hints with unknown terminal behavior",
+			commands: []v1alpha2.Command{
+				generateDummyApplyCommand("command", component, nil, v1alpha2.PutCommandHints(nil, v1alpha2.CommandHints{
+					Terminal: &v1alpha2.CommandTerminalHints{Panel: "reused"},
+				})),
+			},
+			wantErr: []string{"attribute \"command-hints\" is invalid: terminal.panel should be one of: shared, dedicated, new, but is \"reused\""},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -194,7 +225,14 @@ func TestValidateCommandComponent(t *testing.T) {
 		generateDummyVolumeComponent(volumeComponent, ""),
 	}
 
-	invalidCmdErr := ".*command does not map to a valid component"
+	missingComponentErr := ".*command does not map to a valid component: no component is specified"
+	nonexistComponentErr := ".*command does not map to a valid component: component \"garbagealias\" does not exist in the devfile"
+	execKindErr := ".*exec commands must reference a container component, but \"%s\" is a %s component"
+	applyKindErr := ".*apply commands must reference a container, kubernetes, openshift or image component, but \"alias5\" is a volume component"
+	execImageErr := fmt.Sprintf(execKindErr, imageComponent, "image")
+	execKubeErr := fmt.Sprintf(execKindErr, kubeComponent, "kubernetes")
+	execOpenshiftErr := fmt.Sprintf(execKindErr, openshiftComponent, "openshift")
+	execVolumeErr := fmt.Sprintf(execKindErr, volumeComponent, "volume")
 
 	tests := []struct {
 		name    string
@@ -208,32 +246,32 @@ func TestValidateCommandComponent(t *testing.T) {
 		{
 			name:    "Invalid Exec Command with missing component",
 			command: generateDummyExecCommand("command", "", &v1alpha2.CommandGroup{Kind: runGroup}),
-			wantErr: &invalidCmdErr,
+			wantErr: &missingComponentErr,
 		},
 		{
 			name:    "Exec Command with non-exist component",
 			command: generateDummyExecCommand("command", nonexistComponent, &v1alpha2.CommandGroup{Kind: runGroup}),
-			wantErr: &invalidCmdErr,
+			wantErr: &nonexistComponentErr,
 		},
 		{
 			name:    "Exec Command with image component",
 			command: generateDummyExecCommand("command", imageComponent, &v1alpha2.CommandGroup{Kind: runGroup}),
-			wantErr: &invalidCmdErr,
+			wantErr: &execImageErr,
 		},
 		{
 			name:    "Exec Command with kubernetes component",
 			command: generateDummyExecCommand("command", kubeComponent, &v1alpha2.CommandGroup{Kind: runGroup}),
-			wantErr: &invalidCmdErr,
+			wantErr: &execKubeErr,
 		},
 		{
 			name:    "Exec Command with openshift component",
 			command: generateDummyExecCommand("command", openshiftComponent, &v1alpha2.CommandGroup{Kind: runGroup}),
-			wantErr: &invalidCmdErr,
+			wantErr: &execOpenshiftErr,
 		},
 		{
 			name:    "Exec Command with volume component",
 			command: generateDummyExecCommand("command", volumeComponent, &v1alpha2.CommandGroup{Kind: runGroup}),
-			wantErr: &invalidCmdErr,
+			wantErr: &execVolumeErr,
 		},
 		{
 			name:    "Valid Exec Command with Group nil",
@@ -258,12 +296,12 @@ func TestValidateCommandComponent(t *testing.T) {
 		{
 			name:    "Apply Command with non-exist component",
 			command: generateDummyApplyCommand("command", nonexistComponent, &v1alpha2.CommandGroup{Kind: runGroup}, attributes.Attributes{}),
-			wantErr: &invalidCmdErr,
+			wantErr: &nonexistComponentErr,
 		},
 		{
 			name:    "Apply Command with volume component",
 			command: generateDummyApplyCommand("command", volumeComponent, &v1alpha2.CommandGroup{Kind: runGroup}, attributes.Attributes{}),
-			wantErr: &invalidCmdErr,
+			wantErr: &applyKindErr,
 		},
 	}
 	for _, tt := range tests {
@@ -294,6 +332,19 @@ func TestValidateCompositeCommand(t *testing.T) {
 	missingCmdErr := ".*the command .* mentioned in the composite command does not exist in the devfile"
 	selfRefCmdErr := ".*composite command cannot reference itself"
 	indirectRefCmdErr := "composite command cannot indirectly reference itself"
+	sequentialMaxConcurrencyErr := "maxConcurrency can only be set on parallel composite commands"
+	invalidMaxConcurrencyErr := "maxConcurrency should be at least 1, but is 0"
+	unknownContinueOnErrorErr := "the command \"command5\" mentioned in continueOnError is not a sub-command of the composite command"
+
+	// generateDummyParallelCompositeCommand returns a dummy composite command with the given execution settings for testing
+	generateDummyParallelCompositeCommand := func(parallel bool, maxConcurrency *int, continueOnError []string) v1alpha2.Command {
+		command := generateDummyCompositeCommand("command4", []string{"command1", "command2", "command3"}, nil)
+		command.Composite.Parallel = &parallel
+		command.Composite.MaxConcurrency = maxConcurrency
+		command.Composite.ContinueOnError = continueOnError
+		return command
+	}
+	two, zero := 2, 0
 
 	tests := []struct {
 		name                 string
@@ -349,6 +400,29 @@ func TestValidateCompositeCommand(t *testing.T) {
 			},
 			testCompositeCommand: "composite-1",
 		},
+		{
+			name:                 "Valid parallel composite command with max concurrency and continue on error",
+			commands:             append(validExecCommands, generateDummyParallelCompositeCommand(true, &two, []string{"command2"})),
+			testCompositeCommand: "command4",
+		},
+		{
+			name:                 "Invalid composite command, max concurrency on sequential command",
+			commands:             append(validExecCommands, generateDummyParallelCompositeCommand(false, &two, nil)),
+			testCompositeCommand: "command4",
+			wantErr:              &sequentialMaxConcurrencyErr,
+		},
+		{
+			name:                 "Invalid composite command, max concurrency lower than 1",
+			commands:             append(validExecCommands, generateDummyParallelCompositeCommand(true, &zero, nil)),
+			testCompositeCommand: "command4",
+			wantErr:              &invalidMaxConcurrencyErr,
+		},
+		{
+			name:                 "Invalid composite command, continue on error of a command which is not a sub-command",
+			commands:             append(validExecCommands, generateDummyParallelCompositeCommand(false, nil, []string{"command5"})),
+			testCompositeCommand: "command4",
+			wantErr:              &unknownContinueOnErrorErr,
+		},
 	}
 	for _, tt := range tests {
 		commandMap := getCommandsMap(tt.commands)
@@ -448,3 +522,64 @@ func TestValidateGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateContainerCommands(t *testing.T) {
+
+	mountSources := false
+	withoutSources := func(name string, command, args []string) v1alpha2.Component {
+		component := generateDummyContainerComponent(name, nil, nil, nil, v1alpha2.Annotation{}, false)
+		component.Container.MountSources = &mountSources
+		component.Container.Command = command
+		component.Container.Args = args
+		return component
+	}
+
+	tests := []struct {
+		name       string
+		commands   []v1alpha2.Command
+		components []v1alpha2.Component
+		wantErr    []string
+	}{
+		{
+			name:       "Container with mounted sources and without command",
+			commands:   []v1alpha2.Command{generateDummyExecCommand("run", "runtime", nil)},
+			components: []v1alpha2.Component{generateDummyContainerComponent("runtime", nil, nil, nil, v1alpha2.Annotation{}, false)},
+		},
+		{
+			name:       "Container without mounted sources, with a command",
+			commands:   []v1alpha2.Command{generateDummyExecCommand("run", "runtime", nil)},
+			components: []v1alpha2.Component{withoutSources("runtime", []string{"tail", "-f", "/dev/null"}, nil)},
+		},
+		{
+			name:       "Container without mounted sources, with args",
+			commands:   []v1alpha2.Command{generateDummyExecCommand("run", "runtime", nil)},
+			components: []v1alpha2.Component{withoutSources("runtime", nil, []string{"--wait"})},
+		},
+		{
+			name:       "Container without mounted sources, nor exec commands",
+			commands:   []v1alpha2.Command{generateDummyApplyCommand("deploy", "runtime", nil, attributes.Attributes{})},
+			components: []v1alpha2.Component{withoutSources("runtime", nil, nil)},
+		},
+		{
+			name: "Container without mounted sources, nor command or args",
+			commands: []v1alpha2.Command{
+				generateDummyExecCommand("run", "runtime", nil),
+				generateDummyExecCommand("debug", "runtime", nil),
+			},
+			components: []v1alpha2.Component{withoutSources("runtime", nil, nil)},
+			wantErr:    []string{"the container component runtime sets mountSources to false and has neither command nor args, so the entrypoint of its image should keep it running for the exec commands: run, debug"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errList := validateContainerCommands(tt.commands, tt.components)
+			var errMessages []string
+			for _, err := range errList {
+				assert.True(t, IsWarning(err), "expected a warning: %v", err)
+				assert.Equal(t, "container-command", RuleID(err))
+				errMessages = append(errMessages, err.Error())
+			}
+			assert.Equal(t, tt.wantErr, errMessages)
+		})
+	}
+}
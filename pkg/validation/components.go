@@ -2,11 +2,13 @@ package validation
 
 import (
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/hashicorp/go-multierror"
 	"k8s.io/apimachinery/pkg/api/resource"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 )
 
 const (
@@ -23,6 +25,16 @@ const (
 // 3. checks the URI specified in openshift components and kubernetes components are with valid format
 // 4. makes sure the component name is unique
 // 5. makes sure the image dockerfile component git src has at most one remote
+// 6. makes sure the pod-overrides and container-overrides attributes of the container components are valid
+// 7. makes sure the plugin components have a single import reference, registry fields only along with an id,
+// and plugin overrides without duplicates
+// 8. makes sure the embedded resource of the custom components matches the schema registered for their component class
+// 9. makes sure the volume mount paths are unique in each container, and warns about the volumes mounted at different paths
+// by several containers
+// 10. makes sure the order attributes of the components are integers, and that no container components, or plugin components,
+// have the same order
+// 11. makes sure the secret and configMap components reference a valid Secret or ConfigMap name, with an absolute mount path,
+// and items with valid keys and distinct relative paths
 func ValidateComponents(components []v1alpha2.Component) (returnedErr error) {
 
 	processedVolumes := make(map[string]bool)
@@ -125,6 +137,10 @@ func ValidateComponents(components []v1alpha2.Component) (returnedErr error) {
 				}
 			}
 
+			for _, overridesErr := range validateOverridesAttributes(component) {
+				returnedErr = multierror.Append(returnedErr, resolveErrorMessageWithImportAttributes(overridesErr, component.Attributes))
+			}
+
 			err := validateEndpoints(component.Container.Endpoints, processedEndPointPort, processedEndPointName)
 			if len(err) > 0 {
 				for _, endpointErr := range err {
@@ -142,6 +158,16 @@ func ValidateComponents(components []v1alpha2.Component) (returnedErr error) {
 					returnedErr = multierror.Append(returnedErr, resolveErrorMessageWithImportAttributes(invalidVolErr, component.Attributes))
 				}
 			}
+		case component.Secret != nil:
+			processedVolumes[component.Name] = true
+			for _, mountErr := range validateConfigurationMount(component.Name, "secret", component.Secret.SecretName, component.Secret.ConfigurationMount) {
+				returnedErr = multierror.Append(returnedErr, resolveErrorMessageWithImportAttributes(mountErr, component.Attributes))
+			}
+		case component.ConfigMap != nil:
+			processedVolumes[component.Name] = true
+			for _, mountErr := range validateConfigurationMount(component.Name, "configMap", component.ConfigMap.ConfigMapName, component.ConfigMap.ConfigurationMount) {
+				returnedErr = multierror.Append(returnedErr, resolveErrorMessageWithImportAttributes(mountErr, component.Attributes))
+			}
 		case component.Openshift != nil:
 			if component.Openshift.Uri != "" {
 				err := ValidateURI(component.Openshift.Uri)
@@ -184,6 +210,13 @@ func ValidateComponents(components []v1alpha2.Component) (returnedErr error) {
 					returnedErr = multierror.Append(returnedErr, resolveErrorMessageWithImportAttributes(err, component.Attributes))
 				}
 			}
+			for _, pluginErr := range validatePluginComponent(component.Name, component.Plugin) {
+				returnedErr = multierror.Append(returnedErr, resolveErrorMessageWithImportAttributes(pluginErr, component.Attributes))
+			}
+		case component.Custom != nil:
+			for _, customErr := range validateCustomComponent(component.Name, component.Custom) {
+				returnedErr = multierror.Append(returnedErr, resolveErrorMessageWithImportAttributes(customErr, component.Attributes))
+			}
 		}
 
 	}
@@ -205,5 +238,165 @@ func ValidateComponents(components []v1alpha2.Component) (returnedErr error) {
 		returnedErr = multierror.Append(returnedErr, &MissingVolumeMountError{errMsg: invalidVolumeMountsErr})
 	}
 
+	for _, volumeMountErr := range validateVolumeMountPaths(components) {
+		returnedErr = multierror.Append(returnedErr, volumeMountErr)
+	}
+
+	for _, orderErr := range validateComponentOrders(components) {
+		returnedErr = multierror.Append(returnedErr, orderErr)
+	}
+
 	return returnedErr
 }
+
+// validateVolumeMountPaths checks that the volume mounts of each container have distinct paths,
+// and returns a warning for each volume mounted at different paths by several containers,
+// since the files written by a container then appear at another location in the other containers
+func validateVolumeMountPaths(components []v1alpha2.Component) (errList []error) {
+	// the containers that mount each volume, with their mount path, in component order
+	var volumeNames []string
+	volumeMounts := make(map[string][]containerMount)
+
+	for _, component := range components {
+		if component.Container == nil {
+			continue
+		}
+		mountedPaths := make(map[string]string)
+		for _, volumeMount := range component.Container.VolumeMounts {
+			mountPath := volumeMountPath(volumeMount)
+			if otherVolume, isMounted := mountedPaths[mountPath]; isMounted {
+				duplicateErr := &DuplicateVolumeMountPathError{componentName: component.Name, path: mountPath, volumeNames: []string{otherVolume, volumeMount.Name}}
+				errList = append(errList, resolveErrorMessageWithImportAttributes(duplicateErr, component.Attributes))
+				continue
+			}
+			mountedPaths[mountPath] = volumeMount.Name
+
+			if _, exists := volumeMounts[volumeMount.Name]; !exists {
+				volumeNames = append(volumeNames, volumeMount.Name)
+			}
+			volumeMounts[volumeMount.Name] = append(volumeMounts[volumeMount.Name], containerMount{componentName: component.Name, path: mountPath})
+		}
+	}
+
+	for _, volumeName := range volumeNames {
+		mounts := volumeMounts[volumeName]
+		for _, mount := range mounts[1:] {
+			if mount.path != mounts[0].path {
+				errList = append(errList, &VolumeMountPathConflictWarning{volumeName: volumeName, mounts: mounts})
+				break
+			}
+		}
+	}
+	return errList
+}
+
+// volumeMountPath returns the cleaned path of a volume mount, which is `/<name>` by default
+func volumeMountPath(volumeMount v1alpha2.VolumeMount) string {
+	if volumeMount.Path == "" {
+		return "/" + volumeMount.Name
+	}
+	return path.Clean(volumeMount.Path)
+}
+
+// validateConfigurationMount validates the name of the Secret or ConfigMap mounted by a secret or configMap component,
+// as well as its mount path and items
+func validateConfigurationMount(componentName, componentType, objectName string, mount v1alpha2.ConfigurationMount) (errList []error) {
+	newErr := func(reason string) error {
+		return &InvalidConfigurationMountError{componentName: componentName, componentType: componentType, reason: reason}
+	}
+	if msgs := k8svalidation.IsDNS1123Subdomain(objectName); len(msgs) > 0 {
+		errList = append(errList, newErr(fmt.Sprintf("name %q is invalid: %s", objectName, strings.Join(msgs, ", "))))
+	}
+	if mount.MountPath != "" && !path.IsAbs(mount.MountPath) {
+		errList = append(errList, newErr(fmt.Sprintf("mount path %q must be absolute", mount.MountPath)))
+	}
+	processedKeys := make(map[string]bool)
+	processedPaths := make(map[string]string)
+	for _, item := range mount.Items {
+		if msgs := k8svalidation.IsConfigMapKey(item.Key); len(msgs) > 0 {
+			errList = append(errList, newErr(fmt.Sprintf("key %q is invalid: %s", item.Key, strings.Join(msgs, ", "))))
+		} else if processedKeys[item.Key] {
+			errList = append(errList, newErr(fmt.Sprintf("key %q is mounted several times", item.Key)))
+		}
+		processedKeys[item.Key] = true
+
+		if item.Path == "" || path.IsAbs(item.Path) {
+			errList = append(errList, newErr(fmt.Sprintf("path %q of key %q must be a non-empty relative path", item.Path, item.Key)))
+			continue
+		}
+		if containsParentElement(item.Path) {
+			errList = append(errList, newErr(fmt.Sprintf("path %q of key %q must not contain '..'", item.Path, item.Key)))
+			continue
+		}
+		itemPath := path.Clean(item.Path)
+		if otherKey, isMounted := processedPaths[itemPath]; isMounted {
+			errList = append(errList, newErr(fmt.Sprintf("keys %q and %q are mounted at the same path %q", otherKey, item.Key, itemPath)))
+			continue
+		}
+		processedPaths[itemPath] = item.Key
+	}
+	return errList
+}
+
+// containsParentElement returns true if the given slash-separated path contains the `..` element
+func containsParentElement(p string) bool {
+	for _, element := range strings.Split(p, "/") {
+		if element == ".." {
+			return true
+		}
+	}
+	return false
+}
+
+// validateOverridesAttributes validates the pod-overrides and container-overrides attributes of a container component
+func validateOverridesAttributes(component v1alpha2.Component) (errList []error) {
+	if _, err := v1alpha2.GetPodOverrides(component.Attributes); err != nil {
+		errList = append(errList, &InvalidComponentError{componentName: component.Name, reason: err.Error()})
+	}
+	if _, err := v1alpha2.GetContainerOverrides(component.Attributes); err != nil {
+		errList = append(errList, &InvalidComponentError{componentName: component.Name, reason: err.Error()})
+	}
+	return errList
+}
+
+// validateComponentOrders checks that the order attributes of the components are integers,
+// and that the container components, which are ordered in the pod of the devworkspace, and the plugin components,
+// which are ordered when the devfile is flattened, don't set the same order.
+// The components without the attribute are not reported, since they are ordered by declaration.
+func validateComponentOrders(components []v1alpha2.Component) (errList []error) {
+	type typedOrder struct {
+		componentType string
+		order         int
+	}
+	var orders []typedOrder
+	componentsByOrder := make(map[typedOrder][]string)
+	for _, component := range components {
+		if !component.Attributes.Exists(v1alpha2.ComponentOrderAttribute) {
+			continue
+		}
+		order, err := v1alpha2.GetComponentOrder(component.Attributes)
+		if err != nil {
+			errList = append(errList, resolveErrorMessageWithImportAttributes(&InvalidComponentError{componentName: component.Name, reason: err.Error()}, component.Attributes))
+			continue
+		}
+		key := typedOrder{order: order}
+		switch {
+		case component.Container != nil:
+			key.componentType = "container"
+		case component.Plugin != nil:
+			key.componentType = "plugin"
+		default:
+			continue
+		}
+		if _, exists := componentsByOrder[key]; !exists {
+			orders = append(orders, key)
+		}
+		componentsByOrder[key] = append(componentsByOrder[key], component.Name)
+	}
+	for _, key := range orders {
+		if componentNames := componentsByOrder[key]; len(componentNames) > 1 {
+			errList = append(errList, &DuplicateComponentOrderError{componentType: key.componentType, order: key.order, componentNames: componentNames})
+		}
+	}
+	return errList
+}
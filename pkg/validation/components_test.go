@@ -65,6 +65,32 @@ func generateDummyVolumeComponent(name, size string) v1alpha2.Component {
 	}
 }
 
+// generateDummySecretComponent returns a dummy secret component for testing
+func generateDummySecretComponent(name, secretName string, mount v1alpha2.ConfigurationMount) v1alpha2.Component {
+	return v1alpha2.Component{
+		Name: name,
+		ComponentUnion: v1alpha2.ComponentUnion{
+			Secret: &v1alpha2.SecretComponent{
+				SecretName:         secretName,
+				ConfigurationMount: mount,
+			},
+		},
+	}
+}
+
+// generateDummyConfigMapComponent returns a dummy configMap component for testing
+func generateDummyConfigMapComponent(name, configMapName string, mount v1alpha2.ConfigurationMount) v1alpha2.Component {
+	return v1alpha2.Component{
+		Name: name,
+		ComponentUnion: v1alpha2.ComponentUnion{
+			ConfigMap: &v1alpha2.ConfigMapComponent{
+				ConfigMapName:      configMapName,
+				ConfigurationMount: mount,
+			},
+		},
+	}
+}
+
 // generateDummyOpenshiftComponent returns a dummy Openshift component for testing
 func generateDummyOpenshiftComponent(name string, endpoints []v1alpha2.Endpoint, uri string) v1alpha2.Component {
 
@@ -133,6 +159,9 @@ func generateDummyPluginComponent(name, url string, compAttribute attributes.Att
 		ComponentUnion: v1alpha2.ComponentUnion{
 			Plugin: &v1alpha2.PluginComponent{
 				ImportReference: v1alpha2.ImportReference{
+					ImportReferenceUnion: v1alpha2.ImportReferenceUnion{
+						Id: "dummy-plugin",
+					},
 					RegistryUrl: url,
 				},
 			},
@@ -140,6 +169,13 @@ func generateDummyPluginComponent(name, url string, compAttribute attributes.Att
 	}
 }
 
+// generateDummyContainerComponentWithAttributes returns a dummy container component with attributes for testing
+func generateDummyContainerComponentWithAttributes(name string, attrs attributes.Attributes) v1alpha2.Component {
+	component := generateDummyContainerComponentWithResourceRequirement(name, "", "", "", "")
+	component.Attributes = attrs
+	return component
+}
+
 func TestValidateComponents(t *testing.T) {
 
 	volMounts := []v1alpha2.VolumeMount{
@@ -149,6 +185,23 @@ func TestValidateComponents(t *testing.T) {
 		},
 	}
 
+	duplicatePathVolMounts := []v1alpha2.VolumeMount{
+		{
+			Name: "myvol",
+			Path: "/some/path/",
+		},
+		{
+			Name: "myvol2",
+			Path: "/some/path",
+		},
+	}
+
+	defaultPathVolMounts := []v1alpha2.VolumeMount{
+		{
+			Name: "myvol",
+		},
+	}
+
 	invalidVolMounts := []v1alpha2.VolumeMount{
 		{
 			Name: "myinvalidvol",
@@ -249,6 +302,12 @@ func TestValidateComponents(t *testing.T) {
 	imageCompTwoRemoteErr := "component .* should have one remote only"
 	imageCompNoRemoteErr := "component .* should have at least one remote"
 	imageCompInvalidRemoteErr := "unable to find the checkout remote .* in the remotes for component .*"
+	invalidPodOverridesErr := "the component \"name1\" is invalid - attribute \"pod-overrides\" is invalid: the following fields are managed by the devfile and cannot be overridden: spec.containers"
+	invalidContainerOverridesErr := "the component \"name1\" is invalid - attribute \"container-overrides\" is invalid: the following fields are managed by the devfile and cannot be overridden: image, env"
+	badlyTypedContainerOverridesErr := "the component \"name1\" is invalid - attribute \"container-overrides\" is invalid: json: cannot unmarshal number .*"
+	invalidComponentOrderErr := "the component \"name3\" is invalid - attribute \"api.devfile.io/order\" is invalid: it should be an integer, but is \"first\""
+	duplicateContainerOrderErr := "the container components name1, name2 have the same order 1"
+	duplicatePluginOrderErr := "the plugin components name4, name5 have the same order 0"
 	DeploymentAnnotationConflictErr := "deployment annotation: deploy-key1 has been declared multiple times and with different values"
 	ServiceAnnotationConflictErr := "service annotation: svc-key1 has been declared multiple times and with different values"
 
@@ -258,6 +317,14 @@ func TestValidateComponents(t *testing.T) {
 	invalidCpuRequest := ".*cpuRequest is greater than cpuLimit."
 	invalidMemoryRequest := ".*memoryRequest is greater than memoryLimit."
 	quantityParsingErr := "error parsing .* requirement for component.*"
+	duplicateVolMountPathErr := "the volumes myvol and myvol2 are mounted at the same path /some/path in the container component container1"
+	invalidSecretNameErr := "the secret component \"secret1\" is invalid - name \"My_Secret\" is invalid: .*"
+	relativeMountPathErr := "the secret component \"secret1\" is invalid - mount path \"certs\" must be absolute"
+	invalidConfigMapKeyErr := "the configMap component \"config1\" is invalid - key \"a key\" is invalid: .*"
+	parentItemPathErr := "the configMap component \"config1\" is invalid - path \"../settings.json\" of key \"settings\" must not contain '..'"
+	duplicateItemKeyErr := "the configMap component \"config1\" is invalid - key \"settings\" is mounted several times"
+	duplicateItemPathErr := "the configMap component \"config1\" is invalid - keys \"settings\" and \"defaults\" are mounted at the same path \"conf/settings.json\""
+	volMountPathConflictWarning := "the volume myvol is mounted at different paths by the container components: /some/path in container1, /myvol in container2"
 
 	tests := []struct {
 		name       string
@@ -310,6 +377,24 @@ func TestValidateComponents(t *testing.T) {
 			},
 			wantErr: []string{invalidVolMountErr},
 		},
+		{
+			name: "Invalid container mounting two volumes at the same path",
+			components: []v1alpha2.Component{
+				generateDummyVolumeComponent("myvol", "1Gi"),
+				generateDummyVolumeComponent("myvol2", "1Gi"),
+				generateDummyContainerComponent("container1", duplicatePathVolMounts, nil, nil, v1alpha2.Annotation{}, false),
+			},
+			wantErr: []string{duplicateVolMountPathErr},
+		},
+		{
+			name: "Containers mounting the same volume at different paths",
+			components: []v1alpha2.Component{
+				generateDummyVolumeComponent("myvol", "1Gi"),
+				generateDummyContainerComponent("container1", volMounts, nil, nil, v1alpha2.Annotation{}, false),
+				generateDummyContainerComponent("container2", defaultPathVolMounts, nil, nil, v1alpha2.Annotation{}, false),
+			},
+			wantErr: []string{volMountPathConflictWarning},
+		},
 		{
 			name: "Invalid containers with the same endpoint names",
 			components: []v1alpha2.Component{
@@ -527,6 +612,89 @@ func TestValidateComponents(t *testing.T) {
 				generateDummyImageComponent("name1", validUriSrc),
 			},
 		},
+		{
+			name: "Valid container component with pod and container overrides",
+			components: []v1alpha2.Component{
+				generateDummyContainerComponentWithAttributes("name1", attributes.Attributes{}.
+					PutString("unrelated", "value").
+					FromMap(map[string]interface{}{
+						v1alpha2.PodOverridesAttribute:       map[string]interface{}{"spec": map[string]interface{}{"serviceAccountName": "sa"}},
+						v1alpha2.ContainerOverridesAttribute: map[string]interface{}{"resources": map[string]interface{}{"limits": map[string]interface{}{"nvidia.com/gpu": "1"}}},
+					}, nil)),
+			},
+		},
+		{
+			name: "Invalid container component with restricted pod and container overrides",
+			components: []v1alpha2.Component{
+				generateDummyContainerComponentWithAttributes("name1", attributes.Attributes{}.
+					FromMap(map[string]interface{}{
+						v1alpha2.PodOverridesAttribute:       map[string]interface{}{"spec": map[string]interface{}{"containers": []interface{}{}}},
+						v1alpha2.ContainerOverridesAttribute: map[string]interface{}{"image": "other", "env": []interface{}{}},
+					}, nil)),
+			},
+			wantErr: []string{invalidPodOverridesErr, invalidContainerOverridesErr},
+		},
+		{
+			name: "Invalid container component with badly typed container overrides",
+			components: []v1alpha2.Component{
+				generateDummyContainerComponentWithAttributes("name1", attributes.Attributes{}.
+					FromMap(map[string]interface{}{
+						v1alpha2.ContainerOverridesAttribute: map[string]interface{}{"workingDir": 1},
+					}, nil)),
+			},
+			wantErr: []string{badlyTypedContainerOverridesErr},
+		},
+		{
+			name: "Valid secret and configMap components mounted by a container",
+			components: []v1alpha2.Component{
+				generateDummySecretComponent("secret1", "tls.certs", v1alpha2.ConfigurationMount{MountPath: "/etc/certs"}),
+				generateDummyConfigMapComponent("config1", "settings", v1alpha2.ConfigurationMount{
+					Items: []v1alpha2.KeyToPath{{Key: "settings", Path: "conf/settings.json"}, {Key: "defaults", Path: "defaults.json"}},
+				}),
+				generateDummyContainerComponent("container1", []v1alpha2.VolumeMount{{Name: "config1", Path: "/etc/config"}}, nil, nil, v1alpha2.Annotation{}, false),
+			},
+		},
+		{
+			name: "Invalid secret component name and mount path",
+			components: []v1alpha2.Component{
+				generateDummySecretComponent("secret1", "My_Secret", v1alpha2.ConfigurationMount{MountPath: "certs"}),
+			},
+			wantErr: []string{invalidSecretNameErr, relativeMountPathErr},
+		},
+		{
+			name: "Invalid configMap component items",
+			components: []v1alpha2.Component{
+				generateDummyConfigMapComponent("config1", "settings", v1alpha2.ConfigurationMount{
+					Items: []v1alpha2.KeyToPath{
+						{Key: "a key", Path: "key.txt"},
+						{Key: "settings", Path: "../settings.json"},
+						{Key: "settings", Path: "conf/settings.json"},
+						{Key: "defaults", Path: "conf/./settings.json"},
+					},
+				}),
+			},
+			wantErr: []string{invalidConfigMapKeyErr, parentItemPathErr, duplicateItemKeyErr, duplicateItemPathErr},
+		},
+		{
+			name: "Valid components with distinct orders",
+			components: []v1alpha2.Component{
+				generateDummyContainerComponentWithAttributes("name1", attributes.Attributes{}.PutInteger(v1alpha2.ComponentOrderAttribute, 1)),
+				generateDummyContainerComponentWithAttributes("name2", attributes.Attributes{}.PutInteger(v1alpha2.ComponentOrderAttribute, 2)),
+				generateDummyContainerComponentWithAttributes("name3", nil),
+				generateDummyPluginComponent("name4", "", attributes.Attributes{}.PutInteger(v1alpha2.ComponentOrderAttribute, 1)),
+			},
+		},
+		{
+			name: "Invalid components with duplicate or invalid orders",
+			components: []v1alpha2.Component{
+				generateDummyContainerComponentWithAttributes("name1", attributes.Attributes{}.PutInteger(v1alpha2.ComponentOrderAttribute, 1)),
+				generateDummyContainerComponentWithAttributes("name2", attributes.Attributes{}.PutInteger(v1alpha2.ComponentOrderAttribute, 1)),
+				generateDummyContainerComponentWithAttributes("name3", attributes.Attributes{}.PutString(v1alpha2.ComponentOrderAttribute, "first")),
+				generateDummyPluginComponent("name4", "", attributes.Attributes{}.PutInteger(v1alpha2.ComponentOrderAttribute, 0)),
+				generateDummyPluginComponent("name5", "", attributes.Attributes{}.PutInteger(v1alpha2.ComponentOrderAttribute, 0)),
+			},
+			wantErr: []string{invalidComponentOrderErr, duplicateContainerOrderErr, duplicatePluginOrderErr},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,54 @@
+package validation
+
+import (
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+)
+
+// ContentOptions contains the options of the semantic validation of a devworkspace template spec content
+type ContentOptions struct {
+	// MaxAttributesSize is the limit of the serialized size of the attributes of each object, in bytes,
+	// above which a warning is returned. `DefaultMaxAttributesSize` is used when it is zero.
+	MaxAttributesSize int
+
+	// ReservedPorts are the target ports that the endpoints cannot use, indexed by port with a description of their use
+	ReservedPorts map[int]string
+}
+
+// FieldErrors are the validation errors of a top-level field of a devworkspace template spec content
+type FieldErrors struct {
+	// Field is the Json name of the top-level field, such as `commands`
+	Field string
+	// Err contains the validation errors of the field, as a multi-error when there are several of them.
+	// The warnings are recognized by IsWarning.
+	Err error
+}
+
+// ValidateContent runs all the semantic validation rules of this package against the given content,
+// such as the references of the commands to the components, the uniqueness of the component names,
+// the target ports of the endpoints, or the volumes mounted by the container components.
+// It returns the validation errors of each top-level field that has some, the components being validated before the commands
+// that reference them.
+//
+// The schema version and the features it supports are not validated, since they are properties of the devfile,
+// and the content should be flattened beforehand when it has a parent or plugin components.
+func ValidateContent(content *v1alpha2.DevWorkspaceTemplateSpecContent, opts ContentOptions) []FieldErrors {
+	var fieldErrors []FieldErrors
+	add := func(field string, err error) {
+		if err != nil {
+			fieldErrors = append(fieldErrors, FieldErrors{Field: field, Err: err})
+		}
+	}
+
+	add("env", ValidateEnv(content.Env))
+	add("storageStrategy", ValidateStorageStrategy(content))
+	add("components", ValidateComponents(content.Components))
+	add("components", ValidateEndpointPorts(content.Components, opts.ReservedPorts))
+	add("commands", ValidateCommands(content.Commands, content.Components))
+	if content.Events != nil {
+		add("events", ValidateEvents(*content.Events, content.Commands))
+	}
+	add("projects", ValidateProjects(content.Projects))
+	add("starterProjects", ValidateStarterProjects(content.StarterProjects))
+	add("attributes", ValidateAttributes(content, opts.MaxAttributesSize))
+	return fieldErrors
+}
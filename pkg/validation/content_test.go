@@ -0,0 +1,78 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateContent(t *testing.T) {
+	container := func(name string, volumeMounts ...string) v1alpha2.Component {
+		component := v1alpha2.Component{Name: name, ComponentUnion: v1alpha2.ComponentUnion{Container: &v1alpha2.ContainerComponent{
+			Container: v1alpha2.Container{Image: "image", MountSources: new(bool)},
+			Endpoints: []v1alpha2.Endpoint{{Name: name + "-http", TargetPort: 8080}},
+		}}}
+		component.Container.Command = []string{"sleep", "infinity"}
+		for _, volumeMount := range volumeMounts {
+			component.Container.VolumeMounts = append(component.Container.VolumeMounts, v1alpha2.VolumeMount{Name: volumeMount})
+		}
+		return component
+	}
+	composite := func(id string, subCommands ...string) v1alpha2.Command {
+		return v1alpha2.Command{Id: id, CommandUnion: v1alpha2.CommandUnion{Composite: &v1alpha2.CompositeCommand{Commands: subCommands}}}
+	}
+
+	tests := []struct {
+		name       string
+		content    v1alpha2.DevWorkspaceTemplateSpecContent
+		opts       ContentOptions
+		wantFields []string
+		wantRules  []string
+	}{
+		{
+			name: "Valid content",
+			content: v1alpha2.DevWorkspaceTemplateSpecContent{
+				Components: []v1alpha2.Component{container("runtime")},
+			},
+		},
+		{
+			name: "Cross-reference problems",
+			content: v1alpha2.DevWorkspaceTemplateSpecContent{
+				Components: []v1alpha2.Component{container("runtime", "missing"), container("runtime"), container("tools")},
+				Commands:   []v1alpha2.Command{composite("all", "build")},
+			},
+			wantFields: []string{"components", "commands"},
+			wantRules:  []string{"volume-mounts", "endpoints", "commands"},
+		},
+		{
+			name: "Reserved ports",
+			content: v1alpha2.DevWorkspaceTemplateSpecContent{
+				Components: []v1alpha2.Component{container("runtime")},
+			},
+			opts:       ContentOptions{ReservedPorts: map[int]string{8080: "the editor"}},
+			wantFields: []string{"components"},
+			wantRules:  []string{"endpoint-ports"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fields, rules []string
+			for _, fieldErrors := range ValidateContent(&tt.content, tt.opts) {
+				fields = append(fields, fieldErrors.Field)
+				errs := []error{fieldErrors.Err}
+				if merr, isMultiError := fieldErrors.Err.(*multierror.Error); isMultiError {
+					errs = merr.Errors
+				}
+				for _, err := range errs {
+					rules = append(rules, RuleID(err))
+				}
+			}
+			assert.Equal(t, tt.wantFields, fields)
+			for _, rule := range tt.wantRules {
+				assert.Contains(t, rules, rule)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package validation
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/santhosh-tekuri/jsonschema"
+	"sigs.k8s.io/yaml"
+)
+
+// customComponentSchemas are the compiled JSON schemas of the `embeddedResource` of the custom components, by component class
+var customComponentSchemas = struct {
+	sync.RWMutex
+	byClass map[string]*jsonschema.Schema
+}{byClass: map[string]*jsonschema.Schema{}}
+
+// RegisterCustomComponentSchema registers the JSON schema (in json or yaml) that the `embeddedResource`
+// of the custom components with the given `componentClass` must match, so that ValidateComponents
+// validates the payload of these components.
+// Registering a schema for a component class replaces the schema previously registered for this class.
+// The custom components whose class has no registered schema are not validated.
+func RegisterCustomComponentSchema(componentClass string, schema []byte) error {
+	if componentClass == "" {
+		return fmt.Errorf("the component class of a custom component schema cannot be empty")
+	}
+	jsonSchema, err := yaml.YAMLToJSON(schema)
+	if err != nil {
+		return fmt.Errorf("failed to parse the schema of the %q custom component class: %w", componentClass, err)
+	}
+	url := "devfile-custom-component-" + componentClass + ".json"
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(url, bytes.NewReader(jsonSchema)); err != nil {
+		return fmt.Errorf("failed to parse the schema of the %q custom component class: %w", componentClass, err)
+	}
+	compiled, err := compiler.Compile(url)
+	if err != nil {
+		return fmt.Errorf("failed to compile the schema of the %q custom component class: %w", componentClass, err)
+	}
+
+	customComponentSchemas.Lock()
+	defer customComponentSchemas.Unlock()
+	customComponentSchemas.byClass[componentClass] = compiled
+	return nil
+}
+
+// UnregisterCustomComponentSchema removes the schema registered for the given component class, if any
+func UnregisterCustomComponentSchema(componentClass string) {
+	customComponentSchemas.Lock()
+	defer customComponentSchemas.Unlock()
+	delete(customComponentSchemas.byClass, componentClass)
+}
+
+func getCustomComponentSchema(componentClass string) *jsonschema.Schema {
+	customComponentSchemas.RLock()
+	defer customComponentSchemas.RUnlock()
+	return customComponentSchemas.byClass[componentClass]
+}
+
+// validateCustomComponent validates the `embeddedResource` of the given custom component against the schema
+// registered for its component class, and returns an error for each schema violation reported by the schema validator
+func validateCustomComponent(name string, custom *v1alpha2.CustomComponent) (errList []error) {
+	schema := getCustomComponentSchema(custom.ComponentClass)
+	if schema == nil {
+		return nil
+	}
+	basePath := fmt.Sprintf("components[%s].custom.embeddedResource", name)
+
+	payload := custom.EmbeddedResource.Raw
+	if len(payload) == 0 {
+		payload = []byte("null")
+	}
+	err := schema.Validate(bytes.NewReader(payload))
+	if err == nil {
+		return nil
+	}
+	validationErr, isValidationErr := err.(*jsonschema.ValidationError)
+	if !isValidationErr {
+		return []error{&InvalidCustomComponentError{componentName: name, componentClass: custom.ComponentClass,
+			path: basePath, reason: err.Error()}}
+	}
+	for _, cause := range leafValidationErrors(validationErr) {
+		path := basePath
+		// the instance pointers are fragments such as `#/spec/replicas`
+		if ptr := cause.InstancePtr; len(ptr) > 1 {
+			path += ptr[1:]
+		}
+		errList = append(errList, &InvalidCustomComponentError{componentName: name, componentClass: custom.ComponentClass,
+			path: path, reason: cause.Message})
+	}
+	return errList
+}
+
+// leafValidationErrors returns the most specific validation errors nested in the given validation error
+func leafValidationErrors(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsonschema.ValidationError{err}
+	}
+	var leaves []*jsonschema.ValidationError
+	for _, cause := range err.Causes {
+		leaves = append(leaves, leafValidationErrors(cause)...)
+	}
+	return leaves
+}
@@ -0,0 +1,144 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// generateDummyCustomComponent returns a dummy custom component with the given class and embedded resource for testing
+func generateDummyCustomComponent(name, componentClass, embeddedResource string) v1alpha2.Component {
+	return v1alpha2.Component{
+		Name: name,
+		ComponentUnion: v1alpha2.ComponentUnion{
+			Custom: &v1alpha2.CustomComponent{
+				ComponentClass:   componentClass,
+				EmbeddedResource: runtime.RawExtension{Raw: []byte(embeddedResource)},
+			},
+		},
+	}
+}
+
+const databaseSchema = `
+type: object
+required:
+  - engine
+properties:
+  engine:
+    type: string
+    enum: [postgresql, mysql]
+  replicas:
+    type: integer
+    minimum: 1
+additionalProperties: false
+`
+
+func TestValidateCustomComponents(t *testing.T) {
+
+	if !assert.NoError(t, RegisterCustomComponentSchema("database", []byte(databaseSchema))) {
+		return
+	}
+	defer UnregisterCustomComponentSchema("database")
+
+	tests := []struct {
+		name      string
+		component v1alpha2.Component
+		wantErr   []string
+		wantPaths []string
+	}{
+		{
+			name:      "Valid custom component",
+			component: generateDummyCustomComponent("db", "database", `{"engine": "postgresql", "replicas": 2}`),
+		},
+		{
+			name:      "Custom component without registered schema",
+			component: generateDummyCustomComponent("cache", "cache", `{"anything": true}`),
+		},
+		{
+			name:      "Custom component with invalid field",
+			component: generateDummyCustomComponent("db", "database", `{"engine": "postgresql", "replicas": 0}`),
+			wantErr:   []string{"the custom component \"db\" of class \"database\" is invalid at components\\[db\\].custom.embeddedResource/replicas - must be >= 1.*"},
+			wantPaths: []string{"components[db].custom.embeddedResource/replicas"},
+		},
+		{
+			name:      "Custom component with unknown field",
+			component: generateDummyCustomComponent("db", "database", `{"engine": "postgresql", "size": "1Gi"}`),
+			wantErr:   []string{"is invalid at components\\[db\\].custom.embeddedResource - additionalProperties \"size\" not allowed"},
+			wantPaths: []string{"components[db].custom.embeddedResource"},
+		},
+		{
+			name:      "Custom component with missing field",
+			component: generateDummyCustomComponent("db", "database", `{"replicas": 1}`),
+			wantErr:   []string{"is invalid at components\\[db\\].custom.embeddedResource - missing properties: \"engine\""},
+			wantPaths: []string{"components[db].custom.embeddedResource"},
+		},
+		{
+			name:      "Custom component without embedded resource",
+			component: generateDummyCustomComponent("db", "database", ""),
+			wantErr:   []string{"is invalid at components\\[db\\].custom.embeddedResource - expected object, but got null"},
+			wantPaths: []string{"components[db].custom.embeddedResource"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateComponents([]v1alpha2.Component{tt.component})
+
+			if merr, ok := err.(*multierror.Error); ok && tt.wantErr != nil {
+				if assert.Equal(t, len(tt.wantErr), len(merr.Errors), "Error list length should match") {
+					for i := 0; i < len(merr.Errors); i++ {
+						assert.Regexp(t, tt.wantErr[i], merr.Errors[i].Error(), "Error message should match")
+						if customErr, isCustomErr := merr.Errors[i].(*InvalidCustomComponentError); assert.True(t, isCustomErr, "Error should be a custom component error") {
+							assert.Equal(t, tt.wantPaths[i], customErr.Path(), "Error path should match")
+							assert.Equal(t, "custom-components", RuleID(customErr), "Error rule should match")
+						}
+					}
+				}
+			} else {
+				assert.Equal(t, nil, err, "Error should be nil")
+			}
+		})
+	}
+}
+
+func TestRegisterCustomComponentSchema(t *testing.T) {
+	tests := []struct {
+		name           string
+		componentClass string
+		schema         string
+		wantErr        string
+	}{
+		{
+			name:           "Valid json schema",
+			componentClass: "queue",
+			schema:         `{"type": "object"}`,
+		},
+		{
+			name:           "Empty component class",
+			componentClass: "",
+			schema:         `{"type": "object"}`,
+			wantErr:        "the component class of a custom component schema cannot be empty",
+		},
+		{
+			name:           "Invalid schema",
+			componentClass: "queue",
+			schema:         `{"type": "unknown"}`,
+			wantErr:        "failed to compile the schema of the \"queue\" custom component class: .*",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer UnregisterCustomComponentSchema(tt.componentClass)
+			err := RegisterCustomComponentSchema(tt.componentClass, []byte(tt.schema))
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Regexp(t, tt.wantErr, err.Error(), "Error message should match")
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
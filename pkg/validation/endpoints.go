@@ -1,6 +1,9 @@
 package validation
 
-import "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+import (
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/hashicorp/go-multierror"
+)
 
 // validateEndpoints checks if
 // 1. all the endpoint names are unique across components
@@ -27,3 +30,52 @@ func validateEndpoints(endpoints []v1alpha2.Endpoint, processedEndPointPort map[
 
 	return errList
 }
+
+// maxPrivilegedPort is the highest port that only privileged processes can listen to
+const maxPrivilegedPort = 1023
+
+// ValidateEndpointPorts validates the target ports of the endpoints of the container, kubernetes and openshift components:
+// 1. makes sure the target ports of the endpoints of a component are unique
+// 2. makes sure no endpoint uses one of the given reserved ports, such as the ports of the containers
+// that the consumer adds to the workspace pod, indexed by port with a description of their use
+// 3. warns about the public endpoints with a privileged target port (below 1024), which containers running
+// as a non-root user cannot listen to
+func ValidateEndpointPorts(components []v1alpha2.Component, reservedPorts map[int]string) (returnedErr error) {
+	for _, component := range components {
+		var componentType string
+		var endpoints []v1alpha2.Endpoint
+		switch {
+		case component.Container != nil:
+			componentType, endpoints = "container", component.Container.Endpoints
+		case component.Kubernetes != nil:
+			componentType, endpoints = "kubernetes", component.Kubernetes.Endpoints
+		case component.Openshift != nil:
+			componentType, endpoints = "openshift", component.Openshift.Endpoints
+		default:
+			continue
+		}
+
+		// the first endpoint using each target port
+		endpointsByPort := make(map[int]string)
+		for _, endpoint := range endpoints {
+			var endpointErr error
+			otherEndpoint, isDuplicate := endpointsByPort[endpoint.TargetPort]
+			use, isReserved := reservedPorts[endpoint.TargetPort]
+			switch {
+			case isDuplicate:
+				endpointErr = &DuplicateEndpointPortError{componentName: component.Name, port: endpoint.TargetPort, endpointNames: []string{otherEndpoint, endpoint.Name}}
+			case isReserved:
+				endpointErr = &ReservedEndpointPortError{componentName: component.Name, componentType: componentType, endpointName: endpoint.Name, port: endpoint.TargetPort, use: use}
+			case endpoint.TargetPort <= maxPrivilegedPort && (endpoint.Exposure == "" || endpoint.Exposure == v1alpha2.PublicEndpointExposure):
+				endpointErr = &PrivilegedEndpointPortWarning{componentName: component.Name, componentType: componentType, endpointName: endpoint.Name, port: endpoint.TargetPort}
+			}
+			if !isDuplicate {
+				endpointsByPort[endpoint.TargetPort] = endpoint.Name
+			}
+			if endpointErr != nil {
+				returnedErr = multierror.Append(returnedErr, resolveErrorMessageWithImportAttributes(endpointErr, component.Attributes))
+			}
+		}
+	}
+	return returnedErr
+}
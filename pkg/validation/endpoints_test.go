@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/hashicorp/go-multierror"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -95,6 +96,82 @@ func TestValidateEndpoints(t *testing.T) {
 
 }
 
+func TestValidateEndpointPorts(t *testing.T) {
+	reservedPorts := map[int]string{4444: "the terminal server"}
+
+	tests := []struct {
+		name         string
+		components   []v1alpha2.Component
+		wantErr      []string
+		wantWarnings []string
+	}{
+		{
+			name: "Endpoints with distinct target ports",
+			components: []v1alpha2.Component{
+				generateDummyContainerComponent("runtime", nil, []v1alpha2.Endpoint{
+					{Name: "http", TargetPort: 8080},
+					{Name: "debug", TargetPort: 5858, Exposure: v1alpha2.InternalEndpointExposure},
+				}, nil, v1alpha2.Annotation{}, false),
+			},
+		},
+		{
+			name: "Endpoints sharing a target port",
+			components: []v1alpha2.Component{
+				generateDummyContainerComponent("runtime", nil, []v1alpha2.Endpoint{
+					{Name: "http", TargetPort: 8080},
+					{Name: "debug", TargetPort: 8080, Exposure: v1alpha2.InternalEndpointExposure},
+				}, nil, v1alpha2.Annotation{}, false),
+			},
+			wantErr: []string{"endpoints http and debug of component runtime use the same target port 8080"},
+		},
+		{
+			name: "Endpoints sharing a target port with different paths",
+			components: []v1alpha2.Component{
+				generateDummyContainerComponent("runtime", nil, []v1alpha2.Endpoint{
+					{Name: "api", TargetPort: 8080, Path: "/api"},
+					{Name: "ui", TargetPort: 8080},
+				}, nil, v1alpha2.Annotation{}, false),
+			},
+			wantErr: []string{"endpoints api and ui of component runtime use the same target port 8080"},
+		},
+		{
+			name: "Reserved target port",
+			components: []v1alpha2.Component{
+				generateDummyContainerComponent("runtime", nil, []v1alpha2.Endpoint{
+					{Name: "terminal", TargetPort: 4444, Exposure: v1alpha2.InternalEndpointExposure},
+				}, nil, v1alpha2.Annotation{}, false),
+			},
+			wantErr: []string{"endpoint terminal of component runtime uses target port 4444, which is reserved for the terminal server"},
+		},
+		{
+			name: "Privileged target port of a public endpoint",
+			components: []v1alpha2.Component{
+				generateDummyContainerComponent("runtime", nil, []v1alpha2.Endpoint{
+					{Name: "http", TargetPort: 80},
+					{Name: "dns", TargetPort: 53, Exposure: v1alpha2.InternalEndpointExposure},
+				}, nil, v1alpha2.Annotation{}, false),
+			},
+			wantWarnings: []string{"public endpoint http of component runtime uses the privileged target port 80, which containers running as a non-root user cannot listen to"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs, warnings []string
+			if merr, ok := ValidateEndpointPorts(tt.components, reservedPorts).(*multierror.Error); ok {
+				for _, err := range merr.Errors {
+					if IsWarning(err) {
+						warnings = append(warnings, err.Error())
+					} else {
+						errs = append(errs, err.Error())
+					}
+				}
+			}
+			assert.Equal(t, tt.wantErr, errs, "Errors should match")
+			assert.Equal(t, tt.wantWarnings, warnings, "Warnings should match")
+		})
+	}
+}
+
 func generateDummyEndpoint(name string, port int) v1alpha2.Endpoint {
 	return v1alpha2.Endpoint{
 		Name:       name,
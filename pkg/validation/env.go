@@ -0,0 +1,21 @@
+package validation
+
+import (
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/hashicorp/go-multierror"
+)
+
+// ValidateEnv validates the top-level env of the devfile, and makes sure that the env variable names are unique.
+// The reserved env variables are validated in the container components, once the top-level env is applied to them.
+func ValidateEnv(env []v1alpha2.EnvVar) (returnedErr error) {
+	reported := map[string]bool{}
+	seen := map[string]bool{}
+	for _, envVar := range env {
+		if seen[envVar.Name] && !reported[envVar.Name] {
+			reported[envVar.Name] = true
+			returnedErr = multierror.Append(returnedErr, &DuplicateEnvError{envName: envVar.Name})
+		}
+		seen[envVar.Name] = true
+	}
+	return returnedErr
+}
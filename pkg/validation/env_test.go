@@ -0,0 +1,45 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateEnv(t *testing.T) {
+
+	duplicateEnvErr := "env variable A is defined several times in the top-level env"
+
+	tests := []struct {
+		name    string
+		env     []v1alpha2.EnvVar
+		wantErr []string
+	}{
+		{
+			name: "Valid env",
+			env:  []v1alpha2.EnvVar{{Name: "A", Value: "a"}, {Name: "B", Value: "b"}},
+		},
+		{
+			name:    "Duplicate env names",
+			env:     []v1alpha2.EnvVar{{Name: "A", Value: "a"}, {Name: "B", Value: "b"}, {Name: "A", Value: "c"}, {Name: "A", Value: "d"}},
+			wantErr: []string{duplicateEnvErr},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateEnv(tt.env)
+
+			if merr, ok := err.(*multierror.Error); ok && tt.wantErr != nil {
+				if assert.Equal(t, len(tt.wantErr), len(merr.Errors), "Error list length should match") {
+					for i := 0; i < len(merr.Errors); i++ {
+						assert.Regexp(t, tt.wantErr[i], merr.Errors[i].Error(), "Error message should match")
+					}
+				}
+			} else {
+				assert.Equal(t, nil, err, "Error should be nil")
+			}
+		})
+	}
+}
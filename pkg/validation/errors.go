@@ -1,10 +1,15 @@
 package validation
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	attributesAPI "github.com/devfile/api/v2/pkg/attributes"
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/hashicorp/go-multierror"
 )
 
 // InvalidEventError returns an error if the devfile event type has invalid events
@@ -21,12 +26,19 @@ func (e *InvalidEventError) Error() string {
 type InvalidCommandError struct {
 	commandId string
 	reason    string
+	// cause is the error that makes the command invalid, if any, such as `ErrNotFound` for a missing component
+	cause error
 }
 
 func (e *InvalidCommandError) Error() string {
 	return fmt.Sprintf("the command %q is invalid - %s", e.commandId, e.reason)
 }
 
+// Unwrap returns the error that makes the command invalid, if any
+func (e *InvalidCommandError) Unwrap() error {
+	return e.cause
+}
+
 // InvalidCommandError returns an error if the command is invalid
 type InvalidCommandTypeError struct {
 	commandId string
@@ -66,6 +78,40 @@ func (e *ReservedEnvError) Error() string {
 	return fmt.Sprintf("env variable %s is reserved and cannot be customized in component %s", e.envName, e.componentName)
 }
 
+// DuplicateEnvError returns an error if an env variable is defined several times in the top-level env
+type DuplicateEnvError struct {
+	envName string
+}
+
+func (e *DuplicateEnvError) Error() string {
+	return fmt.Sprintf("env variable %s is defined several times in the top-level env", e.envName)
+}
+
+// Unwrap returns `ErrDuplicateKey`, so that the error can be checked with `errors.Is`
+func (e *DuplicateEnvError) Unwrap() error {
+	return devfileerrors.ErrDuplicateKey
+}
+
+// InvalidStorageStrategyError returns an error if the storage strategy of the devworkspace is invalid
+type InvalidStorageStrategyError struct {
+	reason string
+}
+
+func (e *InvalidStorageStrategyError) Error() string {
+	return fmt.Sprintf("the storage strategy is invalid - %s", e.reason)
+}
+
+// InvalidStorageTypeAttributeWarning returns an error if the legacy storage-type attribute has an unknown value,
+// in which case the default storage strategy is used
+type InvalidStorageTypeAttributeWarning struct {
+	strategy v1alpha2.StorageStrategy
+	reason   string
+}
+
+func (e *InvalidStorageTypeAttributeWarning) Error() string {
+	return fmt.Sprintf("the storage strategy %s is used - %s", e.strategy, e.reason)
+}
+
 // InvalidVolumeError returns an error if the volume is invalid
 type InvalidVolumeError struct {
 	name   string
@@ -76,6 +122,17 @@ func (e *InvalidVolumeError) Error() string {
 	return fmt.Sprintf("the volume %q is invalid - %s", e.name, e.reason)
 }
 
+// InvalidConfigurationMountError returns an error if the mount of a secret or configMap component is invalid
+type InvalidConfigurationMountError struct {
+	componentName string
+	componentType string
+	reason        string
+}
+
+func (e *InvalidConfigurationMountError) Error() string {
+	return fmt.Sprintf("the %s component %q is invalid - %s", e.componentType, e.componentName, e.reason)
+}
+
 // MissingVolumeMountError returns an error if the container volume mount does not reference a valid volume component
 type MissingVolumeMountError struct {
 	errMsg string
@@ -85,6 +142,108 @@ func (e *MissingVolumeMountError) Error() string {
 	return fmt.Sprintf("unable to find the following volume mounts in devfile volume components: %s", e.errMsg)
 }
 
+// Unwrap returns `ErrNotFound`, so that the error can be checked with `errors.Is`
+func (e *MissingVolumeMountError) Unwrap() error {
+	return devfileerrors.ErrNotFound
+}
+
+// DuplicateVolumeMountPathError returns an error if several volumes are mounted at the same path in a container
+type DuplicateVolumeMountPathError struct {
+	componentName string
+	path          string
+	volumeNames   []string
+}
+
+func (e *DuplicateVolumeMountPathError) Error() string {
+	return fmt.Sprintf("the volumes %s are mounted at the same path %s in the container component %s", strings.Join(e.volumeNames, " and "), e.path, e.componentName)
+}
+
+// Unwrap returns `ErrDuplicateKey`, so that the error can be checked with `errors.Is`
+func (e *DuplicateVolumeMountPathError) Unwrap() error {
+	return devfileerrors.ErrDuplicateKey
+}
+
+// DuplicateComponentOrderError returns an error if several container components, or several plugin components,
+// have the same order attribute
+type DuplicateComponentOrderError struct {
+	componentType  string
+	order          int
+	componentNames []string
+}
+
+func (e *DuplicateComponentOrderError) Error() string {
+	return fmt.Sprintf("the %s components %s have the same order %d", e.componentType, strings.Join(e.componentNames, ", "), e.order)
+}
+
+// Unwrap returns `ErrDuplicateKey`, so that the error can be checked with `errors.Is`
+func (e *DuplicateComponentOrderError) Unwrap() error {
+	return devfileerrors.ErrDuplicateKey
+}
+
+// containerMount is the mount of a volume in a container component
+type containerMount struct {
+	componentName string
+	path          string
+}
+
+// VolumeMountPathConflictWarning returns an error if a volume is mounted at different paths by several container components
+type VolumeMountPathConflictWarning struct {
+	volumeName string
+	mounts     []containerMount
+}
+
+func (e *VolumeMountPathConflictWarning) Error() string {
+	var mounts []string
+	for _, mount := range e.mounts {
+		mounts = append(mounts, fmt.Sprintf("%s in %s", mount.path, mount.componentName))
+	}
+	return fmt.Sprintf("the volume %s is mounted at different paths by the container components: %s", e.volumeName, strings.Join(mounts, ", "))
+}
+
+// MissingContainerCommandWarning returns an error if a container component that doesn't mount the project sources
+// is the target of exec commands, but doesn't set a command or args to keep it running
+type MissingContainerCommandWarning struct {
+	componentName string
+	commandIds    []string
+}
+
+func (e *MissingContainerCommandWarning) Error() string {
+	return fmt.Sprintf("the container component %s sets mountSources to false and has neither command nor args, "+
+		"so the entrypoint of its image should keep it running for the exec commands: %s", e.componentName, strings.Join(e.commandIds, ", "))
+}
+
+// InvalidAttributeKeyWarning returns an error if an attribute key is not a qualified name
+type InvalidAttributeKeyWarning struct {
+	objectType string
+	objectName string
+	key        string
+	reason     string
+}
+
+func (e *InvalidAttributeKeyWarning) Error() string {
+	return fmt.Sprintf("the attribute key %q of %s is invalid - %s", e.key, attributesOwner(e.objectType, e.objectName), e.reason)
+}
+
+// AttributesSizeWarning returns an error if the serialized attributes of an object are larger than the size limit
+type AttributesSizeWarning struct {
+	objectType string
+	objectName string
+	size       int
+	maxSize    int
+}
+
+func (e *AttributesSizeWarning) Error() string {
+	return fmt.Sprintf("the attributes of %s take %d bytes once serialized, more than the limit of %d bytes", attributesOwner(e.objectType, e.objectName), e.size, e.maxSize)
+}
+
+// attributesOwner describes the object that owns attributes, such as `component runtime`, or `the devfile` for the top-level attributes
+func attributesOwner(objectType, objectName string) string {
+	if objectName == "" {
+		return "the " + objectType
+	}
+	return objectType + " " + objectName
+}
+
 // InvalidEndpointError returns an error if the component endpoint is invalid
 type InvalidEndpointError struct {
 	name string
@@ -102,6 +261,53 @@ func (e *InvalidEndpointError) Error() string {
 	return errMsg
 }
 
+// Unwrap returns `ErrDuplicateKey`, so that the error can be checked with `errors.Is`
+func (e *InvalidEndpointError) Unwrap() error {
+	return devfileerrors.ErrDuplicateKey
+}
+
+// DuplicateEndpointPortError returns an error if several endpoints of a component use the same target port
+type DuplicateEndpointPortError struct {
+	componentName string
+	port          int
+	endpointNames []string
+}
+
+func (e *DuplicateEndpointPortError) Error() string {
+	return fmt.Sprintf("endpoints %s of component %s use the same target port %d", strings.Join(e.endpointNames, " and "), e.componentName, e.port)
+}
+
+// Unwrap returns `ErrDuplicateKey`, so that the error can be checked with `errors.Is`
+func (e *DuplicateEndpointPortError) Unwrap() error {
+	return devfileerrors.ErrDuplicateKey
+}
+
+// ReservedEndpointPortError returns an error if an endpoint uses a target port reserved by the consumer of the devfile
+type ReservedEndpointPortError struct {
+	componentName string
+	componentType string
+	endpointName  string
+	port          int
+	use           string
+}
+
+func (e *ReservedEndpointPortError) Error() string {
+	return fmt.Sprintf("endpoint %s of component %s uses target port %d, which is reserved for %s", e.endpointName, e.componentName, e.port, e.use)
+}
+
+// PrivilegedEndpointPortWarning returns an error if a public endpoint uses a privileged target port,
+// which containers running as a non-root user cannot listen to
+type PrivilegedEndpointPortWarning struct {
+	componentName string
+	componentType string
+	endpointName  string
+	port          int
+}
+
+func (e *PrivilegedEndpointPortWarning) Error() string {
+	return fmt.Sprintf("public endpoint %s of component %s uses the privileged target port %d, which containers running as a non-root user cannot listen to", e.endpointName, e.componentName, e.port)
+}
+
 // InvalidComponentError returns an error if the component is invalid
 type InvalidComponentError struct {
 	componentName string
@@ -141,6 +347,47 @@ func (e *MultipleRemoteError) Error() string {
 	return fmt.Sprintf("%s %s should have one remote only", e.objectType, e.objectName)
 }
 
+// InvalidZipSourceError returns an error if the zip source of a project or starter project is invalid
+type InvalidZipSourceError struct {
+	objectType string
+	objectName string
+	reason     string
+}
+
+func (e *InvalidZipSourceError) Error() string {
+	return fmt.Sprintf("%s %s has an invalid zip source: %s", e.objectType, e.objectName, e.reason)
+}
+
+// InvalidArchiveSourceError returns an error if the archive source of a project or starter project is invalid
+type InvalidArchiveSourceError struct {
+	objectType string
+	objectName string
+	reason     string
+}
+
+func (e *InvalidArchiveSourceError) Error() string {
+	return fmt.Sprintf("%s %s has an invalid archive source: %s", e.objectType, e.objectName, e.reason)
+}
+
+// InvalidCustomComponentError returns an error if the embedded resource of a custom component
+// doesn't match the schema registered for its component class
+type InvalidCustomComponentError struct {
+	componentName  string
+	componentClass string
+	path           string
+	reason         string
+}
+
+func (e *InvalidCustomComponentError) Error() string {
+	return fmt.Sprintf("the custom component %q of class %q is invalid at %s - %s", e.componentName, e.componentClass, e.path, e.reason)
+}
+
+// Path returns the path of the invalid field of the custom component, such as `components[my-component].custom.embeddedResource/spec/replicas`.
+// The fields of the embedded resource are designated by a JSON pointer.
+func (e *InvalidCustomComponentError) Path() string {
+	return e.path
+}
+
 //MissingProjectCheckoutFromRemoteError returns an error if there are multiple git remotes but the checkoutFrom remote has not been specified
 type MissingProjectCheckoutFromRemoteError struct {
 	projectName string
@@ -161,6 +408,11 @@ func (e *InvalidProjectCheckoutRemoteError) Error() string {
 	return fmt.Sprintf("unable to find the checkout remote %s in the remotes for %s %s", e.checkoutRemote, e.objectType, e.objectName)
 }
 
+// Unwrap returns `ErrNotFound`, so that the error can be checked with `errors.Is`
+func (e *InvalidProjectCheckoutRemoteError) Unwrap() error {
+	return devfileerrors.ErrNotFound
+}
+
 type ResourceRequirementType string
 
 const (
@@ -191,6 +443,89 @@ func (e *InvalidResourceRequestError) Error() string {
 	return fmt.Sprintf("invalid resource request for component %s: %s", e.cmpName, e.errMsg)
 }
 
+// InvalidMetadataError returns an error if a devfile metadata field is invalid
+type InvalidMetadataError struct {
+	field  string
+	reason string
+}
+
+func (e *InvalidMetadataError) Error() string {
+	return fmt.Sprintf("the metadata field %q is invalid - %s", e.field, e.reason)
+}
+
+// InvalidSchemaVersionError returns an error if the devfile schema version is invalid or not supported
+type InvalidSchemaVersionError struct {
+	schemaVersion string
+	reason        string
+}
+
+func (e *InvalidSchemaVersionError) Error() string {
+	return fmt.Sprintf("the schema version %q is invalid - %s", e.schemaVersion, e.reason)
+}
+
+// Unwrap returns `ErrUnsupportedVersion`, so that the error can be checked with `errors.Is`
+func (e *InvalidSchemaVersionError) Unwrap() error {
+	return devfileerrors.ErrUnsupportedVersion
+}
+
+// UnsupportedFeatureError returns an error if a devfile uses a feature introduced after its schema version
+type UnsupportedFeatureError struct {
+	feature       Feature
+	schemaVersion string
+	path          string
+}
+
+func (e *UnsupportedFeatureError) Error() string {
+	kind := "feature"
+	if e.feature.isField {
+		kind = "field"
+	}
+	return fmt.Sprintf("%s uses the %s %s, which requires the schema version %s, but the schema version is %s",
+		e.path, e.feature.Name, kind, e.feature.Since, e.schemaVersion)
+}
+
+// Unwrap returns `ErrUnsupportedVersion`, so that the error can be checked with `errors.Is`
+func (e *UnsupportedFeatureError) Unwrap() error {
+	return devfileerrors.ErrUnsupportedVersion
+}
+
+// PluginRule identifies a validation rule of the plugin components
+type PluginRule string
+
+const (
+	// PluginImportReferenceRule requires exactly one of `uri`, `id` or `kubernetes` to be set in a plugin component
+	PluginImportReferenceRule PluginRule = "plugin-import-reference"
+	// PluginRegistryRule requires `registryUrl` and `version` to be only set along with `id` in a plugin component,
+	// and `registryUrl` to be a valid URI
+	PluginRegistryRule PluginRule = "plugin-registry"
+	// PluginOverridesRule requires the plugin overrides to only reference components and commands defined by the plugin,
+	// at most once each
+	PluginOverridesRule PluginRule = "plugin-overrides"
+)
+
+// InvalidPluginComponentError returns an error if a plugin component breaks one of the plugin rules
+type InvalidPluginComponentError struct {
+	componentName string
+	rule          PluginRule
+	reason        string
+	// cause is the error that makes the plugin component invalid, if any, such as `ErrDuplicateKey` for overrides defined twice
+	cause error
+}
+
+func (e *InvalidPluginComponentError) Error() string {
+	return fmt.Sprintf("the plugin component %q is invalid (%s) - %s", e.componentName, e.rule, e.reason)
+}
+
+// Rule returns the identifier of the plugin rule that is broken
+func (e *InvalidPluginComponentError) Rule() PluginRule {
+	return e.rule
+}
+
+// Unwrap returns the error that makes the plugin component invalid, if any
+func (e *InvalidPluginComponentError) Unwrap() error {
+	return e.cause
+}
+
 type AnnotationType string
 
 const (
@@ -223,19 +558,275 @@ func resolveErrorMessageWithImportAttributes(validationErr error, attributes att
 	// if an element is from parentOverride - ImportSourceAttribute + ParentOverrideAttribute
 	// if an element is from pluginOverride - ImportSourceAttribute + PluginOverrideAttribute
 	if findKeyErr == nil {
-		validationErr = fmt.Errorf("%s, imported from %s", validationErr.Error(), importReference)
+		validationErr = fmt.Errorf("%w, imported from %s", validationErr, importReference)
 		parentOverrideReference := attributes.Get(ParentOverrideAttribute, &findKeyErr)
 		if findKeyErr == nil {
-			validationErr = fmt.Errorf("%s, in parent overrides from %s", validationErr.Error(), parentOverrideReference)
+			validationErr = fmt.Errorf("%w, in parent overrides from %s", validationErr, parentOverrideReference)
 		} else {
 			// reset findKeyErr to nil
 			findKeyErr = nil
 			pluginOverrideReference := attributes.Get(PluginOverrideAttribute, &findKeyErr)
 			if findKeyErr == nil {
-				validationErr = fmt.Errorf("%s, in plugin overrides from %s", validationErr.Error(), pluginOverrideReference)
+				validationErr = fmt.Errorf("%w, in plugin overrides from %s", validationErr, pluginOverrideReference)
 			}
 		}
 	}
 
 	return validationErr
 }
+
+// ruleIDs are the identifiers of the validation rules, as returned by RuleID
+var ruleIDs = []string{
+	"annotations",
+	"archive-sources",
+	"attributes",
+	"commands",
+	"component-order",
+	"components",
+	"configuration-mounts",
+	"container-command",
+	"custom-components",
+	"default-command",
+	"endpoint-ports",
+	"endpoints",
+	"env",
+	"events",
+	"metadata",
+	string(PluginImportReferenceRule),
+	string(PluginOverridesRule),
+	string(PluginRegistryRule),
+	"privileged-ports",
+	"project-remotes",
+	"reserved-env",
+	"resource-requirements",
+	"schema-version",
+	"storage-strategy",
+	"volume-mount-paths",
+	"volume-mounts",
+	"volumes",
+	"zip-sources",
+}
+
+// IsWarning returns true if the given validation error is a warning, which should not prevent from using the devfile,
+// such as a missing default command
+func IsWarning(err error) bool {
+	var missingDefaultCmd *MissingDefaultCmdWarning
+	var volumeMountPathConflict *VolumeMountPathConflictWarning
+	var missingContainerCommand *MissingContainerCommandWarning
+	var invalidAttributeKey *InvalidAttributeKeyWarning
+	var attributesSize *AttributesSizeWarning
+	var privilegedEndpointPort *PrivilegedEndpointPortWarning
+	var invalidStorageTypeAttribute *InvalidStorageTypeAttributeWarning
+	return errors.As(err, &missingDefaultCmd) || errors.As(err, &volumeMountPathConflict) || errors.As(err, &missingContainerCommand) ||
+		errors.As(err, &invalidAttributeKey) || errors.As(err, &attributesSize) || errors.As(err, &privilegedEndpointPort) ||
+		errors.As(err, &invalidStorageTypeAttribute)
+}
+
+// FlattenErrors returns the individual validation errors wrapped in the given error,
+// recursively unwrapping the multi-errors returned by the validation functions.
+func FlattenErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	merr, isMultiError := err.(*multierror.Error)
+	if !isMultiError {
+		return []error{err}
+	}
+	var errs []error
+	for _, wrapped := range merr.WrappedErrors() {
+		errs = append(errs, FlattenErrors(wrapped)...)
+	}
+	return errs
+}
+
+// RuleIDs returns the identifiers of the validation rules, sorted alphabetically
+func RuleIDs() []string {
+	return append([]string{}, ruleIDs...)
+}
+
+// RuleID returns the identifier of the validation rule broken by the given validation error,
+// or an empty string if the error is not a known validation error.
+// The validation errors wrapped in other errors, such as the errors located in the devfile content, are also recognized.
+func RuleID(err error) string {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if rule := ruleID(err); rule != "" {
+			return rule
+		}
+	}
+	return ""
+}
+
+func ruleID(err error) string {
+	switch err := err.(type) {
+	case *InvalidPluginComponentError:
+		return string(err.Rule())
+	case *InvalidEventError:
+		return "events"
+	case *InvalidCommandError, *InvalidCommandTypeError:
+		return "commands"
+	case *MultipleDefaultCmdError, *MissingDefaultCmdWarning:
+		return "default-command"
+	case *ReservedEnvError:
+		return "reserved-env"
+	case *DuplicateEnvError:
+		return "env"
+	case *InvalidStorageStrategyError, *InvalidStorageTypeAttributeWarning:
+		return "storage-strategy"
+	case *InvalidVolumeError:
+		return "volumes"
+	case *InvalidConfigurationMountError:
+		return "configuration-mounts"
+	case *MissingVolumeMountError, *DuplicateVolumeMountPathError:
+		return "volume-mounts"
+	case *VolumeMountPathConflictWarning:
+		return "volume-mount-paths"
+	case *MissingContainerCommandWarning:
+		return "container-command"
+	case *InvalidAttributeKeyWarning, *AttributesSizeWarning:
+		return "attributes"
+	case *InvalidEndpointError:
+		return "endpoints"
+	case *DuplicateEndpointPortError, *ReservedEndpointPortError:
+		return "endpoint-ports"
+	case *PrivilegedEndpointPortWarning:
+		return "privileged-ports"
+	case *InvalidComponentError:
+		return "components"
+	case *DuplicateComponentOrderError:
+		return "component-order"
+	case *InvalidCustomComponentError:
+		return "custom-components"
+	case *MissingProjectRemoteError, *MissingRemoteError, *MultipleRemoteError,
+		*MissingProjectCheckoutFromRemoteError, *InvalidProjectCheckoutRemoteError:
+		return "project-remotes"
+	case *InvalidZipSourceError:
+		return "zip-sources"
+	case *InvalidArchiveSourceError:
+		return "archive-sources"
+	case *ParsingResourceRequirementError, *InvalidResourceRequestError:
+		return "resource-requirements"
+	case *InvalidMetadataError:
+		return "metadata"
+	case *AnnotationConflictError:
+		return "annotations"
+	case *InvalidSchemaVersionError, *UnsupportedFeatureError:
+		return "schema-version"
+	}
+	return ""
+}
+
+// ErrorPath returns the path of the devfile element that the given validation error is about,
+// such as `components[runtime].container.memoryLimit`, or an empty string if the error is not about a single element.
+// The elements of the lists are designated by their name, or by their id for the commands.
+// The validation errors wrapped in other errors are also recognized.
+func ErrorPath(err error) string {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if path := errorPath(err); path != "" {
+			return path
+		}
+	}
+	return ""
+}
+
+func errorPath(err error) string {
+	switch err := err.(type) {
+	case *InvalidPluginComponentError:
+		return fmt.Sprintf("components[%s]", err.componentName)
+	case *InvalidEventError:
+		return "events." + err.eventType
+	case *InvalidCommandError:
+		return fmt.Sprintf("commands[%s]", err.commandId)
+	case *InvalidCommandTypeError:
+		return fmt.Sprintf("commands[%s]", err.commandId)
+	case *MultipleDefaultCmdError, *MissingDefaultCmdWarning:
+		return "commands"
+	case *ReservedEnvError:
+		return fmt.Sprintf("components[%s].container.env[%s]", err.componentName, err.envName)
+	case *DuplicateEnvError:
+		return fmt.Sprintf("env[%s]", err.envName)
+	case *InvalidStorageStrategyError:
+		return "storageStrategy"
+	case *InvalidStorageTypeAttributeWarning:
+		return "attributes." + v1alpha2.StorageTypeAttribute
+	case *InvalidVolumeError:
+		return fmt.Sprintf("components[%s].volume", err.name)
+	case *InvalidConfigurationMountError:
+		return fmt.Sprintf("components[%s].%s", err.componentName, err.componentType)
+	case *DuplicateVolumeMountPathError:
+		return fmt.Sprintf("components[%s].container.volumeMounts", err.componentName)
+	case *VolumeMountPathConflictWarning:
+		return fmt.Sprintf("components[%s]", err.volumeName)
+	case *MissingContainerCommandWarning:
+		return fmt.Sprintf("components[%s].container", err.componentName)
+	case *InvalidAttributeKeyWarning:
+		return attributesPath(err.objectType, err.objectName) + "." + err.key
+	case *AttributesSizeWarning:
+		return attributesPath(err.objectType, err.objectName)
+	case *InvalidComponentError:
+		return fmt.Sprintf("components[%s]", err.componentName)
+	case *DuplicateEndpointPortError:
+		return fmt.Sprintf("components[%s]", err.componentName)
+	case *ReservedEndpointPortError:
+		return fmt.Sprintf("components[%s].%s.endpoints[%s]", err.componentName, err.componentType, err.endpointName)
+	case *PrivilegedEndpointPortWarning:
+		return fmt.Sprintf("components[%s].%s.endpoints[%s]", err.componentName, err.componentType, err.endpointName)
+	case *DuplicateComponentOrderError:
+		return fmt.Sprintf("components[%s].attributes.%s", err.componentNames[len(err.componentNames)-1], v1alpha2.ComponentOrderAttribute)
+	case *InvalidCustomComponentError:
+		return pointerToPath(err.path)
+	case *MissingProjectRemoteError:
+		return fmt.Sprintf("projects[%s]", err.projectName)
+	case *MissingProjectCheckoutFromRemoteError:
+		return fmt.Sprintf("projects[%s]", err.projectName)
+	case *MissingRemoteError:
+		return projectPath(err.objectType, err.objectName)
+	case *MultipleRemoteError:
+		return projectPath(err.objectType, err.objectName)
+	case *InvalidProjectCheckoutRemoteError:
+		return projectPath(err.objectType, err.objectName)
+	case *InvalidZipSourceError:
+		return projectPath(err.objectType, err.objectName) + ".zip"
+	case *InvalidArchiveSourceError:
+		return projectPath(err.objectType, err.objectName) + ".archive"
+	case *ParsingResourceRequirementError:
+		return fmt.Sprintf("components[%s].container.%s", err.cmpName, err.resource)
+	case *InvalidResourceRequestError:
+		return fmt.Sprintf("components[%s].container", err.cmpName)
+	case *InvalidMetadataError:
+		return "metadata." + err.field
+	case *InvalidSchemaVersionError:
+		return "schemaVersion"
+	case *UnsupportedFeatureError:
+		return err.path
+	}
+	return ""
+}
+
+// projectPath returns the path of a project or a starter project
+func projectPath(objectType, objectName string) string {
+	return fmt.Sprintf("%ss[%s]", objectType, objectName)
+}
+
+// attributesPath returns the path of the attributes of an object, as described by attributesOwner
+func attributesPath(objectType, objectName string) string {
+	if objectName == "" {
+		return "attributes"
+	}
+	return fmt.Sprintf("%ss[%s].attributes", objectType, objectName)
+}
+
+// pointerToPath converts the JSON pointer that ends the given path, such as `components[my-component].custom.embeddedResource/spec/replicas`,
+// into path segments, such as `components[my-component].custom.embeddedResource.spec.replicas`
+func pointerToPath(path string) string {
+	segments := strings.Split(path, "/")
+	converted := segments[0]
+	for _, segment := range segments[1:] {
+		segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+		if _, err := strconv.Atoi(segment); err == nil {
+			converted += "[" + segment + "]"
+		} else {
+			converted += "." + segment
+		}
+	}
+	return converted
+}
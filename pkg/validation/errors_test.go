@@ -0,0 +1,222 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/api/v2/pkg/devfile"
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	endpoint := v1alpha2.Endpoint{Name: "http", TargetPort: 8080}
+	importedAttributes := attributes.Attributes{}.PutString(ImportSourceAttribute, "uri: http://example.com/devfile.yaml")
+
+	tests := []struct {
+		name     string
+		validate func() error
+		sentinel error
+	}{
+		{
+			name: "Command referencing a missing component",
+			validate: func() error {
+				return ValidateCommands([]v1alpha2.Command{generateDummyExecCommand("run", "missing", nil)}, nil)
+			},
+			sentinel: devfileerrors.ErrNotFound,
+		},
+		{
+			name: "Imported command referencing a missing component",
+			validate: func() error {
+				command := generateDummyExecCommand("run", "missing", nil)
+				command.Attributes = importedAttributes
+				return ValidateCommands([]v1alpha2.Command{command}, nil)
+			},
+			sentinel: devfileerrors.ErrNotFound,
+		},
+		{
+			name: "Duplicate command ids",
+			validate: func() error {
+				container := generateDummyContainerComponent("runtime", nil, nil, nil, v1alpha2.Annotation{}, false)
+				command := generateDummyExecCommand("run", "runtime", nil)
+				return ValidateCommands([]v1alpha2.Command{command, command}, []v1alpha2.Component{container})
+			},
+			sentinel: devfileerrors.ErrDuplicateKey,
+		},
+		{
+			name: "Duplicate endpoint names",
+			validate: func() error {
+				return ValidateComponents([]v1alpha2.Component{
+					generateDummyContainerComponent("runtime", nil, []v1alpha2.Endpoint{endpoint}, nil, v1alpha2.Annotation{}, false),
+					generateDummyContainerComponent("tools", nil, []v1alpha2.Endpoint{endpoint}, nil, v1alpha2.Annotation{}, false),
+				})
+			},
+			sentinel: devfileerrors.ErrDuplicateKey,
+		},
+		{
+			name: "Duplicate top-level env names",
+			validate: func() error {
+				return ValidateEnv([]v1alpha2.EnvVar{{Name: "A", Value: "a"}, {Name: "A", Value: "b"}})
+			},
+			sentinel: devfileerrors.ErrDuplicateKey,
+		},
+		{
+			name: "Missing volume",
+			validate: func() error {
+				return ValidateComponents([]v1alpha2.Component{
+					generateDummyContainerComponent("runtime", []v1alpha2.VolumeMount{{Name: "missing"}}, nil, nil, v1alpha2.Annotation{}, false),
+				})
+			},
+			sentinel: devfileerrors.ErrNotFound,
+		},
+		{
+			name: "Plugin overrides referencing a missing component",
+			validate: func() error {
+				plugin := v1alpha2.Component{
+					Name: "plugin",
+					ComponentUnion: v1alpha2.ComponentUnion{
+						Plugin: &v1alpha2.PluginComponent{
+							PluginOverrides: v1alpha2.PluginOverrides{
+								Components: []v1alpha2.ComponentPluginOverride{{Name: "missing"}},
+							},
+						},
+					},
+				}
+				return ValidatePluginOverrides(plugin, &v1alpha2.DevWorkspaceTemplateSpecContent{})
+			},
+			sentinel: devfileerrors.ErrNotFound,
+		},
+		{
+			name: "Schema version out of range",
+			validate: func() error {
+				return ValidateSchemaVersion("3.0.0")
+			},
+			sentinel: devfileerrors.ErrUnsupportedVersion,
+		},
+		{
+			name: "Feature newer than the schema version",
+			validate: func() error {
+				return ValidateFeatures(&v1alpha2.Devfile{
+					DevfileHeader: devfile.DevfileHeader{SchemaVersion: "2.0.0"},
+					DevWorkspaceTemplateSpec: v1alpha2.DevWorkspaceTemplateSpec{
+						DevWorkspaceTemplateSpecContent: v1alpha2.DevWorkspaceTemplateSpecContent{
+							Variables: map[string]string{"version": "14"},
+						},
+					},
+				})
+			},
+			sentinel: devfileerrors.ErrUnsupportedVersion,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validate()
+			if assert.Error(t, err) {
+				assert.True(t, errors.Is(err, tt.sentinel), "error %q should wrap %q", err, tt.sentinel)
+			}
+		})
+	}
+}
+
+func TestErrorPath(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedPath string
+	}{
+		{
+			name:         "Command error",
+			err:          &InvalidCommandError{commandId: "run", reason: "invalid"},
+			expectedPath: "commands[run]",
+		},
+		{
+			name:         "Resource requirement error",
+			err:          &ParsingResourceRequirementError{resource: MemoryLimit, cmpName: "runtime"},
+			expectedPath: "components[runtime].container.memoryLimit",
+		},
+		{
+			name:         "Starter project zip source error",
+			err:          &InvalidZipSourceError{objectType: "starterProject", objectName: "sample"},
+			expectedPath: "starterProjects[sample].zip",
+		},
+		{
+			name:         "Top-level attribute key warning",
+			err:          &InvalidAttributeKeyWarning{objectType: "devfile", key: "-invalid"},
+			expectedPath: "attributes.-invalid",
+		},
+		{
+			name:         "Duplicate component order error",
+			err:          &DuplicateComponentOrderError{componentType: "container", order: 1, componentNames: []string{"runtime", "tools"}},
+			expectedPath: "components[tools].attributes.api.devfile.io/order",
+		},
+		{
+			name:         "Configuration mount error",
+			err:          &InvalidConfigurationMountError{componentName: "certs", componentType: "secret", reason: "mount path \"certs\" must be absolute"},
+			expectedPath: "components[certs].secret",
+		},
+		{
+			name:         "Privileged endpoint port warning",
+			err:          &PrivilegedEndpointPortWarning{componentName: "runtime", componentType: "container", endpointName: "http", port: 80},
+			expectedPath: "components[runtime].container.endpoints[http]",
+		},
+		{
+			name:         "Custom component error",
+			err:          &InvalidCustomComponentError{componentName: "custom", path: "components[custom].custom.embeddedResource/spec/containers/0/a~1b"},
+			expectedPath: "components[custom].custom.embeddedResource.spec.containers[0].a/b",
+		},
+		{
+			name:         "Wrapped error",
+			err:          fmt.Errorf("%w, imported from uri: http://example.com/devfile.yaml", &InvalidEventError{eventType: "preStart"}),
+			expectedPath: "events.preStart",
+		},
+		{
+			name: "Error about several elements",
+			err:  &MissingVolumeMountError{errMsg: "missing"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedPath, ErrorPath(tt.err))
+		})
+	}
+}
+
+func TestFlattenErrors(t *testing.T) {
+	missingDefaultCmd := &MissingDefaultCmdWarning{groupKind: v1alpha2.BuildCommandGroupKind}
+	invalidEvent := &InvalidEventError{eventType: "preStart", errorMsg: "invalid"}
+	invalidCommand := &InvalidCommandError{commandId: "run", reason: "invalid"}
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedErrors []error
+	}{
+		{
+			name: "No error",
+		},
+		{
+			name:           "Single error",
+			err:            invalidEvent,
+			expectedErrors: []error{invalidEvent},
+		},
+		{
+			name:           "Multi-error",
+			err:            multierror.Append(invalidEvent, missingDefaultCmd),
+			expectedErrors: []error{invalidEvent, missingDefaultCmd},
+		},
+		{
+			name:           "Nested multi-errors",
+			err:            &multierror.Error{Errors: []error{&multierror.Error{Errors: []error{invalidEvent, missingDefaultCmd}}, invalidCommand}},
+			expectedErrors: []error{invalidEvent, missingDefaultCmd, invalidCommand},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedErrors, FlattenErrors(tt.err))
+		})
+	}
+}
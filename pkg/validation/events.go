@@ -49,10 +49,18 @@ func ValidateEvents(events v1alpha2.Events, commands []v1alpha2.Command) (err er
 // 1. event should map to a valid devfile command
 // 2. preStart and postStop events should either map to an apply command or a composite command with apply commands
 // 3. postStart and preStop events should either map to an exec command or a composite command with exec commands
+// 4. a command should be bound at most once to each event type, since the commands of an event type run in order
 func isEventValid(eventNames []string, eventType string, commandMap map[string]v1alpha2.Command) error {
-	var invalidCommand, invalidApplyEvents, invalidExecEvents []string
+	var invalidCommand, invalidApplyEvents, invalidExecEvents, duplicateEvents []string
 
+	boundEvents := map[string]bool{}
 	for _, eventName := range eventNames {
+		if boundEvents[strings.ToLower(eventName)] {
+			duplicateEvents = append(duplicateEvents, eventName)
+			continue
+		}
+		boundEvents[strings.ToLower(eventName)] = true
+
 		command, ok := commandMap[strings.ToLower(eventName)]
 		if !ok { // check if event is in the list of devfile commands
 			invalidCommand = append(invalidCommand, eventName)
@@ -92,6 +100,10 @@ func isEventValid(eventNames []string, eventType string, commandMap map[string]v
 		eventErrorsList = append(eventErrorsList, fmt.Sprintf("%s should either map to an exec command or a composite command with exec commands", strings.Join(invalidExecEvents, ", ")))
 	}
 
+	if len(duplicateEvents) > 0 {
+		eventErrorsList = append(eventErrorsList, fmt.Sprintf("%s should only be bound once to the event type", strings.Join(duplicateEvents, ", ")))
+	}
+
 	if len(eventErrorsList) != 0 {
 		eventErrors := fmt.Sprintf("\n%s", strings.Join(eventErrorsList, "\n"))
 		err = &InvalidEventError{eventType: eventType, errorMsg: eventErrors}
@@ -147,6 +147,7 @@ func TestIsEventValid(t *testing.T) {
 	missingCmdErr := "does not map to a valid devfile command"
 	applyCmdErr := "should either map to an apply command or a composite command with apply commands"
 	execCmdErr := "should either map to an exec command or a composite command with exec commands"
+	duplicateCmdErr := "Exec1 should only be bound once to the event type"
 
 	tests := []struct {
 		name       string
@@ -222,6 +223,16 @@ func TestIsEventValid(t *testing.T) {
 			},
 			wantErr: &missingCmdErr,
 		},
+		{
+			name:      "Invalid events - Command bound twice",
+			eventType: postStart,
+			eventNames: []string{
+				"exec1",
+				"exec2",
+				"Exec1",
+			},
+			wantErr: &duplicateCmdErr,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,92 @@
+package validation
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/devfile"
+	"github.com/hashicorp/go-multierror"
+)
+
+// semverRegexp matches the semver-compatible versions accepted in the devfile metadata
+var semverRegexp = regexp.MustCompile(`^([0-9]+)\.([0-9]+)\.([0-9]+)(\-[0-9a-z-]+(\.[0-9a-z-]+)*)?(\+[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?$`)
+
+// ValidateMetadata validates that the devfile metadata
+// 1. makes sure the version is semver-compatible
+// 2. makes sure the icon is a valid URI, and the website and support URL are valid http(s) URLs
+// 3. makes sure the tags are not blank, don't have leading or trailing spaces, and are unique
+// 4. makes sure the architectures are supported and unique
+func ValidateMetadata(metadata devfile.DevfileMetadata) (returnedErr error) {
+	if metadata.Version != "" && !semverRegexp.MatchString(metadata.Version) {
+		returnedErr = multierror.Append(returnedErr, &InvalidMetadataError{field: "version", reason: fmt.Sprintf("%q is not a semver-compatible version", metadata.Version)})
+	}
+
+	if metadata.Icon != "" {
+		if err := ValidateURI(metadata.Icon); err != nil {
+			returnedErr = multierror.Append(returnedErr, &InvalidMetadataError{field: "icon", reason: err.Error()})
+		}
+	}
+	for _, urlField := range []struct {
+		name  string
+		value string
+	}{
+		{name: "website", value: metadata.Website},
+		{name: "supportUrl", value: metadata.SupportUrl},
+	} {
+		if urlField.value == "" {
+			continue
+		}
+		if err := validateHTTPURL(urlField.value); err != nil {
+			returnedErr = multierror.Append(returnedErr, &InvalidMetadataError{field: urlField.name, reason: err.Error()})
+		}
+	}
+
+	processedTags := make(map[string]bool)
+	for _, tag := range metadata.Tags {
+		switch {
+		case strings.TrimSpace(tag) == "":
+			returnedErr = multierror.Append(returnedErr, &InvalidMetadataError{field: "tags", reason: "tags cannot be blank"})
+		case strings.TrimSpace(tag) != tag:
+			returnedErr = multierror.Append(returnedErr, &InvalidMetadataError{field: "tags", reason: fmt.Sprintf("tag %q has leading or trailing spaces", tag)})
+		case processedTags[strings.ToLower(tag)]:
+			returnedErr = multierror.Append(returnedErr, &InvalidMetadataError{field: "tags", reason: fmt.Sprintf("tag %q is duplicated", tag)})
+		}
+		processedTags[strings.ToLower(tag)] = true
+	}
+
+	processedArchitectures := make(map[devfile.Architecture]bool)
+	for _, architecture := range metadata.Architectures {
+		if !architecture.IsValid() {
+			returnedErr = multierror.Append(returnedErr, &InvalidMetadataError{field: "architectures", reason: fmt.Sprintf("architecture %q is not supported, should be one of: %s",
+				architecture, joinArchitectures(architecture.Values()))})
+		}
+		if processedArchitectures[architecture] {
+			returnedErr = multierror.Append(returnedErr, &InvalidMetadataError{field: "architectures", reason: fmt.Sprintf("architecture %q is duplicated", architecture)})
+		}
+		processedArchitectures[architecture] = true
+	}
+
+	return returnedErr
+}
+
+// validateHTTPURL checks if the string is an absolute http or https URL, return error if not valid
+func validateHTTPURL(value string) error {
+	parsed, err := url.ParseRequestURI(value)
+	if err != nil {
+		return err
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%q should be an absolute http or https URL", value)
+	}
+	return nil
+}
+
+func joinArchitectures(architectures []devfile.Architecture) string {
+	names := make([]string, 0, len(architectures))
+	for _, architecture := range architectures {
+		names = append(names, string(architecture))
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,91 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/devfile"
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMetadata(t *testing.T) {
+
+	invalidVersionErr := "the metadata field \"version\" is invalid - \"1.0\" is not a semver-compatible version"
+	invalidIconErr := "the metadata field \"icon\" is invalid - .*"
+	invalidWebsiteErr := "the metadata field \"website\" is invalid - .*"
+	invalidSupportUrlErr := "the metadata field \"supportUrl\" is invalid - \"ftp://example.com/support\" should be an absolute http or https URL"
+	blankTagErr := "the metadata field \"tags\" is invalid - tags cannot be blank"
+	spacedTagErr := "the metadata field \"tags\" is invalid - tag \" Java\" has leading or trailing spaces"
+	duplicateTagErr := "the metadata field \"tags\" is invalid - tag \"Java\" is duplicated"
+	unsupportedArchitectureErr := "the metadata field \"architectures\" is invalid - architecture \"x86\" is not supported, should be one of: amd64, arm64, ppc64le, s390x"
+	duplicateArchitectureErr := "the metadata field \"architectures\" is invalid - architecture \"amd64\" is duplicated"
+
+	tests := []struct {
+		name     string
+		metadata devfile.DevfileMetadata
+		wantErr  []string
+	}{
+		{
+			name:     "Valid empty metadata",
+			metadata: devfile.DevfileMetadata{},
+		},
+		{
+			name: "Valid metadata",
+			metadata: devfile.DevfileMetadata{
+				Name:          "nodejs",
+				Version:       "1.0.2-beta.1+build.5",
+				Icon:          "images/nodejs.svg",
+				Website:       "https://nodejs.org",
+				SupportUrl:    "http://example.com/support",
+				Tags:          []string{"Node.js", "Express", "ubi8"},
+				Architectures: []devfile.Architecture{devfile.AMD64, devfile.ARM64},
+			},
+		},
+		{
+			name: "Invalid version",
+			metadata: devfile.DevfileMetadata{
+				Version: "1.0",
+			},
+			wantErr: []string{invalidVersionErr},
+		},
+		{
+			name: "Invalid URLs",
+			metadata: devfile.DevfileMetadata{
+				Icon:       "http://[::1]:namedport/icon.svg",
+				Website:    "example.com",
+				SupportUrl: "ftp://example.com/support",
+			},
+			wantErr: []string{invalidIconErr, invalidWebsiteErr, invalidSupportUrlErr},
+		},
+		{
+			name: "Invalid tags",
+			metadata: devfile.DevfileMetadata{
+				Tags: []string{"java", " ", " Java", "Java"},
+			},
+			wantErr: []string{blankTagErr, spacedTagErr, duplicateTagErr},
+		},
+		{
+			name: "Invalid architectures",
+			metadata: devfile.DevfileMetadata{
+				Architectures: []devfile.Architecture{devfile.AMD64, "x86", devfile.AMD64},
+			},
+			wantErr: []string{unsupportedArchitectureErr, duplicateArchitectureErr},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMetadata(tt.metadata)
+
+			if merr, ok := err.(*multierror.Error); ok && tt.wantErr != nil {
+				if assert.Equal(t, len(tt.wantErr), len(merr.Errors), "Error list length should match") {
+					for i := 0; i < len(merr.Errors); i++ {
+						assert.Regexp(t, tt.wantErr[i], merr.Errors[i].Error(), "Error message should match")
+					}
+				}
+			} else {
+				assert.Equal(t, nil, err, "Error should be nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// validatePluginComponent validates that the plugin component
+// 1. has exactly one of `uri`, `id` or `kubernetes` set
+// 2. only sets `registryUrl` and `version` along with `id`
+// 3. doesn't override the same component or command twice
+func validatePluginComponent(name string, plugin *v1alpha2.PluginComponent) (errList []error) {
+	var setReferences []string
+	if plugin.Uri != "" {
+		setReferences = append(setReferences, "uri")
+	}
+	if plugin.Id != "" {
+		setReferences = append(setReferences, "id")
+	}
+	if plugin.Kubernetes != nil {
+		setReferences = append(setReferences, "kubernetes")
+	}
+	switch len(setReferences) {
+	case 0:
+		errList = append(errList, &InvalidPluginComponentError{componentName: name, rule: PluginImportReferenceRule,
+			reason: "one of uri, id or kubernetes must be set"})
+	case 1:
+	default:
+		errList = append(errList, &InvalidPluginComponentError{componentName: name, rule: PluginImportReferenceRule,
+			reason: fmt.Sprintf("only one of uri, id or kubernetes can be set, but found: %s", strings.Join(setReferences, ", "))})
+	}
+
+	if plugin.Id == "" {
+		if plugin.RegistryUrl != "" {
+			errList = append(errList, &InvalidPluginComponentError{componentName: name, rule: PluginRegistryRule,
+				reason: "registryUrl can only be set along with id"})
+		}
+		if plugin.Version != "" {
+			errList = append(errList, &InvalidPluginComponentError{componentName: name, rule: PluginRegistryRule,
+				reason: "version can only be set along with id"})
+		}
+	}
+
+	if err := v1alpha2.CheckDuplicateKeys(plugin.Components); err != nil {
+		errList = append(errList, &InvalidPluginComponentError{componentName: name, rule: PluginOverridesRule,
+			reason: fmt.Sprintf("component overrides contain a %s", err), cause: err})
+	}
+	if err := v1alpha2.CheckDuplicateKeys(plugin.Commands); err != nil {
+		errList = append(errList, &InvalidPluginComponentError{componentName: name, rule: PluginOverridesRule,
+			reason: fmt.Sprintf("command overrides contain a %s", err), cause: err})
+	}
+	return errList
+}
+
+// ValidatePluginOverrides validates that the overrides of the given plugin component only reference
+// components and commands defined by the plugin, whose resolved content is given.
+// It should be called before applying the plugin overrides on the plugin content.
+func ValidatePluginOverrides(component v1alpha2.Component, pluginContent *v1alpha2.DevWorkspaceTemplateSpecContent) (returnedErr error) {
+	if component.Plugin == nil || pluginContent == nil {
+		return nil
+	}
+
+	definedComponents := map[string]bool{}
+	for _, pluginComponent := range pluginContent.Components {
+		definedComponents[pluginComponent.Name] = true
+	}
+	definedCommands := map[string]bool{}
+	for _, pluginCommand := range pluginContent.Commands {
+		definedCommands[pluginCommand.Id] = true
+	}
+
+	for _, override := range component.Plugin.Components {
+		if !definedComponents[override.Name] {
+			pluginErr := &InvalidPluginComponentError{componentName: component.Name, rule: PluginOverridesRule,
+				reason: fmt.Sprintf("overridden component %q is not defined by the plugin", override.Name), cause: devfileerrors.ErrNotFound}
+			returnedErr = multierror.Append(returnedErr, resolveErrorMessageWithImportAttributes(pluginErr, component.Attributes))
+		}
+	}
+	for _, override := range component.Plugin.Commands {
+		if !definedCommands[override.Id] {
+			pluginErr := &InvalidPluginComponentError{componentName: component.Name, rule: PluginOverridesRule,
+				reason: fmt.Sprintf("overridden command %q is not defined by the plugin", override.Id), cause: devfileerrors.ErrNotFound}
+			returnedErr = multierror.Append(returnedErr, resolveErrorMessageWithImportAttributes(pluginErr, component.Attributes))
+		}
+	}
+	return returnedErr
+}
@@ -0,0 +1,161 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+)
+
+// generateDummyPluginComponentWithReference returns a dummy Plugin component with the given import reference for testing
+func generateDummyPluginComponentWithReference(name string, ref v1alpha2.ImportReference, overrides v1alpha2.PluginOverrides) v1alpha2.Component {
+	return v1alpha2.Component{
+		Name: name,
+		ComponentUnion: v1alpha2.ComponentUnion{
+			Plugin: &v1alpha2.PluginComponent{
+				ImportReference: ref,
+				PluginOverrides: overrides,
+			},
+		},
+	}
+}
+
+func TestValidatePluginComponents(t *testing.T) {
+
+	uriReference := v1alpha2.ImportReference{ImportReferenceUnion: v1alpha2.ImportReferenceUnion{Uri: "http://example.com/plugin.yaml"}}
+
+	tests := []struct {
+		name      string
+		component v1alpha2.Component
+		wantErr   []string
+		wantRules []PluginRule
+	}{
+		{
+			name:      "Valid plugin component with uri",
+			component: generateDummyPluginComponentWithReference("plugin", uriReference, v1alpha2.PluginOverrides{}),
+		},
+		{
+			name: "Valid plugin component with id, registry url and version",
+			component: generateDummyPluginComponentWithReference("plugin", v1alpha2.ImportReference{
+				ImportReferenceUnion: v1alpha2.ImportReferenceUnion{Id: "java-plugin"},
+				RegistryUrl:          "http://registry.example.com",
+				Version:              "1.0.0",
+			}, v1alpha2.PluginOverrides{}),
+		},
+		{
+			name:      "Plugin component without import reference",
+			component: generateDummyPluginComponentWithReference("plugin", v1alpha2.ImportReference{}, v1alpha2.PluginOverrides{}),
+			wantErr:   []string{"the plugin component \"plugin\" is invalid \\(plugin-import-reference\\) - one of uri, id or kubernetes must be set"},
+			wantRules: []PluginRule{PluginImportReferenceRule},
+		},
+		{
+			name: "Plugin component with several import references",
+			component: generateDummyPluginComponentWithReference("plugin", v1alpha2.ImportReference{
+				ImportReferenceUnion: v1alpha2.ImportReferenceUnion{
+					Uri:        "http://example.com/plugin.yaml",
+					Kubernetes: &v1alpha2.KubernetesCustomResourceImportReference{Name: "plugin"},
+				},
+			}, v1alpha2.PluginOverrides{}),
+			wantErr:   []string{"only one of uri, id or kubernetes can be set, but found: uri, kubernetes"},
+			wantRules: []PluginRule{PluginImportReferenceRule},
+		},
+		{
+			name: "Plugin component with registry url and version but no id",
+			component: generateDummyPluginComponentWithReference("plugin", v1alpha2.ImportReference{
+				ImportReferenceUnion: v1alpha2.ImportReferenceUnion{Uri: "http://example.com/plugin.yaml"},
+				RegistryUrl:          "http://registry.example.com",
+				Version:              "1.0.0",
+			}, v1alpha2.PluginOverrides{}),
+			wantErr: []string{
+				"\\(plugin-registry\\) - registryUrl can only be set along with id",
+				"\\(plugin-registry\\) - version can only be set along with id",
+			},
+			wantRules: []PluginRule{PluginRegistryRule, PluginRegistryRule},
+		},
+		{
+			name: "Plugin component with duplicate overrides",
+			component: generateDummyPluginComponentWithReference("plugin", uriReference, v1alpha2.PluginOverrides{
+				Components: []v1alpha2.ComponentPluginOverride{{Name: "tools"}, {Name: "tools"}},
+				Commands:   []v1alpha2.CommandPluginOverride{{Id: "build"}, {Id: "build"}},
+			}),
+			wantErr: []string{
+				"\\(plugin-overrides\\) - component overrides contain a duplicate key: tools",
+				"\\(plugin-overrides\\) - command overrides contain a duplicate key: build",
+			},
+			wantRules: []PluginRule{PluginOverridesRule, PluginOverridesRule},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateComponents([]v1alpha2.Component{tt.component})
+
+			if merr, ok := err.(*multierror.Error); ok && tt.wantErr != nil {
+				if assert.Equal(t, len(tt.wantErr), len(merr.Errors), "Error list length should match") {
+					for i := 0; i < len(merr.Errors); i++ {
+						assert.Regexp(t, tt.wantErr[i], merr.Errors[i].Error(), "Error message should match")
+						if pluginErr, isPluginErr := merr.Errors[i].(*InvalidPluginComponentError); assert.True(t, isPluginErr, "Error should be a plugin component error") {
+							assert.Equal(t, tt.wantRules[i], pluginErr.Rule(), "Error rule should match")
+						}
+					}
+				}
+			} else {
+				assert.Equal(t, nil, err, "Error should be nil")
+			}
+		})
+	}
+}
+
+func TestValidatePluginOverrides(t *testing.T) {
+
+	uriReference := v1alpha2.ImportReference{ImportReferenceUnion: v1alpha2.ImportReferenceUnion{Uri: "http://example.com/plugin.yaml"}}
+	pluginContent := &v1alpha2.DevWorkspaceTemplateSpecContent{
+		Components: []v1alpha2.Component{generateDummyContainerComponent("tools", nil, nil, nil, v1alpha2.Annotation{}, false)},
+		Commands:   []v1alpha2.Command{generateDummyExecCommand("build", "tools", nil)},
+	}
+
+	tests := []struct {
+		name      string
+		component v1alpha2.Component
+		wantErr   []string
+	}{
+		{
+			name: "Overrides of elements defined by the plugin",
+			component: generateDummyPluginComponentWithReference("plugin", uriReference, v1alpha2.PluginOverrides{
+				Components: []v1alpha2.ComponentPluginOverride{{Name: "tools"}},
+				Commands:   []v1alpha2.CommandPluginOverride{{Id: "build"}},
+			}),
+		},
+		{
+			name: "Overrides of elements not defined by the plugin",
+			component: func() v1alpha2.Component {
+				component := generateDummyPluginComponentWithReference("plugin", uriReference, v1alpha2.PluginOverrides{
+					Components: []v1alpha2.ComponentPluginOverride{{Name: "runtime"}},
+					Commands:   []v1alpha2.CommandPluginOverride{{Id: "run"}},
+				})
+				component.Attributes = attributes.Attributes{}.PutString(ImportSourceAttribute, "uri: http://127.0.0.1:8080")
+				return component
+			}(),
+			wantErr: []string{
+				"the plugin component \"plugin\" is invalid \\(plugin-overrides\\) - overridden component \"runtime\" is not defined by the plugin, imported from uri: http://127.0.0.1:8080",
+				"the plugin component \"plugin\" is invalid \\(plugin-overrides\\) - overridden command \"run\" is not defined by the plugin, imported from uri: http://127.0.0.1:8080",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePluginOverrides(tt.component, pluginContent)
+
+			if merr, ok := err.(*multierror.Error); ok && tt.wantErr != nil {
+				if assert.Equal(t, len(tt.wantErr), len(merr.Errors), "Error list length should match") {
+					for i := 0; i < len(merr.Errors); i++ {
+						assert.Regexp(t, tt.wantErr[i], merr.Errors[i].Error(), "Error message should match")
+					}
+				}
+			} else {
+				assert.Equal(t, nil, err, "Error should be nil")
+			}
+		})
+	}
+}
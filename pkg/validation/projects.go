@@ -1,23 +1,35 @@
 package validation
 
 import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/hashicorp/go-multierror"
 )
 
+// sha256Regexp matches the hexadecimal SHA-256 checksums of the zip and archive sources
+var sha256Regexp = regexp.MustCompile(`^[a-fA-F0-9]{64}$`)
+
 // ValidateStarterProjects checks if starter project has only one remote configured
-// and if the checkout remote matches the remote configured
+// and if the checkout remote matches the remote configured.
+// It also checks that the zip and archive sources have a valid location and checksum.
 func ValidateStarterProjects(starterProjects []v1alpha2.StarterProject) (returnedErr error) {
 
 	for _, starterProject := range starterProjects {
-		var gitSource v1alpha2.GitLikeProjectSource
-		if starterProject.Git != nil {
-			gitSource = starterProject.Git.GitLikeProjectSource
-		} else {
-			continue
+		var starterProjectErr error
+		switch {
+		case starterProject.Git != nil:
+			starterProjectErr = validateSingleRemoteGitSrc("starterProject", starterProject.Name, starterProject.Git.GitLikeProjectSource)
+		case starterProject.Zip != nil:
+			starterProjectErr = validateZipSrc("starterProject", starterProject.Name, *starterProject.Zip)
+		case starterProject.Archive != nil:
+			starterProjectErr = validateArchiveSrc("starterProject", starterProject.Name, *starterProject.Archive)
 		}
 
-		if starterProjectErr := validateSingleRemoteGitSrc("starterProject", starterProject.Name, gitSource); starterProjectErr != nil {
+		if starterProjectErr != nil {
 			newErr := resolveErrorMessageWithImportAttributes(starterProjectErr, starterProject.Attributes)
 			returnedErr = multierror.Append(returnedErr, newErr)
 		}
@@ -27,10 +39,26 @@ func ValidateStarterProjects(starterProjects []v1alpha2.StarterProject) (returne
 }
 
 // ValidateProjects checks if the project has more than one remote configured then a checkout
-// remote is mandatory and if the checkout remote matches the renote configured
+// remote is mandatory and if the checkout remote matches the renote configured.
+// It also checks that the zip and archive sources have a valid location and checksum.
 func ValidateProjects(projects []v1alpha2.Project) (returnedErr error) {
 
 	for _, project := range projects {
+		if project.Zip != nil {
+			if err := validateZipSrc("project", project.Name, *project.Zip); err != nil {
+				newErr := resolveErrorMessageWithImportAttributes(err, project.Attributes)
+				returnedErr = multierror.Append(returnedErr, newErr)
+			}
+			continue
+		}
+		if project.Archive != nil {
+			if err := validateArchiveSrc("project", project.Name, *project.Archive); err != nil {
+				newErr := resolveErrorMessageWithImportAttributes(err, project.Attributes)
+				returnedErr = multierror.Append(returnedErr, newErr)
+			}
+			continue
+		}
+
 		var gitSource v1alpha2.GitLikeProjectSource
 		if project.Git != nil {
 			gitSource = project.Git.GitLikeProjectSource
@@ -92,3 +120,81 @@ func validateSingleRemoteGitSrc(objectType, objectName string, gitSource v1alpha
 
 	return err
 }
+
+// validateZipSrc validates that a zip source has a location, which is either an http, https or file URL, or a relative path,
+// and that its checksum, if any, is a valid SHA-256 checksum
+func validateZipSrc(objectType, objectName string, zipSource v1alpha2.ZipProjectSource) error {
+	if err := validateSourceLocation(zipSource.Location); err != nil {
+		return &InvalidZipSourceError{objectType: objectType, objectName: objectName, reason: err.Error()}
+	}
+
+	if zipSource.Sha256 != "" && !sha256Regexp.MatchString(zipSource.Sha256) {
+		return &InvalidZipSourceError{objectType: objectType, objectName: objectName,
+			reason: fmt.Sprintf("the sha256 checksum %q should have 64 hexadecimal characters", zipSource.Sha256)}
+	}
+	return nil
+}
+
+// validateArchiveSrc validates that an archive source has a location, which is either an http, https or file URL, or a relative path,
+// a valid SHA-256 checksum, a format that is set or can be inferred from the location, and a positive number of stripped path components
+func validateArchiveSrc(objectType, objectName string, archiveSource v1alpha2.ArchiveProjectSource) error {
+	invalidArchiveSource := func(reason string) error {
+		return &InvalidArchiveSourceError{objectType: objectType, objectName: objectName, reason: reason}
+	}
+
+	if err := validateSourceLocation(archiveSource.Location); err != nil {
+		return invalidArchiveSource(err.Error())
+	}
+	if archiveSource.Format != "" && !archiveSource.Format.IsValid() {
+		return invalidArchiveSource(fmt.Sprintf("the format %q should be one of: %s", archiveSource.Format, joinArchiveFormats(archiveSource.Format.Values())))
+	}
+	if _, err := archiveSource.EffectiveFormat(); err != nil {
+		return invalidArchiveSource(err.Error())
+	}
+	if archiveSource.Sha256 == "" {
+		return invalidArchiveSource("the sha256 checksum is required")
+	}
+	if !sha256Regexp.MatchString(archiveSource.Sha256) {
+		return invalidArchiveSource(fmt.Sprintf("the sha256 checksum %q should have 64 hexadecimal characters", archiveSource.Sha256))
+	}
+	if archiveSource.StripComponents < 0 {
+		return invalidArchiveSource(fmt.Sprintf("the number of stripped path components %d should not be negative", archiveSource.StripComponents))
+	}
+	return nil
+}
+
+// validateSourceLocation validates that the location of a zip or archive source is either an http, https or file URL, or a relative path
+func validateSourceLocation(sourceLocation string) error {
+	if sourceLocation == "" {
+		return fmt.Errorf("the location is required")
+	}
+
+	location, err := url.Parse(sourceLocation)
+	if err != nil {
+		return err
+	}
+	switch location.Scheme {
+	case "":
+		// relative path
+	case "http", "https":
+		if err := validateHTTPURL(sourceLocation); err != nil {
+			return err
+		}
+	case "file":
+		if location.Path == "" && location.Opaque == "" {
+			return fmt.Errorf("the location %q has no file path", sourceLocation)
+		}
+	default:
+		return fmt.Errorf("the location %q should be an http, https or file URL, or a relative path", sourceLocation)
+	}
+	return nil
+}
+
+// joinArchiveFormats returns the given archive formats, separated by commas
+func joinArchiveFormats(formats []v1alpha2.ArchiveFormat) string {
+	var names []string
+	for _, format := range formats {
+		names = append(names, string(format))
+	}
+	return strings.Join(names, ", ")
+}
@@ -40,6 +40,27 @@ func generateDummyGitProject(name string, checkoutRemote *v1alpha2.CheckoutFrom,
 	}
 }
 
+func generateDummyZipStarterProject(name string, location string, sha256 string) v1alpha2.StarterProject {
+	return v1alpha2.StarterProject{
+		Name: name,
+		ProjectSource: v1alpha2.ProjectSource{
+			Zip: &v1alpha2.ZipProjectSource{
+				Location: location,
+				Sha256:   sha256,
+			},
+		},
+	}
+}
+
+func generateDummyArchiveStarterProject(name string, archiveSource v1alpha2.ArchiveProjectSource) v1alpha2.StarterProject {
+	return v1alpha2.StarterProject{
+		Name: name,
+		ProjectSource: v1alpha2.ProjectSource{
+			Archive: &archiveSource,
+		},
+	}
+}
+
 func TestValidateStarterProjects(t *testing.T) {
 
 	oneRemoteErr := "starterProject .* should have one remote only"
@@ -96,6 +117,56 @@ func TestValidateStarterProjects(t *testing.T) {
 			},
 			wantErr: []string{atleastOneRemoteErr, oneRemoteErr},
 		},
+		{
+			name: "Valid zip Starter Projects",
+			starterProjects: []v1alpha2.StarterProject{
+				generateDummyZipStarterProject("project1", "https://github.com/devfile-samples/nodejs/archive/main.zip", "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"),
+				generateDummyZipStarterProject("project2", "file:///tmp/project.zip", ""),
+				generateDummyZipStarterProject("project3", "project.zip", ""),
+			},
+		},
+		{
+			name: "Invalid zip Starter Projects",
+			starterProjects: []v1alpha2.StarterProject{
+				generateDummyZipStarterProject("project1", "", ""),
+				generateDummyZipStarterProject("project2", "ftp://example.com/project.zip", ""),
+				generateDummyZipStarterProject("project3", "https:///project.zip", ""),
+				generateDummyZipStarterProject("project4", "https://example.com/project.zip", "not-a-checksum"),
+			},
+			wantErr: []string{
+				"starterProject project1 has an invalid zip source: the location is required",
+				"starterProject project2 has an invalid zip source: the location \"ftp://example.com/project.zip\" should be an http, https or file URL, or a relative path",
+				"starterProject project3 has an invalid zip source: \"https:///project.zip\" should be an absolute http or https URL",
+				"starterProject project4 has an invalid zip source: the sha256 checksum \"not-a-checksum\" should have 64 hexadecimal characters",
+			},
+		},
+		{
+			name: "Valid archive Starter Projects",
+			starterProjects: []v1alpha2.StarterProject{
+				generateDummyArchiveStarterProject("project1", v1alpha2.ArchiveProjectSource{Location: "https://artifacts.example.com/nodejs/1.0.0/nodejs.tar.gz", Sha256: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", StripComponents: 1}),
+				generateDummyArchiveStarterProject("project2", v1alpha2.ArchiveProjectSource{Location: "https://artifacts.example.com/nodejs?version=1.0.0", Sha256: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", Format: v1alpha2.ZipArchiveFormat}),
+				generateDummyArchiveStarterProject("project3", v1alpha2.ArchiveProjectSource{Location: "project.tgz", Sha256: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"}),
+			},
+		},
+		{
+			name: "Invalid archive Starter Projects",
+			starterProjects: []v1alpha2.StarterProject{
+				generateDummyArchiveStarterProject("project1", v1alpha2.ArchiveProjectSource{Location: "ftp://example.com/project.tar", Sha256: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"}),
+				generateDummyArchiveStarterProject("project2", v1alpha2.ArchiveProjectSource{Location: "https://example.com/project", Sha256: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"}),
+				generateDummyArchiveStarterProject("project3", v1alpha2.ArchiveProjectSource{Location: "https://example.com/project", Sha256: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", Format: "rar"}),
+				generateDummyArchiveStarterProject("project4", v1alpha2.ArchiveProjectSource{Location: "https://example.com/project.tar"}),
+				generateDummyArchiveStarterProject("project5", v1alpha2.ArchiveProjectSource{Location: "https://example.com/project.tar", Sha256: "not-a-checksum"}),
+				generateDummyArchiveStarterProject("project6", v1alpha2.ArchiveProjectSource{Location: "https://example.com/project.tar", Sha256: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", StripComponents: -1}),
+			},
+			wantErr: []string{
+				"starterProject project1 has an invalid archive source: the location \"ftp://example.com/project.tar\" should be an http, https or file URL, or a relative path",
+				"starterProject project2 has an invalid archive source: the format of the archive \"https://example.com/project\" cannot be inferred from its extension, and should be set",
+				"starterProject project3 has an invalid archive source: the format \"rar\" should be one of: tar, tar.gz, tar.bz2, tar.xz, zip",
+				"starterProject project4 has an invalid archive source: the sha256 checksum is required",
+				"starterProject project5 has an invalid archive source: the sha256 checksum \"not-a-checksum\" should have 64 hexadecimal characters",
+				"starterProject project6 has an invalid archive source: the number of stripped path components -1 should not be negative",
+			},
+		},
 		{
 			name: "Invalid Starter Project due to wrong checkout with import source attributes",
 			starterProjects: []v1alpha2.StarterProject{
@@ -178,6 +249,30 @@ func TestValidateProjects(t *testing.T) {
 			},
 			wantErr: []string{atleastOneRemoteErr, wrongCheckoutErr},
 		},
+		{
+			name: "Invalid zip Project",
+			projects: []v1alpha2.Project{
+				{
+					Name: "project1",
+					ProjectSource: v1alpha2.ProjectSource{
+						Zip: &v1alpha2.ZipProjectSource{Location: "file://"},
+					},
+				},
+			},
+			wantErr: []string{"project project1 has an invalid zip source: the location \"file://\" has no file path"},
+		},
+		{
+			name: "Invalid archive Project",
+			projects: []v1alpha2.Project{
+				{
+					Name: "project1",
+					ProjectSource: v1alpha2.ProjectSource{
+						Archive: &v1alpha2.ArchiveProjectSource{Location: "file:///tmp/project.tar.xz"},
+					},
+				},
+			},
+			wantErr: []string{"project project1 has an invalid archive source: the sha256 checksum is required"},
+		},
 		{
 			name: "Invalid Project due to wrong checkout with import source attributes",
 			projects: []v1alpha2.Project{
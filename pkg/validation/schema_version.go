@@ -0,0 +1,208 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/apis/workspaces/versions"
+	"github.com/devfile/api/v2/pkg/devfile"
+	"github.com/hashicorp/go-multierror"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+const (
+	// MinSchemaVersion is the oldest devfile schema version supported by this library
+	MinSchemaVersion = versions.Min
+	// LatestSchemaVersion is the latest devfile schema version supported by this library.
+	// Its patch versions and pre-releases are supported too.
+	LatestSchemaVersion = versions.LatestRelease
+)
+
+// Feature is a devfile feature, which can only be used from a given schema version
+type Feature struct {
+	// Name is the name of the feature, such as `deploy command group`,
+	// or `<GO type>.<Json field name>`, such as `Container.cpuLimit`, for the fields annotated with the `+devfile:since` marker
+	Name string
+	// Since is the first schema version that supports the feature
+	Since string
+	// usedAt returns the paths of the elements of the devfile that use the feature.
+	// It is not set for the field features, whose uses are found by walking the devfile.
+	usedAt func(devfile *v1alpha2.Devfile) []string
+	// isField is true for the features of the fields annotated with the `+devfile:since` marker
+	isField bool
+}
+
+// features are the devfile features introduced after the first supported schema version that are not carried by a field,
+// in introduction order
+var features = []Feature{
+	{
+		Name:  "deploy command group",
+		Since: "2.2.0",
+		usedAt: func(devfile *v1alpha2.Devfile) (paths []string) {
+			for _, command := range devfile.Commands {
+				if group := getGroup(command); group != nil && group.Kind == v1alpha2.DeployCommandGroupKind {
+					paths = append(paths, fmt.Sprintf("commands[%s]", command.Id))
+				}
+			}
+			return paths
+		},
+	},
+}
+
+// fieldsSince contains the schema versions in which the fields annotated with the `+devfile:since` marker were introduced,
+// indexed by the GO package and the name of the GO type of the fields, then by the Json name of the fields
+var fieldsSince = map[string]map[string]map[string]string{
+	reflect.TypeOf(v1alpha2.Devfile{}).PkgPath():      v1alpha2.FieldsSince(),
+	reflect.TypeOf(devfile.DevfileHeader{}).PkgPath(): devfile.FieldsSince(),
+}
+
+// Features returns the devfile features introduced after the first supported schema version:
+// the fields annotated with the `+devfile:since` marker, then the other features,
+// each sorted by schema version
+func Features() []Feature {
+	var fieldFeatures []Feature
+	for _, types := range fieldsSince {
+		for typeName, fields := range types {
+			for jsonName, since := range fields {
+				fieldFeatures = append(fieldFeatures, Feature{Name: typeName + "." + jsonName, Since: since, isField: true})
+			}
+		}
+	}
+	sort.Slice(fieldFeatures, func(i, j int) bool {
+		sinceI, sinceJ := version.MustParseSemantic(fieldFeatures[i].Since), version.MustParseSemantic(fieldFeatures[j].Since)
+		if sinceI.LessThan(sinceJ) || sinceJ.LessThan(sinceI) {
+			return sinceI.LessThan(sinceJ)
+		}
+		return fieldFeatures[i].Name < fieldFeatures[j].Name
+	})
+	return append(fieldFeatures, features...)
+}
+
+// SupportedFeatures returns the features that can be used in a devfile with the given schema version
+func SupportedFeatures(schemaVersion string) ([]Feature, error) {
+	parsed, err := parseSchemaVersion(schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	var supported []Feature
+	for _, feature := range Features() {
+		if feature.supportedBy(parsed) {
+			supported = append(supported, feature)
+		}
+	}
+	return supported, nil
+}
+
+func (f Feature) supportedBy(schemaVersion *version.Version) bool {
+	// the pre-releases of a schema version already support its features
+	return schemaVersion.WithPreRelease("").AtLeast(version.MustParseSemantic(f.Since))
+}
+
+// ValidateSchemaVersion validates that the schema version is a semver-compatible version,
+// between MinSchemaVersion and the patch versions of LatestSchemaVersion
+func ValidateSchemaVersion(schemaVersion string) error {
+	_, err := parseSchemaVersion(schemaVersion)
+	return err
+}
+
+// ValidateFeatures validates that the devfile only uses the features supported by its schema version.
+// It returns an error for each use of a feature introduced after the schema version,
+// and no error if the schema version is invalid, which is reported by ValidateSchemaVersion.
+func ValidateFeatures(devfile *v1alpha2.Devfile) (returnedErr error) {
+	parsed, err := parseSchemaVersion(devfile.SchemaVersion)
+	if err != nil {
+		return nil
+	}
+	walkUsedFields(reflect.ValueOf(devfile), "", func(structType reflect.Type, jsonName, path string) {
+		since, isGated := fieldsSince[structType.PkgPath()][structType.Name()][jsonName]
+		if !isGated {
+			return
+		}
+		if feature := (Feature{Name: structType.Name() + "." + jsonName, Since: since, isField: true}); !feature.supportedBy(parsed) {
+			returnedErr = multierror.Append(returnedErr, &UnsupportedFeatureError{feature: feature, schemaVersion: devfile.SchemaVersion, path: path})
+		}
+	})
+	for _, feature := range features {
+		if feature.supportedBy(parsed) {
+			continue
+		}
+		for _, path := range feature.usedAt(devfile) {
+			returnedErr = multierror.Append(returnedErr, &UnsupportedFeatureError{feature: feature, schemaVersion: devfile.SchemaVersion, path: path})
+		}
+	}
+	return returnedErr
+}
+
+// walkUsedFields calls visit for each field of the given value, and of the values it contains, which is set,
+// with the GO type of the struct of the field, the Json name of the field, and the path of the field in the devfile.
+// The elements of lists are identified by their key, or else by their index.
+func walkUsedFields(value reflect.Value, path string, visit func(structType reflect.Type, jsonName, path string)) {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !value.IsNil() {
+			walkUsedFields(value.Elem(), path, visit)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			element := value.Index(i)
+			key := strconv.Itoa(i)
+			if keyed, isKeyed := element.Interface().(v1alpha2.Keyed); isKeyed {
+				key = keyed.Key()
+			}
+			walkUsedFields(element, fmt.Sprintf("%s[%s]", path, key), visit)
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.PkgPath != "" {
+				// unexported field
+				continue
+			}
+			fieldValue := value.Field(i)
+			jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+			if jsonName == "" && field.Anonymous {
+				// inlined struct
+				walkUsedFields(fieldValue, path, visit)
+				continue
+			}
+			if jsonName == "" || jsonName == "-" || isEmptyValue(fieldValue) {
+				continue
+			}
+			fieldPath := jsonName
+			if path != "" {
+				fieldPath = path + "." + jsonName
+			}
+			visit(value.Type(), jsonName, fieldPath)
+			walkUsedFields(fieldValue, fieldPath, visit)
+		}
+	}
+}
+
+// isEmptyValue returns true if the value would be omitted by an `omitempty` Json tag, or is a zero struct
+func isEmptyValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.String:
+		return value.Len() == 0
+	}
+	return value.IsZero()
+}
+
+// parseSchemaVersion parses the schema version, and checks that it is supported
+func parseSchemaVersion(schemaVersion string) (*version.Version, error) {
+	parsed, err := version.ParseSemantic(schemaVersion)
+	if err != nil || !semverRegexp.MatchString(schemaVersion) {
+		return nil, &InvalidSchemaVersionError{schemaVersion: schemaVersion, reason: "it is not a semver-compatible version"}
+	}
+	latest := version.MustParseSemantic(LatestSchemaVersion)
+	// the lowest version above the patch versions of the latest version, including its pre-releases
+	upperBound := latest.WithMinor(latest.Minor() + 1).WithPatch(0).WithPreRelease("0")
+	if parsed.LessThan(version.MustParseSemantic(MinSchemaVersion)) || !parsed.LessThan(upperBound) {
+		return nil, &InvalidSchemaVersionError{schemaVersion: schemaVersion,
+			reason: fmt.Sprintf("the supported schema versions are %s to %d.%d.x", MinSchemaVersion, latest.Major(), latest.Minor())}
+	}
+	return parsed, nil
+}
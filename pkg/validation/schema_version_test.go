@@ -0,0 +1,174 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/api/v2/pkg/devfile"
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSchemaVersion(t *testing.T) {
+	tests := []struct {
+		schemaVersion string
+		wantErr       string
+	}{
+		{schemaVersion: "2.0.0"},
+		{schemaVersion: "2.1.0"},
+		{schemaVersion: "2.2.1"},
+		{schemaVersion: "2.2.0-alpha"},
+		{schemaVersion: "2.2", wantErr: `the schema version "2.2" is invalid - it is not a semver-compatible version`},
+		{schemaVersion: "v2.2.0", wantErr: `the schema version "v2.2.0" is invalid - it is not a semver-compatible version`},
+		{schemaVersion: "1.0.0", wantErr: `the schema version "1.0.0" is invalid - the supported schema versions are 2.0.0 to 2.2.x`},
+		{schemaVersion: "2.3.0-alpha", wantErr: `the schema version "2.3.0-alpha" is invalid - the supported schema versions are 2.0.0 to 2.2.x`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.schemaVersion, func(t *testing.T) {
+			err := ValidateSchemaVersion(tt.schemaVersion)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Equal(t, "schema-version", RuleID(err), "Error rule should match")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSupportedFeatures(t *testing.T) {
+	featureNames := func(schemaVersion string) []string {
+		supported, err := SupportedFeatures(schemaVersion)
+		assert.NoError(t, err)
+		var names []string
+		for _, feature := range supported {
+			names = append(names, feature.Name)
+		}
+		return names
+	}
+
+	assert.Empty(t, featureNames("2.0.0"))
+	assert.Equal(t, []string{
+		"DevWorkspaceTemplateSpecContent.attributes",
+		"DevWorkspaceTemplateSpecContent.variables",
+		"ParentOverrides.attributes",
+		"ParentOverrides.variables",
+	}, featureNames("2.1.0"))
+	assert.Len(t, featureNames("2.2.0-alpha"), len(Features()), "Pre-releases should support the features of their version")
+
+	_, err := SupportedFeatures("3.0.0")
+	assert.Error(t, err)
+}
+
+func TestValidateFeatures(t *testing.T) {
+	memoryRequest := "1Gi"
+	devfileWithFeatures := func(schemaVersion string) *v1alpha2.Devfile {
+		return &v1alpha2.Devfile{
+			DevfileHeader: devfile.DevfileHeader{
+				SchemaVersion: schemaVersion,
+				Metadata:      devfile.DevfileMetadata{Provider: "Red Hat"},
+			},
+			DevWorkspaceTemplateSpec: v1alpha2.DevWorkspaceTemplateSpec{
+				Parent: &v1alpha2.Parent{
+					ParentOverrides: v1alpha2.ParentOverrides{
+						Components: []v1alpha2.ComponentParentOverride{
+							{
+								Name: "runtime",
+								ComponentUnionParentOverride: v1alpha2.ComponentUnionParentOverride{
+									Container: &v1alpha2.ContainerComponentParentOverride{
+										ContainerParentOverride: v1alpha2.ContainerParentOverride{MemoryRequest: &memoryRequest},
+									},
+								},
+							},
+						},
+					},
+				},
+				DevWorkspaceTemplateSpecContent: v1alpha2.DevWorkspaceTemplateSpecContent{
+					Variables:  map[string]string{"version": "14"},
+					Attributes: attributes.Attributes{}.PutString("owner", "team"),
+					Components: []v1alpha2.Component{
+						{
+							Name: "runtime",
+							ComponentUnion: v1alpha2.ComponentUnion{
+								Container: &v1alpha2.ContainerComponent{
+									Container: v1alpha2.Container{Image: "node", CpuLimit: "1"},
+								},
+							},
+						},
+						{
+							Name: "build-image",
+							ComponentUnion: v1alpha2.ComponentUnion{
+								Image: &v1alpha2.ImageComponent{},
+							},
+						},
+					},
+					Commands: []v1alpha2.Command{
+						{
+							Id: "deploy",
+							CommandUnion: v1alpha2.CommandUnion{
+								Apply: &v1alpha2.ApplyCommand{
+									LabeledCommand: v1alpha2.LabeledCommand{
+										BaseCommand: v1alpha2.BaseCommand{
+											Group: &v1alpha2.CommandGroup{Kind: v1alpha2.DeployCommandGroupKind},
+										},
+									},
+									Component: "build-image",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		schemaVersion string
+		wantErr       []string
+	}{
+		{
+			schemaVersion: "2.0.0",
+			wantErr: []string{
+				"metadata.provider uses the DevfileMetadata.provider field, which requires the schema version 2.2.0, but the schema version is 2.0.0",
+				"parent.components\\[runtime\\].container.memoryRequest uses the ContainerParentOverride.memoryRequest field.*",
+				"variables uses the DevWorkspaceTemplateSpecContent.variables field, which requires the schema version 2.1.0, but the schema version is 2.0.0",
+				"attributes uses the DevWorkspaceTemplateSpecContent.attributes field, which requires the schema version 2.1.0, but the schema version is 2.0.0",
+				"components\\[runtime\\].container.cpuLimit uses the Container.cpuLimit field.*",
+				"components\\[build-image\\].image uses the ComponentUnion.image field, which requires the schema version 2.2.0.*",
+				"commands\\[deploy\\] uses the deploy command group feature.*",
+			},
+		},
+		{
+			schemaVersion: "2.1.0",
+			wantErr: []string{
+				"metadata.provider uses the DevfileMetadata.provider field.*",
+				"parent.components\\[runtime\\].container.memoryRequest uses the ContainerParentOverride.memoryRequest field.*",
+				"components\\[runtime\\].container.cpuLimit uses the Container.cpuLimit field.*",
+				"components\\[build-image\\].image uses the ComponentUnion.image field, which requires the schema version 2.2.0, but the schema version is 2.1.0",
+				"commands\\[deploy\\] uses the deploy command group feature.*",
+			},
+		},
+		{
+			schemaVersion: "2.2.0",
+		},
+		{
+			schemaVersion: "invalid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.schemaVersion, func(t *testing.T) {
+			err := ValidateFeatures(devfileWithFeatures(tt.schemaVersion))
+
+			if merr, ok := err.(*multierror.Error); ok && tt.wantErr != nil {
+				if assert.Equal(t, len(tt.wantErr), len(merr.Errors), "Error list length should match") {
+					for i := 0; i < len(merr.Errors); i++ {
+						assert.Regexp(t, tt.wantErr[i], merr.Errors[i].Error(), "Error message should match")
+					}
+				}
+			} else {
+				assert.Equal(t, nil, err, "Error should be nil")
+			}
+		})
+	}
+}
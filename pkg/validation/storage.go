@@ -0,0 +1,25 @@
+package validation
+
+import (
+	"fmt"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+)
+
+// ValidateStorageStrategy validates that the storage strategy of the devworkspace, selected by the `storageStrategy` field,
+// is one of the values of the `StorageStrategy` enum.
+// When the field is not set, an unknown value of the legacy `controller.devfile.io/storage-type` attribute
+// is reported as a warning, since the default storage strategy is used instead.
+func ValidateStorageStrategy(content *v1alpha2.DevWorkspaceTemplateSpecContent) error {
+	if content.StorageStrategy != nil {
+		if !content.StorageStrategy.IsValid() {
+			return &InvalidStorageStrategyError{reason: fmt.Sprintf("%q is not one of: %s, %s, %s", *content.StorageStrategy,
+				v1alpha2.PerWorkspaceStorageStrategy, v1alpha2.CommonStorageStrategy, v1alpha2.EphemeralStorageStrategy)}
+		}
+		return nil
+	}
+	if strategy, err := content.ResolveStorageStrategy(); err != nil {
+		return &InvalidStorageTypeAttributeWarning{strategy: strategy, reason: err.Error()}
+	}
+	return nil
+}
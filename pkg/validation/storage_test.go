@@ -0,0 +1,73 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateStorageStrategy(t *testing.T) {
+	common := v1alpha2.CommonStorageStrategy
+	unknown := v1alpha2.StorageStrategy("per-user")
+
+	tests := []struct {
+		name        string
+		content     v1alpha2.DevWorkspaceTemplateSpecContent
+		wantErr     string
+		wantWarning bool
+		wantPath    string
+	}{
+		{
+			name: "No storage strategy",
+		},
+		{
+			name:    "Valid storage strategy",
+			content: v1alpha2.DevWorkspaceTemplateSpecContent{StorageStrategy: &common},
+		},
+		{
+			name:    "Valid legacy attribute",
+			content: v1alpha2.DevWorkspaceTemplateSpecContent{Attributes: attributes.Attributes{}.PutString(v1alpha2.StorageTypeAttribute, "ephemeral")},
+		},
+		{
+			name:     "Invalid storage strategy",
+			content:  v1alpha2.DevWorkspaceTemplateSpecContent{StorageStrategy: &unknown},
+			wantErr:  `the storage strategy is invalid - "per-user" is not one of: per-workspace, common, ephemeral`,
+			wantPath: "storageStrategy",
+		},
+		{
+			name:    "Legacy per-user attribute",
+			content: v1alpha2.DevWorkspaceTemplateSpecContent{Attributes: attributes.Attributes{}.PutString(v1alpha2.StorageTypeAttribute, "per-user")},
+		},
+		{
+			name:        "Unknown legacy attribute",
+			content:     v1alpha2.DevWorkspaceTemplateSpecContent{Attributes: attributes.Attributes{}.PutString(v1alpha2.StorageTypeAttribute, "async")},
+			wantErr:     `the storage strategy per-workspace is used - attribute "controller.devfile.io/storage-type" is invalid`,
+			wantWarning: true,
+			wantPath:    "attributes.controller.devfile.io/storage-type",
+		},
+		{
+			name: "Storage strategy takes precedence over an invalid legacy attribute",
+			content: v1alpha2.DevWorkspaceTemplateSpecContent{
+				StorageStrategy: &common,
+				Attributes:      attributes.Attributes{}.PutString(v1alpha2.StorageTypeAttribute, "async"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateStorageStrategy(&tt.content)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Equal(t, tt.wantWarning, IsWarning(err))
+				assert.Equal(t, "storage-strategy", RuleID(err))
+				assert.Equal(t, tt.wantPath, ErrorPath(err))
+			}
+		})
+	}
+}
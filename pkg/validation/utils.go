@@ -5,17 +5,18 @@ import (
 	"strings"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/devfile/keys"
 )
 
 // attribute keys for imported and overridden elements
 // the value of those keys is the resource information
 const (
 	// attribute key of the imported element resource information
-	ImportSourceAttribute = "api.devfile.io/imported-from"
+	ImportSourceAttribute = keys.ImportSourceAttribute
 	// attribute key of the parent overridden element resource information
-	ParentOverrideAttribute = "api.devfile.io/parent-override-from"
+	ParentOverrideAttribute = keys.ParentOverrideAttribute
 	// attribute key of the plugin overridden element resource information
-	PluginOverrideAttribute = "api.devfile.io/plugin-override-from"
+	PluginOverrideAttribute = keys.PluginOverrideAttribute
 )
 
 // getCommandsMap iterates through the commands and returns a map of command
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
 )
 
 // InvalidKeysError returns an error for the invalid keys
@@ -15,6 +17,11 @@ func (e *InvalidKeysError) Error() string {
 	return fmt.Sprintf("invalid variable references - %s", strings.Join(e.Keys, ","))
 }
 
+// Unwrap returns `ErrNotFound`, since the invalid keys reference undefined variables
+func (e *InvalidKeysError) Unwrap() error {
+	return devfileerrors.ErrNotFound
+}
+
 // newInvalidKeysError processes the invalid key set and returns an InvalidKeysError if present
 func newInvalidKeysError(keySet map[string]bool) error {
 	var invalidKeysArr []string
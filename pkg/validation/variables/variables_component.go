@@ -45,6 +45,18 @@ func ValidateAndReplaceForComponents(variables map[string]string, components []v
 					componentsWarningMap[components[i].Name] = verr.Keys
 				}
 			}
+		case components[i].Secret != nil:
+			if err = validateAndReplaceForSecretComponent(variables, components[i].Secret); err != nil {
+				if verr, ok := err.(*InvalidKeysError); ok {
+					componentsWarningMap[components[i].Name] = verr.Keys
+				}
+			}
+		case components[i].ConfigMap != nil:
+			if err = validateAndReplaceForConfigMapComponent(variables, components[i].ConfigMap); err != nil {
+				if verr, ok := err.(*InvalidKeysError); ok {
+					componentsWarningMap[components[i].Name] = verr.Keys
+				}
+			}
 		}
 	}
 
@@ -291,3 +303,64 @@ func validateAndReplaceForVolumeComponent(variables map[string]string, volume *v
 
 	return newInvalidKeysError(invalidKeys)
 }
+
+// validateAndReplaceForSecretComponent validates the secret component data for global variable references and replaces them with the variable value
+func validateAndReplaceForSecretComponent(variables map[string]string, secret *v1alpha2.SecretComponent) error {
+
+	if secret == nil {
+		return nil
+	}
+
+	var err error
+	invalidKeys := make(map[string]bool)
+
+	// Validate secret name
+	if secret.SecretName, err = validateAndReplaceDataWithVariable(secret.SecretName, variables); err != nil {
+		checkForInvalidError(invalidKeys, err)
+	}
+
+	validateAndReplaceForConfigurationMount(variables, &secret.ConfigurationMount, invalidKeys)
+
+	return newInvalidKeysError(invalidKeys)
+}
+
+// validateAndReplaceForConfigMapComponent validates the configMap component data for global variable references and replaces them with the variable value
+func validateAndReplaceForConfigMapComponent(variables map[string]string, configMap *v1alpha2.ConfigMapComponent) error {
+
+	if configMap == nil {
+		return nil
+	}
+
+	var err error
+	invalidKeys := make(map[string]bool)
+
+	// Validate configMap name
+	if configMap.ConfigMapName, err = validateAndReplaceDataWithVariable(configMap.ConfigMapName, variables); err != nil {
+		checkForInvalidError(invalidKeys, err)
+	}
+
+	validateAndReplaceForConfigurationMount(variables, &configMap.ConfigurationMount, invalidKeys)
+
+	return newInvalidKeysError(invalidKeys)
+}
+
+// validateAndReplaceForConfigurationMount validates the mount of a secret or configMap component for global variable references,
+// replaces them with the variable value, and records the invalid references in invalidKeys
+func validateAndReplaceForConfigurationMount(variables map[string]string, mount *v1alpha2.ConfigurationMount, invalidKeys map[string]bool) {
+	var err error
+
+	// Validate mount path
+	if mount.MountPath, err = validateAndReplaceDataWithVariable(mount.MountPath, variables); err != nil {
+		checkForInvalidError(invalidKeys, err)
+	}
+
+	// Validate the keys and paths of the items
+	for i := range mount.Items {
+		if mount.Items[i].Key, err = validateAndReplaceDataWithVariable(mount.Items[i].Key, variables); err != nil {
+			checkForInvalidError(invalidKeys, err)
+		}
+		if mount.Items[i].Path, err = validateAndReplaceDataWithVariable(mount.Items[i].Path, variables); err != nil {
+			checkForInvalidError(invalidKeys, err)
+		}
+	}
+}
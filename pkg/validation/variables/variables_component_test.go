@@ -298,6 +298,124 @@ func TestValidateAndReplaceVolumeComponent(t *testing.T) {
 	}
 }
 
+func TestValidateAndReplaceSecretComponent(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		testFile     string
+		outputFile   string
+		variableFile string
+		wantErr      bool
+	}{
+		{
+			name:         "Good Substitution",
+			testFile:     "test-fixtures/components/secret.yaml",
+			outputFile:   "test-fixtures/components/secret-output.yaml",
+			variableFile: "test-fixtures/variables/variables-referenced.yaml",
+			wantErr:      false,
+		},
+		{
+			name:         "Invalid Reference",
+			testFile:     "test-fixtures/components/secret.yaml",
+			outputFile:   "test-fixtures/components/secret.yaml",
+			variableFile: "test-fixtures/variables/variables-notreferenced.yaml",
+			wantErr:      true,
+		},
+		{
+			name:         "Not a secret component",
+			testFile:     "test-fixtures/components/volume.yaml",
+			outputFile:   "test-fixtures/components/volume.yaml",
+			variableFile: "test-fixtures/variables/variables-notreferenced.yaml",
+			wantErr:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSecretComponent := v1alpha2.SecretComponent{}
+			readFileToStruct(t, tt.testFile, &testSecretComponent)
+
+			testVariable := make(map[string]string)
+			readFileToStruct(t, tt.variableFile, &testVariable)
+
+			var err error
+			if reflect.DeepEqual(testSecretComponent, v1alpha2.SecretComponent{}) {
+				err = validateAndReplaceForSecretComponent(testVariable, nil)
+			} else {
+				err = validateAndReplaceForSecretComponent(testVariable, &testSecretComponent)
+			}
+			_, ok := err.(*InvalidKeysError)
+			if tt.wantErr && !ok {
+				t.Errorf("Expected InvalidKeysError error from test but got %+v", err)
+			} else if !tt.wantErr && err != nil {
+				t.Errorf("Got unexpected error: %s", err)
+			} else {
+				expectedSecretComponent := v1alpha2.SecretComponent{}
+				readFileToStruct(t, tt.outputFile, &expectedSecretComponent)
+				assert.Equal(t, expectedSecretComponent, testSecretComponent, "The two values should be the same.")
+			}
+		})
+	}
+}
+
+func TestValidateAndReplaceConfigMapComponent(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		testFile     string
+		outputFile   string
+		variableFile string
+		wantErr      bool
+	}{
+		{
+			name:         "Good Substitution",
+			testFile:     "test-fixtures/components/configmap.yaml",
+			outputFile:   "test-fixtures/components/configmap-output.yaml",
+			variableFile: "test-fixtures/variables/variables-referenced.yaml",
+			wantErr:      false,
+		},
+		{
+			name:         "Invalid Reference",
+			testFile:     "test-fixtures/components/configmap.yaml",
+			outputFile:   "test-fixtures/components/configmap.yaml",
+			variableFile: "test-fixtures/variables/variables-notreferenced.yaml",
+			wantErr:      true,
+		},
+		{
+			name:         "Not a configMap component",
+			testFile:     "test-fixtures/components/volume.yaml",
+			outputFile:   "test-fixtures/components/volume.yaml",
+			variableFile: "test-fixtures/variables/variables-notreferenced.yaml",
+			wantErr:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testConfigMapComponent := v1alpha2.ConfigMapComponent{}
+			readFileToStruct(t, tt.testFile, &testConfigMapComponent)
+
+			testVariable := make(map[string]string)
+			readFileToStruct(t, tt.variableFile, &testVariable)
+
+			var err error
+			if reflect.DeepEqual(testConfigMapComponent, v1alpha2.ConfigMapComponent{}) {
+				err = validateAndReplaceForConfigMapComponent(testVariable, nil)
+			} else {
+				err = validateAndReplaceForConfigMapComponent(testVariable, &testConfigMapComponent)
+			}
+			_, ok := err.(*InvalidKeysError)
+			if tt.wantErr && !ok {
+				t.Errorf("Expected InvalidKeysError error from test but got %+v", err)
+			} else if !tt.wantErr && err != nil {
+				t.Errorf("Got unexpected error: %s", err)
+			} else {
+				expectedConfigMapComponent := v1alpha2.ConfigMapComponent{}
+				readFileToStruct(t, tt.outputFile, &expectedConfigMapComponent)
+				assert.Equal(t, expectedConfigMapComponent, testConfigMapComponent, "The two values should be the same.")
+			}
+		})
+	}
+}
+
 func TestValidateAndReplaceEnv(t *testing.T) {
 
 	tests := []struct {
@@ -84,6 +84,10 @@ func validateandReplaceForProjectSource(variables map[string]string, projectSour
 		if projectSource.Zip.Location, err = validateAndReplaceDataWithVariable(projectSource.Zip.Location, variables); err != nil {
 			checkForInvalidError(invalidKeys, err)
 		}
+	case projectSource.Archive != nil:
+		if projectSource.Archive.Location, err = validateAndReplaceDataWithVariable(projectSource.Archive.Location, variables); err != nil {
+			checkForInvalidError(invalidKeys, err)
+		}
 	case projectSource.Git != nil:
 		gitProject := &projectSource.Git.GitLikeProjectSource
 
@@ -0,0 +1,78 @@
+// Package defaulting provides a reusable mutating admission webhook handler for
+// `DevWorkspace` and `DevWorkspaceTemplate` resources, companion of the validating
+// webhook handler of the `github.com/devfile/api/v2/pkg/webhook/validation` package.
+//
+// The handler:
+// 1. normalizes all the unions of the devworkspace template
+// 2. sets the unset fields to their default values, through the generated `Default()` methods
+// 3. stamps provenance attributes on the devworkspace template
+package defaulting
+
+import (
+	"reflect"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/api/v2/pkg/devfile/keys"
+	"github.com/devfile/api/v2/pkg/utils/unions"
+	"github.com/mitchellh/reflectwalk"
+)
+
+const (
+	// CreatedByAttribute is the attribute key of the name of the user who created the resource
+	CreatedByAttribute = keys.CreatedByAttribute
+	// LastModifiedByAttribute is the attribute key of the name of the user who last created or updated the resource
+	LastModifiedByAttribute = keys.LastModifiedByAttribute
+)
+
+// defaulter is implemented by the API types that have a generated `Default()` method
+type defaulter interface {
+	Default()
+}
+
+type defaultSetter struct {
+}
+
+func (d *defaultSetter) Struct(s reflect.Value) error {
+	if s.CanAddr() {
+		addr := s.Addr()
+		if addr.CanInterface() {
+			i := addr.Interface()
+			if d, ok := i.(defaulter); ok {
+				d.Default()
+			}
+		}
+	}
+	return nil
+}
+func (d *defaultSetter) StructField(reflect.StructField, reflect.Value) error {
+	return nil
+}
+
+// DefaultTemplateSpec normalizes all the unions of the given devworkspace template spec,
+// and then sets all the unset fields to their default values.
+func DefaultTemplateSpec(spec *v1alpha2.DevWorkspaceTemplateSpec) error {
+	if spec == nil {
+		return nil
+	}
+	if err := unions.Normalize(spec); err != nil {
+		return err
+	}
+	return reflectwalk.Walk(spec, &defaultSetter{})
+}
+
+// StampProvenance stamps the provenance attributes of the given devworkspace template spec
+// with the name of the user who is creating or updating it.
+// The `CreatedByAttribute` attribute is only set if it isn't already there.
+func StampProvenance(spec *v1alpha2.DevWorkspaceTemplateSpec, username string) {
+	if spec == nil || username == "" {
+		return
+	}
+	if spec.Attributes == nil {
+		spec.Attributes = attributes.Attributes{}
+	}
+	if !spec.Attributes.Exists(CreatedByAttribute) {
+		spec.Attributes.PutString(CreatedByAttribute, username)
+	}
+	spec.Attributes.PutString(LastModifiedByAttribute, username)
+}
@@ -0,0 +1,124 @@
+package defaulting
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/webhook/internal/review"
+	admissionv1 "k8s.io/api/admission/v1"
+)
+
+const (
+	// DevWorkspaceDefaultingPath is the default path the mutating webhook is served on for `DevWorkspace` resources
+	DevWorkspaceDefaultingPath = "/mutate-devworkspace"
+	// DevWorkspaceTemplateDefaultingPath is the default path the mutating webhook is served on for `DevWorkspaceTemplate` resources
+	DevWorkspaceTemplateDefaultingPath = "/mutate-devworkspacetemplate"
+)
+
+// Registry is implemented by webhook servers on which the defaulting handler can be registered.
+//
+// The controller-runtime `*webhook.Server` (returned by `manager.GetWebhookServer()`) implements it.
+type Registry interface {
+	Register(path string, hook http.Handler)
+}
+
+// RegisterHandlers registers a defaulting handler for `DevWorkspace` and `DevWorkspaceTemplate`
+// resources on the given registry, at the default mutating paths.
+func RegisterHandlers(registry Registry) {
+	handler := NewHandler()
+	registry.Register(DevWorkspaceDefaultingPath, handler)
+	registry.Register(DevWorkspaceTemplateDefaultingPath, handler)
+}
+
+// Handler applies defaults to the v1alpha2 `DevWorkspace` and `DevWorkspaceTemplate` resources
+// contained in admission requests, and returns the corresponding JSON patch.
+//
+// It can be used either directly through the `Default` method, or as an `http.Handler`
+// that serves `admission.k8s.io/v1` `AdmissionReview` requests.
+type Handler struct {
+	// StampProvenance enables stamping the provenance attributes
+	// (`CreatedByAttribute` and `LastModifiedByAttribute`) on the devworkspace template.
+	StampProvenance bool
+}
+
+// NewHandler returns a new defaulting handler, with provenance stamping enabled
+func NewHandler() *Handler {
+	return &Handler{StampProvenance: true}
+}
+
+// Default applies defaults to the resource contained in the given admission request and returns the admission response,
+// which contains a JSON patch if the resource was modified.
+//
+// Deletions, and resources of unknown kinds or versions, are always allowed without modification.
+func (h *Handler) Default(request *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	response := &admissionv1.AdmissionResponse{
+		UID:     request.UID,
+		Allowed: true,
+	}
+	if request.Operation == admissionv1.Delete ||
+		request.Kind.Group != v1alpha2.SchemeGroupVersion.Group ||
+		request.Kind.Version != v1alpha2.SchemeGroupVersion.Version {
+		return response
+	}
+
+	var object interface{}
+	var templateSpec *v1alpha2.DevWorkspaceTemplateSpec
+	switch request.Kind.Kind {
+	case v1alpha2.DevWorkspaceKind:
+		workspace := &v1alpha2.DevWorkspace{}
+		if err := json.Unmarshal(request.Object.Raw, workspace); err != nil {
+			return review.Errored(request, http.StatusBadRequest, err)
+		}
+		object, templateSpec = workspace, &workspace.Spec.Template
+	case v1alpha2.DevWorkspaceTemplateKind:
+		template := &v1alpha2.DevWorkspaceTemplate{}
+		if err := json.Unmarshal(request.Object.Raw, template); err != nil {
+			return review.Errored(request, http.StatusBadRequest, err)
+		}
+		object, templateSpec = template, &template.Spec
+	default:
+		return response
+	}
+
+	original, err := json.Marshal(object)
+	if err != nil {
+		return review.Errored(request, http.StatusInternalServerError, err)
+	}
+
+	if err := DefaultTemplateSpec(templateSpec); err != nil {
+		return review.Errored(request, http.StatusBadRequest, err)
+	}
+	if h.StampProvenance {
+		StampProvenance(templateSpec, request.UserInfo.Username)
+	}
+
+	defaulted, err := json.Marshal(object)
+	if err != nil {
+		return review.Errored(request, http.StatusInternalServerError, err)
+	}
+	// the patch is computed against the raw object, so that the fields the defaulting didn't touch,
+	// including the fields unknown to this version of the API types, are left as they are
+	operations, err := createPatch(request.Object.Raw, original, defaulted)
+	if err != nil {
+		return review.Errored(request, http.StatusInternalServerError, err)
+	}
+	if len(operations) == 0 {
+		return response
+	}
+
+	patch, err := json.Marshal(operations)
+	if err != nil {
+		return review.Errored(request, http.StatusInternalServerError, err)
+	}
+	patchType := admissionv1.PatchTypeJSONPatch
+	response.Patch = patch
+	response.PatchType = &patchType
+	return response
+}
+
+// ServeHTTP implements `http.Handler` by decoding an `AdmissionReview` from the request body,
+// applying defaults to its request, and writing back the `AdmissionReview` with the admission response.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	review.Serve(w, r, h.Default)
+}
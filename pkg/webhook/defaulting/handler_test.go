@@ -0,0 +1,207 @@
+package defaulting
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func generateAdmissionRequest(t *testing.T, operation admissionv1.Operation, gvk metav1.GroupVersionKind, obj interface{}) *admissionv1.AdmissionRequest {
+	raw, err := json.Marshal(obj)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return &admissionv1.AdmissionRequest{
+		UID:       "test-uid",
+		Kind:      gvk,
+		Name:      "test",
+		Operation: operation,
+		UserInfo:  authenticationv1.UserInfo{Username: "user"},
+		Object:    runtime.RawExtension{Raw: raw},
+	}
+}
+
+func TestDefaultTemplateSpec(t *testing.T) {
+	spec := &v1alpha2.DevWorkspaceTemplateSpec{
+		DevWorkspaceTemplateSpecContent: v1alpha2.DevWorkspaceTemplateSpecContent{
+			Components: []v1alpha2.Component{
+				{
+					Name: "tools",
+					ComponentUnion: v1alpha2.ComponentUnion{
+						Container: &v1alpha2.ContainerComponent{
+							Container: v1alpha2.Container{Image: "image"},
+							Endpoints: []v1alpha2.Endpoint{{Name: "http", TargetPort: 8080}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if !assert.NoError(t, DefaultTemplateSpec(spec)) {
+		return
+	}
+	component := spec.Components[0]
+	assert.Equal(t, v1alpha2.ContainerComponentType, component.ComponentType)
+	if assert.NotNil(t, component.Container.DedicatedPod) {
+		assert.False(t, *component.Container.DedicatedPod)
+	}
+	if assert.NotNil(t, component.Container.RunOnDemand) {
+		assert.False(t, *component.Container.RunOnDemand)
+	}
+	if assert.NotNil(t, component.Container.Endpoints[0].Secure) {
+		assert.False(t, *component.Container.Endpoints[0].Secure)
+	}
+}
+
+func TestStampProvenance(t *testing.T) {
+	spec := &v1alpha2.DevWorkspaceTemplateSpec{
+		DevWorkspaceTemplateSpecContent: v1alpha2.DevWorkspaceTemplateSpecContent{
+			Attributes: attributes.Attributes{}.PutString(CreatedByAttribute, "creator"),
+		},
+	}
+	StampProvenance(spec, "user")
+
+	var err error
+	assert.Equal(t, "creator", spec.Attributes.GetString(CreatedByAttribute, &err))
+	assert.Equal(t, "user", spec.Attributes.GetString(LastModifiedByAttribute, &err))
+	assert.NoError(t, err)
+}
+
+func TestHandlerDefault(t *testing.T) {
+	devWorkspaceGVK := metav1.GroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.DevWorkspaceKind))
+	templateGVK := metav1.GroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.DevWorkspaceTemplateKind))
+	container := v1alpha2.Component{
+		Name: "tools",
+		ComponentUnion: v1alpha2.ComponentUnion{
+			Container: &v1alpha2.ContainerComponent{
+				Container: v1alpha2.Container{Image: "image"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		operation       admissionv1.Operation
+		gvk             metav1.GroupVersionKind
+		obj             interface{}
+		stampProvenance bool
+		wantOperations  []jsonPatchOperation
+	}{
+		{
+			name:      "DevWorkspace is defaulted",
+			operation: admissionv1.Create,
+			gvk:       devWorkspaceGVK,
+			obj: &v1alpha2.DevWorkspace{
+				Spec: v1alpha2.DevWorkspaceSpec{
+					Template: v1alpha2.DevWorkspaceTemplateSpec{
+						DevWorkspaceTemplateSpecContent: v1alpha2.DevWorkspaceTemplateSpecContent{
+							Components: []v1alpha2.Component{container},
+						},
+					},
+				},
+			},
+			wantOperations: []jsonPatchOperation{
+				{Operation: "add", Path: "/spec/template/components/0/componentType", Value: json.RawMessage(`"Container"`)},
+				{Operation: "add", Path: "/spec/template/components/0/container/dedicatedPod", Value: json.RawMessage(`false`)},
+				{Operation: "add", Path: "/spec/template/components/0/container/runOnDemand", Value: json.RawMessage(`false`)},
+			},
+		},
+		{
+			name:      "DevWorkspaceTemplate is defaulted and stamped",
+			operation: admissionv1.Update,
+			gvk:       templateGVK,
+			obj: &v1alpha2.DevWorkspaceTemplate{
+				Spec: v1alpha2.DevWorkspaceTemplateSpec{
+					DevWorkspaceTemplateSpecContent: v1alpha2.DevWorkspaceTemplateSpecContent{
+						Components: []v1alpha2.Component{container},
+					},
+				},
+			},
+			stampProvenance: true,
+			wantOperations: []jsonPatchOperation{
+				{Operation: "add", Path: "/spec/attributes", Value: json.RawMessage(`{"api.devfile.io/created-by":"user","api.devfile.io/last-modified-by":"user"}`)},
+				{Operation: "add", Path: "/spec/components/0/componentType", Value: json.RawMessage(`"Container"`)},
+				{Operation: "add", Path: "/spec/components/0/container/dedicatedPod", Value: json.RawMessage(`false`)},
+				{Operation: "add", Path: "/spec/components/0/container/runOnDemand", Value: json.RawMessage(`false`)},
+			},
+		},
+		{
+			name:      "Unknown and untouched fields are not patched",
+			operation: admissionv1.Create,
+			gvk:       devWorkspaceGVK,
+			obj: json.RawMessage(`{
+				"metadata": {"name": "test", "labels": {"app": "test"}},
+				"spec": {
+					"started": true,
+					"unknownField": "kept",
+					"template": {
+						"components": [{"name": "tools", "container": {"image": "image", "futureField": 1, "dedicatedPod": true}}]
+					}
+				}
+			}`),
+			wantOperations: []jsonPatchOperation{
+				{Operation: "add", Path: "/spec/template/components/0/componentType", Value: json.RawMessage(`"Container"`)},
+				{Operation: "add", Path: "/spec/template/components/0/container/runOnDemand", Value: json.RawMessage(`false`)},
+			},
+		},
+		{
+			name:            "Missing spec is added",
+			operation:       admissionv1.Create,
+			gvk:             templateGVK,
+			obj:             json.RawMessage(`{"metadata": {"name": "test"}}`),
+			stampProvenance: true,
+			wantOperations: []jsonPatchOperation{
+				{Operation: "add", Path: "/spec", Value: json.RawMessage(`{"attributes":{"api.devfile.io/created-by":"user","api.devfile.io/last-modified-by":"user"}}`)},
+			},
+		},
+		{
+			name:      "Already defaulted DevWorkspaceTemplate is not patched",
+			operation: admissionv1.Create,
+			gvk:       templateGVK,
+			obj:       &v1alpha2.DevWorkspaceTemplate{},
+		},
+		{
+			name:      "Deletion is not patched",
+			operation: admissionv1.Delete,
+			gvk:       templateGVK,
+			obj:       &v1alpha2.DevWorkspaceTemplate{},
+		},
+		{
+			name:      "v1alpha1 resource is not patched",
+			operation: admissionv1.Create,
+			gvk:       metav1.GroupVersionKind{Group: v1alpha2.SchemeGroupVersion.Group, Version: "v1alpha1", Kind: v1alpha2.DevWorkspaceKind},
+			obj:       map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := generateAdmissionRequest(t, tt.operation, tt.gvk, tt.obj)
+			handler := &Handler{StampProvenance: tt.stampProvenance}
+			response := handler.Default(request)
+
+			assert.Equal(t, request.UID, response.UID)
+			assert.True(t, response.Allowed)
+			if len(tt.wantOperations) == 0 {
+				assert.Nil(t, response.Patch)
+				return
+			}
+			if !assert.NotNil(t, response.PatchType) {
+				return
+			}
+			assert.Equal(t, admissionv1.PatchTypeJSONPatch, *response.PatchType)
+
+			var operations []jsonPatchOperation
+			if assert.NoError(t, json.Unmarshal(response.Patch, &operations)) {
+				assert.Equal(t, tt.wantOperations, operations)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package defaulting
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonPatchOperation is a single operation of a JSON patch (RFC 6902)
+type jsonPatchOperation struct {
+	Operation string          `json:"op"`
+	Path      string          `json:"path"`
+	Value     json.RawMessage `json:"value,omitempty"`
+}
+
+// jsonValue is a generic Json value, along with whether it is present at all in its parent object
+type jsonValue struct {
+	value   interface{}
+	present bool
+}
+
+// createPatch returns the JSON patch operations that apply to the raw object of an admission request
+// the changes made by the defaulting, given the object as decoded before the defaulting and the defaulted object.
+//
+// Only the values changed by the defaulting are patched: the values of the raw object that the defaulting didn't touch,
+// including the fields unknown to the API types, are left as they are.
+func createPatch(raw, original, defaulted []byte) ([]jsonPatchOperation, error) {
+	var rawValue, originalValue, defaultedValue interface{}
+	for _, decoded := range []struct {
+		data  []byte
+		value *interface{}
+	}{{raw, &rawValue}, {original, &originalValue}, {defaulted, &defaultedValue}} {
+		if err := json.Unmarshal(decoded.data, decoded.value); err != nil {
+			return nil, err
+		}
+	}
+	var operations []jsonPatchOperation
+	err := diffValues(&operations, "", jsonValue{rawValue, true}, jsonValue{originalValue, true}, jsonValue{defaultedValue, true})
+	return operations, err
+}
+
+// diffValues appends to the given operations the operations that apply to the raw value at the given path
+// the changes between the original and defaulted values
+func diffValues(operations *[]jsonPatchOperation, path string, raw, original, defaulted jsonValue) error {
+	if original.present == defaulted.present && reflect.DeepEqual(original.value, defaulted.value) {
+		return nil
+	}
+	if !defaulted.present {
+		if raw.present {
+			*operations = append(*operations, jsonPatchOperation{Operation: "remove", Path: path})
+		}
+		return nil
+	}
+	if !raw.present {
+		return appendValueOperation(operations, "add", path, defaulted.value)
+	}
+
+	rawObject, rawIsObject := raw.value.(map[string]interface{})
+	defaultedObject, defaultedIsObject := defaulted.value.(map[string]interface{})
+	if rawIsObject && defaultedIsObject {
+		originalObject, _ := original.value.(map[string]interface{})
+		keys := make([]string, 0, len(defaultedObject)+len(originalObject))
+		for key := range defaultedObject {
+			keys = append(keys, key)
+		}
+		for key := range originalObject {
+			if _, isDefaulted := defaultedObject[key]; !isDefaulted {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			rawField, rawHasField := rawObject[key]
+			originalField, originalHasField := originalObject[key]
+			defaultedField, defaultedHasField := defaultedObject[key]
+			if err := diffValues(operations, path+"/"+escapePointerToken(key),
+				jsonValue{rawField, rawHasField}, jsonValue{originalField, originalHasField}, jsonValue{defaultedField, defaultedHasField}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	rawList, rawIsList := raw.value.([]interface{})
+	originalList, originalIsList := original.value.([]interface{})
+	defaultedList, defaultedIsList := defaulted.value.([]interface{})
+	if rawIsList && originalIsList && defaultedIsList && len(rawList) == len(defaultedList) && len(originalList) == len(defaultedList) {
+		for i := range defaultedList {
+			if err := diffValues(operations, path+"/"+strconv.Itoa(i),
+				jsonValue{rawList[i], true}, jsonValue{originalList[i], true}, jsonValue{defaultedList[i], true}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return appendValueOperation(operations, "replace", path, defaulted.value)
+}
+
+// appendValueOperation appends to the given operations the operation that sets the value at the given path
+func appendValueOperation(operations *[]jsonPatchOperation, operation, path string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	*operations = append(*operations, jsonPatchOperation{Operation: operation, Path: path, Value: data})
+	return nil
+}
+
+// escapePointerToken escapes the given object key as a JSON pointer (RFC 6901) reference token
+func escapePointerToken(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
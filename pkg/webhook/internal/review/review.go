@@ -0,0 +1,68 @@
+// Package review contains the admission review plumbing shared by the webhook handlers.
+package review
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AdmitFunc returns the admission response for the given admission request
+type AdmitFunc func(request *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse
+
+// Serve decodes an `admission.k8s.io/v1` `AdmissionReview` from the body of the given HTTP request,
+// calls the admit function on its request, and writes back the `AdmissionReview` with the admission response.
+func Serve(w http.ResponseWriter, r *http.Request, admit AdmitFunc) {
+	contentType := r.Header.Get("Content-Type")
+	if mediaType, _, err := mime.ParseMediaType(contentType); err != nil || mediaType != "application/json" {
+		http.Error(w, fmt.Sprintf("invalid content type %q, expected application/json", contentType), http.StatusBadRequest)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("unable to read the request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	review := admissionv1.AdmissionReview{}
+	if err := json.Unmarshal(body, &review); err != nil {
+		http.Error(w, fmt.Sprintf("unable to decode the admission review: %v", err), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(w, "the admission review doesn't contain any request", http.StatusBadRequest)
+		return
+	}
+
+	response := admissionv1.AdmissionReview{
+		TypeMeta: review.TypeMeta,
+		Response: admit(review.Request),
+	}
+	if response.APIVersion == "" {
+		response.SetGroupVersionKind(admissionv1.SchemeGroupVersion.WithKind("AdmissionReview"))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, fmt.Sprintf("unable to encode the admission review: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// Errored returns an admission response that rejects the given request because of the given error
+func Errored(request *admissionv1.AdmissionRequest, code int32, err error) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		UID:     request.UID,
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    code,
+			Message: err.Error(),
+		},
+	}
+}
@@ -0,0 +1,161 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha1"
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/webhook/internal/review"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DevWorkspaceValidationPath is the default path the validating webhook is served on for `DevWorkspace` resources
+	DevWorkspaceValidationPath = "/validate-devworkspace"
+	// DevWorkspaceTemplateValidationPath is the default path the validating webhook is served on for `DevWorkspaceTemplate` resources
+	DevWorkspaceTemplateValidationPath = "/validate-devworkspacetemplate"
+)
+
+// Registry is implemented by webhook servers on which the validation handler can be registered.
+//
+// The controller-runtime `*webhook.Server` (returned by `manager.GetWebhookServer()`) implements it.
+type Registry interface {
+	Register(path string, hook http.Handler)
+}
+
+// RegisterHandlers registers a validation handler for `DevWorkspace` and `DevWorkspaceTemplate`
+// resources on the given registry, at the default validation paths.
+func RegisterHandlers(registry Registry) {
+	handler := NewHandler()
+	registry.Register(DevWorkspaceValidationPath, handler)
+	registry.Register(DevWorkspaceTemplateValidationPath, handler)
+}
+
+// Handler validates the `DevWorkspace` and `DevWorkspaceTemplate` resources
+// contained in admission requests.
+//
+// It can be used either directly through the `Validate` method, or as an `http.Handler`
+// that serves `admission.k8s.io/v1` `AdmissionReview` requests.
+type Handler struct{}
+
+// NewHandler returns a new validation handler
+func NewHandler() *Handler {
+	return &Handler{}
+}
+
+// Validate validates the resource contained in the given admission request and returns the admission response.
+//
+// Deletions and resources of unknown kinds are always allowed.
+func (h *Handler) Validate(request *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if request.Operation == admissionv1.Delete {
+		return allowed(request, nil)
+	}
+
+	spec, fieldPath, err := decodeTemplateSpec(request)
+	if err != nil {
+		return review.Errored(request, http.StatusBadRequest, err)
+	}
+	if spec == nil {
+		return allowed(request, nil)
+	}
+
+	causes, warnings := ValidateTemplateSpec(spec, fieldPath)
+	if len(causes) > 0 {
+		return denied(request, causes, warnings)
+	}
+	return allowed(request, warnings)
+}
+
+// ServeHTTP implements `http.Handler` by decoding an `AdmissionReview` from the request body,
+// validating its request, and writing back the `AdmissionReview` with the admission response.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	review.Serve(w, r, h.Validate)
+}
+
+// decodeTemplateSpec decodes the resource contained in the admission request, and returns its devworkspace
+// template spec, as well as the field path of the template spec inside the resource.
+// v1alpha1 resources are converted to v1alpha2 before being returned.
+// It returns a nil spec if the resource is not a `DevWorkspace` or a `DevWorkspaceTemplate`.
+func decodeTemplateSpec(request *admissionv1.AdmissionRequest) (*v1alpha2.DevWorkspaceTemplateSpec, string, error) {
+	if request.Kind.Group != v1alpha2.SchemeGroupVersion.Group {
+		return nil, "", nil
+	}
+
+	switch request.Kind.Version {
+	case v1alpha2.SchemeGroupVersion.Version:
+		switch request.Kind.Kind {
+		case v1alpha2.DevWorkspaceKind:
+			workspace := &v1alpha2.DevWorkspace{}
+			if err := json.Unmarshal(request.Object.Raw, workspace); err != nil {
+				return nil, "", err
+			}
+			return &workspace.Spec.Template, "spec.template", nil
+		case v1alpha2.DevWorkspaceTemplateKind:
+			template := &v1alpha2.DevWorkspaceTemplate{}
+			if err := json.Unmarshal(request.Object.Raw, template); err != nil {
+				return nil, "", err
+			}
+			return &template.Spec, "spec", nil
+		}
+	case v1alpha1.SchemeGroupVersion.Version:
+		switch request.Kind.Kind {
+		case v1alpha2.DevWorkspaceKind:
+			workspace := &v1alpha1.DevWorkspace{}
+			if err := json.Unmarshal(request.Object.Raw, workspace); err != nil {
+				return nil, "", err
+			}
+			converted := &v1alpha2.DevWorkspace{}
+			if err := workspace.ConvertTo(converted); err != nil {
+				return nil, "", err
+			}
+			return &converted.Spec.Template, "spec.template", nil
+		case v1alpha2.DevWorkspaceTemplateKind:
+			template := &v1alpha1.DevWorkspaceTemplate{}
+			if err := json.Unmarshal(request.Object.Raw, template); err != nil {
+				return nil, "", err
+			}
+			converted := &v1alpha2.DevWorkspaceTemplate{}
+			if err := template.ConvertTo(converted); err != nil {
+				return nil, "", err
+			}
+			return &converted.Spec, "spec", nil
+		}
+	}
+	return nil, "", nil
+}
+
+func allowed(request *admissionv1.AdmissionRequest, warnings []string) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		UID:      request.UID,
+		Allowed:  true,
+		Warnings: warnings,
+	}
+}
+
+func denied(request *admissionv1.AdmissionRequest, causes []metav1.StatusCause, warnings []string) *admissionv1.AdmissionResponse {
+	messages := make([]string, 0, len(causes))
+	for _, cause := range causes {
+		messages = append(messages, fmt.Sprintf("%s: %s", cause.Field, cause.Message))
+	}
+	return &admissionv1.AdmissionResponse{
+		UID:     request.UID,
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusUnprocessableEntity,
+			Reason:  metav1.StatusReasonInvalid,
+			Message: fmt.Sprintf("%s %q is invalid: %s", request.Kind.Kind, request.Name, strings.Join(messages, "; ")),
+			Details: &metav1.StatusDetails{
+				Name:   request.Name,
+				Group:  request.Kind.Group,
+				Kind:   request.Kind.Kind,
+				Causes: causes,
+			},
+		},
+		Warnings: warnings,
+	}
+}
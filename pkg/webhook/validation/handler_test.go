@@ -0,0 +1,275 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func generateDummyContainerComponent(name string) v1alpha2.Component {
+	return v1alpha2.Component{
+		Name: name,
+		ComponentUnion: v1alpha2.ComponentUnion{
+			Container: &v1alpha2.ContainerComponent{
+				Container: v1alpha2.Container{
+					Image: "image",
+				},
+			},
+		},
+	}
+}
+
+func generateDummyExecCommand(id, component string, group *v1alpha2.CommandGroup) v1alpha2.Command {
+	return v1alpha2.Command{
+		Id: id,
+		CommandUnion: v1alpha2.CommandUnion{
+			Exec: &v1alpha2.ExecCommand{
+				LabeledCommand: v1alpha2.LabeledCommand{
+					BaseCommand: v1alpha2.BaseCommand{
+						Group: group,
+					},
+				},
+				CommandLine: "echo",
+				Component:   component,
+			},
+		},
+	}
+}
+
+func generateAdmissionRequest(t *testing.T, operation admissionv1.Operation, kind string, obj interface{}) *admissionv1.AdmissionRequest {
+	raw, err := json.Marshal(obj)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return &admissionv1.AdmissionRequest{
+		UID:       "test-uid",
+		Kind:      metav1.GroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind(kind)),
+		Name:      "test",
+		Operation: operation,
+		Object:    runtime.RawExtension{Raw: raw},
+	}
+}
+
+func TestHandlerValidate(t *testing.T) {
+	buildGroup := &v1alpha2.CommandGroup{Kind: v1alpha2.BuildCommandGroupKind}
+
+	tests := []struct {
+		name          string
+		operation     admissionv1.Operation
+		kind          string
+		obj           interface{}
+		wantAllowed   bool
+		wantFields    []string
+		wantWarnings  int
+		wantErrorCode int32
+	}{
+		{
+			name:      "Valid DevWorkspace",
+			operation: admissionv1.Create,
+			kind:      v1alpha2.DevWorkspaceKind,
+			obj: &v1alpha2.DevWorkspace{
+				Spec: v1alpha2.DevWorkspaceSpec{
+					Template: v1alpha2.DevWorkspaceTemplateSpec{
+						DevWorkspaceTemplateSpecContent: v1alpha2.DevWorkspaceTemplateSpecContent{
+							Components: []v1alpha2.Component{generateDummyContainerComponent("tools")},
+							Commands:   []v1alpha2.Command{generateDummyExecCommand("build", "tools", nil)},
+						},
+					},
+				},
+			},
+			wantAllowed: true,
+		},
+		{
+			name:      "Invalid DevWorkspace command",
+			operation: admissionv1.Update,
+			kind:      v1alpha2.DevWorkspaceKind,
+			obj: &v1alpha2.DevWorkspace{
+				Spec: v1alpha2.DevWorkspaceSpec{
+					Template: v1alpha2.DevWorkspaceTemplateSpec{
+						DevWorkspaceTemplateSpecContent: v1alpha2.DevWorkspaceTemplateSpecContent{
+							Components: []v1alpha2.Component{generateDummyContainerComponent("tools")},
+							Commands:   []v1alpha2.Command{generateDummyExecCommand("build", "unknown", nil)},
+						},
+					},
+				},
+			},
+			wantAllowed: false,
+			wantFields:  []string{"spec.template.commands"},
+		},
+		{
+			name:      "Invalid DevWorkspaceTemplate with duplicate components",
+			operation: admissionv1.Create,
+			kind:      v1alpha2.DevWorkspaceTemplateKind,
+			obj: &v1alpha2.DevWorkspaceTemplate{
+				Spec: v1alpha2.DevWorkspaceTemplateSpec{
+					DevWorkspaceTemplateSpecContent: v1alpha2.DevWorkspaceTemplateSpecContent{
+						Components: []v1alpha2.Component{
+							generateDummyContainerComponent("tools"),
+							generateDummyContainerComponent("tools"),
+						},
+					},
+				},
+			},
+			wantAllowed: false,
+			wantFields:  []string{"spec.components"},
+		},
+		{
+			name:      "Missing default command is a warning",
+			operation: admissionv1.Create,
+			kind:      v1alpha2.DevWorkspaceTemplateKind,
+			obj: &v1alpha2.DevWorkspaceTemplate{
+				Spec: v1alpha2.DevWorkspaceTemplateSpec{
+					DevWorkspaceTemplateSpecContent: v1alpha2.DevWorkspaceTemplateSpecContent{
+						Components: []v1alpha2.Component{generateDummyContainerComponent("tools")},
+						Commands: []v1alpha2.Command{
+							generateDummyExecCommand("build1", "tools", buildGroup),
+							generateDummyExecCommand("build2", "tools", buildGroup),
+						},
+					},
+				},
+			},
+			wantAllowed:  true,
+			wantWarnings: 1,
+		},
+		{
+			name:      "Invalid attribute key is a warning",
+			operation: admissionv1.Create,
+			kind:      v1alpha2.DevWorkspaceTemplateKind,
+			obj: &v1alpha2.DevWorkspaceTemplate{
+				Spec: v1alpha2.DevWorkspaceTemplateSpec{
+					DevWorkspaceTemplateSpecContent: v1alpha2.DevWorkspaceTemplateSpecContent{
+						Attributes: attributes.Attributes{}.PutString("invalid key", "value"),
+						Components: []v1alpha2.Component{generateDummyContainerComponent("tools")},
+					},
+				},
+			},
+			wantAllowed:  true,
+			wantWarnings: 1,
+		},
+		{
+			name:        "Deletion is always allowed",
+			operation:   admissionv1.Delete,
+			kind:        v1alpha2.DevWorkspaceKind,
+			obj:         map[string]interface{}{},
+			wantAllowed: true,
+		},
+		{
+			name:        "Unknown kind is allowed",
+			operation:   admissionv1.Create,
+			kind:        "Unknown",
+			obj:         map[string]interface{}{},
+			wantAllowed: true,
+		},
+		{
+			name:          "Undecodable object is rejected",
+			operation:     admissionv1.Create,
+			kind:          v1alpha2.DevWorkspaceKind,
+			obj:           map[string]interface{}{"spec": "invalid"},
+			wantAllowed:   false,
+			wantErrorCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := generateAdmissionRequest(t, tt.operation, tt.kind, tt.obj)
+			response := NewHandler().Validate(request)
+
+			assert.Equal(t, request.UID, response.UID)
+			assert.Equal(t, tt.wantAllowed, response.Allowed)
+			assert.Len(t, response.Warnings, tt.wantWarnings)
+			if tt.wantErrorCode != 0 {
+				if assert.NotNil(t, response.Result) {
+					assert.Equal(t, tt.wantErrorCode, response.Result.Code)
+				}
+			}
+			if len(tt.wantFields) > 0 {
+				if assert.NotNil(t, response.Result) && assert.NotNil(t, response.Result.Details) {
+					var fields []string
+					for _, cause := range response.Result.Details.Causes {
+						fields = append(fields, cause.Field)
+					}
+					assert.Equal(t, tt.wantFields, fields)
+				}
+			}
+		})
+	}
+}
+
+func TestHandlerServeHTTP(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		wantCode    int
+	}{
+		{
+			name:        "Json content type",
+			contentType: "application/json",
+			wantCode:    http.StatusOK,
+		},
+		{
+			name:        "Json content type with a charset parameter",
+			contentType: "application/json; charset=utf-8",
+			wantCode:    http.StatusOK,
+		},
+		{
+			name:        "Non-Json content type",
+			contentType: "text/plain",
+			wantCode:    http.StatusBadRequest,
+		},
+		{
+			name:        "Missing content type",
+			contentType: "",
+			wantCode:    http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := generateAdmissionRequest(t, admissionv1.Create, v1alpha2.DevWorkspaceTemplateKind, &v1alpha2.DevWorkspaceTemplate{})
+			review := admissionv1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: admissionv1.SchemeGroupVersion.String(), Kind: "AdmissionReview"},
+				Request:  request,
+			}
+			body, err := json.Marshal(review)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			httpRequest := httptest.NewRequest(http.MethodPost, DevWorkspaceTemplateValidationPath, bytes.NewReader(body))
+			httpRequest.Header.Set("Content-Type", tt.contentType)
+			recorder := httptest.NewRecorder()
+			NewHandler().ServeHTTP(recorder, httpRequest)
+
+			if !assert.Equal(t, tt.wantCode, recorder.Code) || tt.wantCode != http.StatusOK {
+				return
+			}
+			result := admissionv1.AdmissionReview{}
+			if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &result)) && assert.NotNil(t, result.Response) {
+				assert.Equal(t, review.TypeMeta, result.TypeMeta)
+				assert.Equal(t, request.UID, result.Response.UID)
+				assert.True(t, result.Response.Allowed)
+			}
+		})
+	}
+}
+
+type testRegistry map[string]http.Handler
+
+func (r testRegistry) Register(path string, hook http.Handler) {
+	r[path] = hook
+}
+
+func TestRegisterHandlers(t *testing.T) {
+	registry := testRegistry{}
+	RegisterHandlers(registry)
+	assert.Contains(t, registry, DevWorkspaceValidationPath)
+	assert.Contains(t, registry, DevWorkspaceTemplateValidationPath)
+}
@@ -0,0 +1,52 @@
+// Package validation provides a reusable admission webhook handler that validates
+// `DevWorkspace` and `DevWorkspaceTemplate` resources against the semantic validation
+// rules of the `github.com/devfile/api/v2/pkg/validation` package.
+//
+// Denied admission responses contain one status cause per validation error,
+// with the field path of the invalid part of the resource.
+package validation
+
+import (
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValidateTemplateSpec runs the semantic validation rules against the given devworkspace template spec,
+// and returns:
+// 1. a status cause for each validation error, whose field is prefixed with the given field path
+// 2. a warning message for each validation problem that should not prevent admission (such as a missing default command, a volume mounted at different paths, or attributes close to the size limit of the API server)
+//
+// The rules are those of `validation.ValidateContent`, with the default options.
+func ValidateTemplateSpec(spec *v1alpha2.DevWorkspaceTemplateSpec, fieldPath string) (causes []metav1.StatusCause, warnings []string) {
+	if spec == nil {
+		return nil, nil
+	}
+
+	addErrors := func(field string, err error) {
+		for _, e := range validation.FlattenErrors(err) {
+			if validation.IsWarning(e) {
+				warnings = append(warnings, e.Error())
+				continue
+			}
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: e.Error(),
+				Field:   joinFieldPath(fieldPath, field),
+			})
+		}
+	}
+
+	for _, fieldErrors := range validation.ValidateContent(&spec.DevWorkspaceTemplateSpecContent, validation.ContentOptions{}) {
+		addErrors(fieldErrors.Field, fieldErrors.Err)
+	}
+
+	return causes, warnings
+}
+
+func joinFieldPath(parent, field string) string {
+	if parent == "" {
+		return field
+	}
+	return parent + "." + field
+}
@@ -0,0 +1,78 @@
+package schemas
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	devfileerrors "github.com/devfile/api/v2/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSchema(t *testing.T) {
+	latestVersion, err := os.ReadFile(filepath.Join("latest", "jsonSchemaVersion.txt"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	latestSchema, err := os.ReadFile(filepath.Join("latest", "devfile.json"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tests := []struct {
+		name          string
+		version       string
+		expectedError error
+	}{
+		{
+			name:    "Latest version",
+			version: "",
+		},
+		{
+			name:    "Latest folder",
+			version: "latest",
+		},
+		{
+			name:    "Devfile version",
+			version: strings.TrimSpace(string(latestVersion)),
+		},
+		{
+			name:          "Unknown version",
+			version:       "1.0.0",
+			expectedError: devfileerrors.ErrUnsupportedVersion,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := GetSchema(tt.version)
+			if tt.expectedError != nil {
+				assert.True(t, errors.Is(err, tt.expectedError), "unexpected error: %v", err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, latestSchema, schema)
+				assert.True(t, json.Valid(schema))
+			}
+		})
+	}
+}
+
+func TestGetSchemaFile(t *testing.T) {
+	schema, err := GetSchemaFile("", "dev-workspace.json")
+	if assert.NoError(t, err) {
+		assert.True(t, json.Valid(schema))
+	}
+
+	_, err = GetSchemaFile("", "unknown.json")
+	assert.True(t, errors.Is(err, devfileerrors.ErrNotFound), "unexpected error: %v", err)
+}
+
+func TestVersions(t *testing.T) {
+	latestVersion, err := os.ReadFile(filepath.Join("latest", "jsonSchemaVersion.txt"))
+	if assert.NoError(t, err) {
+		assert.Contains(t, Versions(), strings.TrimSpace(string(latestVersion)))
+	}
+}
@@ -17,8 +17,8 @@ func addParentEnv(numEnv int) []schema.EnvVarParentOverride {
 	commandEnvs := make([]schema.EnvVarParentOverride, numEnv)
 	for i := 0; i < numEnv; i++ {
 		commandEnvs[i].Name = "Name_" + GetRandomString(5, false)
-		commandEnvs[i].Value = "Value_" + GetRandomString(5, false)
-		LogInfoMessage(fmt.Sprintf("Add Parent Env: %s", commandEnvs[i]))
+		commandEnvs[i].Value = StringPointer("Value_" + GetRandomString(5, false))
+		LogInfoMessage(fmt.Sprintf("Add Parent Env: %v", commandEnvs[i]))
 	}
 	return commandEnvs
 }
@@ -78,10 +78,10 @@ func (testDevFile *TestDevfile) SetParentExecCommandValues(command *schema.Comma
 	execCommand := command.Exec
 
 	// exec command must be mentioned by a container component
-	execCommand.Component = testDevFile.GetParentContainerName()
+	execCommand.Component = StringPointer(testDevFile.GetParentContainerName())
 
-	execCommand.CommandLine = GetRandomString(4, false) + " " + GetRandomString(4, false)
-	LogInfoMessage(fmt.Sprintf("....... commandLine: %s", execCommand.CommandLine))
+	execCommand.CommandLine = StringPointer(GetRandomString(4, false) + " " + GetRandomString(4, false))
+	LogInfoMessage(fmt.Sprintf("....... commandLine: %s", *execCommand.CommandLine))
 
 	// If group already leave it to make sure defaults are not deleted or added
 	if execCommand.Group == nil {
@@ -91,17 +91,17 @@ func (testDevFile *TestDevfile) SetParentExecCommandValues(command *schema.Comma
 	}
 
 	if GetBinaryDecision() {
-		execCommand.Label = GetRandomString(12, false)
-		LogInfoMessage(fmt.Sprintf("....... label: %s", execCommand.Label))
+		execCommand.Label = StringPointer(GetRandomString(12, false))
+		LogInfoMessage(fmt.Sprintf("....... label: %s", *execCommand.Label))
 	} else {
-		execCommand.Label = ""
+		execCommand.Label = nil
 	}
 
 	if GetBinaryDecision() {
-		execCommand.WorkingDir = "./tmp"
-		LogInfoMessage(fmt.Sprintf("....... WorkingDir: %s", execCommand.WorkingDir))
+		execCommand.WorkingDir = StringPointer("./tmp")
+		LogInfoMessage(fmt.Sprintf("....... WorkingDir: %s", *execCommand.WorkingDir))
 	} else {
-		execCommand.WorkingDir = ""
+		execCommand.WorkingDir = nil
 	}
 
 	value := GetBinaryDecision()
@@ -150,8 +150,8 @@ func (testDevFile *TestDevfile) SetParentCompositeCommandValues(command *schema.
 	}
 
 	if GetBinaryDecision() {
-		compositeCommand.Label = GetRandomString(12, false)
-		LogInfoMessage(fmt.Sprintf("....... label: %s", compositeCommand.Label))
+		compositeCommand.Label = StringPointer(GetRandomString(12, false))
+		LogInfoMessage(fmt.Sprintf("....... label: %s", *compositeCommand.Label))
 	}
 
 	if GetBinaryDecision() {
@@ -178,15 +178,15 @@ func (testDevFile *TestDevfile) createParentApplyCommand() *schema.CommandParent
 func (testDevFile *TestDevfile) SetParentApplyCommandValues(command *schema.CommandParentOverride) {
 	applyCommand := command.Apply
 
-	applyCommand.Component = testDevFile.GetParentContainerName()
+	applyCommand.Component = StringPointer(testDevFile.GetParentContainerName())
 
 	if GetRandomDecision(2, 1) {
 		applyCommand.Group = testDevFile.addParentGroup()
 	}
 
 	if GetBinaryDecision() {
-		applyCommand.Label = GetRandomString(63, false)
-		LogInfoMessage(fmt.Sprintf("....... label: %s", applyCommand.Label))
+		applyCommand.Label = StringPointer(GetRandomString(63, false))
+		LogInfoMessage(fmt.Sprintf("....... label: %s", *applyCommand.Label))
 	}
 
 	LogInfoMessage(fmt.Sprintf("parent command updated Id: %s", command.Id))
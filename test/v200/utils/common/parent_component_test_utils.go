@@ -19,8 +19,8 @@ func (devfile *TestDevfile) addParentVolume(numVols int) []schema.VolumeMountPar
 	for i := 0; i < numVols; i++ {
 		volumeComponent := devfile.AddParentComponent(schema.VolumeComponentType)
 		commandVols[i].Name = volumeComponent.Name
-		commandVols[i].Path = "/Path_" + GetRandomString(5, false)
-		LogInfoMessage(fmt.Sprintf("....... Add Volume: %s", commandVols[i]))
+		commandVols[i].Path = StringPointer("/Path_" + GetRandomString(5, false))
+		LogInfoMessage(fmt.Sprintf("....... Add Volume: %v", commandVols[i]))
 	}
 	return commandVols
 }
@@ -64,7 +64,7 @@ func (devfile *TestDevfile) GetParentContainerName() string {
 
 	if componentName == "" {
 		component := devfile.AddParentComponent(schema.ContainerComponentType)
-		component.Container.Image = GetRandomUniqueString(GetRandomNumber(8, 18), false)
+		component.Container.Image = StringPointer(GetRandomUniqueString(GetRandomNumber(8, 18), false))
 		componentName = component.Name
 		LogInfoMessage(fmt.Sprintf("return new container from GetParentContainerName : %s", componentName))
 	}
@@ -77,7 +77,7 @@ func (devfile *TestDevfile) SetParentContainerComponentValues(component *schema.
 
 	containerComponent := component.Container.ContainerParentOverride
 
-	containerComponent.Image = GetRandomUniqueString(GetRandomNumber(8, 18), false)
+	containerComponent.Image = StringPointer(GetRandomUniqueString(GetRandomNumber(8, 18), false))
 
 	if GetBinaryDecision() {
 		numCommands := GetRandomNumber(1, 3)
@@ -102,8 +102,8 @@ func (devfile *TestDevfile) SetParentContainerComponentValues(component *schema.
 	LogInfoMessage(fmt.Sprintf("....... DedicatedPod: %t", *(containerComponent.DedicatedPod)))
 
 	if GetBinaryDecision() {
-		containerComponent.MemoryLimit = strconv.Itoa(GetRandomNumber(4, 124)) + "M"
-		LogInfoMessage(fmt.Sprintf("....... MemoryLimit: %s", containerComponent.MemoryLimit))
+		containerComponent.MemoryLimit = StringPointer(strconv.Itoa(GetRandomNumber(4, 124)) + "M")
+		LogInfoMessage(fmt.Sprintf("....... MemoryLimit: %s", *containerComponent.MemoryLimit))
 	}
 
 	if GetBinaryDecision() {
@@ -112,8 +112,8 @@ func (devfile *TestDevfile) SetParentContainerComponentValues(component *schema.
 		LogInfoMessage(fmt.Sprintf("....... MountSources: %t", *containerComponent.MountSources))
 
 		if setMountSources {
-			containerComponent.SourceMapping = "/" + GetRandomString(8, false)
-			LogInfoMessage(fmt.Sprintf("....... SourceMapping: %s", containerComponent.SourceMapping))
+			containerComponent.SourceMapping = StringPointer("/" + GetRandomString(8, false))
+			LogInfoMessage(fmt.Sprintf("....... SourceMapping: %s", *containerComponent.SourceMapping))
 		}
 	}
 
@@ -174,8 +174,8 @@ func (devfile *TestDevfile) SetParentK8sComponentValues(component *schema.Compon
 // SetParentVolumeComponentValues randomly sets/updates volume component attributes to random values
 func (devfile *TestDevfile) SetParentVolumeComponentValues(component *schema.ComponentParentOverride) {
 
-	component.Volume.Size = strconv.Itoa(4+GetRandomNumber(64, 256)) + "G"
-	LogInfoMessage(fmt.Sprintf("....... volumeComponent.Size: %s", component.Volume.Size))
+	component.Volume.Size = StringPointer(strconv.Itoa(4+GetRandomNumber(64, 256)) + "G")
+	LogInfoMessage(fmt.Sprintf("....... volumeComponent.Size: %s", *component.Volume.Size))
 	LogInfoMessage(fmt.Sprintf("component updated Name: %s", component.Name))
 
 	value := GetBinaryDecision()
@@ -22,11 +22,11 @@ func (devfile *TestDevfile) CreateParentEndpoints() []schema.EndpointParentOverr
 		LogInfoMessage(fmt.Sprintf("   ....... add endpoint %d name  : %s", i, endpoint.Name))
 
 		if GetBinaryDecision() {
-			endpoint.TargetPort = devfile.getUniquePort()
+			endpoint.TargetPort = IntPointer(devfile.getUniquePort())
 		} else {
-			endpoint.TargetPort = commonPort
+			endpoint.TargetPort = IntPointer(commonPort)
 		}
-		LogInfoMessage(fmt.Sprintf("   ....... add endpoint %d targetPort: %d", i, endpoint.TargetPort))
+		LogInfoMessage(fmt.Sprintf("   ....... add endpoint %d targetPort: %d", i, *endpoint.TargetPort))
 
 		if GetBinaryDecision() {
 			endpoint.Exposure = schema.EndpointExposureParentOverride(getRandomExposure())
@@ -43,8 +43,8 @@ func (devfile *TestDevfile) CreateParentEndpoints() []schema.EndpointParentOverr
 		LogInfoMessage(fmt.Sprintf("   ....... add endpoint %d secure: %t", i, *endpoint.Secure))
 
 		if GetBinaryDecision() {
-			endpoint.Path = "/Path_" + GetRandomString(GetRandomNumber(3, 15), false)
-			LogInfoMessage(fmt.Sprintf("   ....... add endpoint %d path: %s", i, endpoint.Path))
+			endpoint.Path = StringPointer("/Path_" + GetRandomString(GetRandomNumber(3, 15), false))
+			LogInfoMessage(fmt.Sprintf("   ....... add endpoint %d path: %s", i, *endpoint.Path))
 		}
 
 		endpoints[i] = endpoint
@@ -113,8 +113,8 @@ func createParentZipProject() *schema.ZipProjectSourceParentOverride {
 func (testDevfile *TestDevfile) SetParentProjectValues(project *schema.ProjectParentOverride) {
 
 	if GetBinaryDecision() {
-		project.ClonePath = "./" + GetRandomString(GetRandomNumber(4, 12), false)
-		LogInfoMessage(fmt.Sprintf("Set ClonePath : %s", project.ClonePath))
+		project.ClonePath = StringPointer("./" + GetRandomString(GetRandomNumber(4, 12), false))
+		LogInfoMessage(fmt.Sprintf("Set ClonePath : %s", *project.ClonePath))
 	}
 
 	if project.Git != nil {
@@ -131,18 +131,20 @@ func (testDevfile *TestDevfile) SetParentStarterProjectValues(starterProject *sc
 
 	if GetBinaryDecision() {
 		numWords := GetRandomNumber(2, 6)
+		description := ""
 		for i := 0; i < numWords; i++ {
 			if i > 0 {
-				starterProject.Description += " "
+				description += " "
 			}
-			starterProject.Description += GetRandomString(8, false)
+			description += GetRandomString(8, false)
 		}
-		LogInfoMessage(fmt.Sprintf("Set Description : %s", starterProject.Description))
+		starterProject.Description = &description
+		LogInfoMessage(fmt.Sprintf("Set Description : %s", *starterProject.Description))
 	}
 
 	if GetBinaryDecision() {
-		starterProject.SubDir = GetRandomString(12, false)
-		LogInfoMessage(fmt.Sprintf("Set SubDir : %s", starterProject.SubDir))
+		starterProject.SubDir = StringPointer(GetRandomString(12, false))
+		LogInfoMessage(fmt.Sprintf("Set SubDir : %s", *starterProject.SubDir))
 	}
 
 	if starterProject.Git != nil {
@@ -164,9 +166,9 @@ func setParentGitProjectValues(gitProject *schema.GitProjectSourceParentOverride
 			numKey--
 			if numKey <= 0 {
 				gitProject.CheckoutFrom = &schema.CheckoutFromParentOverride{}
-				gitProject.CheckoutFrom.Remote = key
-				gitProject.CheckoutFrom.Revision = GetRandomString(8, false)
-				LogInfoMessage(fmt.Sprintf("set CheckoutFrom remote = %s, and revision = %s", gitProject.CheckoutFrom.Remote, gitProject.CheckoutFrom.Revision))
+				gitProject.CheckoutFrom.Remote = StringPointer(key)
+				gitProject.CheckoutFrom.Revision = StringPointer(GetRandomString(8, false))
+				LogInfoMessage(fmt.Sprintf("set CheckoutFrom remote = %s, and revision = %s", *gitProject.CheckoutFrom.Remote, *gitProject.CheckoutFrom.Revision))
 				break
 			}
 		}
@@ -175,5 +177,5 @@ func setParentGitProjectValues(gitProject *schema.GitProjectSourceParentOverride
 
 // setParentZipProjectValues randomly sets attributes for a Zip Project
 func setParentZipProjectValues(zipProject *schema.ZipProjectSourceParentOverride) {
-	zipProject.Location = GetRandomString(GetRandomNumber(8, 16), false)
+	zipProject.Location = StringPointer(GetRandomString(GetRandomNumber(8, 16), false))
 }
@@ -284,6 +284,16 @@ func GetRandomNumber(min int, max int) int {
 	return rand.Intn(max-min) + min + 1
 }
 
+// StringPointer returns a pointer to the given string, as set in the optional string fields of the override types
+func StringPointer(value string) *string {
+	return &value
+}
+
+// IntPointer returns a pointer to the given integer, as set in the optional integer fields of the override types
+func IntPointer(value int) *int {
+	return &value
+}
+
 // GetDevfile returns a structure used to represent a specific devfile in a test
 func GetDevfile(fileName string, follower DevfileFollower, validator DevfileValidator) (TestDevfile, error) {
 